@@ -2,6 +2,7 @@ package assignments
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/quickfeed/quickfeed/qf"
@@ -23,6 +24,9 @@ type assignmentData struct {
 	ScoreLimit       uint32 `yaml:"scorelimit"`
 	Reviewers        uint32 `yaml:"reviewers"`
 	ContainerTimeout uint32 `yaml:"containertimeout"`
+	Reconcile        string `yaml:"reconcile"`
+	Anonymous        bool   `yaml:"anonymous"`
+	PeerReviews      uint32 `yaml:"peerreviews"`
 }
 
 func newAssignmentFromFile(contents []byte, assignmentName string, courseID uint64) (*qf.Assignment, error) {
@@ -42,6 +46,10 @@ func newAssignmentFromFile(contents []byte, assignmentName string, courseID uint
 	if err != nil {
 		return nil, fmt.Errorf("error parsing deadline: %w", err)
 	}
+	reconcilePolicy, ok := qf.Assignment_ReconcilePolicy_value[strings.ToUpper(newAssignment.Reconcile)]
+	if newAssignment.Reconcile != "" && !ok {
+		return nil, fmt.Errorf("unknown reconcile policy %q", newAssignment.Reconcile)
+	}
 	// AssignmentID field from the parsed yaml is used to set Order, not assignment ID,
 	// or it will cause a database constraint violation (IDs must be unique)
 	// The Name field below is the folder name of the assignment.
@@ -55,6 +63,9 @@ func newAssignmentFromFile(contents []byte, assignmentName string, courseID uint
 		ScoreLimit:       newAssignment.ScoreLimit,
 		Reviewers:        newAssignment.Reviewers,
 		ContainerTimeout: newAssignment.ContainerTimeout,
+		ReconcilePolicy:  qf.Assignment_ReconcilePolicy(reconcilePolicy),
+		Anonymous:        newAssignment.Anonymous,
+		PeerReviews:      newAssignment.PeerReviews,
 	}
 	return assignment, nil
 }
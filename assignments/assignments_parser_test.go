@@ -29,6 +29,9 @@ autoapprove: false
 name: "Nested loops"
 deadline: "27-08-2018 12:00"
 autoapprove: false
+reconcile: average
+anonymous: true
+peerreviews: 2
 `
 	y3 = `order: 3
 name: "Nested loops"
@@ -69,10 +72,12 @@ autoapprove: false
 		},
 		{
 			"heading": "Second benchmark",
+			"weight": 2,
 			"criteria": [
 				{
 					"description": "Test 3",
-					"points": 5
+					"points": 5,
+					"partial": true
 				}
 			]
 		}
@@ -122,10 +127,12 @@ func TestParse(t *testing.T) {
 		},
 		{
 			Heading: "Second benchmark",
+			Weight:  2,
 			Criteria: []*qf.GradingCriterion{
 				{
 					Description: "Test 3",
 					Points:      5,
+					Partial:     true,
 				},
 			},
 		},
@@ -148,6 +155,9 @@ func TestParse(t *testing.T) {
 		Order:             2,
 		ScoreLimit:        80,
 		GradingBenchmarks: wantCriteria,
+		ReconcilePolicy:   qf.Assignment_AVERAGE,
+		Anonymous:         true,
+		PeerReviews:       2,
 	}
 
 	assignments, dockerfile, err := readTestsRepositoryContent(testsDir, 0)
@@ -0,0 +1,91 @@
+package assignments
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+// AssignPeerReviews returns peer reviews for the given submissions to the assignment,
+// such that each student reviews the assignment's number of peer submissions.
+// The submissions are shuffled using rng, and the students that made submission i
+// review the next submissions i+1, ..., i+k in the shuffled order, wrapping around.
+// Hence, each submission is reviewed by the students of k other submissions,
+// and no student reviews their own (or their group's) submission.
+// The returned peer reviews are open.
+func AssignPeerReviews(assignment *qf.Assignment, submissions []*qf.Submission, rng *rand.Rand) ([]*qf.PeerReview, error) {
+	k := int(assignment.GetPeerReviews())
+	if k == 0 {
+		return nil, fmt.Errorf("assignment %s has no peer reviews", assignment.GetName())
+	}
+	if k >= len(submissions) {
+		return nil, fmt.Errorf("assignment %s needs more than %d submissions for %d peer reviews", assignment.GetName(), len(submissions), k)
+	}
+	submissions = append([]*qf.Submission{}, submissions...)
+	sort.Slice(submissions, func(i, j int) bool {
+		return submissions[i].GetID() < submissions[j].GetID()
+	})
+	rng.Shuffle(len(submissions), func(i, j int) {
+		submissions[i], submissions[j] = submissions[j], submissions[i]
+	})
+
+	var peerReviews []*qf.PeerReview
+	for i, submission := range submissions {
+		for _, reviewerID := range submitters(submission) {
+			for j := 1; j <= k; j++ {
+				peerSubmission := submissions[(i+j)%len(submissions)]
+				if peerSubmission.BelongsTo(reviewerID) {
+					continue // the student made several submissions, e.g., both individually and in a group
+				}
+				peerReviews = append(peerReviews, &qf.PeerReview{
+					CourseID:     assignment.GetCourseID(),
+					AssignmentID: assignment.GetID(),
+					SubmissionID: peerSubmission.GetID(),
+					ReviewerID:   reviewerID,
+					Open:         true,
+				})
+			}
+		}
+	}
+	return peerReviews, nil
+}
+
+// PeerReviewScores returns the peer review score of each reviewer of the given peer reviews of an assignment,
+// in the order the reviewers first appear. The score is the average quality of the reviewer's peer reviews,
+// where peer reviews that were never created count as zero quality.
+func PeerReviewScores(peerReviews []*qf.PeerReview) []*qf.PeerReviewScore {
+	total := make(map[uint64]uint32) // [reviewerID] -> total quality
+	count := make(map[uint64]uint32) // [reviewerID] -> number of peer reviews
+	var scores []*qf.PeerReviewScore
+	for _, peerReview := range peerReviews {
+		if count[peerReview.GetReviewerID()] == 0 {
+			scores = append(scores, &qf.PeerReviewScore{
+				CourseID:     peerReview.GetCourseID(),
+				AssignmentID: peerReview.GetAssignmentID(),
+				UserID:       peerReview.GetReviewerID(),
+			})
+		}
+		if peerReview.GetReviewID() > 0 {
+			total[peerReview.GetReviewerID()] += peerReview.GetQuality()
+		}
+		count[peerReview.GetReviewerID()]++
+	}
+	for _, score := range scores {
+		score.Score = total[score.GetUserID()] / count[score.GetUserID()]
+	}
+	return scores
+}
+
+// submitters returns the IDs of the students that made the submission.
+func submitters(submission *qf.Submission) []uint64 {
+	if submission.GetGroupID() == 0 {
+		return []uint64{submission.GetUserID()}
+	}
+	var userIDs []uint64
+	for _, grade := range submission.GetGrades() {
+		userIDs = append(userIDs, grade.GetUserID())
+	}
+	return userIDs
+}
@@ -0,0 +1,82 @@
+package assignments
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestAssignPeerReviews(t *testing.T) {
+	assignment := &qf.Assignment{ID: 1, CourseID: 1, PeerReviews: 2}
+	submissions := []*qf.Submission{
+		{ID: 1, UserID: 1},
+		{ID: 2, UserID: 2},
+		{ID: 3, GroupID: 1, Grades: []*qf.Grade{{UserID: 3}, {UserID: 4}}},
+		{ID: 4, UserID: 5},
+		{ID: 5, UserID: 6},
+	}
+	for seed := int64(0); seed < 10; seed++ {
+		got, err := AssignPeerReviews(assignment, submissions, rand.New(rand.NewSource(seed)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		reviewsBy := make(map[uint64]int)               // [reviewerID] -> number of peer reviews
+		reviewersOf := make(map[uint64]map[uint64]bool) // [submissionID][reviewerID] -> assigned
+		for _, peerReview := range got {
+			if !peerReview.GetOpen() || peerReview.GetCourseID() != 1 || peerReview.GetAssignmentID() != 1 {
+				t.Errorf("AssignPeerReviews() = %v, want open peer review for course 1 and assignment 1", peerReview)
+			}
+			submission := submissions[peerReview.GetSubmissionID()-1]
+			if submission.BelongsTo(peerReview.GetReviewerID()) {
+				t.Errorf("AssignPeerReviews() assigned user %d to review own submission %d", peerReview.GetReviewerID(), submission.GetID())
+			}
+			if reviewersOf[submission.GetID()] == nil {
+				reviewersOf[submission.GetID()] = make(map[uint64]bool)
+			}
+			if reviewersOf[submission.GetID()][peerReview.GetReviewerID()] {
+				t.Errorf("AssignPeerReviews() assigned user %d to review submission %d twice", peerReview.GetReviewerID(), submission.GetID())
+			}
+			reviewersOf[submission.GetID()][peerReview.GetReviewerID()] = true
+			reviewsBy[peerReview.GetReviewerID()]++
+		}
+		for userID := uint64(1); userID <= 6; userID++ {
+			if reviewsBy[userID] != 2 {
+				t.Errorf("AssignPeerReviews() assigned user %d %d peer reviews, want 2", userID, reviewsBy[userID])
+			}
+		}
+		for _, submission := range submissions {
+			if len(reviewersOf[submission.GetID()]) < 2 {
+				t.Errorf("AssignPeerReviews() assigned submission %d %d reviewers, want at least 2", submission.GetID(), len(reviewersOf[submission.GetID()]))
+			}
+		}
+	}
+
+	if _, err := AssignPeerReviews(&qf.Assignment{Name: "lab1"}, submissions, rand.New(rand.NewSource(1))); err == nil {
+		t.Error("AssignPeerReviews() expected error for assignment without peer reviews")
+	}
+	if _, err := AssignPeerReviews(&qf.Assignment{Name: "lab1", PeerReviews: 5}, submissions, rand.New(rand.NewSource(1))); err == nil {
+		t.Error("AssignPeerReviews() expected error for too few submissions")
+	}
+}
+
+func TestPeerReviewScores(t *testing.T) {
+	peerReviews := []*qf.PeerReview{
+		{CourseID: 1, AssignmentID: 2, ReviewerID: 1, ReviewID: 1, Quality: 80},
+		{CourseID: 1, AssignmentID: 2, ReviewerID: 2, ReviewID: 3, Quality: 90},
+		{CourseID: 1, AssignmentID: 2, ReviewerID: 1, ReviewID: 2, Quality: 60},
+		{CourseID: 1, AssignmentID: 2, ReviewerID: 2, Quality: 100}, // never created; counts as zero
+		{CourseID: 1, AssignmentID: 2, ReviewerID: 3},
+	}
+	want := []*qf.PeerReviewScore{
+		{CourseID: 1, AssignmentID: 2, UserID: 1, Score: 70},
+		{CourseID: 1, AssignmentID: 2, UserID: 2, Score: 45},
+		{CourseID: 1, AssignmentID: 2, UserID: 3, Score: 0},
+	}
+	got := PeerReviewScores(peerReviews)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("PeerReviewScores() mismatch (-want +got):\n%s", diff)
+	}
+}
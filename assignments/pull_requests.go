@@ -9,22 +9,6 @@ import (
 	"github.com/quickfeed/quickfeed/scm"
 )
 
-// countMap maps a (courseID/groupID, userID)-pair to the number reviews
-// the user has been assigned for the given course/group.
-type countMap map[uint64]map[uint64]int
-
-// Creates a new map if none exists for the given course/group id.
-func (m countMap) initialize(id uint64) {
-	if _, ok := m[id]; !ok {
-		m[id] = make(map[uint64]int) // [id][userID] -> count
-	}
-}
-
-var (
-	teacherReviewCounter = make(countMap) // [courseID][userID] -> count
-	groupReviewCounter   = make(countMap) // [groupID][userID] -> count
-)
-
 // AssignReviewers assigns reviewers to a group repository pull request.
 // It assigns one other group member and one course teacher as reviewers.
 func AssignReviewers(ctx context.Context, sc scm.SCM, db database.Database, course *qf.Course, repo *qf.Repository, pullRequest *qf.PullRequest) error {
@@ -54,36 +38,16 @@ func AssignReviewers(ctx context.Context, sc scm.SCM, db database.Database, cour
 	return db.UpdatePullRequest(pullRequest)
 }
 
-// getNextReviewer gets the next reviewer from either teacherReviewCounter or studentReviewCounter,
-// based on whoever in total has been assigned to the least amount of pull requests.
-// It is simple, and does not account for how many current review requests any user has.
-func getNextReviewer(users []*qf.User, reviewCounter map[uint64]int) *qf.User {
-	userWithLowestCount := users[0]
-	lowestCount := reviewCounter[userWithLowestCount.GetID()]
-	for _, user := range users {
-		count, ok := reviewCounter[user.GetID()]
-		if !ok {
-			// Found user with no prior reviews; assign as the next reviewer.
-			reviewCounter[user.GetID()] = 1
-			return user
-		}
-		if count < lowestCount {
-			userWithLowestCount = user
-			lowestCount = count
-		}
-	}
-	reviewCounter[userWithLowestCount.GetID()]++
-	return userWithLowestCount
-}
-
 // getNextTeacherReviewer gets the teacher with the least total reviews.
 func getNextTeacherReviewer(db database.Database, course *qf.Course) (*qf.User, error) {
 	teachers, err := db.GetCourseTeachers(course)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get teachers from database: %w", err)
 	}
-	teacherReviewCounter.initialize(course.GetID())
-	teacherReviewer := getNextReviewer(teachers, teacherReviewCounter[course.GetID()])
+	teacherReviewer, err := db.NextReviewer(course.GetID(), 0, teachers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select teacher reviewer: %w", err)
+	}
 	return teacherReviewer, nil
 }
 
@@ -93,8 +57,10 @@ func getNextStudentReviewer(db database.Database, groupID, ownerID uint64) (*qf.
 	if err != nil {
 		return nil, fmt.Errorf("failed to get group from database: %w", err)
 	}
-	groupReviewCounter.initialize(group.GetID())
 	// We exclude the PR owner from the search.
-	studentReviewer := getNextReviewer(group.GetUsersExcept(ownerID), groupReviewCounter[group.GetID()])
+	studentReviewer, err := db.NextReviewer(group.GetCourseID(), group.GetID(), group.GetUsersExcept(ownerID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to select student reviewer: %w", err)
+	}
 	return studentReviewer, nil
 }
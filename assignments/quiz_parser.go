@@ -0,0 +1,83 @@
+package assignments
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultQuizAttempts = 1
+
+// quizData holds information about a quiz.
+// This is only used for parsing the 'quiz.yml' file.
+type quizData struct {
+	TimeLimit uint32         `yaml:"timelimit"`
+	Attempts  uint32         `yaml:"attempts"`
+	Questions []questionData `yaml:"questions"`
+}
+
+// questionData holds information about a single quiz question.
+type questionData struct {
+	Text    string   `yaml:"text"`
+	Choices []string `yaml:"choices"`
+	Answers []string `yaml:"answers"`
+	Weight  uint32   `yaml:"weight"`
+}
+
+// newQuizFromFile returns the quiz defined in the given 'quiz.yml' file contents.
+// The questions are numbered in the order they appear in the file,
+// and the choices of each question are labeled a, b, c, and so on.
+func newQuizFromFile(contents []byte, courseID uint64) (*qf.Quiz, error) {
+	var newQuiz quizData
+	if err := yaml.Unmarshal(contents, &newQuiz); err != nil {
+		return nil, fmt.Errorf("error unmarshalling quiz: %w", err)
+	}
+	if newQuiz.TimeLimit < 1 {
+		return nil, errors.New("quiz time limit must be greater than 0")
+	}
+	if len(newQuiz.Questions) == 0 {
+		return nil, errors.New("quiz must have at least one question")
+	}
+	if newQuiz.Attempts < 1 {
+		newQuiz.Attempts = defaultQuizAttempts
+	}
+	quiz := &qf.Quiz{
+		CourseID:    courseID,
+		TimeLimit:   newQuiz.TimeLimit,
+		MaxAttempts: newQuiz.Attempts,
+	}
+	for i, q := range newQuiz.Questions {
+		number := uint32(i + 1)
+		if q.Text == "" {
+			return nil, fmt.Errorf("quiz question %d has no text", number)
+		}
+		if len(q.Choices) < 2 || len(q.Choices) > 26 {
+			return nil, fmt.Errorf("quiz question %d must have between 2 and 26 choices", number)
+		}
+		if len(q.Answers) == 0 {
+			return nil, fmt.Errorf("quiz question %d has no answers", number)
+		}
+		labels := qf.QuizChoiceLabels(len(q.Choices))
+		for _, answer := range q.Answers {
+			if !slices.Contains(labels, answer) {
+				return nil, fmt.Errorf("quiz question %d: answer %q does not match any choice", number, answer)
+			}
+		}
+		if q.Weight < 1 {
+			q.Weight = 1
+		}
+		answers := slices.Clone(q.Answers)
+		slices.Sort(answers)
+		quiz.Questions = append(quiz.Questions, &qf.QuizQuestion{
+			Number:  number,
+			Text:    q.Text,
+			Choices: q.Choices,
+			Answers: slices.Compact(answers),
+			Weight:  q.Weight,
+		})
+	}
+	return quiz, nil
+}
@@ -0,0 +1,47 @@
+package assignments
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestNewQuizFromFile(t *testing.T) {
+	const validQuiz = `timelimit: 300
+questions:
+  - text: "Pick the primes"
+    choices: ["2", "4", "5"]
+    answers: [c, a, a]
+`
+	want := &qf.Quiz{
+		CourseID:    1,
+		TimeLimit:   300,
+		MaxAttempts: 1,
+		Questions: []*qf.QuizQuestion{
+			{Number: 1, Text: "Pick the primes", Choices: []string{"2", "4", "5"}, Answers: []string{"a", "c"}, Weight: 1},
+		},
+	}
+	got, err := newQuizFromFile([]byte(validQuiz), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("newQuizFromFile() mismatch (-want +got):\n%s", diff)
+	}
+
+	invalidQuizzes := map[string]string{
+		"NoTimeLimit":   "questions:\n  - text: q\n    choices: [x, y]\n    answers: [a]\n",
+		"NoQuestions":   "timelimit: 60\n",
+		"NoText":        "timelimit: 60\nquestions:\n  - choices: [x, y]\n    answers: [a]\n",
+		"OneChoice":     "timelimit: 60\nquestions:\n  - text: q\n    choices: [x]\n    answers: [a]\n",
+		"NoAnswers":     "timelimit: 60\nquestions:\n  - text: q\n    choices: [x, y]\n",
+		"UnknownAnswer": "timelimit: 60\nquestions:\n  - text: q\n    choices: [x, y]\n    answers: [c]\n",
+	}
+	for name, content := range invalidQuizzes {
+		if _, err := newQuizFromFile([]byte(content), 1); err == nil {
+			t.Errorf("newQuizFromFile(%s): expected error, got nil", name)
+		}
+	}
+}
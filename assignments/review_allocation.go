@@ -0,0 +1,111 @@
+package assignments
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+// AllocateReviewers returns new review allocations for the given submissions to a manually graded assignment,
+// such that each submission gets the assignment's number of reviewers.
+// Existing allocations and reviews of a submission count toward its number of reviewers,
+// except peer reviews and unfinished reviews by users that are no longer among the reviewers.
+// Each new allocation goes to the eligible reviewer with the fewest allocations for the assignment,
+// where ties are broken by user ID. A reviewer is never allocated to their own (or their group's) submission.
+// If there are too few eligible reviewers, a submission may get fewer than the required number of reviewers.
+func AllocateReviewers(assignment *qf.Assignment, submissions []*qf.Submission, reviewers []*qf.User, allocations []*qf.ReviewAllocation) ([]*qf.ReviewAllocation, error) {
+	if !assignment.GradedManually() {
+		return nil, fmt.Errorf("assignment %s is not graded manually", assignment.GetName())
+	}
+	reviewers = append([]*qf.User{}, reviewers...)
+	sort.Slice(reviewers, func(i, j int) bool {
+		return reviewers[i].GetID() < reviewers[j].GetID()
+	})
+	load := make(map[uint64]int) // [reviewerID] -> number of allocations
+	for _, reviewer := range reviewers {
+		load[reviewer.GetID()] = 0
+	}
+	allocated := make(map[uint64]map[uint64]bool) // [submissionID][reviewerID] -> allocated
+	allocate := func(submissionID, reviewerID uint64) {
+		if _, ok := allocated[submissionID]; !ok {
+			allocated[submissionID] = make(map[uint64]bool)
+		}
+		allocated[submissionID][reviewerID] = true
+	}
+	for _, allocation := range allocations {
+		if _, ok := load[allocation.GetReviewerID()]; ok {
+			load[allocation.GetReviewerID()]++
+		}
+		allocate(allocation.GetSubmissionID(), allocation.GetReviewerID())
+	}
+
+	submissions = append([]*qf.Submission{}, submissions...)
+	sort.Slice(submissions, func(i, j int) bool {
+		return submissions[i].GetID() < submissions[j].GetID()
+	})
+	var newAllocations []*qf.ReviewAllocation
+	for _, submission := range submissions {
+		for _, review := range submission.GetReviews() {
+			if review.GetPeer() {
+				continue // peer reviews do not count toward the submission's reviewers
+			}
+			if _, isReviewer := load[review.GetReviewerID()]; isReviewer || review.GetReady() {
+				allocate(submission.GetID(), review.GetReviewerID())
+			}
+		}
+		for needed := int(assignment.GetReviewers()) - len(allocated[submission.GetID()]); needed > 0; needed-- {
+			reviewer := leastLoadedReviewer(reviewers, load, func(reviewerID uint64) bool {
+				return !allocated[submission.GetID()][reviewerID] && !submission.BelongsTo(reviewerID)
+			})
+			if reviewer == nil {
+				break // no more eligible reviewers for this submission
+			}
+			load[reviewer.GetID()]++
+			allocate(submission.GetID(), reviewer.GetID())
+			newAllocations = append(newAllocations, &qf.ReviewAllocation{
+				CourseID:     assignment.GetCourseID(),
+				AssignmentID: assignment.GetID(),
+				SubmissionID: submission.GetID(),
+				ReviewerID:   reviewer.GetID(),
+			})
+		}
+	}
+	return newAllocations, nil
+}
+
+// PendingAllocations returns the allocations whose reviewer has not yet completed
+// a review of the allocated submission.
+func PendingAllocations(submissions []*qf.Submission, allocations []*qf.ReviewAllocation) []*qf.ReviewAllocation {
+	ready := make(map[uint64]map[uint64]bool) // [submissionID][reviewerID] -> review ready
+	for _, submission := range submissions {
+		ready[submission.GetID()] = make(map[uint64]bool)
+		for _, review := range submission.GetReviews() {
+			if review.GetReady() {
+				ready[submission.GetID()][review.GetReviewerID()] = true
+			}
+		}
+	}
+	pending := []*qf.ReviewAllocation{}
+	for _, allocation := range allocations {
+		if !ready[allocation.GetSubmissionID()][allocation.GetReviewerID()] {
+			pending = append(pending, allocation)
+		}
+	}
+	return pending
+}
+
+// leastLoadedReviewer returns the eligible reviewer with the fewest allocations,
+// or nil if no reviewer is eligible. The reviewers must be sorted by ID.
+func leastLoadedReviewer(reviewers []*qf.User, load map[uint64]int, eligible func(uint64) bool) *qf.User {
+	var leastLoaded *qf.User
+	for _, reviewer := range reviewers {
+		if !eligible(reviewer.GetID()) {
+			continue
+		}
+		if leastLoaded == nil || load[reviewer.GetID()] < load[leastLoaded.GetID()] {
+			leastLoaded = reviewer
+		}
+	}
+	return leastLoaded
+}
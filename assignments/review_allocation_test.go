@@ -0,0 +1,70 @@
+package assignments
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestAllocateReviewers(t *testing.T) {
+	assignment := &qf.Assignment{ID: 1, CourseID: 1, Reviewers: 2, GradingBenchmarks: []*qf.GradingBenchmark{{ID: 1}}}
+	reviewers := []*qf.User{{ID: 3}, {ID: 1}, {ID: 2}}
+	submissions := []*qf.Submission{
+		{ID: 1, UserID: 4},
+		{ID: 2, GroupID: 1, Grades: []*qf.Grade{{UserID: 1}, {UserID: 5}}}, // reviewer 1 is a group member
+		{ID: 3, UserID: 6, Reviews: []*qf.Review{{ReviewerID: 2}}},         // reviewer 2 has started a review
+		{ID: 4, UserID: 7, Reviews: []*qf.Review{{ReviewerID: 9}}},         // unfinished review by a former reviewer
+		{ID: 5, UserID: 8, Reviews: []*qf.Review{{ReviewerID: 9, Ready: true}}},
+	}
+	existing := []*qf.ReviewAllocation{{ID: 1, AssignmentID: 1, SubmissionID: 1, ReviewerID: 3}}
+
+	got, err := AllocateReviewers(assignment, submissions, reviewers, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newAllocation := func(submissionID, reviewerID uint64) *qf.ReviewAllocation {
+		return &qf.ReviewAllocation{CourseID: 1, AssignmentID: 1, SubmissionID: submissionID, ReviewerID: reviewerID}
+	}
+	want := []*qf.ReviewAllocation{
+		newAllocation(1, 1),
+		newAllocation(2, 2),
+		newAllocation(2, 3),
+		newAllocation(3, 1),
+		newAllocation(4, 2),
+		newAllocation(4, 1),
+		newAllocation(5, 2),
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("AllocateReviewers() mismatch (-want +got):\n%s", diff)
+	}
+
+	// a single eligible reviewer cannot satisfy two reviewers per submission
+	got, err = AllocateReviewers(assignment, submissions[:1], []*qf.User{{ID: 1}, {ID: 4}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*qf.ReviewAllocation{newAllocation(1, 1)}, got, protocmp.Transform()); diff != "" {
+		t.Errorf("AllocateReviewers() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := AllocateReviewers(&qf.Assignment{Name: "lab1"}, submissions, reviewers, nil); err == nil {
+		t.Error("AllocateReviewers() expected error for assignment not graded manually")
+	}
+}
+
+func TestPendingAllocations(t *testing.T) {
+	submissions := []*qf.Submission{
+		{ID: 1, Reviews: []*qf.Review{{ReviewerID: 1, Ready: true}, {ReviewerID: 2}}},
+	}
+	allocations := []*qf.ReviewAllocation{
+		{ID: 1, SubmissionID: 1, ReviewerID: 1},
+		{ID: 2, SubmissionID: 1, ReviewerID: 2},
+		{ID: 3, SubmissionID: 2, ReviewerID: 1},
+	}
+	got := PendingAllocations(submissions, allocations)
+	if diff := cmp.Diff(allocations[1:], got, protocmp.Transform()); diff != "" {
+		t.Errorf("PendingAllocations() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -15,6 +15,7 @@ const (
 	assignmentFile     = "assignment.yml"
 	assignmentFileYaml = "assignment.yaml"
 	criteriaFile       = "criteria.json"
+	quizFile           = "quiz.yml"
 	dockerfile         = "Dockerfile"
 	taskFilePattern    = "task-*.md"
 )
@@ -23,6 +24,7 @@ var patterns = []string{
 	assignmentFile,
 	assignmentFileYaml,
 	criteriaFile,
+	quizFile,
 	dockerfile,
 	taskFilePattern,
 }
@@ -48,6 +50,7 @@ func match(filename, pattern string) bool {
 // readTestsRepositoryContent reads dir and returns a list of assignments and
 // the course's Dockerfile content if there exists a 'tests/scripts/Dockerfile'.
 // Assignments are extracted from 'assignment.yml' files, one for each assignment.
+// An assignment's quiz, if any, is extracted from the 'quiz.yml' file next to its 'assignment.yml' file.
 func readTestsRepositoryContent(dir string, courseID uint64) ([]*qf.Assignment, string, error) {
 	files, err := walkTestsRepository(dir)
 	if err != nil {
@@ -87,10 +90,27 @@ func readTestsRepositoryContent(dir string, courseID uint64) ([]*qf.Assignment,
 				bm.CourseID = courseID
 				for _, c := range bm.Criteria {
 					c.CourseID = courseID
+					if c.GetPartial() && c.GetPoints() == 0 {
+						return nil, "", fmt.Errorf("partial credit criterion %q in %q for assignment %s has no points", c.GetDescription(), criteriaFile, assignmentName)
+					}
 				}
 			}
 			assignmentsMap[assignmentName].GradingBenchmarks = benchmarks
 
+		case quizFile:
+			assignment, ok := assignmentsMap[assignmentName]
+			if !ok {
+				return nil, "", fmt.Errorf("found %q without %q for assignment %s", quizFile, assignmentFile, assignmentName)
+			}
+			if assignment.GetIsGroupLab() {
+				return nil, "", fmt.Errorf("quiz not supported for group assignment %s", assignmentName)
+			}
+			quiz, err := newQuizFromFile(contents, courseID)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to parse %q for assignment %s: %w", quizFile, assignmentName, err)
+			}
+			assignment.Quiz = quiz
+
 		case dockerfile:
 			courseDockerfile = string(contents)
 		}
@@ -21,6 +21,7 @@ func TestWalkTestsRepository(t *testing.T) {
 		"testdata/tests/lab1/assignment.yml":       {},
 		"testdata/tests/lab1/run.sh":               {},
 		"testdata/tests/lab2/assignment.yml":       {},
+		"testdata/tests/lab2/quiz.yml":             {},
 		"testdata/tests/lab3/assignment.yml":       {},
 	}
 	files, err := walkTestsRepository(testsFolder)
@@ -54,6 +55,27 @@ WORKDIR /quickfeed
 			Order:      2,
 			ScoreLimit: 80,
 			Deadline:   qtest.Timestamp(t, "2019-01-31T16:00:00"),
+			Quiz: &qf.Quiz{
+				CourseID:    1,
+				TimeLimit:   600,
+				MaxAttempts: 2,
+				Questions: []*qf.QuizQuestion{
+					{
+						Number:  1,
+						Text:    "Which of the following are valid Go types?",
+						Choices: []string{"int", "integer", "float64"},
+						Answers: []string{"a", "c"},
+						Weight:  2,
+					},
+					{
+						Number:  2,
+						Text:    "Which keyword starts a goroutine?",
+						Choices: []string{"go", "async", "spawn"},
+						Answers: []string{"a"},
+						Weight:  1,
+					},
+				},
+			},
 		},
 		{
 			Name:       "lab3",
@@ -2,6 +2,7 @@ package ci
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/quickfeed/quickfeed/database"
 	"github.com/quickfeed/quickfeed/kit/score"
@@ -28,6 +29,9 @@ func (r RunData) RecordResults(logger *zap.SugaredLogger, db database.Database,
 		logger.Debugf("Recording new submission for %s", r)
 	} else {
 		logger.Debugf("Updating submission %d for %s", previous.GetID(), r)
+		if err := r.addMemberGrades(db, previous, r.submissionDate(previous, results)); err != nil {
+			return nil, fmt.Errorf("failed to add grades for group members of %s: %w", r, err)
+		}
 	}
 
 	resType, newSubmission := r.newSubmission(previous, results)
@@ -54,6 +58,40 @@ func (r RunData) previousSubmission(db database.Database) (*qf.Submission, error
 	return db.GetSubmission(submissionQuery)
 }
 
+// submissionDate returns the time the submission was delivered. Rebuilds keep the previous submission's delivery date.
+func (r RunData) submissionDate(previous *qf.Submission, results *score.Results) time.Time {
+	if r.Rebuild && previous.GetBuildInfo() != nil {
+		return previous.GetBuildInfo().GetSubmissionDate().AsTime()
+	}
+	if results != nil && results.BuildInfo != nil {
+		return results.BuildInfo.GetSubmissionDate().AsTime()
+	}
+	return time.Now()
+}
+
+// addMemberGrades adds a grade to the previous group submission for each student who was
+// a member of the group when the submission was delivered. Grades of former members are kept,
+// such that students who leave the group are still credited for the group's earlier work.
+func (r RunData) addMemberGrades(db database.Database, previous *qf.Submission, submitted time.Time) error {
+	if previous.GetGroupID() == 0 {
+		return nil
+	}
+	memberships, err := db.GetGroupMemberships(&qf.GroupMembership{GroupID: previous.GetGroupID()})
+	if err != nil {
+		return err
+	}
+	for _, membership := range memberships {
+		if !membership.IsMemberAt(submitted) || previous.BelongsTo(membership.GetUserID()) {
+			continue
+		}
+		previous.Grades = append(previous.Grades, &qf.Grade{
+			SubmissionID: previous.GetID(),
+			UserID:       membership.GetUserID(),
+		})
+	}
+	return nil
+}
+
 func (r RunData) newSubmission(previous *qf.Submission, results *score.Results) (string, *qf.Submission) {
 	if results != nil {
 		return "test execution", r.newTestRunSubmission(previous, results)
@@ -18,6 +18,7 @@ import (
 	"github.com/quickfeed/quickfeed/scm"
 	"github.com/quickfeed/quickfeed/web/stream"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // To run this test, please see instructions in the developer guide (dev.md).
@@ -309,6 +310,61 @@ func TestRecordResultsForManualReview(t *testing.T) {
 	}
 }
 
+func TestRecordResultsForGroupMembers(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &qf.Course{Name: "Test", ScmOrganizationID: 1}
+	admin := qtest.CreateFakeUser(t, db)
+	qtest.CreateCourse(t, db, admin, course)
+	var users []*qf.User
+	for range 3 {
+		user := qtest.CreateFakeUser(t, db)
+		qtest.EnrollStudent(t, db, user, course)
+		users = append(users, user)
+	}
+	group := &qf.Group{Name: "group", CourseID: course.ID, Users: users[:2]}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	assignment := &qf.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, IsGroupLab: true, Deadline: qtest.Timestamp(t, "2099-11-11T13:00:00")}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+	runData := &ci.RunData{
+		Course:     course,
+		Assignment: assignment,
+		Repo:       &qf.Repository{RepoType: qf.Repository_GROUP, GroupID: group.ID},
+		JobOwner:   "test",
+		CommitID:   "deadbeef",
+	}
+	record := func() []uint64 {
+		t.Helper()
+		results := &score.Results{BuildInfo: &score.BuildInfo{SubmissionDate: timestamppb.Now(), BuildDate: timestamppb.Now()}}
+		submission, err := runData.RecordResults(qtest.Logger(t), db, results)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var userIDs []uint64
+		for _, grade := range submission.GetGrades() {
+			userIDs = append(userIDs, grade.GetUserID())
+		}
+		return userIDs
+	}
+	if diff := cmp.Diff([]uint64{users[0].ID, users[1].ID}, record()); diff != "" {
+		t.Errorf("RecordResults() graded users mismatch (-want +got):\n%s", diff)
+	}
+
+	// the first user leaves the group and keeps the grade, while the third user is graded from the next delivery
+	group.Users = users[1:]
+	if err := db.UpdateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]uint64{users[0].ID, users[1].ID, users[2].ID}, record()); diff != "" {
+		t.Errorf("RecordResults() graded users mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestStreamRecordResults(t *testing.T) {
 	db, cleanup := qtest.TestDB(t)
 	defer cleanup()
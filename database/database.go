@@ -51,6 +51,14 @@ type Database interface {
 	GetEnrollmentsByCourse(courseID uint64, statuses ...qf.Enrollment_UserStatus) ([]*qf.Enrollment, error)
 	// GetEnrollmentsByUser fetches all enrollments for the given user
 	GetEnrollmentsByUser(userID uint64, statuses ...qf.Enrollment_UserStatus) ([]*qf.Enrollment, error)
+	// GetRoster returns the students on the course's institution roster.
+	GetRoster(courseID uint64) ([]*qf.RosterEntry, error)
+	// UpdateRoster replaces the course's institution roster with the given students.
+	UpdateRoster(courseID uint64, entries []*qf.RosterEntry) error
+	// GetEnrollmentPolicy returns the self-enrollment policy of the given course.
+	GetEnrollmentPolicy(courseID uint64) (*qf.EnrollmentPolicy, error)
+	// UpdateEnrollmentPolicy creates or replaces the course's self-enrollment policy.
+	UpdateEnrollmentPolicy(*qf.EnrollmentPolicy) error
 
 	// CreateGroup creates a new group and assign users to newly created group.
 	CreateGroup(*qf.Group) error
@@ -64,6 +72,18 @@ type Database interface {
 	GetGroup(uint64) (*qf.Group, error)
 	// GetGroupsByCourse returns the groups for the given course.
 	GetGroupsByCourse(courseID uint64, statuses ...qf.Group_GroupStatus) ([]*qf.Group, error)
+	// GetGroupMemberships returns all group memberships matching the query.
+	GetGroupMemberships(query *qf.GroupMembership) ([]*qf.GroupMembership, error)
+	// CreateGroupInvitations creates the given group invitations.
+	CreateGroupInvitations([]*qf.GroupInvitation) error
+	// GetGroupInvitations returns all group invitations matching the query and one of the given statuses.
+	GetGroupInvitations(query *qf.GroupInvitation, statuses ...qf.GroupInvitation_Status) ([]*qf.GroupInvitation, error)
+	// UpdateGroupInvitation updates the status of the given group invitation.
+	UpdateGroupInvitation(*qf.GroupInvitation) error
+	// GetGroupPreferences returns all group preferences matching the query.
+	GetGroupPreferences(query *qf.GroupPreference) ([]*qf.GroupPreference, error)
+	// UpdateGroupPreference creates or replaces the user's group preference in the course.
+	UpdateGroupPreference(*qf.GroupPreference) error
 
 	// CreateAssignment creates a new or updates an existing assignment.
 	CreateAssignment(*qf.Assignment) error
@@ -103,17 +123,88 @@ type Database interface {
 	GetCourseSubmissions(courseID uint64, submissionType qf.SubmissionRequest_SubmissionType) ([]*qf.Submission, error)
 	// UpdateSubmission updates the specified submission with approved or not approved.
 	UpdateSubmission(*qf.Submission) error
-	// UpdateSubmissions releases and/or approves all submissions with a certain score.
+	// UpdateSubmissions releases and/or approves all submissions with a certain score,
+	// and returns the number of updated submissions.
 	// The boolean argument determines whether to approve or reject the submissions.
-	UpdateSubmissions(*qf.Submission, bool) error
+	UpdateSubmissions(*qf.Submission, bool) (int, error)
+	// ReleaseReviewedSubmissions releases all submissions for the assignment that have a ready review,
+	// and returns the number of released submissions.
+	ReleaseReviewedSubmissions(assignmentID uint64) (int64, error)
+	// GradeSubmissions approves the ungraded submissions for the assignment with score equal or above
+	// the score limit and rejects the rest, and returns the number of approved and rejected submissions.
+	GradeSubmissions(assignmentID uint64, scoreLimit uint32) (approved, rejected int, err error)
 	// GetReview returns a single review matching the given query.
 	GetReview(query *qf.Review) (*qf.Review, error)
 	// CreateReview adds a new submission review.
 	CreateReview(*qf.Review) error
 	// UpdateReview updates the given review.
 	UpdateReview(*qf.Review) error
-	// DeleteReview removes all review records matching the query.
+	// CreateFinalReview creates the final review for a submission, replacing any existing final review,
+	// and updates the submission's score to the final review's score.
+	CreateFinalReview(*qf.Review) error
+	// DeleteReview removes all review records matching the query, along with their grading benchmarks and criteria.
 	DeleteReview(*qf.Review) error
+	// GetLineComments returns all line comments matching the query.
+	GetLineComments(query *qf.LineComment) ([]*qf.LineComment, error)
+	// CreateLineComment adds a new line comment.
+	CreateLineComment(*qf.LineComment) error
+	// UpdateLineComment updates the given line comment.
+	UpdateLineComment(*qf.LineComment) error
+	// DeleteLineComment removes the given line comment.
+	DeleteLineComment(*qf.LineComment) error
+	// GetFeedbackSnippets returns all feedback snippets, with their usage, matching the query.
+	GetFeedbackSnippets(query *qf.FeedbackSnippet) ([]*qf.FeedbackSnippet, error)
+	// CreateFeedbackSnippet adds a new feedback snippet.
+	CreateFeedbackSnippet(*qf.FeedbackSnippet) error
+	// UpdateFeedbackSnippet updates the title, body and tags of the given feedback snippet.
+	UpdateFeedbackSnippet(*qf.FeedbackSnippet) error
+	// DeleteFeedbackSnippet removes the given feedback snippet and its usage.
+	DeleteFeedbackSnippet(*qf.FeedbackSnippet) error
+	// IncrementFeedbackSnippetUsage increments the number of uses of the snippet in the given assignment.
+	IncrementFeedbackSnippetUsage(snippetID, assignmentID uint64) error
+	// GetRegradeRequests returns all regrade requests matching the query.
+	GetRegradeRequests(query *qf.RegradeRequest) ([]*qf.RegradeRequest, error)
+	// CreateRegradeRequest adds a new regrade request.
+	CreateRegradeRequest(*qf.RegradeRequest) error
+	// UpdateRegradeRequest updates the given regrade request.
+	UpdateRegradeRequest(*qf.RegradeRequest) error
+	// GetGradingConfig returns the grading configuration of the given course.
+	GetGradingConfig(courseID uint64) (*qf.GradingConfig, error)
+	// UpdateGradingConfig creates or replaces the course's grading configuration.
+	UpdateGradingConfig(*qf.GradingConfig) error
+	// UpdateExternalGrades creates the given external grades, or replaces the enrollments' existing scores.
+	UpdateExternalGrades([]*qf.ExternalGrade) error
+	// GetScheduledJobs returns all scheduled jobs matching the query.
+	GetScheduledJobs(query *qf.ScheduledJob) ([]*qf.ScheduledJob, error)
+	// GetPendingScheduledJobs returns all scheduled jobs that have not yet run.
+	GetPendingScheduledJobs() ([]*qf.ScheduledJob, error)
+	// SaveScheduledJob creates the scheduled job, or replaces the assignment's job of the same type.
+	SaveScheduledJob(*qf.ScheduledJob) error
+	// DeleteScheduledJob removes the given scheduled job.
+	DeleteScheduledJob(*qf.ScheduledJob) error
+	// GetAuditEntries returns all audit entries matching the query.
+	GetAuditEntries(query *qf.AuditEntry) ([]*qf.AuditEntry, error)
+	// CreateAuditEntry adds a new audit entry.
+	CreateAuditEntry(*qf.AuditEntry) error
+	// GetReviewAllocations returns all review allocations matching the query.
+	GetReviewAllocations(query *qf.ReviewAllocation) ([]*qf.ReviewAllocation, error)
+	// UpdateReviewAllocations removes the deleted and creates the created review allocations.
+	UpdateReviewAllocations(deleted, created []*qf.ReviewAllocation) error
+	// GetReviewerLoads returns the pull request reviewer loads for the given course.
+	GetReviewerLoads(courseID uint64) ([]*qf.ReviewerLoad, error)
+	// NextReviewer selects the candidate with the fewest pull request reviews in the given course and group,
+	// and increments the candidate's review count.
+	NextReviewer(courseID, groupID uint64, candidates []*qf.User) (*qf.User, error)
+	// GetPeerReviews returns all peer reviews matching the query.
+	GetPeerReviews(query *qf.PeerReview) ([]*qf.PeerReview, error)
+	// CreatePeerReviews creates the given peer reviews.
+	CreatePeerReviews([]*qf.PeerReview) error
+	// UpdatePeerReview updates the given peer review.
+	UpdatePeerReview(*qf.PeerReview) error
+	// GetPeerReviewScores returns all peer review scores matching the query.
+	GetPeerReviewScores(query *qf.PeerReviewScore) ([]*qf.PeerReviewScore, error)
+	// UpdatePeerReviewScores creates the given peer review scores, or replaces the reviewers' existing scores.
+	UpdatePeerReviewScores([]*qf.PeerReviewScore) error
 	// GetBenchmarks return all benchmarks and criteria for an assignment
 	GetBenchmarks(*qf.Assignment) ([]*qf.GradingBenchmark, error)
 	// CreateRepository creates a new repository.
@@ -142,4 +233,13 @@ type Database interface {
 
 	// UpdateSlipDays updates used slip days for the given course enrollment
 	UpdateSlipDays([]*qf.UsedSlipDays) error
+
+	// GetQuiz returns the quiz, with its questions, matching the given query.
+	GetQuiz(query *qf.Quiz) (*qf.Quiz, error)
+	// CreateQuizAttempt creates a new quiz attempt, unless the user has no attempts remaining.
+	CreateQuizAttempt(*qf.QuizAttempt) error
+	// GetQuizAttempts returns all quiz attempts, with answers, matching the given query.
+	GetQuizAttempts(query *qf.QuizAttempt) ([]*qf.QuizAttempt, error)
+	// UpdateQuizAttempt updates the given quiz attempt and saves its answers.
+	UpdateQuizAttempt(*qf.QuizAttempt) error
 }
@@ -53,28 +53,60 @@ func NewGormDB(path string, logger *zap.Logger) (*GormDB, error) {
 
 	schema.RegisterSerializer("timestamp", &TimestampSerializer{})
 
+	// Courses created before signed score lines were introduced use tests that emit the legacy score format
+	legacyCourses := conn.Migrator().HasTable(&qf.Course{}) && !conn.Migrator().HasColumn(&qf.Course{}, "LegacyScoreFormat")
+
 	if err := conn.AutoMigrate(
 		&qf.User{},
 		&qf.Course{},
 		&qf.Enrollment{},
+		&qf.RosterEntry{},
+		&qf.EnrollmentPolicy{},
+		&qf.GroupPreference{},
+		&qf.GroupInvitation{},
+		&qf.GroupMembership{},
 		&qf.Assignment{},
 		&qf.Submission{},
 		&qf.Grade{},
 		&qf.Group{},
 		&qf.Repository{},
 		&qf.UsedSlipDays{},
+		&qf.ExternalGrade{},
 		&qf.GradingBenchmark{},
 		&qf.GradingCriterion{},
 		&qf.Review{},
+		&qf.LineComment{},
+		&qf.FeedbackSnippet{},
+		&qf.FeedbackSnippetUsage{},
+		&qf.RegradeRequest{},
+		&qf.ScheduledJob{},
+		&qf.AuditEntry{},
+		&qf.GradingConfig{},
+		&qf.AssignmentWeight{},
+		&qf.ExternalComponent{},
 		&qf.Issue{},
 		&qf.Task{},
 		&qf.PullRequest{},
+		&qf.ReviewAllocation{},
+		&qf.ReviewerLoad{},
+		&qf.PeerReview{},
+		&qf.PeerReviewScore{},
+		&qf.Quiz{},
+		&qf.QuizQuestion{},
+		&qf.QuizAttempt{},
+		&qf.QuizAnswer{},
 		&score.BuildInfo{},
 		&score.Score{},
 	); err != nil {
 		return nil, err
 	}
 
+	if legacyCourses {
+		if err := conn.Session(&gorm.Session{AllowGlobalUpdate: true}).Model(&qf.Course{}).Update("legacy_score_format", true).Error; err != nil {
+			return nil, err
+		}
+	}
+
 	return &GormDB{conn}, nil
 }
 
@@ -102,7 +102,7 @@ func (db *GormDB) UpdateAssignments(assignments []*qf.Assignment) error {
 
 			if err := tx.Model(v).Where(&qf.Assignment{
 				ID: assignment.ID,
-			}).Select("*").Updates(&qf.Assignment{
+			}).Select("*").Omit("Quiz").Updates(&qf.Assignment{
 				ID:               v.ID,
 				CourseID:         v.CourseID,
 				Name:             v.Name,
@@ -113,13 +113,16 @@ func (db *GormDB) UpdateAssignments(assignments []*qf.Assignment) error {
 				ScoreLimit:       v.ScoreLimit,
 				Reviewers:        v.Reviewers,
 				ContainerTimeout: v.ContainerTimeout,
+				ReconcilePolicy:  v.ReconcilePolicy,
+				Anonymous:        v.Anonymous,
+				PeerReviews:      v.PeerReviews,
 				// Submissions:       v.Submissions,
 				Tasks:             v.Tasks,
 				GradingBenchmarks: v.GradingBenchmarks,
 			}).Error; err != nil {
 				return err
 			}
-			return nil
+			return updateQuiz(tx, v)
 		})
 		if err != nil {
 			errs = errors.Join(errs, err)
@@ -138,6 +138,54 @@ func TestUpdateAssignment(t *testing.T) {
 	}
 }
 
+func TestUpdateAssignmentReviewSettings(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	course := &qf.Course{}
+	admin := qtest.CreateFakeUser(t, db)
+	qtest.CreateCourse(t, db, admin, course)
+
+	assignment := &qf.Assignment{
+		CourseID:  course.ID,
+		Name:      "lab1",
+		Deadline:  qtest.Timestamp(t, "2022-11-11T23:59:00"),
+		Order:     1,
+		Reviewers: 2,
+	}
+	if err := db.CreateAssignment(assignment); err != nil {
+		t.Fatal(err)
+	}
+
+	// the settings of an existing assignment must survive updates from the tests repository
+	for _, want := range []*qf.Assignment{
+		{ReconcilePolicy: qf.Assignment_MANUAL, Anonymous: true, PeerReviews: 2},
+		{ReconcilePolicy: qf.Assignment_AVERAGE, PeerReviews: 1},
+		{},
+	} {
+		update := proto.Clone(assignment).(*qf.Assignment)
+		update.ReconcilePolicy = want.GetReconcilePolicy()
+		update.Anonymous = want.GetAnonymous()
+		update.PeerReviews = want.GetPeerReviews()
+		if err := db.UpdateAssignments([]*qf.Assignment{update}); err != nil {
+			t.Fatal(err)
+		}
+		got, err := db.GetAssignment(&qf.Assignment{CourseID: course.ID, Order: assignment.Order})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.GetReconcilePolicy() != want.GetReconcilePolicy() {
+			t.Errorf("UpdateAssignments() ReconcilePolicy = %v, want %v", got.GetReconcilePolicy(), want.GetReconcilePolicy())
+		}
+		if got.GetAnonymous() != want.GetAnonymous() {
+			t.Errorf("UpdateAssignments() Anonymous = %t, want %t", got.GetAnonymous(), want.GetAnonymous())
+		}
+		if got.GetPeerReviews() != want.GetPeerReviews() {
+			t.Errorf("UpdateAssignments() PeerReviews = %d, want %d", got.GetPeerReviews(), want.GetPeerReviews())
+		}
+	}
+}
+
 func TestGetCourseSubmissions(t *testing.T) {
 	db, cleanup := qtest.TestDB(t)
 	defer cleanup()
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/quickfeed/quickfeed/qf"
+	"gorm.io/gorm"
 )
 
 // CreateCourse creates a new course if user with given ID is admin, enrolls user as course teacher.
@@ -64,6 +65,7 @@ func (db *GormDB) GetCourse(courseID uint64, withEnrollments bool) (*qf.Course,
 		userStates := []qf.Enrollment_UserStatus{
 			qf.Enrollment_STUDENT,
 			qf.Enrollment_TEACHER,
+			qf.Enrollment_ASSISTANT,
 		}
 		// and only group submissions from approved groups
 		modelGroup := &qf.Group{Status: qf.Group_APPROVED, CourseID: courseID}
@@ -72,6 +74,7 @@ func (db *GormDB) GetCourse(courseID uint64, withEnrollments bool) (*qf.Course,
 			Preload("Enrollments.User").
 			Preload("Enrollments.Group").
 			Preload("Enrollments.UsedSlipDays").
+			Preload("Enrollments.ExternalGrades").
 			Preload("Groups", modelGroup).
 			First(&course, courseID).Error; err != nil {
 			return nil, err
@@ -177,7 +180,19 @@ func (db *GormDB) GetCourseTeachers(query *qf.Course) ([]*qf.User, error) {
 
 // UpdateCourse updates course information.
 func (db *GormDB) UpdateCourse(course *qf.Course) error {
-	return db.conn.Model(&qf.Course{}).
-		Where(&qf.Course{ID: course.GetID()}).
-		Updates(course).Error
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&qf.Course{}).
+			Where(&qf.Course{ID: course.GetID()}).
+			Updates(course).Error; err != nil {
+			return err
+		}
+		// Updates ignores zero-valued fields; update group sizes explicitly so that they can be disabled.
+		// The legacy score format flag is optional, and is only updated by Updates above if set.
+		return tx.Model(&qf.Course{}).
+			Where(&qf.Course{ID: course.GetID()}).
+			Updates(map[string]any{
+				"min_group_size": course.GetMinGroupSize(),
+				"max_group_size": course.GetMaxGroupSize(),
+			}).Error
+	})
 }
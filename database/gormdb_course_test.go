@@ -2,6 +2,7 @@ package database_test
 
 import (
 	"errors"
+	"path/filepath"
 	"sort"
 	"testing"
 
@@ -9,7 +10,9 @@ import (
 	"github.com/quickfeed/quickfeed/database"
 	"github.com/quickfeed/quickfeed/internal/qtest"
 	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -271,6 +274,92 @@ func TestGormDBUpdateCourse(t *testing.T) {
 	}
 }
 
+func TestGormDBUpdateCourseLegacyScoreFormat(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := &qf.Course{Code: "DAT100", LegacyScoreFormat: proto.Bool(true)}
+	qtest.CreateCourse(t, db, admin, course)
+
+	for _, tt := range []struct {
+		flag *bool
+		want bool
+	}{
+		{flag: proto.Bool(false), want: false},
+		{flag: proto.Bool(true), want: true},
+		{flag: nil, want: true}, // unset flag leaves the stored flag unchanged
+		{flag: proto.Bool(false), want: false},
+		{flag: nil, want: false},
+	} {
+		course.LegacyScoreFormat = tt.flag
+		if err := db.UpdateCourse(course); err != nil {
+			t.Fatal(err)
+		}
+		gotCourse, err := db.GetCourse(course.ID, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotCourse.GetLegacyScoreFormat() != tt.want {
+			t.Errorf("LegacyScoreFormat = %t, want %t", gotCourse.GetLegacyScoreFormat(), tt.want)
+		}
+	}
+}
+
+func TestGormDBMigrateLegacyScoreFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	logger := qtest.Logger(t).Desugar()
+	db, err := database.NewGormDB(path, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin := qtest.CreateFakeUser(t, db)
+	existing := &qf.Course{Code: "DAT100"}
+	qtest.CreateCourse(t, db, admin, existing)
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// emulate a database created before the legacy score format flag was introduced
+	conn, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.Migrator().DropColumn(&qf.Course{}, "LegacyScoreFormat"); err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := conn.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err = database.NewGormDB(path, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	gotCourse, err := db.GetCourse(existing.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gotCourse.GetLegacyScoreFormat() {
+		t.Error("LegacyScoreFormat = false for existing course, want true")
+	}
+	// courses created after the migration use signed score lines by default
+	course := &qf.Course{Code: "DAT200"}
+	qtest.CreateCourse(t, db, admin, course)
+	gotCourse, err = db.GetCourse(course.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotCourse.GetLegacyScoreFormat() {
+		t.Error("LegacyScoreFormat = true for new course, want false")
+	}
+}
+
 func TestGormDBGetCourseByOrganization(t *testing.T) {
 	wantCourse := &qf.Course{
 		Name:              "Test Course",
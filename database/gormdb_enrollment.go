@@ -55,7 +55,7 @@ func (db *GormDB) UpdateEnrollment(enrol *qf.Enrollment) error {
 // GetEnrollmentByCourseAndUser returns a user enrollment for the given course ID.
 func (db *GormDB) GetEnrollmentByCourseAndUser(courseID uint64, userID uint64) (*qf.Enrollment, error) {
 	var enrollment qf.Enrollment
-	m := db.conn.Preload("Course").Preload("User").Preload("UsedSlipDays")
+	m := db.conn.Preload("Course").Preload("User").Preload("UsedSlipDays").Preload("ExternalGrades")
 	if err := m.
 		Where(&qf.Enrollment{
 			CourseID: courseID,
@@ -85,6 +85,7 @@ func (db *GormDB) getEnrollments(model interface{}, statuses ...qf.Enrollment_Us
 			qf.Enrollment_PENDING,
 			qf.Enrollment_STUDENT,
 			qf.Enrollment_TEACHER,
+			qf.Enrollment_ASSISTANT,
 		}
 	}
 	var enrollments []*qf.Enrollment
@@ -92,6 +93,7 @@ func (db *GormDB) getEnrollments(model interface{}, statuses ...qf.Enrollment_Us
 		Preload("Course").
 		Preload("Group").
 		Preload("UsedSlipDays").
+		Preload("ExternalGrades").
 		Model(model).
 		Where("status in (?)", statuses).
 		Association("Enrollments").
@@ -0,0 +1,34 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"gorm.io/gorm"
+)
+
+// GetEnrollmentPolicy returns the self-enrollment policy of the given course.
+func (db *GormDB) GetEnrollmentPolicy(courseID uint64) (*qf.EnrollmentPolicy, error) {
+	var policy qf.EnrollmentPolicy
+	if err := db.conn.Where(&qf.EnrollmentPolicy{CourseID: courseID}).First(&policy).Error; err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// UpdateEnrollmentPolicy creates the given enrollment policy, or replaces the course's existing policy.
+func (db *GormDB) UpdateEnrollmentPolicy(policy *qf.EnrollmentPolicy) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		var existing qf.EnrollmentPolicy
+		err := tx.Where(&qf.EnrollmentPolicy{CourseID: policy.GetCourseID()}).First(&existing).Error
+		switch {
+		case err == nil:
+			policy.ID = existing.GetID()
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			policy.ID = 0
+		default:
+			return err
+		}
+		return tx.Save(policy).Error
+	})
+}
@@ -0,0 +1,31 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"gorm.io/gorm"
+)
+
+// UpdateExternalGrades creates the given external grades, or replaces the enrollments'
+// existing scores for the same components. Either all or none of the grades are updated.
+func (db *GormDB) UpdateExternalGrades(grades []*qf.ExternalGrade) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		for _, grade := range grades {
+			var existing qf.ExternalGrade
+			err := tx.Where("enrollment_id = ? AND component = ?", grade.GetEnrollmentID(), grade.GetComponent()).First(&existing).Error
+			switch {
+			case err == nil:
+				grade.ID = existing.GetID()
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				grade.ID = 0
+			default:
+				return err
+			}
+			if err := tx.Save(grade).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,48 @@
+package database
+
+import (
+	"github.com/quickfeed/quickfeed/qf"
+	"gorm.io/gorm"
+)
+
+// GetFeedbackSnippets returns all feedback snippets, with their usage, matching the given query.
+func (db *GormDB) GetFeedbackSnippets(query *qf.FeedbackSnippet) ([]*qf.FeedbackSnippet, error) {
+	var snippets []*qf.FeedbackSnippet
+	if err := db.conn.Preload("Usage").Where(query).Order("id").Find(&snippets).Error; err != nil {
+		return nil, err
+	}
+	return snippets, nil
+}
+
+// CreateFeedbackSnippet adds a new feedback snippet.
+func (db *GormDB) CreateFeedbackSnippet(snippet *qf.FeedbackSnippet) error {
+	return db.conn.Omit("Usage").Create(snippet).Error
+}
+
+// UpdateFeedbackSnippet updates the title, body and tags of the given feedback snippet.
+// The snippet's usage is not changed.
+func (db *GormDB) UpdateFeedbackSnippet(snippet *qf.FeedbackSnippet) error {
+	return db.conn.Model(snippet).Select("title", "body", "tags").Updates(snippet).Error
+}
+
+// DeleteFeedbackSnippet removes the given feedback snippet and its usage.
+func (db *GormDB) DeleteFeedbackSnippet(snippet *qf.FeedbackSnippet) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(&qf.FeedbackSnippetUsage{FeedbackSnippetID: snippet.GetID()}).Delete(&qf.FeedbackSnippetUsage{}).Error; err != nil {
+			return err // will rollback transaction
+		}
+		return tx.Delete(snippet).Error
+	})
+}
+
+// IncrementFeedbackSnippetUsage increments the number of uses of the snippet in the given assignment.
+func (db *GormDB) IncrementFeedbackSnippetUsage(snippetID, assignmentID uint64) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		usage := &qf.FeedbackSnippetUsage{FeedbackSnippetID: snippetID, AssignmentID: assignmentID}
+		if err := tx.Where(usage).FirstOrInit(usage).Error; err != nil {
+			return err // will rollback transaction
+		}
+		usage.Count++
+		return tx.Save(usage).Error
+	})
+}
@@ -0,0 +1,50 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"gorm.io/gorm"
+)
+
+// GetGradingConfig returns the grading configuration of the given course,
+// with its assignment weights and external components.
+func (db *GormDB) GetGradingConfig(courseID uint64) (*qf.GradingConfig, error) {
+	var config qf.GradingConfig
+	if err := db.conn.Preload("Weights").Preload("Components").
+		Where(&qf.GradingConfig{CourseID: courseID}).
+		First(&config).Error; err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// UpdateGradingConfig creates the given grading configuration, or replaces
+// the course's existing configuration, its assignment weights and external components.
+func (db *GormDB) UpdateGradingConfig(config *qf.GradingConfig) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		var existing qf.GradingConfig
+		err := tx.Where(&qf.GradingConfig{CourseID: config.GetCourseID()}).First(&existing).Error
+		switch {
+		case err == nil:
+			config.ID = existing.GetID()
+			if err := tx.Where(&qf.AssignmentWeight{GradingConfigID: config.GetID()}).Delete(&qf.AssignmentWeight{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Where(&qf.ExternalComponent{GradingConfigID: config.GetID()}).Delete(&qf.ExternalComponent{}).Error; err != nil {
+				return err
+			}
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			config.ID = 0
+		default:
+			return err
+		}
+		for _, weight := range config.GetWeights() {
+			weight.ID = 0
+		}
+		for _, component := range config.GetComponents() {
+			component.ID = 0
+		}
+		return tx.Save(config).Error
+	})
+}
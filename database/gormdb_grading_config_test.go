@@ -0,0 +1,62 @@
+package database_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/testing/protocmp"
+	"gorm.io/gorm"
+)
+
+func TestGormDBUpdateGradingConfig(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	const courseID = 1
+	if _, err := db.GetGradingConfig(courseID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("GetGradingConfig() without configuration: got %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+
+	config := &qf.GradingConfig{
+		CourseID:    courseID,
+		Weights:     []*qf.AssignmentWeight{{AssignmentID: 1, Weight: 2}, {AssignmentID: 2, Weight: 1, Required: true}},
+		MinApproved: 2,
+		Components:  []*qf.ExternalComponent{{Name: "exam", Weight: 4}},
+		GradePoints: []uint32{60, 0},
+		GradeNames:  []string{"Pass", "Fail"},
+	}
+	if err := db.UpdateGradingConfig(config); err != nil {
+		t.Fatal(err)
+	}
+	got, err := db.GetGradingConfig(courseID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(config, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetGradingConfig() mismatch (-want +got):\n%s", diff)
+	}
+
+	// updating the configuration replaces its weights and components
+	update := &qf.GradingConfig{
+		CourseID:    courseID,
+		Weights:     []*qf.AssignmentWeight{{AssignmentID: 3, Weight: 5}},
+		GradePoints: []uint32{90, 0},
+		GradeNames:  []string{"A", "F"},
+	}
+	if err := db.UpdateGradingConfig(update); err != nil {
+		t.Fatal(err)
+	}
+	if update.GetID() != config.GetID() {
+		t.Errorf("UpdateGradingConfig() ID = %d, want %d", update.GetID(), config.GetID())
+	}
+	got, err = db.GetGradingConfig(courseID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(update, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetGradingConfig() after update mismatch (-want +got):\n%s", diff)
+	}
+}
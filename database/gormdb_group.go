@@ -9,6 +9,7 @@ import (
 )
 
 // CreateGroup creates a new group and assign users to newly created group.
+// The users' membership of the group is recorded in the group's membership history.
 func (db *GormDB) CreateGroup(group *qf.Group) error {
 	if len(group.Users) == 0 {
 		return ErrEmptyGroup
@@ -42,7 +43,7 @@ func (db *GormDB) CreateGroup(group *qf.Group) error {
 	query := tx.Model(&qf.Enrollment{}).
 		Where(&qf.Enrollment{CourseID: group.CourseID}).
 		Where("user_id IN (?) AND status IN (?)", userids,
-			[]qf.Enrollment_UserStatus{qf.Enrollment_STUDENT, qf.Enrollment_TEACHER}).
+			[]qf.Enrollment_UserStatus{qf.Enrollment_STUDENT, qf.Enrollment_TEACHER, qf.Enrollment_ASSISTANT}).
 		Updates(&qf.Enrollment{GroupID: group.ID})
 	if query.Error != nil {
 		tx.Rollback()
@@ -52,12 +53,17 @@ func (db *GormDB) CreateGroup(group *qf.Group) error {
 		tx.Rollback()
 		return ErrUpdateGroup
 	}
+	if err := updateGroupMemberships(tx, group, userids); err != nil {
+		tx.Rollback()
+		return err
+	}
 
 	tx.Commit()
 	return nil
 }
 
 // UpdateGroup updates a group with the specified users and enrollments.
+// Users joining or leaving the group are recorded in the group's membership history.
 func (db *GormDB) UpdateGroup(group *qf.Group) error {
 	if group.CourseID == 0 {
 		return gorm.ErrRecordNotFound
@@ -96,6 +102,7 @@ func (db *GormDB) UpdateGroup(group *qf.Group) error {
 		Where("status IN (?)", []qf.Enrollment_UserStatus{
 			qf.Enrollment_STUDENT,
 			qf.Enrollment_TEACHER,
+			qf.Enrollment_ASSISTANT,
 		}).Updates(&qf.Enrollment{GroupID: group.ID})
 	if query.Error != nil {
 		tx.Rollback()
@@ -105,6 +112,10 @@ func (db *GormDB) UpdateGroup(group *qf.Group) error {
 		tx.Rollback()
 		return ErrUpdateGroup
 	}
+	if err := updateGroupMemberships(tx, group, userids); err != nil {
+		tx.Rollback()
+		return err
+	}
 
 	tx.Commit()
 	return nil
@@ -115,7 +126,8 @@ func (db *GormDB) UpdateGroupStatus(group *qf.Group) error {
 	return db.conn.Model(group).Update("status", group.Status).Error
 }
 
-// DeleteGroup deletes a group and its corresponding enrollments.
+// DeleteGroup deletes a group, its invitations and its corresponding enrollments.
+// The group's members are recorded as leaving the group, keeping the group's membership history.
 func (db *GormDB) DeleteGroup(groupID uint64) error {
 	group, err := db.GetGroup(groupID)
 	if err != nil {
@@ -131,6 +143,14 @@ func (db *GormDB) DeleteGroup(groupID uint64) error {
 		tx.Rollback()
 		return err
 	}
+	if err := tx.Where(&qf.GroupInvitation{GroupID: groupID}).Delete(&qf.GroupInvitation{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := updateGroupMemberships(tx, group, nil); err != nil {
+		tx.Rollback()
+		return err
+	}
 	tx.Commit()
 	return nil
 }
@@ -168,6 +188,7 @@ func (db *GormDB) GetGroupsByCourse(courseID uint64, statuses ...qf.Group_GroupS
 		statuses = []qf.Group_GroupStatus{
 			qf.Group_PENDING,
 			qf.Group_APPROVED,
+			qf.Group_INVITED,
 		}
 	}
 	var groups []*qf.Group
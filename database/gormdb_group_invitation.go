@@ -0,0 +1,33 @@
+package database
+
+import (
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+// CreateGroupInvitations creates the given group invitations.
+func (db *GormDB) CreateGroupInvitations(invitations []*qf.GroupInvitation) error {
+	if len(invitations) == 0 {
+		return nil
+	}
+	return db.conn.Omit("Group", "User", "Inviter").Create(invitations).Error
+}
+
+// GetGroupInvitations returns all group invitations matching the query and one of the given statuses,
+// ordered by ID, with their groups, invited users and inviters. If no status is given, invitations
+// with any status are returned.
+func (db *GormDB) GetGroupInvitations(query *qf.GroupInvitation, statuses ...qf.GroupInvitation_Status) ([]*qf.GroupInvitation, error) {
+	m := db.conn.Preload("Group").Preload("User").Preload("Inviter").Where(query)
+	if len(statuses) > 0 {
+		m = m.Where("status in (?)", statuses)
+	}
+	var invitations []*qf.GroupInvitation
+	if err := m.Order("id").Find(&invitations).Error; err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// UpdateGroupInvitation updates the status of the given group invitation.
+func (db *GormDB) UpdateGroupInvitation(invitation *qf.GroupInvitation) error {
+	return db.conn.Model(invitation).Update("status", invitation.GetStatus()).Error
+}
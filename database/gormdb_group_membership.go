@@ -0,0 +1,58 @@
+package database
+
+import (
+	"slices"
+	"time"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// GetGroupMemberships returns all group memberships matching the query, with their users,
+// ordered by group and the time the students joined the group.
+func (db *GormDB) GetGroupMemberships(query *qf.GroupMembership) ([]*qf.GroupMembership, error) {
+	var memberships []*qf.GroupMembership
+	if err := db.conn.Preload("User").Where(query).Order("group_id, joined, id").Find(&memberships).Error; err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+// updateGroupMemberships records that the given users are the group's members from now on:
+// the current members that are not among the users leave the group, and the users that
+// are not current members join the group. Must be called within a transaction.
+func updateGroupMemberships(tx *gorm.DB, group *qf.Group, userIDs []uint64) error {
+	var current []*qf.GroupMembership
+	if err := tx.Where(&qf.GroupMembership{GroupID: group.GetID()}).Where("`left` IS NULL").Find(&current).Error; err != nil {
+		return err
+	}
+	now := timestamppb.New(time.Now())
+	members := make(map[uint64]bool)
+	for _, membership := range current {
+		members[membership.GetUserID()] = true
+		if slices.Contains(userIDs, membership.GetUserID()) {
+			continue
+		}
+		membership.Left = now
+		if err := tx.Omit("User").Save(membership).Error; err != nil {
+			return err
+		}
+	}
+	for _, userID := range userIDs {
+		if members[userID] {
+			continue
+		}
+		membership := &qf.GroupMembership{
+			CourseID:  group.GetCourseID(),
+			GroupID:   group.GetID(),
+			UserID:    userID,
+			GroupName: group.GetName(),
+			Joined:    now,
+		}
+		if err := tx.Omit("User").Create(membership).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
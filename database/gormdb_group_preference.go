@@ -0,0 +1,34 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"gorm.io/gorm"
+)
+
+// GetGroupPreferences returns all group preferences matching the query, ordered by ID.
+func (db *GormDB) GetGroupPreferences(query *qf.GroupPreference) ([]*qf.GroupPreference, error) {
+	var preferences []*qf.GroupPreference
+	if err := db.conn.Where(query).Order("id").Find(&preferences).Error; err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
+
+// UpdateGroupPreference creates the given group preference, or replaces the user's existing preference in the course.
+func (db *GormDB) UpdateGroupPreference(preference *qf.GroupPreference) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		var existing qf.GroupPreference
+		err := tx.Where(&qf.GroupPreference{CourseID: preference.GetCourseID(), UserID: preference.GetUserID()}).First(&existing).Error
+		switch {
+		case err == nil:
+			preference.ID = existing.GetID()
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			preference.ID = 0
+		default:
+			return err
+		}
+		return tx.Save(preference).Error
+	})
+}
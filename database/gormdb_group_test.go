@@ -352,3 +352,52 @@ func TestGetGroupsByCourse(t *testing.T) {
 		t.Errorf("Expected one pending and one approved group, got %d pending, %d approved", len(pendingGroups), len(approvedGroups))
 	}
 }
+
+func TestGroupMemberships(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := &qf.Course{}
+	qtest.CreateCourse(t, db, admin, course)
+	var users []*qf.User
+	for range 3 {
+		user := qtest.CreateFakeUser(t, db)
+		qtest.EnrollStudent(t, db, user, course)
+		users = append(users, user)
+	}
+
+	group := &qf.Group{Name: "MembershipGroup", CourseID: course.ID, Users: users[:2]}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	// the first user leaves the group, and the third user joins the group
+	group.Users = users[1:]
+	if err := db.UpdateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	checkMemberships := func(want map[uint64]bool) {
+		t.Helper()
+		memberships, err := db.GetGroupMemberships(&qf.GroupMembership{GroupID: group.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make(map[uint64]bool)
+		for _, membership := range memberships {
+			if membership.GetGroupName() != group.Name || membership.GetUser().GetID() != membership.GetUserID() {
+				t.Errorf("GetGroupMemberships() = %v, want membership of group %q with user", membership, group.Name)
+			}
+			got[membership.GetUserID()] = membership.GetLeft() == nil
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("GetGroupMemberships() current members mismatch (-want +got):\n%s", diff)
+		}
+	}
+	checkMemberships(map[uint64]bool{users[0].ID: false, users[1].ID: true, users[2].ID: true})
+
+	// deleting the group keeps the group's history
+	if err := db.DeleteGroup(group.ID); err != nil {
+		t.Fatal(err)
+	}
+	checkMemberships(map[uint64]bool{users[0].ID: false, users[1].ID: false, users[2].ID: false})
+}
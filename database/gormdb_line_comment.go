@@ -0,0 +1,27 @@
+package database
+
+import "github.com/quickfeed/quickfeed/qf"
+
+// GetLineComments returns all line comments matching the given query, ordered by file and line.
+func (db *GormDB) GetLineComments(query *qf.LineComment) ([]*qf.LineComment, error) {
+	var comments []*qf.LineComment
+	if err := db.conn.Where(query).Order("path, start_line, id").Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// CreateLineComment adds a new line comment.
+func (db *GormDB) CreateLineComment(comment *qf.LineComment) error {
+	return db.conn.Create(comment).Error
+}
+
+// UpdateLineComment updates the given line comment.
+func (db *GormDB) UpdateLineComment(comment *qf.LineComment) error {
+	return db.conn.Model(comment).Select("*").Updates(comment).Error
+}
+
+// DeleteLineComment removes the given line comment.
+func (db *GormDB) DeleteLineComment(comment *qf.LineComment) error {
+	return db.conn.Delete(comment).Error
+}
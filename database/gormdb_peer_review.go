@@ -0,0 +1,62 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"gorm.io/gorm"
+)
+
+// GetPeerReviews returns all peer reviews matching the given query.
+func (db *GormDB) GetPeerReviews(query *qf.PeerReview) ([]*qf.PeerReview, error) {
+	var peerReviews []*qf.PeerReview
+	if err := db.conn.Where(query).Order("id").Find(&peerReviews).Error; err != nil {
+		return nil, err
+	}
+	return peerReviews, nil
+}
+
+// CreatePeerReviews creates the given peer reviews.
+func (db *GormDB) CreatePeerReviews(peerReviews []*qf.PeerReview) error {
+	if len(peerReviews) == 0 {
+		return nil
+	}
+	return db.conn.Create(peerReviews).Error
+}
+
+// UpdatePeerReview updates the given peer review, including zero value fields such as the open field.
+func (db *GormDB) UpdatePeerReview(peerReview *qf.PeerReview) error {
+	return db.conn.Model(peerReview).Select("*").Updates(peerReview).Error
+}
+
+// GetPeerReviewScores returns all peer review scores matching the given query.
+func (db *GormDB) GetPeerReviewScores(query *qf.PeerReviewScore) ([]*qf.PeerReviewScore, error) {
+	var scores []*qf.PeerReviewScore
+	if err := db.conn.Where(query).Order("id").Find(&scores).Error; err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
+
+// UpdatePeerReviewScores creates the given peer review scores, or replaces the reviewers'
+// existing scores for the same assignments. Either all or none of the scores are updated.
+func (db *GormDB) UpdatePeerReviewScores(scores []*qf.PeerReviewScore) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		for _, score := range scores {
+			var existing qf.PeerReviewScore
+			err := tx.Where("assignment_id = ? AND user_id = ?", score.GetAssignmentID(), score.GetUserID()).First(&existing).Error
+			switch {
+			case err == nil:
+				score.ID = existing.GetID()
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				score.ID = 0
+			default:
+				return err
+			}
+			if err := tx.Save(score).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
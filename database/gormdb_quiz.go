@@ -0,0 +1,105 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"gorm.io/gorm"
+)
+
+// ErrNoQuizAttempts is returned when trying to start a quiz attempt
+// after all the quiz's attempts have been used.
+var ErrNoQuizAttempts = errors.New("no quiz attempts remaining")
+
+// GetQuiz returns the quiz, with its questions, matching the given query.
+func (db *GormDB) GetQuiz(query *qf.Quiz) (*qf.Quiz, error) {
+	var quiz qf.Quiz
+	if err := db.conn.Where(query).
+		Preload("Questions", func(tx *gorm.DB) *gorm.DB {
+			return tx.Order("number")
+		}).
+		First(&quiz).Error; err != nil {
+		return nil, err
+	}
+	return &quiz, nil
+}
+
+// CreateQuizAttempt creates a new attempt for the given user and quiz,
+// unless all the quiz's attempts have already been used by the user.
+// The attempt number is assigned by this method.
+func (db *GormDB) CreateQuizAttempt(attempt *qf.QuizAttempt) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		var quiz qf.Quiz
+		if err := tx.First(&quiz, attempt.GetQuizID()).Error; err != nil {
+			return err // will rollback transaction
+		}
+		var attempts int64
+		if err := tx.Model(&qf.QuizAttempt{}).Where(&qf.QuizAttempt{
+			QuizID: attempt.GetQuizID(),
+			UserID: attempt.GetUserID(),
+		}).Count(&attempts).Error; err != nil {
+			return err // will rollback transaction
+		}
+		if attempts >= int64(quiz.GetMaxAttempts()) {
+			return ErrNoQuizAttempts
+		}
+		attempt.Attempt = uint32(attempts) + 1
+		return tx.Create(attempt).Error
+	})
+}
+
+// GetQuizAttempts returns all attempts, with answers, matching the given query.
+// The attempts are ordered by attempt number.
+func (db *GormDB) GetQuizAttempts(query *qf.QuizAttempt) ([]*qf.QuizAttempt, error) {
+	var attempts []*qf.QuizAttempt
+	if err := db.conn.Where(query).
+		Preload("Answers").
+		Order("attempt").
+		Find(&attempts).Error; err != nil {
+		return nil, err
+	}
+	return attempts, nil
+}
+
+// UpdateQuizAttempt updates the given attempt and saves its answers.
+func (db *GormDB) UpdateQuizAttempt(attempt *qf.QuizAttempt) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(&qf.QuizAnswer{AttemptID: attempt.GetID()}).Delete(&qf.QuizAnswer{}).Error; err != nil {
+			return err // will rollback transaction
+		}
+		for _, answer := range attempt.GetAnswers() {
+			answer.ID = 0
+		}
+		return tx.Session(&gorm.Session{FullSaveAssociations: true}).Save(attempt).Error
+	})
+}
+
+// updateQuiz updates the assignment's quiz and replaces its questions with those of the incoming assignment.
+// The quiz record is kept, such that existing attempts remain associated with the quiz.
+// If the incoming assignment has no quiz, the existing quiz and its questions are removed.
+func updateQuiz(tx *gorm.DB, assignment *qf.Assignment) error {
+	var quiz qf.Quiz
+	err := tx.Where(&qf.Quiz{AssignmentID: assignment.GetID()}).First(&quiz).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if quiz.GetID() > 0 {
+		if err := tx.Where(&qf.QuizQuestion{QuizID: quiz.GetID()}).Delete(&qf.QuizQuestion{}).Error; err != nil {
+			return err
+		}
+	}
+	newQuiz := assignment.GetQuiz()
+	if newQuiz == nil {
+		if quiz.GetID() > 0 {
+			return tx.Delete(&quiz).Error
+		}
+		return nil
+	}
+	newQuiz.ID = quiz.GetID()
+	newQuiz.AssignmentID = assignment.GetID()
+	for _, question := range newQuiz.GetQuestions() {
+		question.ID = 0
+		question.QuizID = newQuiz.GetID()
+	}
+	return tx.Save(newQuiz).Error
+}
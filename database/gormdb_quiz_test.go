@@ -0,0 +1,122 @@
+package database_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/database"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newQuizAssignment(questions ...string) *qf.Assignment {
+	quiz := &qf.Quiz{CourseID: 1, TimeLimit: 60, MaxAttempts: 2}
+	for i, text := range questions {
+		quiz.Questions = append(quiz.Questions, &qf.QuizQuestion{
+			Number:  uint32(i + 1),
+			Text:    text,
+			Choices: []string{"yes", "no"},
+			Answers: []string{"a"},
+			Weight:  1,
+		})
+	}
+	return &qf.Assignment{CourseID: 1, Order: 1, Name: "quiz", Quiz: quiz}
+}
+
+func TestGormDBUpdateAssignmentsWithQuiz(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db)
+	qtest.CreateCourse(t, db, admin, &qf.Course{})
+
+	if err := db.UpdateAssignments([]*qf.Assignment{newQuizAssignment("Q1", "Q2")}); err != nil {
+		t.Fatal(err)
+	}
+	quiz, err := db.GetQuiz(&qf.Quiz{AssignmentID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quiz.GetQuestions()) != 2 {
+		t.Fatalf("got %d questions, want 2", len(quiz.GetQuestions()))
+	}
+
+	// update the quiz; the quiz ID must be kept and the questions replaced
+	if err := db.UpdateAssignments([]*qf.Assignment{newQuizAssignment("Q3")}); err != nil {
+		t.Fatal(err)
+	}
+	updatedQuiz, err := db.GetQuiz(&qf.Quiz{AssignmentID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := newQuizAssignment("Q3").GetQuiz()
+	want.ID = quiz.GetID()
+	want.AssignmentID = 1
+	if diff := cmp.Diff(want, updatedQuiz, protocmp.Transform(), protocmp.IgnoreFields(&qf.QuizQuestion{}, "ID", "QuizID")); diff != "" {
+		t.Errorf("GetQuiz() mismatch (-want +got):\n%s", diff)
+	}
+
+	// remove the quiz
+	assignment := newQuizAssignment()
+	assignment.Quiz = nil
+	if err := db.UpdateAssignments([]*qf.Assignment{assignment}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetQuiz(&qf.Quiz{AssignmentID: 1}); err == nil {
+		t.Error("GetQuiz() succeeded for removed quiz")
+	}
+}
+
+func TestGormDBQuizAttempts(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeUser(t, db)
+	qtest.CreateCourse(t, db, admin, &qf.Course{})
+	if err := db.UpdateAssignments([]*qf.Assignment{newQuizAssignment("Q1")}); err != nil {
+		t.Fatal(err)
+	}
+	quiz, err := db.GetQuiz(&qf.Quiz{AssignmentID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := uint32(1); i <= quiz.GetMaxAttempts(); i++ {
+		attempt := &qf.QuizAttempt{QuizID: quiz.GetID(), UserID: admin.GetID(), Started: timestamppb.Now()}
+		if err := db.CreateQuizAttempt(attempt); err != nil {
+			t.Fatal(err)
+		}
+		if attempt.GetAttempt() != i {
+			t.Errorf("CreateQuizAttempt() attempt = %d, want %d", attempt.GetAttempt(), i)
+		}
+	}
+	if err := db.CreateQuizAttempt(&qf.QuizAttempt{QuizID: quiz.GetID(), UserID: admin.GetID()}); !errors.Is(err, database.ErrNoQuizAttempts) {
+		t.Errorf("CreateQuizAttempt() error = %v, want %v", err, database.ErrNoQuizAttempts)
+	}
+
+	attempts, err := db.GetQuizAttempts(&qf.QuizAttempt{QuizID: quiz.GetID(), UserID: admin.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	attempt := attempts[len(attempts)-1]
+	attempt.Submitted = timestamppb.Now()
+	attempt.Answers = []*qf.QuizAnswer{{Number: 1, Selected: []string{"a", "b"}}}
+	if err := db.UpdateQuizAttempt(attempt); err != nil {
+		t.Fatal(err)
+	}
+	// submitting again replaces the answers
+	attempt.Answers = []*qf.QuizAnswer{{Number: 1, Selected: []string{"a"}}}
+	if err := db.UpdateQuizAttempt(attempt); err != nil {
+		t.Fatal(err)
+	}
+	gotAttempts, err := db.GetQuizAttempts(&qf.QuizAttempt{ID: attempt.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*qf.QuizAttempt{attempt}, gotAttempts, protocmp.Transform()); diff != "" {
+		t.Errorf("GetQuizAttempts() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,22 @@
+package database
+
+import "github.com/quickfeed/quickfeed/qf"
+
+// GetRegradeRequests returns all regrade requests matching the given query, oldest first.
+func (db *GormDB) GetRegradeRequests(query *qf.RegradeRequest) ([]*qf.RegradeRequest, error) {
+	var requests []*qf.RegradeRequest
+	if err := db.conn.Where(query).Order("id").Find(&requests).Error; err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// CreateRegradeRequest adds a new regrade request.
+func (db *GormDB) CreateRegradeRequest(request *qf.RegradeRequest) error {
+	return db.conn.Create(request).Error
+}
+
+// UpdateRegradeRequest updates the given regrade request.
+func (db *GormDB) UpdateRegradeRequest(request *qf.RegradeRequest) error {
+	return db.conn.Model(request).Select("*").Updates(request).Error
+}
@@ -0,0 +1,30 @@
+package database
+
+import (
+	"github.com/quickfeed/quickfeed/qf"
+	"gorm.io/gorm"
+)
+
+// GetReviewAllocations returns all review allocations matching the given query.
+func (db *GormDB) GetReviewAllocations(query *qf.ReviewAllocation) ([]*qf.ReviewAllocation, error) {
+	var allocations []*qf.ReviewAllocation
+	if err := db.conn.Where(query).Order("id").Find(&allocations).Error; err != nil {
+		return nil, err
+	}
+	return allocations, nil
+}
+
+// UpdateReviewAllocations removes the deleted allocations and creates the new allocations in a single transaction.
+func (db *GormDB) UpdateReviewAllocations(deleted, created []*qf.ReviewAllocation) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		for _, allocation := range deleted {
+			if err := tx.Delete(allocation).Error; err != nil {
+				return err // will rollback transaction
+			}
+		}
+		if len(created) == 0 {
+			return nil
+		}
+		return tx.Create(created).Error
+	})
+}
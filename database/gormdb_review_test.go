@@ -100,3 +100,47 @@ func updateSubmission(t *testing.T, db database.Database, wantReview *qf.Review)
 		t.Errorf("Expected same review, but got (-got +want):\n%s", diff)
 	}
 }
+
+func TestDeleteReview(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	user, _, assignment := setupCourseAssignment(t, db)
+	submission := &qf.Submission{AssignmentID: assignment.ID, UserID: user.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	var reviews []*qf.Review
+	for _, reviewerID := range []uint64{1, 2} {
+		review := &qf.Review{
+			SubmissionID: submission.ID,
+			ReviewerID:   reviewerID,
+			GradingBenchmarks: []*qf.GradingBenchmark{{
+				AssignmentID: assignment.ID,
+				Heading:      "benchmark",
+				Criteria:     []*qf.GradingCriterion{{Description: "criterion", Points: 10}},
+			}},
+		}
+		if err := db.CreateReview(review); err != nil {
+			t.Fatal(err)
+		}
+		reviews = append(reviews, review)
+	}
+
+	if err := db.DeleteReview(&qf.Review{}); err != database.ErrMissingReviewQuery {
+		t.Errorf("DeleteReview() with empty query = %v, want %v", err, database.ErrMissingReviewQuery)
+	}
+	if err := db.DeleteReview(&qf.Review{ID: reviews[0].ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetReview(&qf.Review{ID: reviews[0].ID}); err == nil {
+		t.Errorf("GetReview() found deleted review %d", reviews[0].ID)
+	}
+	gotReview, err := db.GetReview(&qf.Review{ID: reviews[1].ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(reviews[1], gotReview, protocmp.Transform()); diff != "" {
+		t.Errorf("GetReview() mismatch (-want +got):\n%s", diff)
+	}
+}
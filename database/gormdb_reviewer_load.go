@@ -0,0 +1,60 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"gorm.io/gorm"
+)
+
+// ErrNoReviewers is returned when selecting a reviewer among no candidates.
+var ErrNoReviewers = errors.New("no reviewers to select from")
+
+// GetReviewerLoads returns the reviewer loads for the given course, including both teacher and group loads.
+func (db *GormDB) GetReviewerLoads(courseID uint64) ([]*qf.ReviewerLoad, error) {
+	var loads []*qf.ReviewerLoad
+	if err := db.conn.Where(&qf.ReviewerLoad{CourseID: courseID}).
+		Order("group_id, user_id").
+		Find(&loads).Error; err != nil {
+		return nil, err
+	}
+	return loads, nil
+}
+
+// NextReviewer selects the candidate with the lowest review count for the given course and group,
+// and increments the selected candidate's review count. Ties are resolved by the order of the candidates.
+// The group ID is 0 for teacher reviewers. Selection and update are done in a single transaction,
+// such that concurrent selections for the same course or group do not select from stale counts.
+func (db *GormDB) NextReviewer(courseID, groupID uint64, candidates []*qf.User) (*qf.User, error) {
+	if len(candidates) == 0 {
+		return nil, ErrNoReviewers
+	}
+	var reviewer *qf.User
+	err := db.conn.Transaction(func(tx *gorm.DB) error {
+		var loads []*qf.ReviewerLoad
+		// Must use string-based query since GORM does not support zero values in type-based Where clauses
+		if err := tx.Where("course_id = ? AND group_id = ?", courseID, groupID).Find(&loads).Error; err != nil {
+			return err // will rollback transaction
+		}
+		counts := make(map[uint64]*qf.ReviewerLoad)
+		for _, load := range loads {
+			counts[load.GetUserID()] = load
+		}
+		reviewer = candidates[0]
+		for _, candidate := range candidates {
+			if counts[candidate.GetID()].GetCount() < counts[reviewer.GetID()].GetCount() {
+				reviewer = candidate
+			}
+		}
+		load, ok := counts[reviewer.GetID()]
+		if !ok {
+			load = &qf.ReviewerLoad{CourseID: courseID, GroupID: groupID, UserID: reviewer.GetID()}
+		}
+		load.Count++
+		return tx.Save(load).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reviewer, nil
+}
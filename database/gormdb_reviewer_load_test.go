@@ -0,0 +1,73 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestGormDBNextReviewer(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	const courseID = 1
+	groupIDs := []uint64{1, 2, 3, 4}
+	teachers := []*qf.User{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}}
+	students := []*qf.User{{ID: 6}, {ID: 7}, {ID: 8}}
+
+	nextReviewer := func(groupID uint64, candidates []*qf.User, want *qf.User) {
+		t.Helper()
+		got, err := db.NextReviewer(courseID, groupID, candidates)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("NextReviewer(%d, %d) mismatch (-want, +got):\n%s", courseID, groupID, diff)
+		}
+	}
+
+	for i := 0; i < len(teachers)*5; i++ {
+		nextReviewer(0, teachers, teachers[i%len(teachers)])
+	}
+	// A new teacher is expected to be picked as reviewer until catching up with the other teachers.
+	newTeacher := &qf.User{ID: 9}
+	teachers = append(teachers, newTeacher)
+	for i := 0; i < 5; i++ {
+		nextReviewer(0, teachers, newTeacher)
+	}
+
+	for _, groupID := range groupIDs {
+		// group review counts are independent of other groups and of the course's teachers
+		for i := 0; i < len(students)*3; i++ {
+			nextReviewer(groupID, students, students[i%len(students)])
+		}
+		newStudent := &qf.User{ID: 10}
+		for i := 0; i < 3; i++ {
+			nextReviewer(groupID, append(students, newStudent), newStudent)
+		}
+	}
+
+	loads, err := db.GetReviewerLoads(courseID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := len(teachers) + len(groupIDs)*(len(students)+1); len(loads) != want {
+		t.Errorf("GetReviewerLoads() got %d loads, want %d", len(loads), want)
+	}
+	for _, load := range loads {
+		want := uint32(3) // student reviews per group
+		if load.GetGroupID() == 0 {
+			want = 5 // teacher reviews per course
+		}
+		if load.GetCount() != want {
+			t.Errorf("GetReviewerLoads() user %d in group %d has %d reviews, want %d", load.GetUserID(), load.GetGroupID(), load.GetCount(), want)
+		}
+	}
+
+	if _, err := db.NextReviewer(courseID, 1, nil); err == nil {
+		t.Error("NextReviewer() expected error for no candidates")
+	}
+}
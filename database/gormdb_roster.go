@@ -0,0 +1,32 @@
+package database
+
+import (
+	"github.com/quickfeed/quickfeed/qf"
+	"gorm.io/gorm"
+)
+
+// GetRoster returns the students on the course's institution roster, ordered by ID.
+func (db *GormDB) GetRoster(courseID uint64) ([]*qf.RosterEntry, error) {
+	var entries []*qf.RosterEntry
+	if err := db.conn.Where(&qf.RosterEntry{CourseID: courseID}).Order("id").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// UpdateRoster replaces the course's institution roster with the given students.
+func (db *GormDB) UpdateRoster(courseID uint64, entries []*qf.RosterEntry) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(&qf.RosterEntry{CourseID: courseID}).Delete(&qf.RosterEntry{}).Error; err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			entry.ID = 0
+			entry.CourseID = courseID
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		return tx.Create(entries).Error
+	})
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"gorm.io/gorm"
+)
+
+// GetScheduledJobs returns all scheduled jobs matching the given query.
+func (db *GormDB) GetScheduledJobs(query *qf.ScheduledJob) ([]*qf.ScheduledJob, error) {
+	var jobs []*qf.ScheduledJob
+	if err := db.conn.Where(query).Order("id").Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// GetPendingScheduledJobs returns all scheduled jobs that have not yet run.
+func (db *GormDB) GetPendingScheduledJobs() ([]*qf.ScheduledJob, error) {
+	var jobs []*qf.ScheduledJob
+	if err := db.conn.Where("completed IS NULL").Order("id").Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// SaveScheduledJob creates the given scheduled job, or replaces the assignment's
+// existing job of the same type.
+func (db *GormDB) SaveScheduledJob(job *qf.ScheduledJob) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		var existing qf.ScheduledJob
+		err := tx.Where("assignment_id = ? AND type = ?", job.GetAssignmentID(), job.GetType()).First(&existing).Error
+		switch {
+		case err == nil:
+			job.ID = existing.GetID()
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			job.ID = 0
+		default:
+			return err
+		}
+		return tx.Save(job).Error
+	})
+}
+
+// DeleteScheduledJob removes the given scheduled job.
+func (db *GormDB) DeleteScheduledJob(job *qf.ScheduledJob) error {
+	return db.conn.Delete(job).Error
+}
+
+// GetAuditEntries returns all audit entries matching the given query, oldest first.
+func (db *GormDB) GetAuditEntries(query *qf.AuditEntry) ([]*qf.AuditEntry, error) {
+	var entries []*qf.AuditEntry
+	if err := db.conn.Where(query).Order("id").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// CreateAuditEntry adds a new audit entry.
+func (db *GormDB) CreateAuditEntry(entry *qf.AuditEntry) error {
+	return db.conn.Create(entry).Error
+}
@@ -14,6 +14,8 @@ var (
 	ErrInvalidSubmission = errors.New("submission must specify exactly one of UserID or GroupID")
 	// ErrInvalidAssignmentID is returned if assignment is not specified.
 	ErrInvalidAssignmentID = errors.New("cannot create submission without an associated assignment")
+	// ErrMissingReviewQuery is returned if a review deletion specifies neither review ID nor submission ID.
+	ErrMissingReviewQuery = errors.New("cannot delete reviews without review ID or submission ID")
 )
 
 // CreateSubmission creates a new submission record or updates the most
@@ -184,9 +186,11 @@ func (db *GormDB) UpdateSubmission(query *qf.Submission) error {
 }
 
 // UpdateSubmissions approves and/or releases all submissions that have score
-// equal or above the provided score for the given assignment ID
-func (db *GormDB) UpdateSubmissions(query *qf.Submission, approve bool) error {
-	return db.conn.Transaction(func(tx *gorm.DB) error {
+// equal or above the provided score for the given assignment ID.
+// It returns the number of updated submissions.
+func (db *GormDB) UpdateSubmissions(query *qf.Submission, approve bool) (int, error) {
+	var updated int
+	err := db.conn.Transaction(func(tx *gorm.DB) error {
 		var submissionIDs []*uint64
 		if err := tx.Model(&qf.Submission{}).
 			Where("assignment_id = ? AND score >= ?", query.AssignmentID, query.Score).
@@ -218,8 +222,55 @@ func (db *GormDB) UpdateSubmissions(query *qf.Submission, approve bool) error {
 			return err
 		}
 
+		updated = len(submissionIDs)
 		return nil
 	})
+	return updated, err
+}
+
+// ReleaseReviewedSubmissions releases all unreleased submissions for the given assignment
+// that have a ready review, not counting peer reviews.
+// It returns the number of released submissions.
+func (db *GormDB) ReleaseReviewedSubmissions(assignmentID uint64) (int64, error) {
+	reviewed := db.conn.Model(&qf.Review{}).Select("submission_id").Where("ready = ? AND peer = ?", true, false)
+	result := db.conn.Model(&qf.Submission{}).
+		Where("assignment_id = ? AND released = ? AND id IN (?)", assignmentID, false, reviewed).
+		Update("released", true)
+	return result.RowsAffected, result.Error
+}
+
+// GradeSubmissions approves the ungraded submissions for the given assignment that have score
+// equal or above the provided score limit, and rejects the remaining ungraded submissions.
+// Grades that have already been set are kept.
+// It returns the number of approved and rejected submissions.
+func (db *GormDB) GradeSubmissions(assignmentID uint64, scoreLimit uint32) (approved, rejected int, err error) {
+	err = db.conn.Transaction(func(tx *gorm.DB) error {
+		grade := func(condition string, status qf.Submission_Status) (int, error) {
+			var submissionIDs []uint64
+			if err := tx.Model(&qf.Submission{}).
+				Where("assignment_id = ? AND "+condition, assignmentID, scoreLimit).
+				Where("id IN (?)", tx.Model(&qf.Grade{}).Select("submission_id").Where("status = ?", qf.Submission_NONE)).
+				Pluck("id", &submissionIDs).Error; err != nil {
+				return 0, err
+			}
+			if len(submissionIDs) == 0 {
+				return 0, nil
+			}
+			if err := tx.Model(&qf.Grade{}).
+				Where("submission_id IN (?) AND status = ?", submissionIDs, qf.Submission_NONE).
+				Update("status", status).Error; err != nil {
+				return 0, err
+			}
+			return len(submissionIDs), nil
+		}
+		var err error
+		if approved, err = grade("score >= ?", qf.Submission_APPROVED); err != nil {
+			return err
+		}
+		rejected, err = grade("score < ?", qf.Submission_REJECTED)
+		return err
+	})
+	return approved, rejected, err
 }
 
 // GetReview fetches a review
@@ -244,7 +295,8 @@ func (db *GormDB) UpdateReview(query *qf.Review) error {
 	// By default, Gorm will not update zero value fields; such as the Ready bool field.
 	// Therefore we use Select before the Updates call. For additional context, see
 	// https://github.com/quickfeed/quickfeed/issues/569#issuecomment-1013729572
-	return db.conn.Model(&query).Select("*").Updates(&qf.Review{
+	// The final and peer fields are omitted, since they are decided when the review is created.
+	return db.conn.Model(&query).Select("*").Omit("final", "peer").Updates(&qf.Review{
 		ID:           query.ID,
 		SubmissionID: query.SubmissionID,
 		Feedback:     query.Feedback,
@@ -255,7 +307,60 @@ func (db *GormDB) UpdateReview(query *qf.Review) error {
 	}).Error
 }
 
-// DeleteReview removes all reviews matching the query.
+// CreateFinalReview creates the final review for the review's submission, replacing any existing final review,
+// and updates the submission's score to the final review's score.
+func (db *GormDB) CreateFinalReview(review *qf.Review) error {
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		var finalReviews []*qf.Review
+		if err := tx.Where(&qf.Review{SubmissionID: review.GetSubmissionID(), Final: true}).
+			Preload("GradingBenchmarks").
+			Find(&finalReviews).Error; err != nil {
+			return err // will rollback transaction
+		}
+		if err := deleteReviews(tx, finalReviews); err != nil {
+			return err // will rollback transaction
+		}
+		review.Final = true
+		if err := tx.Create(review).Error; err != nil {
+			return err // will rollback transaction
+		}
+		return tx.Model(&qf.Submission{ID: review.GetSubmissionID()}).
+			Update("score", review.GetScore()).Error
+	})
+}
+
+// DeleteReview removes all reviews matching the query, along with their grading benchmarks and criteria.
+// The query must specify the review ID or the submission ID.
 func (db *GormDB) DeleteReview(query *qf.Review) error {
-	return db.conn.Delete(&qf.Review{}, &query).Error
+	if query.GetID() == 0 && query.GetSubmissionID() == 0 {
+		return ErrMissingReviewQuery
+	}
+	return db.conn.Transaction(func(tx *gorm.DB) error {
+		var reviews []*qf.Review
+		if err := tx.Where(query).
+			Preload("GradingBenchmarks").
+			Find(&reviews).Error; err != nil {
+			return err // will rollback transaction
+		}
+		return deleteReviews(tx, reviews)
+	})
+}
+
+// deleteReviews removes the given reviews, along with their grading benchmarks and criteria.
+// The reviews' grading benchmarks must be preloaded.
+func deleteReviews(tx *gorm.DB, reviews []*qf.Review) error {
+	for _, review := range reviews {
+		for _, bm := range review.GetGradingBenchmarks() {
+			if err := tx.Where(&qf.GradingCriterion{BenchmarkID: bm.GetID()}).Delete(&qf.GradingCriterion{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(bm).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Delete(review).Error; err != nil {
+			return err
+		}
+	}
+	return nil
 }
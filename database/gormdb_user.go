@@ -44,6 +44,7 @@ func (db *GormDB) GetUserByCourse(query *qf.Course, login string) (*qf.User, err
 	enrollmentStatuses := []qf.Enrollment_UserStatus{
 		qf.Enrollment_STUDENT,
 		qf.Enrollment_TEACHER,
+		qf.Enrollment_ASSISTANT,
 	}
 
 	if err := db.conn.First(&course, query).Error; err != nil {
@@ -0,0 +1,240 @@
+package exercise
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	ErrNoQuestions       = errors.New("answer key has no questions")
+	ErrNegativePenalty   = errors.New("answer key penalty must be non-negative")
+	ErrInvalidQuestion   = errors.New("question number must be positive")
+	ErrDuplicateQuestion = errors.New("duplicate question")
+	ErrNoAnswers         = errors.New("question has no correct answers")
+	ErrInvalidLabel      = errors.New("answer label must be one of a-f")
+	ErrDuplicateLabel    = errors.New("duplicate answer label")
+	ErrNegativeWeight    = errors.New("question weight must be non-negative")
+)
+
+var labelRegExp = regexp.MustCompile(`^[a-f]$`)
+
+// AnswerKey holds the correct answers to a set of multiple choice questions.
+// An answer key is typically loaded from a YAML file in the tests repository:
+//
+//	deadline: 2024-02-01T23:59:00Z
+//	penalty: 0.5
+//	questions:
+//	  - number: 1
+//	    answers: [a, c]
+//	    weight: 2
+//	    explanation: "Both a) and c) multiply to 30."
+//	  - number: 2
+//	    answers: [b]
+//
+// Each question is worth weight points; the weight defaults to 1 if omitted or zero.
+// A question with several correct answers gives partial credit for each correct
+// answer selected. With a non-zero penalty (negative marking), each incorrectly
+// selected answer deducts penalty times the value of a correct answer, such that
+// a question may give negative points, down to -penalty times the question's weight.
+// The total score is never less than zero.
+//
+// If a deadline is given, the explanations are included in the feedback
+// after the deadline has passed.
+type AnswerKey struct {
+	Deadline  time.Time  `yaml:"deadline"`
+	Penalty   float64    `yaml:"penalty"`
+	Questions []Question `yaml:"questions"`
+}
+
+// Question holds the correct answers to a single multiple choice question.
+type Question struct {
+	Number      int      `yaml:"number"`
+	Answers     []string `yaml:"answers"`
+	Weight      int      `yaml:"weight"`
+	Explanation string   `yaml:"explanation"`
+}
+
+// QuestionResult holds the result of checking the selected answers for a question.
+type QuestionResult struct {
+	Number    int
+	Points    float64
+	MaxPoints int
+	Correct   []string // correct answers selected
+	Incorrect []string // incorrect answers selected
+	Missing   []string // correct answers not selected
+}
+
+// LoadAnswerKey returns the answer key found in the given YAML file.
+func LoadAnswerKey(keyFile string) (*AnswerKey, error) {
+	b, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnswerKey(b)
+}
+
+// ParseAnswerKey returns the answer key found in the given YAML content.
+// Questions without a weight or with weight 0 are given weight 1, and the answer labels are sorted.
+func ParseAnswerKey(content []byte) (*AnswerKey, error) {
+	key := &AnswerKey{}
+	if err := yaml.UnmarshalStrict(content, key); err != nil {
+		return nil, fmt.Errorf("failed to parse answer key: %w", err)
+	}
+	if err := key.validate(); err != nil {
+		return nil, err
+	}
+	for i := range key.Questions {
+		q := &key.Questions[i]
+		if q.Weight == 0 {
+			q.Weight = 1
+		}
+		sort.Strings(q.Answers)
+	}
+	sort.Slice(key.Questions, func(i, j int) bool {
+		return key.Questions[i].Number < key.Questions[j].Number
+	})
+	return key, nil
+}
+
+func (k *AnswerKey) validate() error {
+	if len(k.Questions) == 0 {
+		return ErrNoQuestions
+	}
+	if k.Penalty < 0 {
+		return ErrNegativePenalty
+	}
+	seen := make(map[int]bool)
+	for _, q := range k.Questions {
+		if q.Number < 1 {
+			return fmt.Errorf("%w: %d", ErrInvalidQuestion, q.Number)
+		}
+		if seen[q.Number] {
+			return fmt.Errorf("%w: %d", ErrDuplicateQuestion, q.Number)
+		}
+		seen[q.Number] = true
+		if len(q.Answers) == 0 {
+			return fmt.Errorf("%w: %d", ErrNoAnswers, q.Number)
+		}
+		labels := make(map[string]bool)
+		for _, label := range q.Answers {
+			if !labelRegExp.MatchString(label) {
+				return fmt.Errorf("%w: question %d: %q", ErrInvalidLabel, q.Number, label)
+			}
+			if labels[label] {
+				return fmt.Errorf("%w: question %d: %q", ErrDuplicateLabel, q.Number, label)
+			}
+			labels[label] = true
+		}
+		if q.Weight < 0 {
+			return fmt.Errorf("%w: %d", ErrNegativeWeight, q.Number)
+		}
+	}
+	return nil
+}
+
+// MaxScore returns the maximum score obtainable for the answer key,
+// that is the sum of the question weights.
+func (k *AnswerKey) MaxScore() int {
+	total := 0
+	for _, q := range k.Questions {
+		total += q.Weight
+	}
+	return total
+}
+
+// Check returns the result of comparing the selected answers to the answer key.
+// The selections map from question number to the selected answer labels,
+// as returned by ParseMarkdownSelections. The results are ordered by question number.
+func (k *AnswerKey) Check(selections map[int][]string) []QuestionResult {
+	results := make([]QuestionResult, 0, len(k.Questions))
+	for _, q := range k.Questions {
+		res := QuestionResult{Number: q.Number, MaxPoints: q.Weight}
+		selected := selections[q.Number]
+		for _, label := range selected {
+			if slices.Contains(q.Answers, label) {
+				res.Correct = append(res.Correct, label)
+			} else {
+				res.Incorrect = append(res.Incorrect, label)
+			}
+		}
+		for _, label := range q.Answers {
+			if !slices.Contains(selected, label) {
+				res.Missing = append(res.Missing, label)
+			}
+		}
+		credit := (float64(len(res.Correct)) - k.Penalty*float64(len(res.Incorrect))) / float64(len(q.Answers))
+		res.Points = math.Max(credit, -k.Penalty) * float64(q.Weight)
+		results = append(results, res)
+	}
+	return results
+}
+
+// Score returns the total score for the given results, rounded to the nearest integer.
+// The total score is never less than zero.
+func Score(results []QuestionResult) int {
+	total := 0.0
+	for _, res := range results {
+		total += res.Points
+	}
+	return int(math.Max(math.Round(total), 0))
+}
+
+// Feedback returns a per-question summary of the given results. If the answer key's
+// deadline has passed at the given time, the correct answers and explanations are
+// also included. Otherwise, only the points awarded for each question are included.
+func (k *AnswerKey) Feedback(results []QuestionResult, now time.Time) string {
+	released := !k.Deadline.IsZero() && now.After(k.Deadline)
+	explanations := make(map[int]Question, len(k.Questions))
+	for _, q := range k.Questions {
+		explanations[q.Number] = q
+	}
+	var b strings.Builder
+	for _, res := range results {
+		fmt.Fprintf(&b, "Question %d: %.2f/%d points", res.Number, res.Points, res.MaxPoints)
+		if released {
+			q := explanations[res.Number]
+			fmt.Fprintf(&b, "; correct answers: %s", strings.Join(q.Answers, ", "))
+			if len(res.Incorrect) > 0 {
+				fmt.Fprintf(&b, "; incorrect answers selected: %s", strings.Join(res.Incorrect, ", "))
+			}
+			if q.Explanation != "" {
+				fmt.Fprintf(&b, "\n\t%s", q.Explanation)
+			}
+		}
+		fmt.Fprintln(&b)
+	}
+	return b.String()
+}
+
+// MultipleChoiceWithKey reads the answer file in markdown format and compare answers with the answer key.
+// Multiple answers may be selected per question. The result is updated via the score object,
+// and the per-question feedback is recorded in the score object's TestDetails.
+// The score object's MaxScore should equal the answer key's MaxScore.
+func MultipleChoiceWithKey(t *testing.T, sc *score.Score, answerFile string, key *AnswerKey) {
+	t.Helper()
+	selections, err := ParseMarkdownSelections(answerFile)
+	if err != nil {
+		sc.Fail()
+		t.Fatal(err)
+	}
+	results := key.Check(selections)
+	for _, res := range results {
+		if res.Points < float64(res.MaxPoints) {
+			t.Errorf("%v: Question %d: Answer not found or incorrect.\n", sc.TestName, res.Number)
+		}
+	}
+	sc.Fail()
+	sc.IncBy(Score(results))
+	sc.TestDetails = key.Feedback(results, time.Now())
+}
@@ -0,0 +1,198 @@
+package exercise_test
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/kit/exercise"
+)
+
+var answerKeyFile = filepath.Join("..", "testdata", "c-prog-questions-answer-key.yml")
+
+func init() {
+	key, err := exercise.LoadAnswerKey(answerKeyFile)
+	if err != nil {
+		panic(err)
+	}
+	scores.Add(TestMultipleChoiceWithKey, key.MaxScore(), 1)
+}
+
+func TestParseMarkdownSelections(t *testing.T) {
+	selectionTests := []struct {
+		file string
+		want map[int][]string
+	}{
+		{
+			file: "c-prog-questions-blank-answers.md",
+			want: map[int][]string{},
+		},
+		{
+			file: "c-prog-questions-all-answers.md",
+			want: map[int][]string{1: {"a"}, 2: {"b"}, 3: {"c"}, 4: {"a"}, 5: {"b"}, 6: {"b"}, 7: {"d"}},
+		},
+		{
+			file: "c-prog-questions-multiple-checked.md",
+			want: map[int][]string{1: {"a", "c"}, 2: {"b", "d"}, 3: {"c"}, 4: {"a"}, 5: {"a", "b", "c", "d"}, 6: {"b"}, 7: {"d"}},
+		},
+	}
+	for _, test := range selectionTests {
+		t.Run(test.file, func(t *testing.T) {
+			got, err := exercise.ParseMarkdownSelections(filepath.Join("..", "testdata", test.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ParseMarkdownSelections() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseAnswerKey(t *testing.T) {
+	keyTests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{name: "NoQuestions", content: "penalty: 1\n", wantErr: exercise.ErrNoQuestions},
+		{name: "NegativePenalty", content: "penalty: -1\nquestions:\n  - number: 1\n    answers: [a]\n", wantErr: exercise.ErrNegativePenalty},
+		{name: "InvalidQuestion", content: "questions:\n  - number: 0\n    answers: [a]\n", wantErr: exercise.ErrInvalidQuestion},
+		{name: "DuplicateQuestion", content: "questions:\n  - number: 1\n    answers: [a]\n  - number: 1\n    answers: [b]\n", wantErr: exercise.ErrDuplicateQuestion},
+		{name: "NoAnswers", content: "questions:\n  - number: 1\n", wantErr: exercise.ErrNoAnswers},
+		{name: "InvalidLabel", content: "questions:\n  - number: 1\n    answers: [g]\n", wantErr: exercise.ErrInvalidLabel},
+		{name: "DuplicateLabel", content: "questions:\n  - number: 1\n    answers: [a, a]\n", wantErr: exercise.ErrDuplicateLabel},
+		{name: "NegativeWeight", content: "questions:\n  - number: 1\n    answers: [a]\n    weight: -1\n", wantErr: exercise.ErrNegativeWeight},
+		{name: "Valid", content: "questions:\n  - number: 2\n    answers: [c, a]\n    weight: 0\n  - number: 1\n    answers: [b]\n    weight: 3\n"},
+	}
+	for _, test := range keyTests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := exercise.ParseAnswerKey([]byte(test.content))
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("ParseAnswerKey() error = %v, want %v", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			want := &exercise.AnswerKey{
+				Questions: []exercise.Question{
+					{Number: 1, Answers: []string{"b"}, Weight: 3},
+					{Number: 2, Answers: []string{"a", "c"}, Weight: 1},
+				},
+			}
+			if diff := cmp.Diff(want, key); diff != "" {
+				t.Errorf("ParseAnswerKey() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnswerKeyCheck(t *testing.T) {
+	key, err := exercise.LoadAnswerKey(answerKeyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := key.MaxScore(), 9; got != want {
+		t.Errorf("MaxScore() = %d, want %d", got, want)
+	}
+	checkTests := []struct {
+		file       string
+		wantPoints []float64
+		wantScore  int
+	}{
+		{
+			file:       "c-prog-questions-blank-answers.md",
+			wantPoints: []float64{0, 0, 0, 0, 0, 0, 0},
+			wantScore:  0,
+		},
+		{
+			file:       "c-prog-questions-partial-answers.md",
+			wantPoints: []float64{1, 0, 0, -0.5, 0, -0.5, 0},
+			wantScore:  0,
+		},
+		{
+			// question 1 has two correct answers; only one is selected
+			file:       "c-prog-questions-all-answers.md",
+			wantPoints: []float64{1, 1, 1, 1, 2, 1, 1},
+			wantScore:  8,
+		},
+		{
+			// question 2 has one correct and one incorrect answer selected;
+			// question 5 has all answers selected, giving negative points
+			file:       "c-prog-questions-multiple-checked.md",
+			wantPoints: []float64{2, 0.5, 1, 1, -1, 1, 1},
+			wantScore:  6,
+		},
+	}
+	for _, test := range checkTests {
+		t.Run(test.file, func(t *testing.T) {
+			selections, err := exercise.ParseMarkdownSelections(filepath.Join("..", "testdata", test.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			results := key.Check(selections)
+			gotPoints := make([]float64, len(results))
+			for i, res := range results {
+				gotPoints[i] = res.Points
+			}
+			if diff := cmp.Diff(test.wantPoints, gotPoints); diff != "" {
+				t.Errorf("Check() points mismatch (-want +got):\n%s", diff)
+			}
+			if got := exercise.Score(results); got != test.wantScore {
+				t.Errorf("Score() = %d, want %d", got, test.wantScore)
+			}
+		})
+	}
+}
+
+func TestAnswerKeyFeedback(t *testing.T) {
+	key, err := exercise.LoadAnswerKey(answerKeyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	selections, err := exercise.ParseMarkdownSelections(filepath.Join("..", "testdata", "c-prog-questions-multiple-checked.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := key.Check(selections)
+
+	before := key.Feedback(results, key.Deadline.Add(-time.Hour))
+	if !strings.Contains(before, "Question 5: -1.00/2 points") {
+		t.Errorf("Feedback() before deadline missing points:\n%s", before)
+	}
+	if strings.Contains(before, "correct answers") || strings.Contains(before, key.Questions[0].Explanation) {
+		t.Errorf("Feedback() before deadline reveals answers:\n%s", before)
+	}
+
+	after := key.Feedback(results, key.Deadline.Add(time.Hour))
+	for _, want := range []string{
+		"Question 1: 2.00/2 points; correct answers: a, c",
+		key.Questions[0].Explanation,
+		"Question 5: -1.00/2 points; correct answers: b; incorrect answers selected: a, c, d",
+	} {
+		if !strings.Contains(after, want) {
+			t.Errorf("Feedback() after deadline missing %q:\n%s", want, after)
+		}
+	}
+}
+
+func TestMultipleChoiceWithKey(t *testing.T) {
+	key, err := exercise.LoadAnswerKey(answerKeyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the all-answers file selects only a) for question 1, which has two correct answers in the key
+	key.Questions[0].Answers = []string{"a"}
+	sc := scores.Min()
+	defer sc.Print(t)
+	exercise.MultipleChoiceWithKey(t, sc, filepath.Join("..", "testdata", "c-prog-questions-all-answers.md"), key)
+	if sc.Score != sc.MaxScore {
+		t.Errorf("Score=%d, expected %d", sc.Score, sc.MaxScore)
+	}
+	if !strings.Contains(sc.TestDetails, key.Questions[0].Explanation) {
+		t.Errorf("TestDetails missing explanation:\n%s", sc.TestDetails)
+	}
+}
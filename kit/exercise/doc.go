@@ -1,2 +1,7 @@
 // Package exercise contains helper functions for multiple choice exercises answered in markdown files.
+//
+// The MultipleChoice function checks answers against a map of correct answers, allowing only
+// a single answer per question. The MultipleChoiceWithKey function checks answers against an
+// AnswerKey loaded from a YAML file, allowing multiple correct answers per question,
+// per-question weights, negative marking, and explanations released after the deadline.
 package exercise
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -49,6 +50,37 @@ func ParseMarkdownAnswers(answerFile string) (map[int]string, error) {
 	return answerMap, nil
 }
 
+// ParseMarkdownSelections returns a map of all the answers found in the given answer file.
+// Unlike ParseMarkdownAnswers, multiple answers are allowed per question;
+// the answer labels for each question are returned in sorted order.
+func ParseMarkdownSelections(answerFile string) (map[int][]string, error) {
+	md, err := os.ReadFile(answerFile)
+	if err != nil {
+		return nil, err
+	}
+
+	currentQ := -1
+	// map: question# -> answer labels
+	selectionMap := make(map[int][]string)
+	for _, line := range strings.Split(string(md), "\n") {
+		if qNumRegExp.MatchString(line) {
+			qNum := qNumRegExp.ReplaceAllString(line, "$1")
+			// ignore error since regular expression ensure it is already a number
+			currentQ, _ = strconv.Atoi(qNum)
+		}
+		if currentQ != -1 && selectionRegExp.MatchString(line) {
+			label := selectionRegExp.ReplaceAllString(line, "$2")
+			if !slices.Contains(selectionMap[currentQ], label) {
+				selectionMap[currentQ] = append(selectionMap[currentQ], label)
+			}
+		}
+	}
+	for _, labels := range selectionMap {
+		sort.Strings(labels)
+	}
+	return selectionMap, nil
+}
+
 // CheckMultipleChoice returns the result of comparing the answers to the correct maps.
 // The answers and correct maps from keys representing the question number to the labels (answer value).
 // The question numbers (keys) in the correct map must contain all question numbers in the range 1 - len(correct).
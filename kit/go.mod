@@ -6,4 +6,5 @@ require (
 	github.com/alta/protopatch v0.5.3
 	github.com/google/go-cmp v0.6.0
 	google.golang.org/protobuf v1.35.2
+	gopkg.in/yaml.v2 v2.4.0
 )
@@ -0,0 +1,166 @@
+package score
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/quickfeed/quickfeed/kit/internal/test"
+)
+
+var (
+	ErrCaseType = errors.New("test case must be a struct with a string Name field")
+	ErrNoCases  = errors.New("no test cases")
+)
+
+// AddCases registers one score object for each of the given table-driven test cases.
+// Each test case is registered as a subtest of testFn with the name given by the
+// test case's Name field, a max score of 1, and the weight given by the test case's
+// Weight field. If the Weight field is missing or zero, the test case is given weight 1.
+// The test cases must be run with RunCases.
+//
+// Will panic if the test cases are not structs with a string Name field,
+// if a test case name is used more than once, or if a weight is negative.
+func AddCases[T any](s *registry, testFn any, cases []T) {
+	addCases(s, test.Name(testFn), "", cases)
+}
+
+// AddCasesWithTask registers one score object for each of the given table-driven test cases.
+// This function is identical to AddCases, with the addition of assigning a task name.
+func AddCasesWithTask[T any](s *registry, testFn any, taskName string, cases []T) {
+	addCases(s, test.Name(testFn), taskName, cases)
+}
+
+// AddCasesAggregate registers a single score object for testFn covering all the given
+// table-driven test cases. The max score is the sum of the test cases' Weight fields,
+// such that a passing test case increments the score by its weight.
+// If the Weight field is missing or zero, the test case is given weight 1.
+// The test cases must be run with RunCases.
+//
+// Will panic if the test cases are not structs with a string Name field,
+// if the test has already been registered, if weight is non-positive, or if
+// a test case's weight is negative.
+func AddCasesAggregate[T any](s *registry, testFn any, weight int, cases []T) {
+	s.internalAdd(test.Name(testFn), "", sumWeights(cases), weight)
+}
+
+// AddCasesAggregateWithTask registers a single score object for testFn covering all the
+// given table-driven test cases. This function is identical to AddCasesAggregate,
+// with the addition of assigning a task name.
+func AddCasesAggregateWithTask[T any](s *registry, testFn any, taskName string, weight int, cases []T) {
+	s.internalAdd(test.Name(testFn), taskName, sumWeights(cases), weight)
+}
+
+// RunCases runs fn as a subtest for each of the given test cases, and increments
+// the score for each passing subtest. A subtest passes if fn does not fail or panic.
+// The score objects are printed when the subtests have completed; there is no
+// need to call Print.
+//
+// If the cases were registered with AddCasesAggregate, a single score object is used,
+// and incremented by the weight of each passing test case. Otherwise, each test case
+// has its own score object.
+//
+// RunCases must be called from the Test function registered with the test cases:
+//
+//	func TestFibonacci(t *testing.T) {
+//	    score.RunCases(t, scores, fibonacciTests, func(t *testing.T, tc fibonacciTest) {
+//	        if got := fibonacci(tc.in); got != tc.want {
+//	            t.Errorf("fibonacci(%d) = %d, want %d", tc.in, got, tc.want)
+//	        }
+//	    })
+//	}
+//
+// Will panic with unknown score test, if the test cases haven't been added.
+func RunCases[T any](t *testing.T, s *registry, cases []T, fn func(*testing.T, T)) {
+	testName := t.Name()
+	if _, aggregate := s.scores[testName]; aggregate {
+		sc := s.get(testName)
+		for _, tc := range cases {
+			name, weight := caseInfo(tc)
+			if runCase(t, name, tc, fn) {
+				sc.IncBy(weight)
+			}
+		}
+		sc.Print(t)
+		return
+	}
+	for _, tc := range cases {
+		name, _ := caseInfo(tc)
+		sc := s.get(caseTestName(testName, name))
+		if runCase(t, name, tc, fn) {
+			sc.Inc()
+		}
+		sc.Print(t)
+	}
+}
+
+// runCase runs fn as a subtest for the given test case, and returns true if it passed.
+// A panicking subtest is recovered and reported as failed, allowing the remaining
+// test cases to run.
+func runCase[T any](t *testing.T, name string, tc T, fn func(*testing.T, T)) bool {
+	return t.Run(name, func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Fail()
+				printPanicMessage(t.Name(), "", r)
+			}
+		}()
+		fn(t, tc)
+	})
+}
+
+func addCases[T any](s *registry, testName, taskName string, cases []T) {
+	if len(cases) == 0 {
+		panic(test.ErrMsg(testName, ErrNoCases.Error()))
+	}
+	for _, tc := range cases {
+		name, weight := caseInfo(tc)
+		s.internalAdd(caseTestName(testName, name), taskName, 1, weight)
+	}
+}
+
+// sumWeights returns the sum of the weights of the given test cases.
+func sumWeights[T any](cases []T) int {
+	total := 0
+	for _, tc := range cases {
+		_, weight := caseInfo(tc)
+		total += weight
+	}
+	return total
+}
+
+// caseInfo returns the Name and Weight fields of the given test case.
+// If the Weight field is missing or zero, the returned weight is 1.
+// Will panic if the Weight field is negative.
+func caseInfo(tc any) (name string, weight int) {
+	v := reflect.ValueOf(tc)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		panic(test.ErrMsg(v.Type().String(), ErrCaseType.Error()))
+	}
+	nameField := v.FieldByName("Name")
+	if !nameField.IsValid() || nameField.Kind() != reflect.String {
+		panic(test.ErrMsg(v.Type().String(), ErrCaseType.Error()))
+	}
+	weight = 1
+	switch weightField := v.FieldByName("Weight"); {
+	case weightField.CanInt() && weightField.Int() != 0:
+		weight = int(weightField.Int())
+	case weightField.CanUint() && weightField.Uint() != 0:
+		weight = int(weightField.Uint())
+	}
+	if weight < 1 {
+		panic(test.ErrMsg(nameField.String(), ErrWeight.Error()))
+	}
+	return nameField.String(), weight
+}
+
+// caseTestName returns the name of the subtest for the given test case name.
+// Spaces are replaced by underscores, as done by the testing package.
+func caseTestName(testName, caseName string) string {
+	return fmt.Sprintf("%s/%s", testName, strings.ReplaceAll(caseName, " ", "_"))
+}
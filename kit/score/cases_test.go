@@ -0,0 +1,143 @@
+package score_test
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+)
+
+// To run the failing table-driven tests on their own, use:
+//   QUICKFEED_CASES_TEST=1 go test -v -run TestCasesFailing
+//
+
+const (
+	casesTestEnvName = "QUICKFEED_CASES_TEST"
+)
+
+type triangularCase struct {
+	Name     string
+	Weight   int
+	in, want uint
+}
+
+var triangularCases = []triangularCase{
+	{Name: "zero", in: 0, want: 0},
+	{Name: "one", in: 1, want: 1},
+	{Name: "two", Weight: 2, in: 2, want: 3},
+	{Name: "seven is large", Weight: 3, in: 7, want: 28},
+}
+
+var failingCases = []triangularCase{
+	{Name: "pass", Weight: 2, in: 3, want: 6},
+	{Name: "fail", Weight: 3, in: 4, want: 11},
+	{Name: "panic", in: 5, want: 15},
+}
+
+var caseScores = score.NewRegistry()
+
+func init() {
+	score.AddCases(caseScores, TestCases, triangularCases)
+	score.AddCasesAggregate(caseScores, TestCasesAggregate, 10, triangularCases)
+	score.AddCasesWithTask(caseScores, TestCasesFailing, "triangular", failingCases)
+	score.AddCasesAggregateWithTask(caseScores, TestCasesFailingAggregate, "triangular", 10, failingCases)
+}
+
+func checkTriangular(t *testing.T, tc triangularCase) {
+	if tc.Name == "panic" {
+		panic("emulating a panic in student code")
+	}
+	if got := triangular(tc.in); got != tc.want {
+		t.Errorf("triangular(%d) = %d, want %d", tc.in, got, tc.want)
+	}
+}
+
+func TestCases(t *testing.T) {
+	score.RunCases(t, caseScores, triangularCases, checkTriangular)
+	for _, tc := range triangularCases {
+		sc := caseScores.MinByName(t.Name() + "/" + strings.ReplaceAll(tc.Name, " ", "_"))
+		if sc.Score != 1 {
+			t.Errorf("%s: Score=%d, expected 1", sc.TestName, sc.Score)
+		}
+	}
+}
+
+func TestCasesAggregate(t *testing.T) {
+	score.RunCases(t, caseScores, triangularCases, checkTriangular)
+	sc := caseScores.MinByName(t.Name())
+	if want := int32(1 + 1 + 2 + 3); sc.Score != want || sc.MaxScore != want {
+		t.Errorf("Score=%d/%d, expected %d/%d", sc.Score, sc.MaxScore, want, want)
+	}
+}
+
+func TestCasesAggregateNegativeWeight(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			out := strings.TrimSpace(fmt.Sprintln(r))
+			if !strings.HasSuffix(out, "weight must be greater than 0: negative") {
+				t.Errorf("AddCasesAggregate() unexpected panic: %v", r)
+			}
+		} else {
+			t.Errorf("AddCasesAggregate() did not panic")
+		}
+	}()
+	cases := []triangularCase{
+		{Name: "positive", Weight: 3, in: 2, want: 3},
+		{Name: "negative", Weight: -1, in: 3, want: 6},
+	}
+	score.AddCasesAggregate(score.NewRegistry(), TestCasesAggregateNegativeWeight, 10, cases) // should panic with negative weight
+}
+
+// TestCasesFailing has failing and panicking test cases.
+// The test is expected to fail, and is therefore only run by TestCasesExtractResults.
+func TestCasesFailing(t *testing.T) {
+	if os.Getenv(casesTestEnvName) == "" {
+		t.Skipf("Skipping; expected to fail. Run with: %s=1 go test -v -run %s", casesTestEnvName, t.Name())
+	}
+	score.RunCases(t, caseScores, failingCases, checkTriangular)
+}
+
+// TestCasesFailingAggregate has failing and panicking test cases.
+// The test is expected to fail, and is therefore only run by TestCasesExtractResults.
+func TestCasesFailingAggregate(t *testing.T) {
+	if os.Getenv(casesTestEnvName) == "" {
+		t.Skipf("Skipping; expected to fail. Run with: %s=1 go test -v -run %s", casesTestEnvName, t.Name())
+	}
+	score.RunCases(t, caseScores, failingCases, checkTriangular)
+}
+
+func TestCasesExtractResults(t *testing.T) {
+	cmd := exec.Command("go", "test", "-v", "-run", "^TestCasesFailing")
+	cmd.Env = append(os.Environ(), casesTestEnvName+"=1", "QUICKFEED_SESSION_SECRET="+theSecret)
+	out, err := cmd.Output()
+	if err == nil {
+		t.Fatal("expected TestCasesFailing to fail")
+	}
+	res, err := score.ExtractResults(string(out), theSecret, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scores := make(map[string]*score.Score)
+	for _, sc := range res.Scores {
+		scores[sc.TestName] = sc
+	}
+	want := []*score.Score{
+		{TestName: "TestCasesFailing/pass", TaskName: "triangular", Score: 1, MaxScore: 1, Weight: 2},
+		{TestName: "TestCasesFailing/fail", TaskName: "triangular", Score: 0, MaxScore: 1, Weight: 3},
+		{TestName: "TestCasesFailing/panic", TaskName: "triangular", Score: 0, MaxScore: 1, Weight: 1},
+		{TestName: "TestCasesFailingAggregate", TaskName: "triangular", Score: 2, MaxScore: 6, Weight: 10},
+	}
+	for _, wantScore := range want {
+		sc, ok := scores[wantScore.TestName]
+		if !ok {
+			t.Errorf("ExtractResults(): missing score for %s", wantScore.TestName)
+			continue
+		}
+		if !wantScore.Equal(sc) || sc.TaskName != wantScore.TaskName {
+			t.Errorf("ExtractResults(): got %v, expected %v", sc, wantScore)
+		}
+	}
+}
@@ -80,6 +80,62 @@
 //       }
 //   }
 //
+// Table-driven tests can register and score their test cases automatically, avoiding
+// the need to register each subtest by name. The test case struct must have a string
+// Name field, and may have a Weight field; a missing or zero Weight gives weight 1,
+// and a negative Weight causes a panic.
+// score.AddCases() registers one score object per test case, whereas score.AddCasesAggregate()
+// registers a single score object for all the test cases. score.RunCases() runs each test
+// case as a subtest, increments the score for each passing subtest, and prints the scores.
+//
+//   type fibonacciTest struct {
+//       Name     string
+//       Weight   int
+//       in, want uint
+//   }
+//
+//   var fibonacciTests = []fibonacciTest{
+//       {Name: "zero", in: 0, want: 0},
+//       {Name: "large", Weight: 5, in: 20, want: 6765},
+//   }
+//
+//   func init() {
+//       score.AddCases(scores, TestFibonacciCases, fibonacciTests)
+//   }
+//
+//   func TestFibonacciCases(t *testing.T) {
+//       score.RunCases(t, scores, fibonacciTests, func(t *testing.T, ft fibonacciTest) {
+//           if got := fibonacci(ft.in); got != ft.want {
+//               t.Errorf("fibonacci(%d) = %d, want %d", ft.in, got, ft.want)
+//           }
+//       })
+//   }
+//
+// Tests that may deadlock or loop forever can be registered with a per-test timeout
+// using the registry's AddWithTimeout() or AddWithTaskAndTimeout() methods. The body of
+// such a test must be run via the registry's Run() method, which fails the test and records
+// the goroutine stack traces in the score's TestDetails if the body does not complete in time.
+// The remaining tests will continue to run and their scores are recorded as usual.
+// Since the body runs in a separate goroutine, it must not call t.Fatal() or t.FailNow().
+//
+//   var scores = score.NewRegistry()
+//
+//   func init() {
+//       scores.AddWithTimeout(TestFibonacciTimeout, len(fibonacciTests), 20, 5*time.Second)
+//   }
+//
+//   func TestFibonacciTimeout(t *testing.T) {
+//       sc := scores.Max()
+//       defer sc.Print(t)
+//       scores.Run(t, func() {
+//           for _, ft := range fibonacciTests {
+//               if fibonacci(ft.in) != ft.want {
+//                   sc.Dec()
+//               }
+//           }
+//       })
+//   }
+//
 // Please see package score/testdata/sequence for other usage examples.
 //
 package score
@@ -1,6 +1,7 @@
 package score
 
 import (
+	"crypto/hmac"
 	"encoding/json"
 	"errors"
 	"strings"
@@ -17,27 +18,49 @@ var (
 	ErrEmptyTestName    = errors.New("test name must be specified")
 	ErrSecret           = errors.New("secret field must match expected secret")
 	ErrSuppressedSecret = errors.New("error suppressed to avoid revealing secret")
+	ErrSignature        = errors.New("signature must match the score's contents")
+	ErrLegacyFormat     = errors.New("unsigned score format not accepted for this course")
 )
 
 // parse returns a score object for the provided JSON string s
-// which contains secret.
-func parse(s, secret string) (*Score, error) {
+// which must be signed with an HMAC keyed by secret.
+// If legacy is true, unsigned score strings that contain secret are also accepted.
+func parse(s, secret string, legacy bool) (*Score, error) {
 	if strings.Contains(s, secret) {
-		var sc Score
-		err := json.Unmarshal([]byte(s), &sc)
-		if err == nil {
-			if err = sc.isValid(secret); err != nil {
-				return nil, err
-			}
-			return &sc, nil
-		}
-		if strings.Contains(err.Error(), secret) {
-			// this is probably not necessary, but to be safe
-			return nil, ErrSuppressedSecret
+		if !legacy {
+			return nil, ErrLegacyFormat
 		}
+		return parseLegacy(s, secret)
+	}
+	var sc Score
+	if err := json.Unmarshal([]byte(s), &sc); err != nil {
 		return nil, err
 	}
-	return nil, ErrScoreNotFound
+	if sc.GetSignature() == "" {
+		return nil, ErrScoreNotFound
+	}
+	if err := sc.isSigned(secret); err != nil {
+		return nil, err
+	}
+	return &sc, nil
+}
+
+// parseLegacy returns a score object for the provided JSON string s
+// which contains secret.
+func parseLegacy(s, secret string) (*Score, error) {
+	var sc Score
+	err := json.Unmarshal([]byte(s), &sc)
+	if err == nil {
+		if err = sc.isValid(secret); err != nil {
+			return nil, err
+		}
+		return &sc, nil
+	}
+	if strings.Contains(err.Error(), secret) {
+		// this is probably not necessary, but to be safe
+		return nil, ErrSuppressedSecret
+	}
+	return nil, err
 }
 
 // isValid returns an error if the score object is invalid.
@@ -45,6 +68,33 @@ func parse(s, secret string) (*Score, error) {
 // If the given secret matches the score's secret value,
 // the Secret field is redacted with the empty string "".
 func (sc *Score) isValid(secret string) error {
+	if err := sc.isWellFormed(); err != nil {
+		return err
+	}
+	if sc.Secret != secret {
+		return test.ErrMsg(sc.GetTestName(), ErrSecret.Error())
+	}
+	sc.Secret = "" // redact the secret session key
+	return nil
+}
+
+// isSigned returns an error if the score object is invalid or if its signature
+// does not match the HMAC of its contents keyed by the given secret.
+// Otherwise, nil is returned and the Signature field is cleared.
+func (sc *Score) isSigned(secret string) error {
+	if err := sc.isWellFormed(); err != nil {
+		return err
+	}
+	if !hmac.Equal([]byte(sc.GetSignature()), []byte(sc.sign(secret))) {
+		return test.ErrMsg(sc.GetTestName(), ErrSignature.Error())
+	}
+	sc.Signature = ""
+	return nil
+}
+
+// isWellFormed returns an error if one of the score object's fields are invalid.
+// Otherwise, nil is returned.
+func (sc *Score) isWellFormed() error {
 	tName := sc.GetTestName()
 	if tName == "" {
 		return test.ErrMsg("", ErrEmptyTestName.Error())
@@ -58,10 +108,6 @@ func (sc *Score) isValid(secret string) error {
 	if sc.Score < 0 || sc.Score > sc.MaxScore {
 		return test.ErrMsg(tName, ErrScoreInterval.Error())
 	}
-	if sc.Secret != secret {
-		return test.ErrMsg(tName, ErrSecret.Error())
-	}
-	sc.Secret = "" // redact the secret session key
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package score
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 )
@@ -16,7 +17,7 @@ var nonJSONLog = []string{
 
 func TestParseNonJSONStrings(t *testing.T) {
 	for _, s := range nonJSONLog {
-		sc, err := parse(s, theSecret)
+		sc, err := parse(s, theSecret, true)
 		if err == nil {
 			t.Errorf("Expected '%v', got '<nil>'", ErrScoreNotFound.Error())
 		}
@@ -45,7 +46,7 @@ var jsonLog = []struct {
 
 func TestParseJSONStrings(t *testing.T) {
 	for _, s := range jsonLog {
-		sc, err := parse(s.in, theSecret)
+		sc, err := parse(s.in, theSecret, true)
 		var expectedScore *Score
 		if s.max > 0 {
 			expectedScore = &Score{
@@ -167,3 +168,59 @@ func clone(src []*Score) []*Score {
 	}
 	return dst
 }
+
+func TestParseSignedJSONStrings(t *testing.T) {
+	signed := &Score{TestName: t.Name(), TaskName: "task", Score: 5, MaxScore: 10, Weight: 2}
+	signed.Signature = signed.sign(theSecret)
+	good := signed.json()
+
+	tampered := &Score{TestName: t.Name(), TaskName: "task", Score: 10, MaxScore: 10, Weight: 2, Signature: signed.Signature}
+	wrongKey := &Score{TestName: t.Name(), TaskName: "task", Score: 5, MaxScore: 10, Weight: 2}
+	wrongKey.Signature = wrongKey.sign("the wrong secret")
+	legacy := `{"Secret":"` + theSecret + `","TestName":"TestParseSignedJSONStrings","Score":5,"MaxScore":10,"Weight":2}`
+
+	tests := []struct {
+		name   string
+		in     string
+		legacy bool
+		want   *Score
+		err    error
+	}{
+		{name: "Signed", in: good, want: &Score{TestName: t.Name(), TaskName: "task", Score: 5, MaxScore: 10, Weight: 2}},
+		{name: "SignedWithLegacy", in: good, legacy: true, want: &Score{TestName: t.Name(), TaskName: "task", Score: 5, MaxScore: 10, Weight: 2}},
+		{name: "Tampered", in: marshal(t, tampered), err: ErrSignature},
+		{name: "WrongKey", in: marshal(t, wrongKey), err: ErrSignature},
+		{name: "Unsigned", in: `{"TestName":"TestParseSignedJSONStrings","Score":5,"MaxScore":10,"Weight":2}`, err: ErrScoreNotFound},
+		{name: "LegacyRejected", in: legacy, err: ErrLegacyFormat},
+		{name: "LegacyAccepted", in: legacy, legacy: true, want: &Score{TestName: t.Name(), Score: 5, MaxScore: 10, Weight: 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc, err := parse(tt.in, theSecret, tt.legacy)
+			if tt.err != nil {
+				if err == nil || !strings.Contains(err.Error(), tt.err.Error()) {
+					t.Errorf("parse(%q) = %v, expected %v", tt.in, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parse(%q) = %v, expected <nil>", tt.in, err)
+			}
+			if !tt.want.Equal(sc) || sc.TaskName != tt.want.TaskName {
+				t.Errorf("parse(%q) = %v, expected %v", tt.in, sc, tt.want)
+			}
+			if sc.Secret != "" || sc.Signature != "" {
+				t.Errorf("parse(%q) did not redact secret or signature: %v", tt.in, sc)
+			}
+		})
+	}
+}
+
+func marshal(t *testing.T, sc *Score) string {
+	t.Helper()
+	b, err := json.Marshal(sc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"time"
 
 	"github.com/quickfeed/quickfeed/kit/internal/test"
 )
@@ -12,14 +13,16 @@ import (
 // registry keeps a map of score objects and a slice of test names,
 // in registration order, which is used to preserve deterministic iteration order.
 type registry struct {
-	testNames []string          // testNames in registration order
-	scores    map[string]*Score // map from TestName to score object
+	testNames []string                 // testNames in registration order
+	scores    map[string]*Score        // map from TestName to score object
+	timeouts  map[string]time.Duration // map from TestName to per-test timeout
 }
 
 func NewRegistry() *registry { // skipcq: RVV-B0011
 	return &registry{
 		testNames: make([]string, 0),
 		scores:    make(map[string]*Score),
+		timeouts:  make(map[string]time.Duration),
 	}
 }
 
@@ -28,7 +31,7 @@ func NewRegistry() *registry { // skipcq: RVV-B0011
 func (s *registry) Validate() error {
 	test.CallFrame()
 	for _, sc := range s.scores {
-		if err := sc.isValid(sessionSecret); err != nil {
+		if err := sc.isWellFormed(); err != nil {
 			return err
 		}
 	}
@@ -80,6 +83,27 @@ func (s *registry) AddWithTask(testFn any, taskName string, max, weight int) {
 	s.internalAdd(test.Name(testFn), taskName, max, weight)
 }
 
+// AddWithTimeout test with given max score, weight and timeout to the registry.
+// The test function must execute its body via Run, which fails the test if the
+// body does not complete within the given timeout.
+//
+// Will panic if the test has already been registered or if max, weight or timeout is non-positive.
+func (s *registry) AddWithTimeout(testFn any, max, weight int, timeout time.Duration) {
+	testName := test.Name(testFn)
+	s.internalAdd(testName, "", max, weight)
+	s.internalAddTimeout(testName, timeout)
+}
+
+// AddWithTaskAndTimeout test with given taskName, max score, weight and timeout to the registry.
+// This function is identical to AddWithTimeout, with the addition of assigning a task name.
+//
+// Will panic if the test has already been registered or if max, weight or timeout is non-positive.
+func (s *registry) AddWithTaskAndTimeout(testFn any, taskName string, max, weight int, timeout time.Duration) {
+	testName := test.Name(testFn)
+	s.internalAdd(testName, taskName, max, weight)
+	s.internalAddTimeout(testName, timeout)
+}
+
 // AddSub test with given max score and weight to the registry.
 // This function should be used to register subtests, and should be used in
 // conjunction with MaxByName and MinByName called from within a subtest.
@@ -145,6 +169,7 @@ var (
 	ErrDuplicateScoreTest = errors.New("duplicate score test")
 	ErrUnauthorizedLookup = errors.New("unauthorized lookup")
 	ErrUnknownScoreTest   = errors.New("unknown score test")
+	ErrTimeout            = errors.New("timeout must be greater than 0")
 )
 
 func (s *registry) internalAdd(testName, taskName string, max, weight int) {
@@ -158,7 +183,6 @@ func (s *registry) internalAdd(testName, taskName string, max, weight int) {
 		panic(test.ErrMsg(testName, ErrWeight.Error()))
 	}
 	sc := &Score{
-		Secret:   sessionSecret,
 		TestName: testName,
 		TaskName: taskName,
 		MaxScore: int32(max),
@@ -169,6 +193,13 @@ func (s *registry) internalAdd(testName, taskName string, max, weight int) {
 	s.scores[testName] = sc
 }
 
+func (s *registry) internalAddTimeout(testName string, timeout time.Duration) {
+	if timeout <= 0 {
+		panic(test.ErrMsg(testName, ErrTimeout.Error()))
+	}
+	s.timeouts[testName] = timeout
+}
+
 func (s *registry) get(testName string) *Score {
 	if !test.IsCaller(testName) {
 		// Only the registered Test function can call the lookup functions
@@ -143,14 +143,17 @@ func (pe parseErrors) Error() string {
 }
 
 // ExtractResults returns the results from a test execution extracted from the given out string.
-func ExtractResults(out, secret string, execTime time.Duration) (*Results, error) {
+// Score lines must be signed with an HMAC keyed by secret. If legacy is true, unsigned score
+// lines containing the raw secret are also accepted; this supports tests using older versions
+// of this package.
+func ExtractResults(out, secret string, execTime time.Duration, legacy bool) (*Results, error) {
 	var filteredLog []string
 	errs := make(parseErrors, 0)
 	results := newResults()
 	for _, line := range strings.Split(out, "\n") {
 		// check if line has expected JSON score string
 		if HasPrefix(line) {
-			sc, err := parse(line, secret)
+			sc, err := parse(line, secret, legacy)
 			if err != nil {
 				errs = append(errs, fmt.Errorf("failed on line '%s': %v", line, err))
 				continue
@@ -18,7 +18,7 @@ func TestExtractResults(t *testing.T) {
 Here are some more logs for the student.
 `
 
-	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10)
+	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10, true)
 	if err != nil {
 		// err may contain multiple errors
 		t.Fatal(err)
@@ -37,7 +37,7 @@ func TestExtractResultsWithWhitespace(t *testing.T) {
 Here are some more logs for the student.
 `
 
-	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10)
+	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10, true)
 	if err != nil {
 		// err may contain multiple errors
 		t.Fatal(err)
@@ -61,7 +61,7 @@ Here are some more logs for the student.
 Here are some more logs for the student.
 `
 
-	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10)
+	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10, true)
 	if err != nil {
 		// err may contain multiple errors
 		t.Fatal(err)
@@ -82,7 +82,7 @@ func TestExtractResultsWithMultipleZeroScoreLines(t *testing.T) {
 	{"Secret":"59fd5fe1c4f741604c1beeab875b9c789d2a7c73","TestName":"JoGo","Score":50,"MaxScore":100,"Weight":1}
 `
 
-	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10)
+	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10, true)
 	if err != nil {
 		// err may contain multiple errors
 		t.Fatal(err)
@@ -109,7 +109,7 @@ func TestExtractResultsWithMultipleNonZeroScoreLines(t *testing.T) {
 	{"Secret":"59fd5fe1c4f741604c1beeab875b9c789d2a7c73","TestName":"JoGo","Score":30,"MaxScore":100,"Weight":1}
 `
 
-	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10)
+	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10, true)
 	if err != nil {
 		// err may contain multiple errors
 		t.Fatal(err)
@@ -138,7 +138,7 @@ func TestExtractResultsWithPanickedAndMaliciousScoreLines(t *testing.T) {
 	{"Secret":"59fd5fe1c4f741604c1beeab875b9c789d2a7c73","TestName":"MaliciousTest","Score":100,"MaxScore":100,"Weight":1}
 `
 
-	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10)
+	res, err := score.ExtractResults(out, "59fd5fe1c4f741604c1beeab875b9c789d2a7c73", 10, true)
 	if err != nil {
 		// err may contain multiple errors
 		t.Fatal(err)
@@ -177,7 +177,7 @@ func TestExtractResultsExecTime(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run("ExecTime#"+tt.id, func(t *testing.T) {
-			res, err := score.ExtractResults("", "", tt.in)
+			res, err := score.ExtractResults("", "", tt.in, false)
 			if err != nil {
 				// err may contain multiple errors
 				t.Fatal(err)
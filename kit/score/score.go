@@ -11,49 +11,61 @@ import (
 
 // Fail sets Score to zero.
 func (s *Score) Fail() {
-	s.Score = 0
+	s.update(func() {
+		s.Score = 0
+	})
 }
 
 // Inc increments score if score is less than MaxScore.
 func (s *Score) Inc() {
-	if s.Score < s.MaxScore {
-		s.Score++
-	}
+	s.update(func() {
+		if s.Score < s.MaxScore {
+			s.Score++
+		}
+	})
 }
 
 // IncBy increments score n times or until score equals MaxScore.
 func (s *Score) IncBy(n int) {
-	m := int32(n)
-	if s.Score+m < s.MaxScore {
-		s.Score += m
-	} else {
-		s.Score = s.MaxScore
-	}
+	s.update(func() {
+		m := int32(n)
+		if s.Score+m < s.MaxScore {
+			s.Score += m
+		} else {
+			s.Score = s.MaxScore
+		}
+	})
 }
 
 // Dec decrements score if score is greater than zero.
 func (s *Score) Dec() {
-	if s.Score > 0 {
-		s.Score--
-	}
+	s.update(func() {
+		if s.Score > 0 {
+			s.Score--
+		}
+	})
 }
 
 // DecBy decrements score n times or until Score equals zero.
 func (s *Score) DecBy(n int) {
-	m := int32(n)
-	if s.Score-m > 0 {
-		s.Score -= m
-	} else {
-		s.Score = 0
-	}
+	s.update(func() {
+		m := int32(n)
+		if s.Score-m > 0 {
+			s.Score -= m
+		} else {
+			s.Score = 0
+		}
+	})
 }
 
 // Normalize the score to the given maxScore.
 func (s *Score) Normalize(maxScore int) {
-	f := float64(maxScore) / float64(s.MaxScore)
-	normScore := float64(s.Score) * f
-	s.Score = int32(math.Round(normScore))
-	s.MaxScore = int32(maxScore)
+	s.update(func() {
+		f := float64(maxScore) / float64(s.MaxScore)
+		normScore := float64(s.Score) * f
+		s.Score = int32(math.Round(normScore))
+		s.MaxScore = int32(maxScore)
+	})
 }
 
 // weightedScore returns the weighted score for this test score.
@@ -61,7 +73,7 @@ func (s *Score) weightedScore(totalWeight float64) float64 {
 	return weightedScore(float64(s.Score), float64(s.MaxScore), float64(s.Weight), totalWeight)
 }
 
-// Equal returns true if s equals other. Ignores the Secret field.
+// Equal returns true if s equals other. Ignores the Secret and Signature fields.
 func (s *Score) Equal(other *Score) bool {
 	return other != nil &&
 		s.TestName == other.TestName &&
@@ -90,7 +102,7 @@ func (s *Score) Print(t *testing.T, msg ...string) {
 	// We rely on JSON score objects to start on a new line, since otherwise
 	// scanning long student generated output lines can be costly.
 	fmt.Println()
-	// print JSON score object: {"Secret":"my secret code","TestName": ...}
+	// print JSON score object: {"TestName": ..., "Signature":"hmac of score"}
 	fmt.Println(s.json())
 }
 
@@ -114,14 +126,22 @@ func (s *Score) PanicHandler(t *testing.T, msg ...string) {
 
 // internalFail resets the score to zero and fails the provided test.
 func (s *Score) internalFail(t *testing.T) {
-	// reset score for panicked test functions
-	s.Score = 0
-	// fail the test
-	t.Fail()
+	s.update(func() {
+		// reset score for panicked test functions
+		s.Score = 0
+		// fail the test
+		t.Fail()
+	})
 }
 
 // json returns a JSON string for the score object.
+// If a session secret is available, the score object is signed with it.
 func (s *Score) json() string {
+	scoreMu.Lock()
+	defer scoreMu.Unlock()
+	if sessionSecret != "" {
+		s.Signature = s.sign(sessionSecret)
+	}
 	b, err := json.Marshal(s)
 	if err != nil {
 		return fmt.Sprintf("json.Marshal error: %v\n", err)
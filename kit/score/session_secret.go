@@ -1,6 +1,12 @@
 package score
 
-import "os"
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+)
 
 const (
 	secretEnvName = "QUICKFEED_SESSION_SECRET"
@@ -10,6 +16,13 @@ var sessionSecret string
 
 func init() {
 	sessionSecret = os.Getenv(secretEnvName)
-	// remove variable as soon as it has been read
-	_ = os.Setenv(secretEnvName, "")
+	// remove variable as soon as it has been read to hide it from child processes
+	_ = os.Unsetenv(secretEnvName)
+}
+
+// sign returns the hex-encoded HMAC-SHA256 of the score's contents keyed by secret.
+func (s *Score) sign(secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s\x00%s\x00%d\x00%d\x00%d\x00%s", s.GetTestName(), s.GetTaskName(), s.GetScore(), s.GetMaxScore(), s.GetWeight(), s.GetTestDetails())
+	return hex.EncodeToString(mac.Sum(nil))
 }
@@ -9,8 +9,8 @@ import (
 //   QUICKFEED_SESSION_SECRET=hei go test -v -run TestSessionSecret
 //
 func TestSessionSecret(t *testing.T) {
-	sessionSecret := os.Getenv(secretEnvName)
-	if sessionSecret != "" {
+	sessionSecret, ok := os.LookupEnv(secretEnvName)
+	if ok {
 		t.Fatalf("Unexpected access to %s=%s", secretEnvName, sessionSecret)
 	}
 }
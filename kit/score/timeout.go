@@ -0,0 +1,116 @@
+package score
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+// maxStackDumpSize is the maximum number of bytes of goroutine stack traces
+// recorded in the TestDetails field of a score object for a timed out test.
+const maxStackDumpSize = 16_000
+
+// ErrTestTimeout is reported when a test does not complete within its registered timeout.
+var ErrTestTimeout = errors.New("test timed out")
+
+var (
+	// scoreMu guards updates to score objects, since the body of a timed out
+	// test may keep updating its score object after Run has returned.
+	scoreMu sync.Mutex
+	// abandoned holds the score objects of timed out tests; these are frozen at zero.
+	abandoned = make(map[*Score]bool)
+)
+
+// update applies fn to the score object, unless its test has timed out.
+func (s *Score) update(fn func()) {
+	scoreMu.Lock()
+	defer scoreMu.Unlock()
+	if abandoned[s] {
+		return
+	}
+	fn()
+}
+
+// Run runs fn under a watchdog if a timeout has been registered for the calling test
+// with AddWithTimeout or AddWithTaskAndTimeout. Otherwise, fn is simply called.
+//
+// If fn does not complete within the timeout, the test's score is set to zero,
+// the stack traces of all goroutines are recorded in the score's TestDetails,
+// and Run returns, allowing the remaining tests to run. The goroutine running
+// fn is abandoned and may keep running until the test binary exits; any later
+// updates to the score object, such as Inc or Dec, are ignored.
+// A panic in fn also sets the test's score to zero.
+//
+// Since fn runs in a separate goroutine, fn must not call t.Fatal, t.FailNow,
+// t.Skip or similar methods that stop the calling goroutine; use t.Error instead.
+// Moreover, fn should only update the score object using its methods, and not
+// assign to the Score field directly.
+//
+// Run must be called from the registered Test function, and the score object
+// must be printed with a deferred call to Print as usual:
+//
+//	func TestFibonacci(t *testing.T) {
+//	    sc := scores.Max()
+//	    defer sc.Print(t)
+//	    scores.Run(t, func() {
+//	        for _, ft := range fibonacciTests {
+//	            if fibonacci(ft.in) != ft.want {
+//	                sc.Dec()
+//	            }
+//	        }
+//	    })
+//	}
+//
+// Will panic with unknown score test, if the test hasn't been added.
+func (s *registry) Run(t *testing.T, fn func()) {
+	testName := t.Name()
+	sc := s.get(testName)
+	timeout, ok := s.timeouts[testName]
+	if !ok {
+		fn()
+		return
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// recover here, since a panic in this goroutine cannot be recovered by Print
+		defer func() {
+			if r := recover(); r != nil {
+				sc.internalFail(t)
+				printPanicMessage(testName, "", r)
+			}
+		}()
+		fn()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		sc.internalTimeout(t, timeout)
+	}
+}
+
+// internalTimeout resets the score to zero, records the stack traces of
+// all goroutines in TestDetails and fails the provided test.
+// The score object is frozen, preventing the abandoned goroutine from updating it.
+func (s *Score) internalTimeout(t *testing.T, timeout time.Duration) {
+	details := fmt.Sprintf("%v after %v; goroutine stack traces:\n%s", ErrTestTimeout, timeout, stackDump())
+	s.update(func() {
+		s.Score = 0
+		s.TestDetails = details
+		abandoned[s] = true
+	})
+	t.Errorf("%s: %v after %v", t.Name(), ErrTestTimeout, timeout)
+}
+
+// stackDump returns the stack traces of all goroutines,
+// truncated to maxStackDumpSize bytes.
+func stackDump() string {
+	buf := make([]byte, maxStackDumpSize)
+	n := runtime.Stack(buf, true)
+	return string(buf[:n])
+}
@@ -0,0 +1,129 @@
+package score_test
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+)
+
+// To run the deadlocking test on its own, use:
+//   QUICKFEED_TIMEOUT_TEST=1 go test -v -run TestTimeoutDeadlock
+//
+
+const (
+	timeoutTestEnvName = "QUICKFEED_TIMEOUT_TEST"
+)
+
+var timeoutScores = score.NewRegistry()
+
+func init() {
+	timeoutScores.AddWithTimeout(TestTimeoutBefore, len(triangularTests), 5, time.Second)
+	timeoutScores.AddWithTimeout(TestTimeoutDeadlock, len(triangularTests), 5, 100*time.Millisecond)
+	timeoutScores.AddWithTimeout(TestTimeoutAbandoned, len(triangularTests), 5, 100*time.Millisecond)
+	timeoutScores.AddWithTaskAndTimeout(TestTimeoutAfter, "triangular", len(triangularTests), 5, time.Second)
+}
+
+func TestTimeoutBefore(t *testing.T) {
+	sc := timeoutScores.Max()
+	defer sc.Print(t)
+	timeoutScores.Run(t, func() {
+		for _, test := range triangularTests {
+			if triangular(test.in) != test.want {
+				sc.Dec()
+			}
+		}
+	})
+	if sc.Score != sc.MaxScore {
+		t.Errorf("Score=%d, expected %d", sc.Score, sc.MaxScore)
+	}
+}
+
+// TestTimeoutDeadlock emulates student code that deadlocks.
+// The test is expected to fail, and is therefore only run by TestTimeoutExtractResults.
+func TestTimeoutDeadlock(t *testing.T) {
+	if os.Getenv(timeoutTestEnvName) == "" {
+		t.Skipf("Skipping; expected to fail. Run with: %s=1 go test -v -run %s", timeoutTestEnvName, t.Name())
+	}
+	sc := timeoutScores.Max()
+	defer sc.Print(t)
+	timeoutScores.Run(t, func() {
+		block := make(chan struct{})
+		<-block
+	})
+}
+
+// TestTimeoutAbandoned emulates student code that keeps updating the score after the timeout.
+// The test is expected to fail, and is therefore only run by TestTimeoutExtractResults.
+func TestTimeoutAbandoned(t *testing.T) {
+	if os.Getenv(timeoutTestEnvName) == "" {
+		t.Skipf("Skipping; expected to fail. Run with: %s=1 go test -v -run %s", timeoutTestEnvName, t.Name())
+	}
+	sc := timeoutScores.Min()
+	defer sc.Print(t)
+	timeoutScores.Run(t, func() {
+		for {
+			sc.Inc()
+			time.Sleep(time.Millisecond)
+			sc.Dec()
+			sc.IncBy(len(triangularTests))
+		}
+	})
+	// give the abandoned goroutine time to update the score before it is printed
+	time.Sleep(50 * time.Millisecond)
+}
+
+func TestTimeoutAfter(t *testing.T) {
+	sc := timeoutScores.Min()
+	defer sc.Print(t)
+	timeoutScores.Run(t, func() {
+		for _, test := range triangularTests {
+			if triangular(test.in) == test.want {
+				sc.Inc()
+			}
+		}
+	})
+	if sc.Score != sc.MaxScore {
+		t.Errorf("Score=%d, expected %d", sc.Score, sc.MaxScore)
+	}
+}
+
+func TestTimeoutExtractResults(t *testing.T) {
+	cmd := exec.Command("go", "test", "-v", "-run", "^TestTimeout(Before|Deadlock|Abandoned|After)$")
+	cmd.Env = append(os.Environ(), timeoutTestEnvName+"=1", "QUICKFEED_SESSION_SECRET="+theSecret)
+	out, err := cmd.Output()
+	if err == nil {
+		t.Fatal("expected TestTimeoutDeadlock to fail")
+	}
+	res, err := score.ExtractResults(string(out), theSecret, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scores := make(map[string]*score.Score)
+	for _, sc := range res.Scores {
+		scores[sc.TestName] = sc
+	}
+	want := map[string]int32{
+		"TestTimeoutBefore":    int32(len(triangularTests)),
+		"TestTimeoutDeadlock":  0,
+		"TestTimeoutAbandoned": 0,
+		"TestTimeoutAfter":     int32(len(triangularTests)),
+	}
+	for testName, wantScore := range want {
+		sc, ok := scores[testName]
+		if !ok {
+			t.Errorf("ExtractResults(): missing score for %s", testName)
+			continue
+		}
+		if sc.Score != wantScore {
+			t.Errorf("%s: Score=%d, expected %d", testName, sc.Score, wantScore)
+		}
+	}
+	details := scores["TestTimeoutDeadlock"].GetTestDetails()
+	if !strings.Contains(details, score.ErrTestTimeout.Error()) || !strings.Contains(details, "goroutine") {
+		t.Errorf("TestTimeoutDeadlock: TestDetails missing timeout message and goroutine stack traces:\n%s", details)
+	}
+}
@@ -128,6 +128,8 @@ func main() {
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
+	// Run scheduled release and approval of submissions until shutdown.
+	go qfService.RunScheduler(ctx, time.Minute)
 	go func() {
 		<-ctx.Done()
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
@@ -23,7 +23,7 @@ export class Score extends Message<Score> {
   SubmissionID = protoInt64.zero;
 
   /**
-   * the unique identifier for a scoring session
+   * the unique identifier for a scoring session; only used by the legacy score format
    *
    * @generated from field: string Secret = 3;
    */
@@ -71,6 +71,13 @@ export class Score extends Message<Score> {
    */
   TestDetails = "";
 
+  /**
+   * HMAC of the score's contents keyed by the session secret
+   *
+   * @generated from field: string Signature = 10;
+   */
+  Signature = "";
+
   constructor(data?: PartialMessage<Score>) {
     super();
     proto3.util.initPartial(data, this);
@@ -88,6 +95,7 @@ export class Score extends Message<Score> {
     { no: 7, name: "MaxScore", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 8, name: "Weight", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 9, name: "TestDetails", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "Signature", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Score {
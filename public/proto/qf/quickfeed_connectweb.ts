@@ -3,8 +3,8 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CourseRequest, CourseSubmissions, EnrollmentRequest, GroupRequest, Organization, RebuildRequest, Repositories, RepositoryRequest, ReviewRequest, SubmissionRequest, UpdateSubmissionRequest, UpdateSubmissionsRequest, Void } from "./requests_pb.js";
-import { Assignments, Course, Courses, Enrollment, Enrollments, GradingBenchmark, GradingCriterion, Group, Groups, Review, Submission, Submissions, User, Users } from "./types_pb.js";
+import { ContributionRequest, CourseRequest, CourseSubmissions, CreateGroupsRequest, EnrollmentRequest, ExternalGradeImportRequest, FeedbackSnippetRequest, FeedbackSnippetUsageRequest, GradeExportRequest, GroupFormationRequest, GroupRequest, LineCommentRequest, Organization, PeerReviewRequest, QuizRequest, QuizSubmission, RebuildRequest, ReconcileRequest, RegradeRequestQuery, Repositories, RepositoryRequest, ReviewAllocationRequest, ReviewRequest, RosterImportRequest, SubmissionRequest, UpdateSubmissionRequest, UpdateSubmissionsRequest, Void } from "./requests_pb.js";
+import { Assignments, AuditEntries, Course, Courses, Enrollment, EnrollmentPolicy, Enrollments, ExternalGradeImport, FeedbackSnippet, FeedbackSnippets, FinalGrades, GradeExport, GradingBenchmark, GradingConfig, GradingCriterion, Group, GroupContributions, GroupInvitation, GroupInvitations, GroupMemberships, GroupPreference, Groups, LineComment, LineComments, PeerReview, PeerReviews, QuizAttempt, Reconciliation, RegradeRequest, RegradeRequests, Review, ReviewAllocations, ReviewerLoads, RosterCoverage, ScheduledJob, ScheduledJobs, Submission, Submissions, User, Users } from "./types_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -89,6 +89,95 @@ export const QuickFeedService = {
       O: Void,
       kind: MethodKind.Unary,
     },
+    /**
+     * ProposeGroups proposes groups for the course's students who are not in a group.
+     * The proposal is not stored; the teacher may edit it before committing it with CreateGroups.
+     *
+     * @generated from rpc qf.QuickFeedService.ProposeGroups
+     */
+    proposeGroups: {
+      name: "ProposeGroups",
+      I: GroupFormationRequest,
+      O: Groups,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateGroups creates and approves the given groups, and creates their repositories.
+     *
+     * @generated from rpc qf.QuickFeedService.CreateGroups
+     */
+    createGroups: {
+      name: "CreateGroups",
+      I: CreateGroupsRequest,
+      O: Groups,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetGroupPreference returns the student's preferred group partners.
+     *
+     * @generated from rpc qf.QuickFeedService.GetGroupPreference
+     */
+    getGroupPreference: {
+      name: "GetGroupPreference",
+      I: GroupRequest,
+      O: GroupPreference,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateGroupPreference replaces the student's preferred group partners.
+     *
+     * @generated from rpc qf.QuickFeedService.UpdateGroupPreference
+     */
+    updateGroupPreference: {
+      name: "UpdateGroupPreference",
+      I: GroupPreference,
+      O: Void,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetGroupInvitations returns the student's invitations to groups, and the invitations to the student's group.
+     *
+     * @generated from rpc qf.QuickFeedService.GetGroupInvitations
+     */
+    getGroupInvitations: {
+      name: "GetGroupInvitations",
+      I: GroupRequest,
+      O: GroupInvitations,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RespondToGroupInvitation accepts or declines the student's invitation to a group.
+     *
+     * @generated from rpc qf.QuickFeedService.RespondToGroupInvitation
+     */
+    respondToGroupInvitation: {
+      name: "RespondToGroupInvitation",
+      I: GroupInvitation,
+      O: Void,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetGroupMemberships returns the membership history of the course's groups.
+     *
+     * @generated from rpc qf.QuickFeedService.GetGroupMemberships
+     */
+    getGroupMemberships: {
+      name: "GetGroupMemberships",
+      I: CourseRequest,
+      O: GroupMemberships,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetGroupContributions analyzes the commits in a group's repository and returns each member's contributions.
+     *
+     * @generated from rpc qf.QuickFeedService.GetGroupContributions
+     */
+    getGroupContributions: {
+      name: "GetGroupContributions",
+      I: ContributionRequest,
+      O: GroupContributions,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc qf.QuickFeedService.GetCourse
      */
@@ -125,6 +214,64 @@ export const QuickFeedService = {
       O: Void,
       kind: MethodKind.Unary,
     },
+    /**
+     * GetGradingConfig returns the course's grading configuration.
+     *
+     * @generated from rpc qf.QuickFeedService.GetGradingConfig
+     */
+    getGradingConfig: {
+      name: "GetGradingConfig",
+      I: CourseRequest,
+      O: GradingConfig,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateGradingConfig replaces the course's grading configuration.
+     *
+     * @generated from rpc qf.QuickFeedService.UpdateGradingConfig
+     */
+    updateGradingConfig: {
+      name: "UpdateGradingConfig",
+      I: GradingConfig,
+      O: GradingConfig,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ComputeFinalGrades computes the final grade of each student in the course,
+     * with a per-assignment breakdown, using the course's grading configuration.
+     *
+     * @generated from rpc qf.QuickFeedService.ComputeFinalGrades
+     */
+    computeFinalGrades: {
+      name: "ComputeFinalGrades",
+      I: CourseRequest,
+      O: FinalGrades,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ExportGrades returns a CSV or XLSX file with the final grades of the course's students,
+     * or fills in the given institution roster, and reports mismatches between the roster and the course.
+     *
+     * @generated from rpc qf.QuickFeedService.ExportGrades
+     */
+    exportGrades: {
+      name: "ExportGrades",
+      I: GradeExportRequest,
+      O: GradeExport,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ImportExternalGrades imports the students' scores for the external components
+     * of the course's grading configuration from a CSV file.
+     *
+     * @generated from rpc qf.QuickFeedService.ImportExternalGrades
+     */
+    importExternalGrades: {
+      name: "ImportExternalGrades",
+      I: ExternalGradeImportRequest,
+      O: ExternalGradeImport,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc qf.QuickFeedService.GetAssignments
      */
@@ -170,6 +317,41 @@ export const QuickFeedService = {
       O: Void,
       kind: MethodKind.Unary,
     },
+    /**
+     * ImportRoster replaces the course's institution roster with the students in the given CSV file,
+     * approves the pending enrollments that match the roster, and returns the roster coverage.
+     *
+     * @generated from rpc qf.QuickFeedService.ImportRoster
+     */
+    importRoster: {
+      name: "ImportRoster",
+      I: RosterImportRequest,
+      O: RosterCoverage,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetRosterCoverage reports which students on the course's roster have not signed up,
+     * and which pending enrollments do not match the roster.
+     *
+     * @generated from rpc qf.QuickFeedService.GetRosterCoverage
+     */
+    getRosterCoverage: {
+      name: "GetRosterCoverage",
+      I: CourseRequest,
+      O: RosterCoverage,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetEnrollmentPolicy returns the course's enrollment policy.
+     *
+     * @generated from rpc qf.QuickFeedService.GetEnrollmentPolicy
+     */
+    getEnrollmentPolicy: {
+      name: "GetEnrollmentPolicy",
+      I: CourseRequest,
+      O: EnrollmentPolicy,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc qf.QuickFeedService.GetSubmission
      */
@@ -228,6 +410,51 @@ export const QuickFeedService = {
       O: Void,
       kind: MethodKind.Unary,
     },
+    /**
+     * GetScheduledJobs returns the course's scheduled bulk changes to submissions.
+     *
+     * @generated from rpc qf.QuickFeedService.GetScheduledJobs
+     */
+    getScheduledJobs: {
+      name: "GetScheduledJobs",
+      I: CourseRequest,
+      O: ScheduledJobs,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ScheduleJob schedules a bulk change to the assignment's submissions,
+     * replacing any earlier job of the same type for the assignment.
+     *
+     * @generated from rpc qf.QuickFeedService.ScheduleJob
+     */
+    scheduleJob: {
+      name: "ScheduleJob",
+      I: ScheduledJob,
+      O: ScheduledJob,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CancelScheduledJob removes a scheduled job that has not yet run.
+     *
+     * @generated from rpc qf.QuickFeedService.CancelScheduledJob
+     */
+    cancelScheduledJob: {
+      name: "CancelScheduledJob",
+      I: ScheduledJob,
+      O: Void,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetAuditEntries returns the course's audit log of bulk changes to submissions.
+     *
+     * @generated from rpc qf.QuickFeedService.GetAuditEntries
+     */
+    getAuditEntries: {
+      name: "GetAuditEntries",
+      I: CourseRequest,
+      O: AuditEntries,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc qf.QuickFeedService.CreateBenchmark
      */
@@ -300,6 +527,287 @@ export const QuickFeedService = {
       O: Review,
       kind: MethodKind.Unary,
     },
+    /**
+     * GetLineComments returns the line comments on the given submission.
+     * Students only get the line comments on their own submissions after the submission is released.
+     *
+     * @generated from rpc qf.QuickFeedService.GetLineComments
+     */
+    getLineComments: {
+      name: "GetLineComments",
+      I: LineCommentRequest,
+      O: LineComments,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc qf.QuickFeedService.CreateLineComment
+     */
+    createLineComment: {
+      name: "CreateLineComment",
+      I: LineComment,
+      O: LineComment,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc qf.QuickFeedService.UpdateLineComment
+     */
+    updateLineComment: {
+      name: "UpdateLineComment",
+      I: LineComment,
+      O: LineComment,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc qf.QuickFeedService.DeleteLineComment
+     */
+    deleteLineComment: {
+      name: "DeleteLineComment",
+      I: LineComment,
+      O: Void,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetFeedbackSnippets returns the course's feedback snippets, optionally filtered by tag.
+     *
+     * @generated from rpc qf.QuickFeedService.GetFeedbackSnippets
+     */
+    getFeedbackSnippets: {
+      name: "GetFeedbackSnippets",
+      I: FeedbackSnippetRequest,
+      O: FeedbackSnippets,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc qf.QuickFeedService.CreateFeedbackSnippet
+     */
+    createFeedbackSnippet: {
+      name: "CreateFeedbackSnippet",
+      I: FeedbackSnippet,
+      O: FeedbackSnippet,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc qf.QuickFeedService.UpdateFeedbackSnippet
+     */
+    updateFeedbackSnippet: {
+      name: "UpdateFeedbackSnippet",
+      I: FeedbackSnippet,
+      O: FeedbackSnippet,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc qf.QuickFeedService.DeleteFeedbackSnippet
+     */
+    deleteFeedbackSnippet: {
+      name: "DeleteFeedbackSnippet",
+      I: FeedbackSnippet,
+      O: Void,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UseFeedbackSnippet records that the snippet was inserted into a review of the given assignment,
+     * and returns the snippet with its updated usage.
+     *
+     * @generated from rpc qf.QuickFeedService.UseFeedbackSnippet
+     */
+    useFeedbackSnippet: {
+      name: "UseFeedbackSnippet",
+      I: FeedbackSnippetUsageRequest,
+      O: FeedbackSnippet,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetRegradeRequests returns the course's regrade requests; students only get their own requests.
+     *
+     * @generated from rpc qf.QuickFeedService.GetRegradeRequests
+     */
+    getRegradeRequests: {
+      name: "GetRegradeRequests",
+      I: RegradeRequestQuery,
+      O: RegradeRequests,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateRegradeRequest creates a regrade request for one of the current user's submissions.
+     *
+     * @generated from rpc qf.QuickFeedService.CreateRegradeRequest
+     */
+    createRegradeRequest: {
+      name: "CreateRegradeRequest",
+      I: RegradeRequest,
+      O: RegradeRequest,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateRegradeRequest accepts, rejects or resolves a regrade request.
+     * Resolving a request may rebuild the submission or create a new review of the submission.
+     *
+     * @generated from rpc qf.QuickFeedService.UpdateRegradeRequest
+     */
+    updateRegradeRequest: {
+      name: "UpdateRegradeRequest",
+      I: RegradeRequest,
+      O: RegradeRequest,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetReconciliation returns the disagreements between the submission's ready reviews,
+     * and the final review proposed by the assignment's reconcile policy.
+     *
+     * @generated from rpc qf.QuickFeedService.GetReconciliation
+     */
+    getReconciliation: {
+      name: "GetReconciliation",
+      I: ReconcileRequest,
+      O: Reconciliation,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ReconcileReviews creates the final review for the submission, whose score becomes the submission's score.
+     *
+     * @generated from rpc qf.QuickFeedService.ReconcileReviews
+     */
+    reconcileReviews: {
+      name: "ReconcileReviews",
+      I: ReconcileRequest,
+      O: Review,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * AllocateReviewers allocates reviewers among the course's teachers to the assignment's submissions,
+     * such that each submission gets the assignment's number of reviewers.
+     *
+     * @generated from rpc qf.QuickFeedService.AllocateReviewers
+     */
+    allocateReviewers: {
+      name: "AllocateReviewers",
+      I: ReviewAllocationRequest,
+      O: ReviewAllocations,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ReassignReviews moves the given reviewer's pending reviews for the assignment to other reviewers.
+     *
+     * @generated from rpc qf.QuickFeedService.ReassignReviews
+     */
+    reassignReviews: {
+      name: "ReassignReviews",
+      I: ReviewAllocationRequest,
+      O: ReviewAllocations,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetReviewQueue returns the pending review allocations of the current user in the given course.
+     *
+     * @generated from rpc qf.QuickFeedService.GetReviewQueue
+     */
+    getReviewQueue: {
+      name: "GetReviewQueue",
+      I: CourseRequest,
+      O: ReviewAllocations,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetReviewerLoads returns the number of pull request reviews requested from each reviewer in the course.
+     *
+     * @generated from rpc qf.QuickFeedService.GetReviewerLoads
+     */
+    getReviewerLoads: {
+      name: "GetReviewerLoads",
+      I: CourseRequest,
+      O: ReviewerLoads,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * StartPeerReview opens the peer review phase for the assignment after its deadline,
+     * assigning each student the assignment's number of peer submissions to review.
+     *
+     * @generated from rpc qf.QuickFeedService.StartPeerReview
+     */
+    startPeerReview: {
+      name: "StartPeerReview",
+      I: PeerReviewRequest,
+      O: PeerReviews,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * EndPeerReview closes the peer review phase for the assignment, revoking the reviewers' access.
+     *
+     * @generated from rpc qf.QuickFeedService.EndPeerReview
+     */
+    endPeerReview: {
+      name: "EndPeerReview",
+      I: PeerReviewRequest,
+      O: PeerReviews,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetPeerReviews returns the assignment's peer reviews; students only get their own peer reviews.
+     *
+     * @generated from rpc qf.QuickFeedService.GetPeerReviews
+     */
+    getPeerReviews: {
+      name: "GetPeerReviews",
+      I: PeerReviewRequest,
+      O: PeerReviews,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GradePeerReview sets the teacher's assessment of a peer review's quality.
+     *
+     * @generated from rpc qf.QuickFeedService.GradePeerReview
+     */
+    gradePeerReview: {
+      name: "GradePeerReview",
+      I: PeerReview,
+      O: PeerReview,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreatePeerReview creates a peer review of a submission assigned to the current user.
+     *
+     * @generated from rpc qf.QuickFeedService.CreatePeerReview
+     */
+    createPeerReview: {
+      name: "CreatePeerReview",
+      I: ReviewRequest,
+      O: Review,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UpdatePeerReview updates the current user's peer review of a submission.
+     *
+     * @generated from rpc qf.QuickFeedService.UpdatePeerReview
+     */
+    updatePeerReview: {
+      name: "UpdatePeerReview",
+      I: ReviewRequest,
+      O: Review,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * StartQuiz starts a new timed attempt for the quiz of the given assignment,
+     * or returns the current attempt if it has not yet been submitted or expired.
+     *
+     * @generated from rpc qf.QuickFeedService.StartQuiz
+     */
+    startQuiz: {
+      name: "StartQuiz",
+      I: QuizRequest,
+      O: QuizAttempt,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SubmitQuiz records the answers for the given attempt as a submission for the quiz's assignment.
+     *
+     * @generated from rpc qf.QuickFeedService.SubmitQuiz
+     */
+    submitQuiz: {
+      name: "SubmitQuiz",
+      I: QuizSubmission,
+      O: Submission,
+      kind: MethodKind.Unary,
+    },
     /**
      * GetOrganization returns the organization with the given organization name.
      * Note that organization ID is not used in the request, but it is populated in the response.
@@ -339,6 +847,24 @@ export const QuickFeedService = {
       O: Submission,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * @generated from rpc qf.QuickFeedService.RegradeRequestStream
+     */
+    regradeRequestStream: {
+      name: "RegradeRequestStream",
+      I: Void,
+      O: RegradeRequest,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc qf.QuickFeedService.GroupInvitationStream
+     */
+    groupInvitationStream: {
+      name: "GroupInvitationStream",
+      I: Void,
+      O: GroupInvitation,
+      kind: MethodKind.ServerStreaming,
+    },
   }
 } as const;
 
@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
-import { Enrollment_UserStatus, Grade, Review, Submissions } from "./types_pb.js";
+import { Enrollment_UserStatus, Grade, Group, QuizAnswer, Review, Submissions } from "./types_pb.js";
 
 /**
  * @generated from message qf.CourseSubmissions
@@ -651,6 +651,910 @@ export class RebuildRequest extends Message<RebuildRequest> {
   }
 }
 
+/**
+ * @generated from message qf.ReconcileRequest
+ */
+export class ReconcileRequest extends Message<ReconcileRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 submissionID = 2;
+   */
+  submissionID = protoInt64.zero;
+
+  /**
+   * final review decided by the teacher; only used with the manual reconcile policy
+   *
+   * @generated from field: qf.Review final = 3;
+   */
+  final?: Review;
+
+  constructor(data?: PartialMessage<ReconcileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.ReconcileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "submissionID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "final", kind: "message", T: Review },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconcileRequest {
+    return new ReconcileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconcileRequest {
+    return new ReconcileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconcileRequest {
+    return new ReconcileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReconcileRequest | PlainMessage<ReconcileRequest> | undefined, b: ReconcileRequest | PlainMessage<ReconcileRequest> | undefined): boolean {
+    return proto3.util.equals(ReconcileRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.ReviewAllocationRequest
+ */
+export class ReviewAllocationRequest extends Message<ReviewAllocationRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 assignmentID = 2;
+   */
+  assignmentID = protoInt64.zero;
+
+  /**
+   * only used for reassignment; the reviewer whose pending reviews are reassigned
+   *
+   * @generated from field: uint64 reviewerID = 3;
+   */
+  reviewerID = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ReviewAllocationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.ReviewAllocationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "assignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "reviewerID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReviewAllocationRequest {
+    return new ReviewAllocationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReviewAllocationRequest {
+    return new ReviewAllocationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReviewAllocationRequest {
+    return new ReviewAllocationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReviewAllocationRequest | PlainMessage<ReviewAllocationRequest> | undefined, b: ReviewAllocationRequest | PlainMessage<ReviewAllocationRequest> | undefined): boolean {
+    return proto3.util.equals(ReviewAllocationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.LineCommentRequest
+ */
+export class LineCommentRequest extends Message<LineCommentRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 submissionID = 2;
+   */
+  submissionID = protoInt64.zero;
+
+  constructor(data?: PartialMessage<LineCommentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.LineCommentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "submissionID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LineCommentRequest {
+    return new LineCommentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LineCommentRequest {
+    return new LineCommentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LineCommentRequest {
+    return new LineCommentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LineCommentRequest | PlainMessage<LineCommentRequest> | undefined, b: LineCommentRequest | PlainMessage<LineCommentRequest> | undefined): boolean {
+    return proto3.util.equals(LineCommentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.FeedbackSnippetRequest
+ */
+export class FeedbackSnippetRequest extends Message<FeedbackSnippetRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * only snippets with the given tag are returned; all snippets if empty
+   *
+   * @generated from field: string tag = 2;
+   */
+  tag = "";
+
+  /**
+   * if set, snippets are ordered by their number of uses in the assignment, most used first
+   *
+   * @generated from field: uint64 assignmentID = 3;
+   */
+  assignmentID = protoInt64.zero;
+
+  constructor(data?: PartialMessage<FeedbackSnippetRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.FeedbackSnippetRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "assignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FeedbackSnippetRequest {
+    return new FeedbackSnippetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FeedbackSnippetRequest {
+    return new FeedbackSnippetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FeedbackSnippetRequest {
+    return new FeedbackSnippetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FeedbackSnippetRequest | PlainMessage<FeedbackSnippetRequest> | undefined, b: FeedbackSnippetRequest | PlainMessage<FeedbackSnippetRequest> | undefined): boolean {
+    return proto3.util.equals(FeedbackSnippetRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.FeedbackSnippetUsageRequest
+ */
+export class FeedbackSnippetUsageRequest extends Message<FeedbackSnippetUsageRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 snippetID = 2;
+   */
+  snippetID = protoInt64.zero;
+
+  /**
+   * the assignment of the review the snippet was inserted into
+   *
+   * @generated from field: uint64 assignmentID = 3;
+   */
+  assignmentID = protoInt64.zero;
+
+  constructor(data?: PartialMessage<FeedbackSnippetUsageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.FeedbackSnippetUsageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "snippetID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "assignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FeedbackSnippetUsageRequest {
+    return new FeedbackSnippetUsageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FeedbackSnippetUsageRequest {
+    return new FeedbackSnippetUsageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FeedbackSnippetUsageRequest {
+    return new FeedbackSnippetUsageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FeedbackSnippetUsageRequest | PlainMessage<FeedbackSnippetUsageRequest> | undefined, b: FeedbackSnippetUsageRequest | PlainMessage<FeedbackSnippetUsageRequest> | undefined): boolean {
+    return proto3.util.equals(FeedbackSnippetUsageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.RegradeRequestQuery
+ */
+export class RegradeRequestQuery extends Message<RegradeRequestQuery> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * only requests for the given submission are returned; all submissions if zero
+   *
+   * @generated from field: uint64 submissionID = 2;
+   */
+  submissionID = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RegradeRequestQuery>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.RegradeRequestQuery";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "submissionID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegradeRequestQuery {
+    return new RegradeRequestQuery().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegradeRequestQuery {
+    return new RegradeRequestQuery().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegradeRequestQuery {
+    return new RegradeRequestQuery().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RegradeRequestQuery | PlainMessage<RegradeRequestQuery> | undefined, b: RegradeRequestQuery | PlainMessage<RegradeRequestQuery> | undefined): boolean {
+    return proto3.util.equals(RegradeRequestQuery, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.GradeExportRequest
+ */
+export class GradeExportRequest extends Message<GradeExportRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * format of both the export and the uploaded roster
+   *
+   * @generated from field: qf.GradeExportRequest.Format format = 2;
+   */
+  format = GradeExportRequest_Format.CSV;
+
+  /**
+   * columns of the export; the default columns are used if empty
+   *
+   * @generated from field: repeated qf.ExportColumn columns = 3;
+   */
+  columns: ExportColumn[] = [];
+
+  /**
+   * institution roster to fill in; the export lists the course's students if empty
+   *
+   * @generated from field: bytes roster = 4;
+   */
+  roster = new Uint8Array(0);
+
+  /**
+   * value of the passed column for students who pass; defaults to "Passed"
+   *
+   * @generated from field: string passValue = 5;
+   */
+  passValue = "";
+
+  /**
+   * value of the passed column for students who do not pass; defaults to "Failed"
+   *
+   * @generated from field: string failValue = 6;
+   */
+  failValue = "";
+
+  /**
+   * number of approved assignments required to pass; overrides the grading configuration if non-zero
+   *
+   * @generated from field: uint32 minApproved = 7;
+   */
+  minApproved = 0;
+
+  constructor(data?: PartialMessage<GradeExportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.GradeExportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "format", kind: "enum", T: proto3.getEnumType(GradeExportRequest_Format) },
+    { no: 3, name: "columns", kind: "message", T: ExportColumn, repeated: true },
+    { no: 4, name: "roster", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 5, name: "passValue", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "failValue", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "minApproved", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GradeExportRequest {
+    return new GradeExportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GradeExportRequest {
+    return new GradeExportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GradeExportRequest {
+    return new GradeExportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GradeExportRequest | PlainMessage<GradeExportRequest> | undefined, b: GradeExportRequest | PlainMessage<GradeExportRequest> | undefined): boolean {
+    return proto3.util.equals(GradeExportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from enum qf.GradeExportRequest.Format
+ */
+export enum GradeExportRequest_Format {
+  /**
+   * @generated from enum value: CSV = 0;
+   */
+  CSV = 0,
+
+  /**
+   * @generated from enum value: XLSX = 1;
+   */
+  XLSX = 1,
+}
+// Retrieve enum metadata with: proto3.getEnumType(GradeExportRequest_Format)
+proto3.util.setEnumType(GradeExportRequest_Format, "qf.GradeExportRequest.Format", [
+  { no: 0, name: "CSV" },
+  { no: 1, name: "XLSX" },
+]);
+
+/**
+ * ExportColumn maps a student field to a column of the grade export.
+ *
+ * @generated from message qf.ExportColumn
+ */
+export class ExportColumn extends Message<ExportColumn> {
+  /**
+   * @generated from field: qf.ExportColumn.Field field = 1;
+   */
+  field = ExportColumn_Field.NAME;
+
+  /**
+   * column header; with a roster, the header of the roster column to match or fill in
+   *
+   * @generated from field: string header = 2;
+   */
+  header = "";
+
+  constructor(data?: PartialMessage<ExportColumn>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.ExportColumn";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "field", kind: "enum", T: proto3.getEnumType(ExportColumn_Field) },
+    { no: 2, name: "header", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportColumn {
+    return new ExportColumn().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportColumn {
+    return new ExportColumn().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportColumn {
+    return new ExportColumn().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportColumn | PlainMessage<ExportColumn> | undefined, b: ExportColumn | PlainMessage<ExportColumn> | undefined): boolean {
+    return proto3.util.equals(ExportColumn, a, b);
+  }
+}
+
+/**
+ * @generated from enum qf.ExportColumn.Field
+ */
+export enum ExportColumn_Field {
+  /**
+   * @generated from enum value: NAME = 0;
+   */
+  NAME = 0,
+
+  /**
+   * @generated from enum value: STUDENT_ID = 1;
+   */
+  STUDENT_ID = 1,
+
+  /**
+   * @generated from enum value: EMAIL = 2;
+   */
+  EMAIL = 2,
+
+  /**
+   * @generated from enum value: LOGIN = 3;
+   */
+  LOGIN = 3,
+
+  /**
+   * number of approved assignments
+   *
+   * @generated from enum value: APPROVED = 4;
+   */
+  APPROVED = 4,
+
+  /**
+   * the pass or fail value
+   *
+   * @generated from enum value: PASSED = 5;
+   */
+  PASSED = 5,
+
+  /**
+   * points of the final grade
+   *
+   * @generated from enum value: POINTS = 6;
+   */
+  POINTS = 6,
+
+  /**
+   * letter grade of the final grade
+   *
+   * @generated from enum value: GRADE = 7;
+   */
+  GRADE = 7,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ExportColumn_Field)
+proto3.util.setEnumType(ExportColumn_Field, "qf.ExportColumn.Field", [
+  { no: 0, name: "NAME" },
+  { no: 1, name: "STUDENT_ID" },
+  { no: 2, name: "EMAIL" },
+  { no: 3, name: "LOGIN" },
+  { no: 4, name: "APPROVED" },
+  { no: 5, name: "PASSED" },
+  { no: 6, name: "POINTS" },
+  { no: 7, name: "GRADE" },
+]);
+
+/**
+ * @generated from message qf.ExternalGradeImportRequest
+ */
+export class ExternalGradeImportRequest extends Message<ExternalGradeImportRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * CSV file with a student ID, component name and score on each row
+   *
+   * @generated from field: bytes data = 2;
+   */
+  data = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<ExternalGradeImportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.ExternalGradeImportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExternalGradeImportRequest {
+    return new ExternalGradeImportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExternalGradeImportRequest {
+    return new ExternalGradeImportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExternalGradeImportRequest {
+    return new ExternalGradeImportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExternalGradeImportRequest | PlainMessage<ExternalGradeImportRequest> | undefined, b: ExternalGradeImportRequest | PlainMessage<ExternalGradeImportRequest> | undefined): boolean {
+    return proto3.util.equals(ExternalGradeImportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.RosterImportRequest
+ */
+export class RosterImportRequest extends Message<RosterImportRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * CSV file with a student ID, email and optional GitHub login on each row
+   *
+   * @generated from field: bytes data = 2;
+   */
+  data = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<RosterImportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.RosterImportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RosterImportRequest {
+    return new RosterImportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RosterImportRequest {
+    return new RosterImportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RosterImportRequest {
+    return new RosterImportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RosterImportRequest | PlainMessage<RosterImportRequest> | undefined, b: RosterImportRequest | PlainMessage<RosterImportRequest> | undefined): boolean {
+    return proto3.util.equals(RosterImportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.GroupFormationRequest
+ */
+export class GroupFormationRequest extends Message<GroupFormationRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * @generated from field: qf.GroupFormationRequest.Strategy strategy = 2;
+   */
+  strategy = GroupFormationRequest_Strategy.RANDOM;
+
+  constructor(data?: PartialMessage<GroupFormationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.GroupFormationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "strategy", kind: "enum", T: proto3.getEnumType(GroupFormationRequest_Strategy) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GroupFormationRequest {
+    return new GroupFormationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GroupFormationRequest {
+    return new GroupFormationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GroupFormationRequest {
+    return new GroupFormationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GroupFormationRequest | PlainMessage<GroupFormationRequest> | undefined, b: GroupFormationRequest | PlainMessage<GroupFormationRequest> | undefined): boolean {
+    return proto3.util.equals(GroupFormationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from enum qf.GroupFormationRequest.Strategy
+ */
+export enum GroupFormationRequest_Strategy {
+  /**
+   * students are grouped at random
+   *
+   * @generated from enum value: RANDOM = 0;
+   */
+  RANDOM = 0,
+
+  /**
+   * each group has a similar mix of students with high and low points in the course
+   *
+   * @generated from enum value: BALANCED = 1;
+   */
+  BALANCED = 1,
+
+  /**
+   * students who prefer each other are grouped together; others at random
+   *
+   * @generated from enum value: PREFERENCES = 2;
+   */
+  PREFERENCES = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(GroupFormationRequest_Strategy)
+proto3.util.setEnumType(GroupFormationRequest_Strategy, "qf.GroupFormationRequest.Strategy", [
+  { no: 0, name: "RANDOM" },
+  { no: 1, name: "BALANCED" },
+  { no: 2, name: "PREFERENCES" },
+]);
+
+/**
+ * @generated from message qf.CreateGroupsRequest
+ */
+export class CreateGroupsRequest extends Message<CreateGroupsRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated qf.Group groups = 2;
+   */
+  groups: Group[] = [];
+
+  constructor(data?: PartialMessage<CreateGroupsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.CreateGroupsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "groups", kind: "message", T: Group, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateGroupsRequest {
+    return new CreateGroupsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateGroupsRequest {
+    return new CreateGroupsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateGroupsRequest {
+    return new CreateGroupsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateGroupsRequest | PlainMessage<CreateGroupsRequest> | undefined, b: CreateGroupsRequest | PlainMessage<CreateGroupsRequest> | undefined): boolean {
+    return proto3.util.equals(CreateGroupsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.ContributionRequest
+ */
+export class ContributionRequest extends Message<ContributionRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 groupID = 2;
+   */
+  groupID = protoInt64.zero;
+
+  /**
+   * percent of an equal share of the changed lines; 50 if zero
+   *
+   * @generated from field: uint32 threshold = 3;
+   */
+  threshold = 0;
+
+  constructor(data?: PartialMessage<ContributionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.ContributionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "groupID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "threshold", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ContributionRequest {
+    return new ContributionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ContributionRequest {
+    return new ContributionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ContributionRequest {
+    return new ContributionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ContributionRequest | PlainMessage<ContributionRequest> | undefined, b: ContributionRequest | PlainMessage<ContributionRequest> | undefined): boolean {
+    return proto3.util.equals(ContributionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.PeerReviewRequest
+ */
+export class PeerReviewRequest extends Message<PeerReviewRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 assignmentID = 2;
+   */
+  assignmentID = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PeerReviewRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.PeerReviewRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "assignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PeerReviewRequest {
+    return new PeerReviewRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PeerReviewRequest {
+    return new PeerReviewRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PeerReviewRequest {
+    return new PeerReviewRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PeerReviewRequest | PlainMessage<PeerReviewRequest> | undefined, b: PeerReviewRequest | PlainMessage<PeerReviewRequest> | undefined): boolean {
+    return proto3.util.equals(PeerReviewRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.QuizRequest
+ */
+export class QuizRequest extends Message<QuizRequest> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 assignmentID = 2;
+   */
+  assignmentID = protoInt64.zero;
+
+  constructor(data?: PartialMessage<QuizRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.QuizRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "assignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuizRequest {
+    return new QuizRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuizRequest {
+    return new QuizRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuizRequest {
+    return new QuizRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QuizRequest | PlainMessage<QuizRequest> | undefined, b: QuizRequest | PlainMessage<QuizRequest> | undefined): boolean {
+    return proto3.util.equals(QuizRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.QuizSubmission
+ */
+export class QuizSubmission extends Message<QuizSubmission> {
+  /**
+   * @generated from field: uint64 courseID = 1;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 attemptID = 2;
+   */
+  attemptID = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated qf.QuizAnswer answers = 3;
+   */
+  answers: QuizAnswer[] = [];
+
+  constructor(data?: PartialMessage<QuizSubmission>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.QuizSubmission";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "attemptID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "answers", kind: "message", T: QuizAnswer, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuizSubmission {
+    return new QuizSubmission().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuizSubmission {
+    return new QuizSubmission().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuizSubmission {
+    return new QuizSubmission().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QuizSubmission | PlainMessage<QuizSubmission> | undefined, b: QuizSubmission | PlainMessage<QuizSubmission> | undefined): boolean {
+    return proto3.util.equals(QuizSubmission, a, b);
+  }
+}
+
 /**
  * @generated from message qf.Void
  */
@@ -72,6 +72,13 @@ export class User extends Message<User> {
    */
   Enrollments: Enrollment[] = [];
 
+  /**
+   * True if the user's email is the public email of the user's GitHub account, which GitHub has verified.
+   *
+   * @generated from field: bool EmailVerified = 12;
+   */
+  EmailVerified = false;
+
   constructor(data?: PartialMessage<User>) {
     super();
     proto3.util.initPartial(data, this);
@@ -91,6 +98,7 @@ export class User extends Message<User> {
     { no: 9, name: "ScmRemoteID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 10, name: "RefreshToken", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 11, name: "Enrollments", kind: "message", T: Enrollment, repeated: true },
+    { no: 12, name: "EmailVerified", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): User {
@@ -227,11 +235,19 @@ export enum Group_GroupStatus {
    * @generated from enum value: APPROVED = 1;
    */
   APPROVED = 1,
+
+  /**
+   * waiting for invited members to respond; cannot be approved
+   *
+   * @generated from enum value: INVITED = 2;
+   */
+  INVITED = 2,
 }
 // Retrieve enum metadata with: proto3.getEnumType(Group_GroupStatus)
 proto3.util.setEnumType(Group_GroupStatus, "qf.Group.GroupStatus", [
   { no: 0, name: "PENDING" },
   { no: 1, name: "APPROVED" },
+  { no: 2, name: "INVITED" },
 ]);
 
 /**
@@ -272,181 +288,189 @@ export class Groups extends Message<Groups> {
 }
 
 /**
- * @generated from message qf.Course
+ * GroupInvitation invites a student to join a group created by another student.
+ *
+ * @generated from message qf.GroupInvitation
  */
-export class Course extends Message<Course> {
+export class GroupInvitation extends Message<GroupInvitation> {
   /**
    * @generated from field: uint64 ID = 1;
    */
   ID = protoInt64.zero;
 
   /**
-   * @generated from field: uint64 courseCreatorID = 2;
-   */
-  courseCreatorID = protoInt64.zero;
-
-  /**
-   * @generated from field: string name = 3;
-   */
-  name = "";
-
-  /**
-   * @generated from field: string code = 4;
-   */
-  code = "";
-
-  /**
-   * @generated from field: uint32 year = 5;
-   */
-  year = 0;
-
-  /**
-   * @generated from field: string tag = 6;
+   * @generated from field: uint64 courseID = 2;
    */
-  tag = "";
+  courseID = protoInt64.zero;
 
   /**
-   * @generated from field: uint64 ScmOrganizationID = 8;
+   * @generated from field: uint64 groupID = 3;
    */
-  ScmOrganizationID = protoInt64.zero;
+  groupID = protoInt64.zero;
 
   /**
-   * The organization's SCM name, e.g., dat520-2020.
+   * the invited student
    *
-   * @generated from field: string ScmOrganizationName = 9;
+   * @generated from field: uint64 userID = 4;
    */
-  ScmOrganizationName = "";
+  userID = protoInt64.zero;
 
   /**
-   * @generated from field: uint32 slipDays = 10;
+   * @generated from field: uint64 inviterID = 5;
    */
-  slipDays = 0;
+  inviterID = protoInt64.zero;
 
   /**
-   * Digest of the dockerfile used to build the course's docker image.
-   *
-   * @generated from field: string DockerfileDigest = 11;
+   * @generated from field: qf.GroupInvitation.Status status = 6;
    */
-  DockerfileDigest = "";
+  status = GroupInvitation_Status.PENDING;
 
   /**
-   * @generated from field: qf.Enrollment.UserStatus enrolled = 12;
+   * @generated from field: google.protobuf.Timestamp expires = 7;
    */
-  enrolled = Enrollment_UserStatus.NONE;
+  expires?: Timestamp;
 
   /**
-   * @generated from field: repeated qf.Enrollment enrollments = 13;
+   * @generated from field: qf.Group group = 8;
    */
-  enrollments: Enrollment[] = [];
+  group?: Group;
 
   /**
-   * @generated from field: repeated qf.Assignment assignments = 14;
+   * @generated from field: qf.User user = 9;
    */
-  assignments: Assignment[] = [];
+  user?: User;
 
   /**
-   * @generated from field: repeated qf.Group groups = 15;
+   * @generated from field: qf.User inviter = 10;
    */
-  groups: Group[] = [];
+  inviter?: User;
 
-  constructor(data?: PartialMessage<Course>) {
+  constructor(data?: PartialMessage<GroupInvitation>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Course";
+  static readonly typeName = "qf.GroupInvitation";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "courseCreatorID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "year", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 6, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 8, name: "ScmOrganizationID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 9, name: "ScmOrganizationName", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 10, name: "slipDays", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 11, name: "DockerfileDigest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 12, name: "enrolled", kind: "enum", T: proto3.getEnumType(Enrollment_UserStatus) },
-    { no: 13, name: "enrollments", kind: "message", T: Enrollment, repeated: true },
-    { no: 14, name: "assignments", kind: "message", T: Assignment, repeated: true },
-    { no: 15, name: "groups", kind: "message", T: Group, repeated: true },
+    { no: 2, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "groupID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "inviterID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "status", kind: "enum", T: proto3.getEnumType(GroupInvitation_Status) },
+    { no: 7, name: "expires", kind: "message", T: Timestamp },
+    { no: 8, name: "group", kind: "message", T: Group },
+    { no: 9, name: "user", kind: "message", T: User },
+    { no: 10, name: "inviter", kind: "message", T: User },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Course {
-    return new Course().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GroupInvitation {
+    return new GroupInvitation().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Course {
-    return new Course().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GroupInvitation {
+    return new GroupInvitation().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Course {
-    return new Course().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GroupInvitation {
+    return new GroupInvitation().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Course | PlainMessage<Course> | undefined, b: Course | PlainMessage<Course> | undefined): boolean {
-    return proto3.util.equals(Course, a, b);
+  static equals(a: GroupInvitation | PlainMessage<GroupInvitation> | undefined, b: GroupInvitation | PlainMessage<GroupInvitation> | undefined): boolean {
+    return proto3.util.equals(GroupInvitation, a, b);
   }
 }
 
 /**
- * @generated from message qf.Courses
+ * @generated from enum qf.GroupInvitation.Status
  */
-export class Courses extends Message<Courses> {
+export enum GroupInvitation_Status {
   /**
-   * @generated from field: repeated qf.Course courses = 1;
+   * @generated from enum value: PENDING = 0;
    */
-  courses: Course[] = [];
+  PENDING = 0,
 
-  constructor(data?: PartialMessage<Courses>) {
+  /**
+   * @generated from enum value: ACCEPTED = 1;
+   */
+  ACCEPTED = 1,
+
+  /**
+   * @generated from enum value: DECLINED = 2;
+   */
+  DECLINED = 2,
+
+  /**
+   * @generated from enum value: EXPIRED = 3;
+   */
+  EXPIRED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(GroupInvitation_Status)
+proto3.util.setEnumType(GroupInvitation_Status, "qf.GroupInvitation.Status", [
+  { no: 0, name: "PENDING" },
+  { no: 1, name: "ACCEPTED" },
+  { no: 2, name: "DECLINED" },
+  { no: 3, name: "EXPIRED" },
+]);
+
+/**
+ * @generated from message qf.GroupInvitations
+ */
+export class GroupInvitations extends Message<GroupInvitations> {
+  /**
+   * @generated from field: repeated qf.GroupInvitation invitations = 1;
+   */
+  invitations: GroupInvitation[] = [];
+
+  constructor(data?: PartialMessage<GroupInvitations>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Courses";
+  static readonly typeName = "qf.GroupInvitations";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "courses", kind: "message", T: Course, repeated: true },
+    { no: 1, name: "invitations", kind: "message", T: GroupInvitation, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Courses {
-    return new Courses().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GroupInvitations {
+    return new GroupInvitations().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Courses {
-    return new Courses().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GroupInvitations {
+    return new GroupInvitations().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Courses {
-    return new Courses().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GroupInvitations {
+    return new GroupInvitations().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Courses | PlainMessage<Courses> | undefined, b: Courses | PlainMessage<Courses> | undefined): boolean {
-    return proto3.util.equals(Courses, a, b);
+  static equals(a: GroupInvitations | PlainMessage<GroupInvitations> | undefined, b: GroupInvitations | PlainMessage<GroupInvitations> | undefined): boolean {
+    return proto3.util.equals(GroupInvitations, a, b);
   }
 }
 
 /**
- * @generated from message qf.Repository
+ * GroupMembership records the interval during which a student was a member of a group.
+ *
+ * @generated from message qf.GroupMembership
  */
-export class Repository extends Message<Repository> {
+export class GroupMembership extends Message<GroupMembership> {
   /**
    * @generated from field: uint64 ID = 1;
    */
   ID = protoInt64.zero;
 
   /**
-   * @generated from field: uint64 ScmOrganizationID = 2;
+   * @generated from field: uint64 courseID = 2;
    */
-  ScmOrganizationID = protoInt64.zero;
+  courseID = protoInt64.zero;
 
   /**
-   * ID of a github repository
-   *
-   * @generated from field: uint64 ScmRepositoryID = 3;
+   * @generated from field: uint64 groupID = 3;
    */
-  ScmRepositoryID = protoInt64.zero;
+  groupID = protoInt64.zero;
 
   /**
    * @generated from field: uint64 userID = 4;
@@ -454,110 +478,107 @@ export class Repository extends Message<Repository> {
   userID = protoInt64.zero;
 
   /**
-   * @generated from field: uint64 groupID = 5;
+   * kept after the group is deleted
+   *
+   * @generated from field: string groupName = 5;
    */
-  groupID = protoInt64.zero;
+  groupName = "";
 
   /**
-   * @generated from field: string HTMLURL = 6;
+   * @generated from field: google.protobuf.Timestamp joined = 6;
    */
-  HTMLURL = "";
+  joined?: Timestamp;
 
   /**
-   * @generated from field: qf.Repository.Type repoType = 7;
+   * unset while the student is a member
+   *
+   * @generated from field: google.protobuf.Timestamp left = 7;
    */
-  repoType = Repository_Type.NONE;
+  left?: Timestamp;
 
   /**
-   * Issues associated with this repository
-   *
-   * @generated from field: repeated qf.Issue issues = 8;
+   * @generated from field: qf.User user = 8;
    */
-  issues: Issue[] = [];
+  user?: User;
 
-  constructor(data?: PartialMessage<Repository>) {
+  constructor(data?: PartialMessage<GroupMembership>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Repository";
+  static readonly typeName = "qf.GroupMembership";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "ScmOrganizationID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "ScmRepositoryID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "groupID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 4, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 5, name: "groupID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 6, name: "HTMLURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "repoType", kind: "enum", T: proto3.getEnumType(Repository_Type) },
-    { no: 8, name: "issues", kind: "message", T: Issue, repeated: true },
+    { no: 5, name: "groupName", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "joined", kind: "message", T: Timestamp },
+    { no: 7, name: "left", kind: "message", T: Timestamp },
+    { no: 8, name: "user", kind: "message", T: User },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Repository {
-    return new Repository().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GroupMembership {
+    return new GroupMembership().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Repository {
-    return new Repository().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GroupMembership {
+    return new GroupMembership().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Repository {
-    return new Repository().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GroupMembership {
+    return new GroupMembership().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Repository | PlainMessage<Repository> | undefined, b: Repository | PlainMessage<Repository> | undefined): boolean {
-    return proto3.util.equals(Repository, a, b);
+  static equals(a: GroupMembership | PlainMessage<GroupMembership> | undefined, b: GroupMembership | PlainMessage<GroupMembership> | undefined): boolean {
+    return proto3.util.equals(GroupMembership, a, b);
   }
 }
 
 /**
- * @generated from enum qf.Repository.Type
+ * @generated from message qf.GroupMemberships
  */
-export enum Repository_Type {
+export class GroupMemberships extends Message<GroupMemberships> {
   /**
-   * @generated from enum value: NONE = 0;
+   * @generated from field: repeated qf.GroupMembership memberships = 1;
    */
-  NONE = 0,
+  memberships: GroupMembership[] = [];
 
-  /**
-   * @generated from enum value: INFO = 1;
-   */
-  INFO = 1,
+  constructor(data?: PartialMessage<GroupMemberships>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * @generated from enum value: ASSIGNMENTS = 2;
-   */
-  ASSIGNMENTS = 2,
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.GroupMemberships";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "memberships", kind: "message", T: GroupMembership, repeated: true },
+  ]);
 
-  /**
-   * @generated from enum value: TESTS = 3;
-   */
-  TESTS = 3,
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GroupMemberships {
+    return new GroupMemberships().fromBinary(bytes, options);
+  }
 
-  /**
-   * @generated from enum value: USER = 4;
-   */
-  USER = 4,
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GroupMemberships {
+    return new GroupMemberships().fromJson(jsonValue, options);
+  }
 
-  /**
-   * @generated from enum value: GROUP = 5;
-   */
-  GROUP = 5,
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GroupMemberships {
+    return new GroupMemberships().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GroupMemberships | PlainMessage<GroupMemberships> | undefined, b: GroupMemberships | PlainMessage<GroupMemberships> | undefined): boolean {
+    return proto3.util.equals(GroupMemberships, a, b);
+  }
 }
-// Retrieve enum metadata with: proto3.getEnumType(Repository_Type)
-proto3.util.setEnumType(Repository_Type, "qf.Repository.Type", [
-  { no: 0, name: "NONE" },
-  { no: 1, name: "INFO" },
-  { no: 2, name: "ASSIGNMENTS" },
-  { no: 3, name: "TESTS" },
-  { no: 4, name: "USER" },
-  { no: 5, name: "GROUP" },
-]);
 
 /**
- * @generated from message qf.Enrollment
+ * GroupPreference lists the students that a student prefers to be in a group with.
+ *
+ * @generated from message qf.GroupPreference
  */
-export class Enrollment extends Message<Enrollment> {
+export class GroupPreference extends Message<GroupPreference> {
   /**
    * @generated from field: uint64 ID = 1;
    */
@@ -574,266 +595,324 @@ export class Enrollment extends Message<Enrollment> {
   userID = protoInt64.zero;
 
   /**
-   * @generated from field: uint64 groupID = 4;
+   * user IDs of the preferred partners
+   *
+   * @generated from field: repeated uint64 partnerIDs = 4;
    */
-  groupID = protoInt64.zero;
+  partnerIDs: bigint[] = [];
 
-  /**
-   * @generated from field: qf.User user = 5;
-   */
-  user?: User;
+  constructor(data?: PartialMessage<GroupPreference>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * @generated from field: qf.Course course = 6;
-   */
-  course?: Course;
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.GroupPreference";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "partnerIDs", kind: "scalar", T: 4 /* ScalarType.UINT64 */, repeated: true },
+  ]);
 
-  /**
-   * @generated from field: qf.Group group = 7;
-   */
-  group?: Group;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GroupPreference {
+    return new GroupPreference().fromBinary(bytes, options);
+  }
 
-  /**
-   * @generated from field: qf.Enrollment.UserStatus status = 8;
-   */
-  status = Enrollment_UserStatus.NONE;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GroupPreference {
+    return new GroupPreference().fromJson(jsonValue, options);
+  }
 
-  /**
-   * @generated from field: qf.Enrollment.DisplayState state = 9;
-   */
-  state = Enrollment_DisplayState.UNSET;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GroupPreference {
+    return new GroupPreference().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GroupPreference | PlainMessage<GroupPreference> | undefined, b: GroupPreference | PlainMessage<GroupPreference> | undefined): boolean {
+    return proto3.util.equals(GroupPreference, a, b);
+  }
+}
 
+/**
+ * GroupContributions summarizes how the members of a group contributed to the group's repository.
+ *
+ * @generated from message qf.GroupContributions
+ */
+export class GroupContributions extends Message<GroupContributions> {
   /**
-   * @generated from field: uint32 slipDaysRemaining = 10;
+   * @generated from field: uint64 groupID = 1;
    */
-  slipDaysRemaining = 0;
+  groupID = protoInt64.zero;
 
   /**
-   * @generated from field: google.protobuf.Timestamp lastActivityDate = 11;
+   * percent of an equal share of the changed lines below which a member is flagged
+   *
+   * @generated from field: uint32 threshold = 2;
    */
-  lastActivityDate?: Timestamp;
+  threshold = 0;
 
   /**
-   * @generated from field: uint64 totalApproved = 12;
+   * @generated from field: repeated qf.MemberContribution members = 3;
    */
-  totalApproved = protoInt64.zero;
+  members: MemberContribution[] = [];
 
   /**
-   * @generated from field: repeated qf.UsedSlipDays usedSlipDays = 13;
+   * commits whose author did not match a group member
+   *
+   * @generated from field: uint32 unattributedCommits = 4;
    */
-  usedSlipDays: UsedSlipDays[] = [];
+  unattributedCommits = 0;
 
-  constructor(data?: PartialMessage<Enrollment>) {
+  constructor(data?: PartialMessage<GroupContributions>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Enrollment";
+  static readonly typeName = "qf.GroupContributions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 4, name: "groupID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 5, name: "user", kind: "message", T: User },
-    { no: 6, name: "course", kind: "message", T: Course },
-    { no: 7, name: "group", kind: "message", T: Group },
-    { no: 8, name: "status", kind: "enum", T: proto3.getEnumType(Enrollment_UserStatus) },
-    { no: 9, name: "state", kind: "enum", T: proto3.getEnumType(Enrollment_DisplayState) },
-    { no: 10, name: "slipDaysRemaining", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 11, name: "lastActivityDate", kind: "message", T: Timestamp },
-    { no: 12, name: "totalApproved", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 13, name: "usedSlipDays", kind: "message", T: UsedSlipDays, repeated: true },
+    { no: 1, name: "groupID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "threshold", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "members", kind: "message", T: MemberContribution, repeated: true },
+    { no: 4, name: "unattributedCommits", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Enrollment {
-    return new Enrollment().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GroupContributions {
+    return new GroupContributions().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Enrollment {
-    return new Enrollment().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GroupContributions {
+    return new GroupContributions().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Enrollment {
-    return new Enrollment().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GroupContributions {
+    return new GroupContributions().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Enrollment | PlainMessage<Enrollment> | undefined, b: Enrollment | PlainMessage<Enrollment> | undefined): boolean {
-    return proto3.util.equals(Enrollment, a, b);
+  static equals(a: GroupContributions | PlainMessage<GroupContributions> | undefined, b: GroupContributions | PlainMessage<GroupContributions> | undefined): boolean {
+    return proto3.util.equals(GroupContributions, a, b);
   }
 }
 
 /**
- * @generated from enum qf.Enrollment.UserStatus
+ * MemberContribution is a group member's commits and changed lines, in total, per assignment and per day.
+ *
+ * @generated from message qf.MemberContribution
  */
-export enum Enrollment_UserStatus {
+export class MemberContribution extends Message<MemberContribution> {
   /**
-   * @generated from enum value: NONE = 0;
+   * @generated from field: uint64 userID = 1;
    */
-  NONE = 0,
+  userID = protoInt64.zero;
 
   /**
-   * @generated from enum value: PENDING = 1;
+   * @generated from field: qf.User user = 2;
    */
-  PENDING = 1,
+  user?: User;
 
   /**
-   * @generated from enum value: STUDENT = 2;
+   * @generated from field: uint32 commits = 3;
    */
-  STUDENT = 2,
+  commits = 0;
 
   /**
-   * @generated from enum value: TEACHER = 3;
+   * @generated from field: uint32 linesAdded = 4;
    */
-  TEACHER = 3,
-}
-// Retrieve enum metadata with: proto3.getEnumType(Enrollment_UserStatus)
-proto3.util.setEnumType(Enrollment_UserStatus, "qf.Enrollment.UserStatus", [
-  { no: 0, name: "NONE" },
-  { no: 1, name: "PENDING" },
-  { no: 2, name: "STUDENT" },
-  { no: 3, name: "TEACHER" },
-]);
+  linesAdded = 0;
 
-/**
- * @generated from enum qf.Enrollment.DisplayState
- */
-export enum Enrollment_DisplayState {
   /**
-   * @generated from enum value: UNSET = 0;
+   * @generated from field: uint32 linesRemoved = 5;
    */
-  UNSET = 0,
+  linesRemoved = 0;
 
   /**
-   * @generated from enum value: HIDDEN = 1;
+   * percent of the group's changed lines
+   *
+   * @generated from field: uint32 share = 6;
    */
-  HIDDEN = 1,
+  share = 0;
 
   /**
-   * @generated from enum value: VISIBLE = 2;
+   * true if the share is below the threshold
+   *
+   * @generated from field: bool flagged = 7;
    */
-  VISIBLE = 2,
+  flagged = false;
 
   /**
-   * @generated from enum value: FAVORITE = 3;
+   * @generated from field: repeated qf.AssignmentContribution assignments = 8;
    */
-  FAVORITE = 3,
+  assignments: AssignmentContribution[] = [];
+
+  /**
+   * @generated from field: repeated qf.ContributionActivity activity = 9;
+   */
+  activity: ContributionActivity[] = [];
+
+  constructor(data?: PartialMessage<MemberContribution>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.MemberContribution";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "user", kind: "message", T: User },
+    { no: 3, name: "commits", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "linesAdded", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "linesRemoved", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "share", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "flagged", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "assignments", kind: "message", T: AssignmentContribution, repeated: true },
+    { no: 9, name: "activity", kind: "message", T: ContributionActivity, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MemberContribution {
+    return new MemberContribution().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MemberContribution {
+    return new MemberContribution().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MemberContribution {
+    return new MemberContribution().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MemberContribution | PlainMessage<MemberContribution> | undefined, b: MemberContribution | PlainMessage<MemberContribution> | undefined): boolean {
+    return proto3.util.equals(MemberContribution, a, b);
+  }
 }
-// Retrieve enum metadata with: proto3.getEnumType(Enrollment_DisplayState)
-proto3.util.setEnumType(Enrollment_DisplayState, "qf.Enrollment.DisplayState", [
-  { no: 0, name: "UNSET" },
-  { no: 1, name: "HIDDEN" },
-  { no: 2, name: "VISIBLE" },
-  { no: 3, name: "FAVORITE" },
-]);
 
 /**
- * @generated from message qf.UsedSlipDays
+ * AssignmentContribution is a member's commits and changed lines in an assignment's folder.
+ *
+ * @generated from message qf.AssignmentContribution
  */
-export class UsedSlipDays extends Message<UsedSlipDays> {
+export class AssignmentContribution extends Message<AssignmentContribution> {
   /**
-   * @generated from field: uint64 ID = 1;
+   * @generated from field: uint64 assignmentID = 1;
    */
-  ID = protoInt64.zero;
+  assignmentID = protoInt64.zero;
 
   /**
-   * @generated from field: uint64 enrollmentID = 2;
+   * @generated from field: string folder = 2;
    */
-  enrollmentID = protoInt64.zero;
+  folder = "";
 
   /**
-   * @generated from field: uint64 assignmentID = 3;
+   * @generated from field: uint32 commits = 3;
    */
-  assignmentID = protoInt64.zero;
+  commits = 0;
 
   /**
-   * @generated from field: uint32 usedDays = 4;
+   * @generated from field: uint32 linesAdded = 4;
    */
-  usedDays = 0;
+  linesAdded = 0;
 
-  constructor(data?: PartialMessage<UsedSlipDays>) {
+  /**
+   * @generated from field: uint32 linesRemoved = 5;
+   */
+  linesRemoved = 0;
+
+  constructor(data?: PartialMessage<AssignmentContribution>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.UsedSlipDays";
+  static readonly typeName = "qf.AssignmentContribution";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "enrollmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "assignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 4, name: "usedDays", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 1, name: "assignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "folder", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "commits", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "linesAdded", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "linesRemoved", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UsedSlipDays {
-    return new UsedSlipDays().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AssignmentContribution {
+    return new AssignmentContribution().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UsedSlipDays {
-    return new UsedSlipDays().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AssignmentContribution {
+    return new AssignmentContribution().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UsedSlipDays {
-    return new UsedSlipDays().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AssignmentContribution {
+    return new AssignmentContribution().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UsedSlipDays | PlainMessage<UsedSlipDays> | undefined, b: UsedSlipDays | PlainMessage<UsedSlipDays> | undefined): boolean {
-    return proto3.util.equals(UsedSlipDays, a, b);
+  static equals(a: AssignmentContribution | PlainMessage<AssignmentContribution> | undefined, b: AssignmentContribution | PlainMessage<AssignmentContribution> | undefined): boolean {
+    return proto3.util.equals(AssignmentContribution, a, b);
   }
 }
 
 /**
- * @generated from message qf.Enrollments
+ * ContributionActivity is a member's commits and changed lines on a given day.
+ *
+ * @generated from message qf.ContributionActivity
  */
-export class Enrollments extends Message<Enrollments> {
+export class ContributionActivity extends Message<ContributionActivity> {
   /**
-   * @generated from field: repeated qf.Enrollment enrollments = 1;
+   * midnight UTC of the day
+   *
+   * @generated from field: google.protobuf.Timestamp date = 1;
    */
-  enrollments: Enrollment[] = [];
+  date?: Timestamp;
 
-  constructor(data?: PartialMessage<Enrollments>) {
+  /**
+   * @generated from field: uint32 commits = 2;
+   */
+  commits = 0;
+
+  /**
+   * @generated from field: uint32 linesChanged = 3;
+   */
+  linesChanged = 0;
+
+  constructor(data?: PartialMessage<ContributionActivity>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Enrollments";
+  static readonly typeName = "qf.ContributionActivity";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "enrollments", kind: "message", T: Enrollment, repeated: true },
+    { no: 1, name: "date", kind: "message", T: Timestamp },
+    { no: 2, name: "commits", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "linesChanged", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Enrollments {
-    return new Enrollments().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ContributionActivity {
+    return new ContributionActivity().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Enrollments {
-    return new Enrollments().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ContributionActivity {
+    return new ContributionActivity().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Enrollments {
-    return new Enrollments().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ContributionActivity {
+    return new ContributionActivity().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Enrollments | PlainMessage<Enrollments> | undefined, b: Enrollments | PlainMessage<Enrollments> | undefined): boolean {
-    return proto3.util.equals(Enrollments, a, b);
+  static equals(a: ContributionActivity | PlainMessage<ContributionActivity> | undefined, b: ContributionActivity | PlainMessage<ContributionActivity> | undefined): boolean {
+    return proto3.util.equals(ContributionActivity, a, b);
   }
 }
 
 /**
- * @generated from message qf.Assignment
+ * @generated from message qf.Course
  */
-export class Assignment extends Message<Assignment> {
+export class Course extends Message<Course> {
   /**
    * @generated from field: uint64 ID = 1;
    */
   ID = protoInt64.zero;
 
   /**
-   * foreign key
-   *
-   * @generated from field: uint64 CourseID = 2;
+   * @generated from field: uint64 courseCreatorID = 2;
    */
-  CourseID = protoInt64.zero;
+  courseCreatorID = protoInt64.zero;
 
   /**
    * @generated from field: string name = 3;
@@ -841,111 +920,143 @@ export class Assignment extends Message<Assignment> {
   name = "";
 
   /**
-   * @generated from field: google.protobuf.Timestamp deadline = 4;
+   * @generated from field: string code = 4;
    */
-  deadline?: Timestamp;
+  code = "";
 
   /**
-   * @generated from field: bool autoApprove = 5;
+   * @generated from field: uint32 year = 5;
    */
-  autoApprove = false;
+  year = 0;
 
   /**
-   * @generated from field: uint32 order = 6;
+   * @generated from field: string tag = 6;
    */
-  order = 0;
+  tag = "";
 
   /**
-   * @generated from field: bool isGroupLab = 7;
+   * @generated from field: uint64 ScmOrganizationID = 8;
    */
-  isGroupLab = false;
+  ScmOrganizationID = protoInt64.zero;
 
   /**
-   * minimal score limit for auto approval
+   * The organization's SCM name, e.g., dat520-2020.
    *
-   * @generated from field: uint32 scoreLimit = 8;
+   * @generated from field: string ScmOrganizationName = 9;
    */
-  scoreLimit = 0;
+  ScmOrganizationName = "";
 
   /**
-   * number of reviewers that will review submissions for this assignment
+   * @generated from field: uint32 slipDays = 10;
+   */
+  slipDays = 0;
+
+  /**
+   * Digest of the dockerfile used to build the course's docker image.
    *
-   * @generated from field: uint32 reviewers = 9;
+   * @generated from field: string DockerfileDigest = 11;
    */
-  reviewers = 0;
+  DockerfileDigest = "";
 
   /**
-   * container timeout for this assignment
+   * @generated from field: qf.Enrollment.UserStatus enrolled = 12;
+   */
+  enrolled = Enrollment_UserStatus.NONE;
+
+  /**
+   * Accept unsigned score lines containing the raw session secret; used while migrating tests to signed score lines. Left unchanged by updates if unset.
    *
-   * @generated from field: uint32 containerTimeout = 10;
+   * @generated from field: optional bool legacyScoreFormat = 16;
    */
-  containerTimeout = 0;
+  legacyScoreFormat?: boolean;
 
   /**
-   * submissions produced for this assignment
+   * Smallest group proposed by ProposeGroups; one if zero.
    *
-   * @generated from field: repeated qf.Submission submissions = 11;
+   * @generated from field: uint32 minGroupSize = 18;
    */
-  submissions: Submission[] = [];
+  minGroupSize = 0;
 
   /**
-   * tasks associated with this assignment
+   * Largest group proposed by ProposeGroups; required to propose groups.
    *
-   * @generated from field: repeated qf.Task tasks = 12;
+   * @generated from field: uint32 maxGroupSize = 19;
    */
-  tasks: Task[] = [];
+  maxGroupSize = 0;
 
   /**
-   * grading benchmarks for this assignment
+   * @generated from field: repeated qf.Enrollment enrollments = 13;
+   */
+  enrollments: Enrollment[] = [];
+
+  /**
+   * @generated from field: repeated qf.Assignment assignments = 14;
+   */
+  assignments: Assignment[] = [];
+
+  /**
+   * @generated from field: repeated qf.Group groups = 15;
+   */
+  groups: Group[] = [];
+
+  /**
+   * not included in responses; UpdateCourse replaces the policy if set, and keeps it otherwise
    *
-   * @generated from field: repeated qf.GradingBenchmark gradingBenchmarks = 13;
+   * @generated from field: qf.EnrollmentPolicy enrollmentPolicy = 17;
    */
-  gradingBenchmarks: GradingBenchmark[] = [];
+  enrollmentPolicy?: EnrollmentPolicy;
 
-  constructor(data?: PartialMessage<Assignment>) {
+  constructor(data?: PartialMessage<Course>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Assignment";
+  static readonly typeName = "qf.Course";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "courseCreatorID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "deadline", kind: "message", T: Timestamp },
-    { no: 5, name: "autoApprove", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 6, name: "order", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 7, name: "isGroupLab", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 8, name: "scoreLimit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 9, name: "reviewers", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 10, name: "containerTimeout", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 11, name: "submissions", kind: "message", T: Submission, repeated: true },
-    { no: 12, name: "tasks", kind: "message", T: Task, repeated: true },
-    { no: 13, name: "gradingBenchmarks", kind: "message", T: GradingBenchmark, repeated: true },
+    { no: 4, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "year", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "ScmOrganizationID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 9, name: "ScmOrganizationName", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "slipDays", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 11, name: "DockerfileDigest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "enrolled", kind: "enum", T: proto3.getEnumType(Enrollment_UserStatus) },
+    { no: 16, name: "legacyScoreFormat", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 18, name: "minGroupSize", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 19, name: "maxGroupSize", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 13, name: "enrollments", kind: "message", T: Enrollment, repeated: true },
+    { no: 14, name: "assignments", kind: "message", T: Assignment, repeated: true },
+    { no: 15, name: "groups", kind: "message", T: Group, repeated: true },
+    { no: 17, name: "enrollmentPolicy", kind: "message", T: EnrollmentPolicy },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Assignment {
-    return new Assignment().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Course {
+    return new Course().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Assignment {
-    return new Assignment().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Course {
+    return new Course().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Assignment {
-    return new Assignment().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Course {
+    return new Course().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Assignment | PlainMessage<Assignment> | undefined, b: Assignment | PlainMessage<Assignment> | undefined): boolean {
-    return proto3.util.equals(Assignment, a, b);
+  static equals(a: Course | PlainMessage<Course> | undefined, b: Course | PlainMessage<Course> | undefined): boolean {
+    return proto3.util.equals(Course, a, b);
   }
 }
 
 /**
- * @generated from message qf.Task
+ * EnrollmentPolicy decides who can enroll in a course, and whose enrollments are approved without a teacher.
+ *
+ * @generated from message qf.EnrollmentPolicy
  */
-export class Task extends Message<Task> {
+export class EnrollmentPolicy extends Message<EnrollmentPolicy> {
   /**
    * @generated from field: uint64 ID = 1;
    */
@@ -954,304 +1065,3611 @@ export class Task extends Message<Task> {
   /**
    * foreign key
    *
-   * @generated from field: uint64 assignmentID = 2;
+   * @generated from field: uint64 CourseID = 2;
    */
-  assignmentID = protoInt64.zero;
+  CourseID = protoInt64.zero;
+
+  /**
+   * enrollment is closed before this time, if set
+   *
+   * @generated from field: google.protobuf.Timestamp opens = 3;
+   */
+  opens?: Timestamp;
+
+  /**
+   * enrollment is closed after this time, if set
+   *
+   * @generated from field: google.protobuf.Timestamp closes = 4;
+   */
+  closes?: Timestamp;
+
+  /**
+   * code that users must give to enroll, if set
+   *
+   * @generated from field: string joinCode = 5;
+   */
+  joinCode = "";
+
+  /**
+   * users with a verified email in one of these domains are enrolled without approval
+   *
+   * @generated from field: repeated string emailDomains = 6;
+   */
+  emailDomains: string[] = [];
+
+  /**
+   * maximum number of students and pending enrollments; further enrollments are waitlisted; unlimited if zero
+   *
+   * @generated from field: uint32 capacity = 7;
+   */
+  capacity = 0;
+
+  constructor(data?: PartialMessage<EnrollmentPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.EnrollmentPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "opens", kind: "message", T: Timestamp },
+    { no: 4, name: "closes", kind: "message", T: Timestamp },
+    { no: 5, name: "joinCode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "emailDomains", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "capacity", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnrollmentPolicy {
+    return new EnrollmentPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnrollmentPolicy {
+    return new EnrollmentPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnrollmentPolicy {
+    return new EnrollmentPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EnrollmentPolicy | PlainMessage<EnrollmentPolicy> | undefined, b: EnrollmentPolicy | PlainMessage<EnrollmentPolicy> | undefined): boolean {
+    return proto3.util.equals(EnrollmentPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.Courses
+ */
+export class Courses extends Message<Courses> {
+  /**
+   * @generated from field: repeated qf.Course courses = 1;
+   */
+  courses: Course[] = [];
+
+  constructor(data?: PartialMessage<Courses>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Courses";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "courses", kind: "message", T: Course, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Courses {
+    return new Courses().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Courses {
+    return new Courses().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Courses {
+    return new Courses().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Courses | PlainMessage<Courses> | undefined, b: Courses | PlainMessage<Courses> | undefined): boolean {
+    return proto3.util.equals(Courses, a, b);
+  }
+}
+
+/**
+ * GradingConfig decides how the final grades of a course's students are computed.
+ *
+ * @generated from message qf.GradingConfig
+ */
+export class GradingConfig extends Message<GradingConfig> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 CourseID = 2;
+   */
+  CourseID = protoInt64.zero;
+
+  /**
+   * assignments without a weight count with weight 1
+   *
+   * @generated from field: repeated qf.AssignmentWeight weights = 3;
+   */
+  weights: AssignmentWeight[] = [];
+
+  /**
+   * minimum number of approved assignments required to pass
+   *
+   * @generated from field: uint32 minApproved = 4;
+   */
+  minApproved = 0;
+
+  /**
+   * grade components graded outside QuickFeed, such as a written exam
+   *
+   * @generated from field: repeated qf.ExternalComponent components = 5;
+   */
+  components: ExternalComponent[] = [];
+
+  /**
+   * @generated from field: string schemeName = 6;
+   */
+  schemeName = "";
+
+  /**
+   * minimum points for each letter grade, in descending order
+   *
+   * @generated from field: repeated uint32 gradePoints = 7;
+   */
+  gradePoints: number[] = [];
+
+  /**
+   * letter grades from best to worst; the last grade is also given to students who do not pass
+   *
+   * @generated from field: repeated string gradeNames = 8;
+   */
+  gradeNames: string[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated = 9;
+   */
+  updated?: Timestamp;
+
+  constructor(data?: PartialMessage<GradingConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.GradingConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "weights", kind: "message", T: AssignmentWeight, repeated: true },
+    { no: 4, name: "minApproved", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "components", kind: "message", T: ExternalComponent, repeated: true },
+    { no: 6, name: "schemeName", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "gradePoints", kind: "scalar", T: 13 /* ScalarType.UINT32 */, repeated: true },
+    { no: 8, name: "gradeNames", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "updated", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GradingConfig {
+    return new GradingConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GradingConfig {
+    return new GradingConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GradingConfig {
+    return new GradingConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GradingConfig | PlainMessage<GradingConfig> | undefined, b: GradingConfig | PlainMessage<GradingConfig> | undefined): boolean {
+    return proto3.util.equals(GradingConfig, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.AssignmentWeight
+ */
+export class AssignmentWeight extends Message<AssignmentWeight> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 GradingConfigID = 2;
+   */
+  GradingConfigID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 AssignmentID = 3;
+   */
+  AssignmentID = protoInt64.zero;
+
+  /**
+   * weight 0 excludes the assignment from the final grade's points
+   *
+   * @generated from field: uint32 weight = 4;
+   */
+  weight = 0;
+
+  /**
+   * if true, the assignment must be approved to pass
+   *
+   * @generated from field: bool required = 5;
+   */
+  required = false;
+
+  constructor(data?: PartialMessage<AssignmentWeight>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.AssignmentWeight";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "GradingConfigID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "AssignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "weight", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "required", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AssignmentWeight {
+    return new AssignmentWeight().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AssignmentWeight {
+    return new AssignmentWeight().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AssignmentWeight {
+    return new AssignmentWeight().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AssignmentWeight | PlainMessage<AssignmentWeight> | undefined, b: AssignmentWeight | PlainMessage<AssignmentWeight> | undefined): boolean {
+    return proto3.util.equals(AssignmentWeight, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.ExternalComponent
+ */
+export class ExternalComponent extends Message<ExternalComponent> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 GradingConfigID = 2;
+   */
+  GradingConfigID = protoInt64.zero;
+
+  /**
+   * e.g., "exam" or "oral"
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: uint32 weight = 4;
+   */
+  weight = 0;
+
+  constructor(data?: PartialMessage<ExternalComponent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.ExternalComponent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "GradingConfigID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "weight", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExternalComponent {
+    return new ExternalComponent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExternalComponent {
+    return new ExternalComponent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExternalComponent {
+    return new ExternalComponent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExternalComponent | PlainMessage<ExternalComponent> | undefined, b: ExternalComponent | PlainMessage<ExternalComponent> | undefined): boolean {
+    return proto3.util.equals(ExternalComponent, a, b);
+  }
+}
+
+/**
+ * FinalGrade is the final grade of a student computed from the course's grading configuration.
+ *
+ * @generated from message qf.FinalGrade
+ */
+export class FinalGrade extends Message<FinalGrade> {
+  /**
+   * @generated from field: uint64 enrollmentID = 1;
+   */
+  enrollmentID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 userID = 2;
+   */
+  userID = protoInt64.zero;
+
+  /**
+   * weighted score between 0 and 100
+   *
+   * @generated from field: uint32 points = 3;
+   */
+  points = 0;
+
+  /**
+   * number of approved assignments
+   *
+   * @generated from field: uint32 approved = 4;
+   */
+  approved = 0;
+
+  /**
+   * true if the required assignments and the minimum number of assignments are approved
+   *
+   * @generated from field: bool passed = 5;
+   */
+  passed = false;
+
+  /**
+   * letter grade; the failing grade if not passed
+   *
+   * @generated from field: string grade = 6;
+   */
+  grade = "";
+
+  /**
+   * per-assignment and per-component breakdown of the points
+   *
+   * @generated from field: repeated qf.GradePart parts = 7;
+   */
+  parts: GradePart[] = [];
+
+  constructor(data?: PartialMessage<FinalGrade>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.FinalGrade";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enrollmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "points", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "approved", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "passed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "grade", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "parts", kind: "message", T: GradePart, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FinalGrade {
+    return new FinalGrade().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FinalGrade {
+    return new FinalGrade().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FinalGrade {
+    return new FinalGrade().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FinalGrade | PlainMessage<FinalGrade> | undefined, b: FinalGrade | PlainMessage<FinalGrade> | undefined): boolean {
+    return proto3.util.equals(FinalGrade, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.GradePart
+ */
+export class GradePart extends Message<GradePart> {
+  /**
+   * zero for external components
+   *
+   * @generated from field: uint64 assignmentID = 1;
+   */
+  assignmentID = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: uint32 weight = 3;
+   */
+  weight = 0;
+
+  /**
+   * @generated from field: uint32 score = 4;
+   */
+  score = 0;
+
+  /**
+   * @generated from field: bool approved = 5;
+   */
+  approved = false;
+
+  /**
+   * @generated from field: bool required = 6;
+   */
+  required = false;
+
+  constructor(data?: PartialMessage<GradePart>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.GradePart";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "assignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "weight", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "score", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "approved", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "required", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GradePart {
+    return new GradePart().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GradePart {
+    return new GradePart().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GradePart {
+    return new GradePart().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GradePart | PlainMessage<GradePart> | undefined, b: GradePart | PlainMessage<GradePart> | undefined): boolean {
+    return proto3.util.equals(GradePart, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.FinalGrades
+ */
+export class FinalGrades extends Message<FinalGrades> {
+  /**
+   * the grading configuration used to compute the grades
+   *
+   * @generated from field: qf.GradingConfig config = 1;
+   */
+  config?: GradingConfig;
+
+  /**
+   * @generated from field: repeated qf.FinalGrade grades = 2;
+   */
+  grades: FinalGrade[] = [];
+
+  constructor(data?: PartialMessage<FinalGrades>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.FinalGrades";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "config", kind: "message", T: GradingConfig },
+    { no: 2, name: "grades", kind: "message", T: FinalGrade, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FinalGrades {
+    return new FinalGrades().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FinalGrades {
+    return new FinalGrades().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FinalGrades {
+    return new FinalGrades().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FinalGrades | PlainMessage<FinalGrades> | undefined, b: FinalGrades | PlainMessage<FinalGrades> | undefined): boolean {
+    return proto3.util.equals(FinalGrades, a, b);
+  }
+}
+
+/**
+ * GradeExport is a CSV or XLSX file with the final grades of a course's students.
+ *
+ * @generated from message qf.GradeExport
+ */
+export class GradeExport extends Message<GradeExport> {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data = new Uint8Array(0);
+
+  /**
+   * differences between the institution roster and the course's students
+   *
+   * @generated from field: repeated qf.RosterMismatch mismatches = 2;
+   */
+  mismatches: RosterMismatch[] = [];
+
+  constructor(data?: PartialMessage<GradeExport>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.GradeExport";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "mismatches", kind: "message", T: RosterMismatch, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GradeExport {
+    return new GradeExport().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GradeExport {
+    return new GradeExport().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GradeExport {
+    return new GradeExport().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GradeExport | PlainMessage<GradeExport> | undefined, b: GradeExport | PlainMessage<GradeExport> | undefined): boolean {
+    return proto3.util.equals(GradeExport, a, b);
+  }
+}
+
+/**
+ * ExternalGradeImport holds the external grades imported from a CSV file.
+ *
+ * @generated from message qf.ExternalGradeImport
+ */
+export class ExternalGradeImport extends Message<ExternalGradeImport> {
+  /**
+   * @generated from field: repeated qf.ExternalGrade grades = 1;
+   */
+  grades: ExternalGrade[] = [];
+
+  /**
+   * rows of the CSV file that were not imported
+   *
+   * @generated from field: repeated qf.RosterMismatch mismatches = 2;
+   */
+  mismatches: RosterMismatch[] = [];
+
+  constructor(data?: PartialMessage<ExternalGradeImport>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.ExternalGradeImport";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "grades", kind: "message", T: ExternalGrade, repeated: true },
+    { no: 2, name: "mismatches", kind: "message", T: RosterMismatch, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExternalGradeImport {
+    return new ExternalGradeImport().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExternalGradeImport {
+    return new ExternalGradeImport().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExternalGradeImport {
+    return new ExternalGradeImport().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExternalGradeImport | PlainMessage<ExternalGradeImport> | undefined, b: ExternalGradeImport | PlainMessage<ExternalGradeImport> | undefined): boolean {
+    return proto3.util.equals(ExternalGradeImport, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.RosterMismatch
+ */
+export class RosterMismatch extends Message<RosterMismatch> {
+  /**
+   * @generated from field: qf.RosterMismatch.Kind kind = 1;
+   */
+  kind = RosterMismatch_Kind.NOT_IN_QUICKFEED;
+
+  /**
+   * @generated from field: string studentID = 2;
+   */
+  studentID = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * row number in the roster, if any
+   *
+   * @generated from field: uint32 row = 4;
+   */
+  row = 0;
+
+  /**
+   * external grade component, if any
+   *
+   * @generated from field: string component = 5;
+   */
+  component = "";
+
+  constructor(data?: PartialMessage<RosterMismatch>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.RosterMismatch";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(RosterMismatch_Kind) },
+    { no: 2, name: "studentID", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "row", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "component", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RosterMismatch {
+    return new RosterMismatch().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RosterMismatch {
+    return new RosterMismatch().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RosterMismatch {
+    return new RosterMismatch().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RosterMismatch | PlainMessage<RosterMismatch> | undefined, b: RosterMismatch | PlainMessage<RosterMismatch> | undefined): boolean {
+    return proto3.util.equals(RosterMismatch, a, b);
+  }
+}
+
+/**
+ * @generated from enum qf.RosterMismatch.Kind
+ */
+export enum RosterMismatch_Kind {
+  /**
+   * the student is in the roster, but not enrolled in the course
+   *
+   * @generated from enum value: NOT_IN_QUICKFEED = 0;
+   */
+  NOT_IN_QUICKFEED = 0,
+
+  /**
+   * the student is enrolled in the course, but not in the roster
+   *
+   * @generated from enum value: NOT_IN_ROSTER = 1;
+   */
+  NOT_IN_ROSTER = 1,
+
+  /**
+   * the student ID is used more than once, either in the roster or in the course
+   *
+   * @generated from enum value: DUPLICATE_STUDENT_ID = 2;
+   */
+  DUPLICATE_STUDENT_ID = 2,
+
+  /**
+   * the component is not an external component of the course's grading configuration
+   *
+   * @generated from enum value: UNKNOWN_COMPONENT = 3;
+   */
+  UNKNOWN_COMPONENT = 3,
+
+  /**
+   * the score is not a number between 0 and 100
+   *
+   * @generated from enum value: INVALID_SCORE = 4;
+   */
+  INVALID_SCORE = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(RosterMismatch_Kind)
+proto3.util.setEnumType(RosterMismatch_Kind, "qf.RosterMismatch.Kind", [
+  { no: 0, name: "NOT_IN_QUICKFEED" },
+  { no: 1, name: "NOT_IN_ROSTER" },
+  { no: 2, name: "DUPLICATE_STUDENT_ID" },
+  { no: 3, name: "UNKNOWN_COMPONENT" },
+  { no: 4, name: "INVALID_SCORE" },
+]);
+
+/**
+ * @generated from message qf.Repository
+ */
+export class Repository extends Message<Repository> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 ScmOrganizationID = 2;
+   */
+  ScmOrganizationID = protoInt64.zero;
+
+  /**
+   * ID of a github repository
+   *
+   * @generated from field: uint64 ScmRepositoryID = 3;
+   */
+  ScmRepositoryID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 userID = 4;
+   */
+  userID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 groupID = 5;
+   */
+  groupID = protoInt64.zero;
+
+  /**
+   * @generated from field: string HTMLURL = 6;
+   */
+  HTMLURL = "";
+
+  /**
+   * @generated from field: qf.Repository.Type repoType = 7;
+   */
+  repoType = Repository_Type.NONE;
+
+  /**
+   * Issues associated with this repository
+   *
+   * @generated from field: repeated qf.Issue issues = 8;
+   */
+  issues: Issue[] = [];
+
+  constructor(data?: PartialMessage<Repository>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Repository";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "ScmOrganizationID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "ScmRepositoryID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "groupID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "HTMLURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "repoType", kind: "enum", T: proto3.getEnumType(Repository_Type) },
+    { no: 8, name: "issues", kind: "message", T: Issue, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Repository {
+    return new Repository().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Repository {
+    return new Repository().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Repository {
+    return new Repository().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Repository | PlainMessage<Repository> | undefined, b: Repository | PlainMessage<Repository> | undefined): boolean {
+    return proto3.util.equals(Repository, a, b);
+  }
+}
+
+/**
+ * @generated from enum qf.Repository.Type
+ */
+export enum Repository_Type {
+  /**
+   * @generated from enum value: NONE = 0;
+   */
+  NONE = 0,
+
+  /**
+   * @generated from enum value: INFO = 1;
+   */
+  INFO = 1,
+
+  /**
+   * @generated from enum value: ASSIGNMENTS = 2;
+   */
+  ASSIGNMENTS = 2,
+
+  /**
+   * @generated from enum value: TESTS = 3;
+   */
+  TESTS = 3,
+
+  /**
+   * @generated from enum value: USER = 4;
+   */
+  USER = 4,
+
+  /**
+   * @generated from enum value: GROUP = 5;
+   */
+  GROUP = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Repository_Type)
+proto3.util.setEnumType(Repository_Type, "qf.Repository.Type", [
+  { no: 0, name: "NONE" },
+  { no: 1, name: "INFO" },
+  { no: 2, name: "ASSIGNMENTS" },
+  { no: 3, name: "TESTS" },
+  { no: 4, name: "USER" },
+  { no: 5, name: "GROUP" },
+]);
+
+/**
+ * @generated from message qf.Enrollment
+ */
+export class Enrollment extends Message<Enrollment> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 courseID = 2;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 userID = 3;
+   */
+  userID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 groupID = 4;
+   */
+  groupID = protoInt64.zero;
+
+  /**
+   * @generated from field: qf.User user = 5;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: qf.Course course = 6;
+   */
+  course?: Course;
+
+  /**
+   * @generated from field: qf.Group group = 7;
+   */
+  group?: Group;
+
+  /**
+   * @generated from field: qf.Enrollment.UserStatus status = 8;
+   */
+  status = Enrollment_UserStatus.NONE;
+
+  /**
+   * @generated from field: qf.Enrollment.DisplayState state = 9;
+   */
+  state = Enrollment_DisplayState.UNSET;
+
+  /**
+   * @generated from field: uint32 slipDaysRemaining = 10;
+   */
+  slipDaysRemaining = 0;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp lastActivityDate = 11;
+   */
+  lastActivityDate?: Timestamp;
+
+  /**
+   * @generated from field: uint64 totalApproved = 12;
+   */
+  totalApproved = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated qf.UsedSlipDays usedSlipDays = 13;
+   */
+  usedSlipDays: UsedSlipDays[] = [];
+
+  /**
+   * @generated from field: repeated qf.ExternalGrade externalGrades = 14;
+   */
+  externalGrades: ExternalGrade[] = [];
+
+  /**
+   * the pending enrollment is on the course's waitlist
+   *
+   * @generated from field: bool waitlisted = 15;
+   */
+  waitlisted = false;
+
+  /**
+   * the course's join code, given when enrolling
+   *
+   * @generated from field: string joinCode = 16;
+   */
+  joinCode = "";
+
+  constructor(data?: PartialMessage<Enrollment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Enrollment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "groupID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "user", kind: "message", T: User },
+    { no: 6, name: "course", kind: "message", T: Course },
+    { no: 7, name: "group", kind: "message", T: Group },
+    { no: 8, name: "status", kind: "enum", T: proto3.getEnumType(Enrollment_UserStatus) },
+    { no: 9, name: "state", kind: "enum", T: proto3.getEnumType(Enrollment_DisplayState) },
+    { no: 10, name: "slipDaysRemaining", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 11, name: "lastActivityDate", kind: "message", T: Timestamp },
+    { no: 12, name: "totalApproved", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 13, name: "usedSlipDays", kind: "message", T: UsedSlipDays, repeated: true },
+    { no: 14, name: "externalGrades", kind: "message", T: ExternalGrade, repeated: true },
+    { no: 15, name: "waitlisted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 16, name: "joinCode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Enrollment {
+    return new Enrollment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Enrollment {
+    return new Enrollment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Enrollment {
+    return new Enrollment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Enrollment | PlainMessage<Enrollment> | undefined, b: Enrollment | PlainMessage<Enrollment> | undefined): boolean {
+    return proto3.util.equals(Enrollment, a, b);
+  }
+}
+
+/**
+ * @generated from enum qf.Enrollment.UserStatus
+ */
+export enum Enrollment_UserStatus {
+  /**
+   * @generated from enum value: NONE = 0;
+   */
+  NONE = 0,
+
+  /**
+   * @generated from enum value: PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: STUDENT = 2;
+   */
+  STUDENT = 2,
+
+  /**
+   * @generated from enum value: TEACHER = 3;
+   */
+  TEACHER = 3,
+
+  /**
+   * teaching assistant; can review, grade and rebuild submissions, but not manage the course
+   *
+   * @generated from enum value: ASSISTANT = 4;
+   */
+  ASSISTANT = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Enrollment_UserStatus)
+proto3.util.setEnumType(Enrollment_UserStatus, "qf.Enrollment.UserStatus", [
+  { no: 0, name: "NONE" },
+  { no: 1, name: "PENDING" },
+  { no: 2, name: "STUDENT" },
+  { no: 3, name: "TEACHER" },
+  { no: 4, name: "ASSISTANT" },
+]);
+
+/**
+ * @generated from enum qf.Enrollment.DisplayState
+ */
+export enum Enrollment_DisplayState {
+  /**
+   * @generated from enum value: UNSET = 0;
+   */
+  UNSET = 0,
+
+  /**
+   * @generated from enum value: HIDDEN = 1;
+   */
+  HIDDEN = 1,
+
+  /**
+   * @generated from enum value: VISIBLE = 2;
+   */
+  VISIBLE = 2,
+
+  /**
+   * @generated from enum value: FAVORITE = 3;
+   */
+  FAVORITE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Enrollment_DisplayState)
+proto3.util.setEnumType(Enrollment_DisplayState, "qf.Enrollment.DisplayState", [
+  { no: 0, name: "UNSET" },
+  { no: 1, name: "HIDDEN" },
+  { no: 2, name: "VISIBLE" },
+  { no: 3, name: "FAVORITE" },
+]);
+
+/**
+ * @generated from message qf.UsedSlipDays
+ */
+export class UsedSlipDays extends Message<UsedSlipDays> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 enrollmentID = 2;
+   */
+  enrollmentID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 assignmentID = 3;
+   */
+  assignmentID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint32 usedDays = 4;
+   */
+  usedDays = 0;
+
+  constructor(data?: PartialMessage<UsedSlipDays>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.UsedSlipDays";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "enrollmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "assignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "usedDays", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UsedSlipDays {
+    return new UsedSlipDays().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UsedSlipDays {
+    return new UsedSlipDays().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UsedSlipDays {
+    return new UsedSlipDays().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UsedSlipDays | PlainMessage<UsedSlipDays> | undefined, b: UsedSlipDays | PlainMessage<UsedSlipDays> | undefined): boolean {
+    return proto3.util.equals(UsedSlipDays, a, b);
+  }
+}
+
+/**
+ * ExternalGrade is an enrollment's score for an external grade component, such as a written exam.
+ *
+ * @generated from message qf.ExternalGrade
+ */
+export class ExternalGrade extends Message<ExternalGrade> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 enrollmentID = 2;
+   */
+  enrollmentID = protoInt64.zero;
+
+  /**
+   * name of the grading configuration's external component
+   *
+   * @generated from field: string component = 3;
+   */
+  component = "";
+
+  /**
+   * @generated from field: uint32 score = 4;
+   */
+  score = 0;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated = 5;
+   */
+  updated?: Timestamp;
+
+  constructor(data?: PartialMessage<ExternalGrade>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.ExternalGrade";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "enrollmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "component", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "score", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "updated", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExternalGrade {
+    return new ExternalGrade().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExternalGrade {
+    return new ExternalGrade().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExternalGrade {
+    return new ExternalGrade().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExternalGrade | PlainMessage<ExternalGrade> | undefined, b: ExternalGrade | PlainMessage<ExternalGrade> | undefined): boolean {
+    return proto3.util.equals(ExternalGrade, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.Enrollments
+ */
+export class Enrollments extends Message<Enrollments> {
+  /**
+   * @generated from field: repeated qf.Enrollment enrollments = 1;
+   */
+  enrollments: Enrollment[] = [];
+
+  constructor(data?: PartialMessage<Enrollments>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Enrollments";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enrollments", kind: "message", T: Enrollment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Enrollments {
+    return new Enrollments().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Enrollments {
+    return new Enrollments().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Enrollments {
+    return new Enrollments().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Enrollments | PlainMessage<Enrollments> | undefined, b: Enrollments | PlainMessage<Enrollments> | undefined): boolean {
+    return proto3.util.equals(Enrollments, a, b);
+  }
+}
+
+/**
+ * RosterEntry is a student on a course's institution roster. Users who match a roster entry
+ * are enrolled as students without waiting for a teacher to approve their enrollment.
+ *
+ * @generated from message qf.RosterEntry
+ */
+export class RosterEntry extends Message<RosterEntry> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 courseID = 2;
+   */
+  courseID = protoInt64.zero;
+
+  /**
+   * @generated from field: string studentID = 3;
+   */
+  studentID = "";
+
+  /**
+   * @generated from field: string email = 4;
+   */
+  email = "";
+
+  /**
+   * GitHub login; optional
+   *
+   * @generated from field: string login = 5;
+   */
+  login = "";
+
+  constructor(data?: PartialMessage<RosterEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.RosterEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "courseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "studentID", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "login", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RosterEntry {
+    return new RosterEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RosterEntry {
+    return new RosterEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RosterEntry {
+    return new RosterEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RosterEntry | PlainMessage<RosterEntry> | undefined, b: RosterEntry | PlainMessage<RosterEntry> | undefined): boolean {
+    return proto3.util.equals(RosterEntry, a, b);
+  }
+}
+
+/**
+ * RosterCoverage reports which of the students on a course's roster have signed up for the course.
+ *
+ * @generated from message qf.RosterCoverage
+ */
+export class RosterCoverage extends Message<RosterCoverage> {
+  /**
+   * number of students on the roster
+   *
+   * @generated from field: uint32 entries = 1;
+   */
+  entries = 0;
+
+  /**
+   * number of students on the roster with an enrollment
+   *
+   * @generated from field: uint32 signedUp = 2;
+   */
+  signedUp = 0;
+
+  /**
+   * students on the roster without an enrollment
+   *
+   * @generated from field: repeated qf.RosterEntry notSignedUp = 3;
+   */
+  notSignedUp: RosterEntry[] = [];
+
+  /**
+   * pending enrollments that do not match a student on the roster
+   *
+   * @generated from field: repeated qf.Enrollment unmatched = 4;
+   */
+  unmatched: Enrollment[] = [];
+
+  constructor(data?: PartialMessage<RosterCoverage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.RosterCoverage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "signedUp", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "notSignedUp", kind: "message", T: RosterEntry, repeated: true },
+    { no: 4, name: "unmatched", kind: "message", T: Enrollment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RosterCoverage {
+    return new RosterCoverage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RosterCoverage {
+    return new RosterCoverage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RosterCoverage {
+    return new RosterCoverage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RosterCoverage | PlainMessage<RosterCoverage> | undefined, b: RosterCoverage | PlainMessage<RosterCoverage> | undefined): boolean {
+    return proto3.util.equals(RosterCoverage, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.Assignment
+ */
+export class Assignment extends Message<Assignment> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 CourseID = 2;
+   */
+  CourseID = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp deadline = 4;
+   */
+  deadline?: Timestamp;
+
+  /**
+   * @generated from field: bool autoApprove = 5;
+   */
+  autoApprove = false;
+
+  /**
+   * @generated from field: uint32 order = 6;
+   */
+  order = 0;
+
+  /**
+   * @generated from field: bool isGroupLab = 7;
+   */
+  isGroupLab = false;
+
+  /**
+   * minimal score limit for auto approval
+   *
+   * @generated from field: uint32 scoreLimit = 8;
+   */
+  scoreLimit = 0;
+
+  /**
+   * number of reviewers that will review submissions for this assignment
+   *
+   * @generated from field: uint32 reviewers = 9;
+   */
+  reviewers = 0;
+
+  /**
+   * container timeout for this assignment
+   *
+   * @generated from field: uint32 containerTimeout = 10;
+   */
+  containerTimeout = 0;
+
+  /**
+   * submissions produced for this assignment
+   *
+   * @generated from field: repeated qf.Submission submissions = 11;
+   */
+  submissions: Submission[] = [];
+
+  /**
+   * tasks associated with this assignment
+   *
+   * @generated from field: repeated qf.Task tasks = 12;
+   */
+  tasks: Task[] = [];
+
+  /**
+   * grading benchmarks for this assignment
+   *
+   * @generated from field: repeated qf.GradingBenchmark gradingBenchmarks = 13;
+   */
+  gradingBenchmarks: GradingBenchmark[] = [];
+
+  /**
+   * quiz for this assignment, if any
+   *
+   * @generated from field: qf.Quiz quiz = 14;
+   */
+  quiz?: Quiz;
+
+  /**
+   * how multiple reviews of a submission are reconciled into a final review
+   *
+   * @generated from field: qf.Assignment.ReconcilePolicy reconcilePolicy = 15;
+   */
+  reconcilePolicy = Assignment_ReconcilePolicy.NONE;
+
+  /**
+   * if true, reviewers cannot see who made a submission until it is released
+   *
+   * @generated from field: bool anonymous = 16;
+   */
+  anonymous = false;
+
+  /**
+   * number of peer submissions each student reviews in the peer review phase
+   *
+   * @generated from field: uint32 peerReviews = 17;
+   */
+  peerReviews = 0;
+
+  constructor(data?: PartialMessage<Assignment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Assignment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "deadline", kind: "message", T: Timestamp },
+    { no: 5, name: "autoApprove", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "order", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "isGroupLab", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "scoreLimit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 9, name: "reviewers", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 10, name: "containerTimeout", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 11, name: "submissions", kind: "message", T: Submission, repeated: true },
+    { no: 12, name: "tasks", kind: "message", T: Task, repeated: true },
+    { no: 13, name: "gradingBenchmarks", kind: "message", T: GradingBenchmark, repeated: true },
+    { no: 14, name: "quiz", kind: "message", T: Quiz },
+    { no: 15, name: "reconcilePolicy", kind: "enum", T: proto3.getEnumType(Assignment_ReconcilePolicy) },
+    { no: 16, name: "anonymous", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 17, name: "peerReviews", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Assignment {
+    return new Assignment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Assignment {
+    return new Assignment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Assignment {
+    return new Assignment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Assignment | PlainMessage<Assignment> | undefined, b: Assignment | PlainMessage<Assignment> | undefined): boolean {
+    return proto3.util.equals(Assignment, a, b);
+  }
+}
+
+/**
+ * @generated from enum qf.Assignment.ReconcilePolicy
+ */
+export enum Assignment_ReconcilePolicy {
+  /**
+   * the submission score is the score of the last updated review
+   *
+   * @generated from enum value: NONE = 0;
+   */
+  NONE = 0,
+
+  /**
+   * the final score is the average score of the ready reviews
+   *
+   * @generated from enum value: AVERAGE = 1;
+   */
+  AVERAGE = 1,
+
+  /**
+   * the final review is the ready review with the highest score
+   *
+   * @generated from enum value: MAX = 2;
+   */
+  MAX = 2,
+
+  /**
+   * the final review is decided by a teacher
+   *
+   * @generated from enum value: MANUAL = 3;
+   */
+  MANUAL = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Assignment_ReconcilePolicy)
+proto3.util.setEnumType(Assignment_ReconcilePolicy, "qf.Assignment.ReconcilePolicy", [
+  { no: 0, name: "NONE" },
+  { no: 1, name: "AVERAGE" },
+  { no: 2, name: "MAX" },
+  { no: 3, name: "MANUAL" },
+]);
+
+/**
+ * @generated from message qf.Task
+ */
+export class Task extends Message<Task> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 assignmentID = 2;
+   */
+  assignmentID = protoInt64.zero;
 
   /**
    * @generated from field: uint32 assignmentOrder = 3;
    */
-  assignmentOrder = 0;
+  assignmentOrder = 0;
+
+  /**
+   * @generated from field: string title = 4;
+   */
+  title = "";
+
+  /**
+   * @generated from field: string body = 5;
+   */
+  body = "";
+
+  /**
+   * @generated from field: string name = 6;
+   */
+  name = "";
+
+  /**
+   * Issues that use this task as a benchmark
+   *
+   * @generated from field: repeated qf.Issue issues = 7;
+   */
+  issues: Issue[] = [];
+
+  constructor(data?: PartialMessage<Task>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Task";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "assignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "assignmentOrder", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "issues", kind: "message", T: Issue, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Task {
+    return new Task().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Task {
+    return new Task().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Task {
+    return new Task().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Task | PlainMessage<Task> | undefined, b: Task | PlainMessage<Task> | undefined): boolean {
+    return proto3.util.equals(Task, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.Issue
+ */
+export class Issue extends Message<Issue> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * Represents the internal ID of a repository
+   *
+   * @generated from field: uint64 repositoryID = 2;
+   */
+  repositoryID = protoInt64.zero;
+
+  /**
+   * Task that this issue draws its content from
+   *
+   * @generated from field: uint64 taskID = 3;
+   */
+  taskID = protoInt64.zero;
+
+  /**
+   * Issue number on scm. Needed for associating db issue with scm issue
+   *
+   * @generated from field: uint64 ScmIssueNumber = 4;
+   */
+  ScmIssueNumber = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Issue>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Issue";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "repositoryID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "taskID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "ScmIssueNumber", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Issue {
+    return new Issue().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Issue {
+    return new Issue().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Issue {
+    return new Issue().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Issue | PlainMessage<Issue> | undefined, b: Issue | PlainMessage<Issue> | undefined): boolean {
+    return proto3.util.equals(Issue, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.PullRequest
+ */
+export class PullRequest extends Message<PullRequest> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * Represents the scm repository ID
+   *
+   * @generated from field: uint64 ScmRepositoryID = 2;
+   */
+  ScmRepositoryID = protoInt64.zero;
+
+  /**
+   * Foreign key
+   *
+   * @generated from field: uint64 taskID = 3;
+   */
+  taskID = protoInt64.zero;
+
+  /**
+   * Foreign key
+   *
+   * @generated from field: uint64 issueID = 4;
+   */
+  issueID = protoInt64.zero;
+
+  /**
+   * The user who owns this PR
+   *
+   * @generated from field: uint64 userID = 5;
+   */
+  userID = protoInt64.zero;
+
+  /**
+   * Scm ID of the comment used for automatic feedback
+   *
+   * @generated from field: uint64 ScmCommentID = 6;
+   */
+  ScmCommentID = protoInt64.zero;
+
+  /**
+   * The source branch for this pull request
+   *
+   * @generated from field: string sourceBranch = 7;
+   */
+  sourceBranch = "";
+
+  /**
+   * Pull request number
+   *
+   * @generated from field: uint64 number = 8;
+   */
+  number = protoInt64.zero;
+
+  /**
+   * @generated from field: qf.PullRequest.Stage stage = 9;
+   */
+  stage = PullRequest_Stage.NONE;
+
+  constructor(data?: PartialMessage<PullRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.PullRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "ScmRepositoryID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "taskID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "issueID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "ScmCommentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 7, name: "sourceBranch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "number", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 9, name: "stage", kind: "enum", T: proto3.getEnumType(PullRequest_Stage) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullRequest {
+    return new PullRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullRequest {
+    return new PullRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullRequest {
+    return new PullRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PullRequest | PlainMessage<PullRequest> | undefined, b: PullRequest | PlainMessage<PullRequest> | undefined): boolean {
+    return proto3.util.equals(PullRequest, a, b);
+  }
+}
+
+/**
+ * @generated from enum qf.PullRequest.Stage
+ */
+export enum PullRequest_Stage {
+  /**
+   * @generated from enum value: NONE = 0;
+   */
+  NONE = 0,
+
+  /**
+   * @generated from enum value: DRAFT = 1;
+   */
+  DRAFT = 1,
+
+  /**
+   * @generated from enum value: REVIEW = 2;
+   */
+  REVIEW = 2,
+
+  /**
+   * @generated from enum value: APPROVED = 3;
+   */
+  APPROVED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PullRequest_Stage)
+proto3.util.setEnumType(PullRequest_Stage, "qf.PullRequest.Stage", [
+  { no: 0, name: "NONE" },
+  { no: 1, name: "DRAFT" },
+  { no: 2, name: "REVIEW" },
+  { no: 3, name: "APPROVED" },
+]);
+
+/**
+ * @generated from message qf.Assignments
+ */
+export class Assignments extends Message<Assignments> {
+  /**
+   * @generated from field: repeated qf.Assignment assignments = 1;
+   */
+  assignments: Assignment[] = [];
+
+  constructor(data?: PartialMessage<Assignments>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Assignments";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "assignments", kind: "message", T: Assignment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Assignments {
+    return new Assignments().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Assignments {
+    return new Assignments().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Assignments {
+    return new Assignments().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Assignments | PlainMessage<Assignments> | undefined, b: Assignments | PlainMessage<Assignments> | undefined): boolean {
+    return proto3.util.equals(Assignments, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.Submission
+ */
+export class Submission extends Message<Submission> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 AssignmentID = 2;
+   */
+  AssignmentID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 userID = 3;
+   */
+  userID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 groupID = 4;
+   */
+  groupID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint32 score = 5;
+   */
+  score = 0;
+
+  /**
+   * @generated from field: string commitHash = 6;
+   */
+  commitHash = "";
+
+  /**
+   * true => feedback is visible to the student or group members
+   *
+   * @generated from field: bool released = 7;
+   */
+  released = false;
+
+  /**
+   * @generated from field: repeated qf.Grade Grades = 8;
+   */
+  Grades: Grade[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp approvedDate = 9;
+   */
+  approvedDate?: Timestamp;
+
+  /**
+   * reviews produced for this submission
+   *
+   * @generated from field: repeated qf.Review reviews = 10;
+   */
+  reviews: Review[] = [];
+
+  /**
+   * build info for tests
+   *
+   * @generated from field: score.BuildInfo BuildInfo = 11;
+   */
+  BuildInfo?: BuildInfo;
+
+  /**
+   * list of scores for different tests
+   *
+   * @generated from field: repeated score.Score Scores = 12;
+   */
+  Scores: Score[] = [];
+
+  /**
+   * replaces user and group IDs of anonymous submissions
+   *
+   * @generated from field: uint64 pseudonym = 13;
+   */
+  pseudonym = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Submission>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Submission";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "AssignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "groupID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "score", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "commitHash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "released", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "Grades", kind: "message", T: Grade, repeated: true },
+    { no: 9, name: "approvedDate", kind: "message", T: Timestamp },
+    { no: 10, name: "reviews", kind: "message", T: Review, repeated: true },
+    { no: 11, name: "BuildInfo", kind: "message", T: BuildInfo },
+    { no: 12, name: "Scores", kind: "message", T: Score, repeated: true },
+    { no: 13, name: "pseudonym", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Submission {
+    return new Submission().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Submission {
+    return new Submission().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Submission {
+    return new Submission().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Submission | PlainMessage<Submission> | undefined, b: Submission | PlainMessage<Submission> | undefined): boolean {
+    return proto3.util.equals(Submission, a, b);
+  }
+}
+
+/**
+ * @generated from enum qf.Submission.Status
+ */
+export enum Submission_Status {
+  /**
+   * @generated from enum value: NONE = 0;
+   */
+  NONE = 0,
+
+  /**
+   * @generated from enum value: APPROVED = 1;
+   */
+  APPROVED = 1,
+
+  /**
+   * @generated from enum value: REJECTED = 2;
+   */
+  REJECTED = 2,
+
+  /**
+   * @generated from enum value: REVISION = 3;
+   */
+  REVISION = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Submission_Status)
+proto3.util.setEnumType(Submission_Status, "qf.Submission.Status", [
+  { no: 0, name: "NONE" },
+  { no: 1, name: "APPROVED" },
+  { no: 2, name: "REJECTED" },
+  { no: 3, name: "REVISION" },
+]);
+
+/**
+ * @generated from message qf.Submissions
+ */
+export class Submissions extends Message<Submissions> {
+  /**
+   * @generated from field: repeated qf.Submission submissions = 1;
+   */
+  submissions: Submission[] = [];
+
+  constructor(data?: PartialMessage<Submissions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Submissions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "submissions", kind: "message", T: Submission, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Submissions {
+    return new Submissions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Submissions {
+    return new Submissions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Submissions {
+    return new Submissions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Submissions | PlainMessage<Submissions> | undefined, b: Submissions | PlainMessage<Submissions> | undefined): boolean {
+    return proto3.util.equals(Submissions, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.Grade
+ */
+export class Grade extends Message<Grade> {
+  /**
+   * @generated from field: uint64 SubmissionID = 1;
+   */
+  SubmissionID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 UserID = 2;
+   */
+  UserID = protoInt64.zero;
+
+  /**
+   * @generated from field: qf.Submission.Status Status = 3;
+   */
+  Status = Submission_Status.NONE;
+
+  constructor(data?: PartialMessage<Grade>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Grade";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "SubmissionID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "UserID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "Status", kind: "enum", T: proto3.getEnumType(Submission_Status) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Grade {
+    return new Grade().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Grade {
+    return new Grade().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Grade {
+    return new Grade().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Grade | PlainMessage<Grade> | undefined, b: Grade | PlainMessage<Grade> | undefined): boolean {
+    return proto3.util.equals(Grade, a, b);
+  }
+}
+
+/**
+ * ScheduledJob is a bulk change to an assignment's submissions that the server performs at a scheduled time.
+ *
+ * @generated from message qf.ScheduledJob
+ */
+export class ScheduledJob extends Message<ScheduledJob> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 CourseID = 2;
+   */
+  CourseID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 AssignmentID = 3;
+   */
+  AssignmentID = protoInt64.zero;
+
+  /**
+   * @generated from field: qf.ScheduledJob.Type type = 4;
+   */
+  type = ScheduledJob_Type.RELEASE;
+
+  /**
+   * only used by release jobs
+   *
+   * @generated from field: google.protobuf.Timestamp runAt = 5;
+   */
+  runAt?: Timestamp;
+
+  /**
+   * only used by grade jobs; hours after the assignment's deadline
+   *
+   * @generated from field: uint32 graceHours = 6;
+   */
+  graceHours = 0;
+
+  /**
+   * only used by grade jobs
+   *
+   * @generated from field: uint32 scoreLimit = 7;
+   */
+  scoreLimit = 0;
+
+  /**
+   * unset until the job has run
+   *
+   * @generated from field: google.protobuf.Timestamp completed = 8;
+   */
+  completed?: Timestamp;
+
+  constructor(data?: PartialMessage<ScheduledJob>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.ScheduledJob";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "AssignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "type", kind: "enum", T: proto3.getEnumType(ScheduledJob_Type) },
+    { no: 5, name: "runAt", kind: "message", T: Timestamp },
+    { no: 6, name: "graceHours", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "scoreLimit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "completed", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduledJob {
+    return new ScheduledJob().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduledJob {
+    return new ScheduledJob().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduledJob {
+    return new ScheduledJob().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScheduledJob | PlainMessage<ScheduledJob> | undefined, b: ScheduledJob | PlainMessage<ScheduledJob> | undefined): boolean {
+    return proto3.util.equals(ScheduledJob, a, b);
+  }
+}
+
+/**
+ * @generated from enum qf.ScheduledJob.Type
+ */
+export enum ScheduledJob_Type {
+  /**
+   * release all submissions with a ready review at runAt
+   *
+   * @generated from enum value: RELEASE = 0;
+   */
+  RELEASE = 0,
+
+  /**
+   * at the deadline plus grace period, approve ungraded submissions with score at least scoreLimit, and reject the rest
+   *
+   * @generated from enum value: GRADE = 1;
+   */
+  GRADE = 1,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ScheduledJob_Type)
+proto3.util.setEnumType(ScheduledJob_Type, "qf.ScheduledJob.Type", [
+  { no: 0, name: "RELEASE" },
+  { no: 1, name: "GRADE" },
+]);
+
+/**
+ * @generated from message qf.ScheduledJobs
+ */
+export class ScheduledJobs extends Message<ScheduledJobs> {
+  /**
+   * @generated from field: repeated qf.ScheduledJob jobs = 1;
+   */
+  jobs: ScheduledJob[] = [];
+
+  constructor(data?: PartialMessage<ScheduledJobs>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.ScheduledJobs";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "jobs", kind: "message", T: ScheduledJob, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduledJobs {
+    return new ScheduledJobs().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduledJobs {
+    return new ScheduledJobs().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduledJobs {
+    return new ScheduledJobs().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScheduledJobs | PlainMessage<ScheduledJobs> | undefined, b: ScheduledJobs | PlainMessage<ScheduledJobs> | undefined): boolean {
+    return proto3.util.equals(ScheduledJobs, a, b);
+  }
+}
+
+/**
+ * AuditEntry records a bulk change to an assignment's submissions.
+ *
+ * @generated from message qf.AuditEntry
+ */
+export class AuditEntry extends Message<AuditEntry> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 CourseID = 2;
+   */
+  CourseID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 AssignmentID = 3;
+   */
+  AssignmentID = protoInt64.zero;
+
+  /**
+   * the teacher making the change; zero for scheduled changes
+   *
+   * @generated from field: uint64 UserID = 4;
+   */
+  UserID = protoInt64.zero;
+
+  /**
+   * the scheduled job making the change, if any
+   *
+   * @generated from field: uint64 ScheduledJobID = 5;
+   */
+  ScheduledJobID = protoInt64.zero;
+
+  /**
+   * description of the change
+   *
+   * @generated from field: string change = 6;
+   */
+  change = "";
+
+  /**
+   * number of changed submissions
+   *
+   * @generated from field: uint32 submissions = 7;
+   */
+  submissions = 0;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created = 8;
+   */
+  created?: Timestamp;
+
+  constructor(data?: PartialMessage<AuditEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.AuditEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "AssignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "UserID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "ScheduledJobID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "change", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "submissions", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "created", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEntry {
+    return new AuditEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEntry {
+    return new AuditEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEntry {
+    return new AuditEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEntry | PlainMessage<AuditEntry> | undefined, b: AuditEntry | PlainMessage<AuditEntry> | undefined): boolean {
+    return proto3.util.equals(AuditEntry, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.AuditEntries
+ */
+export class AuditEntries extends Message<AuditEntries> {
+  /**
+   * @generated from field: repeated qf.AuditEntry entries = 1;
+   */
+  entries: AuditEntry[] = [];
+
+  constructor(data?: PartialMessage<AuditEntries>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.AuditEntries";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: AuditEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEntries {
+    return new AuditEntries().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEntries {
+    return new AuditEntries().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEntries {
+    return new AuditEntries().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEntries | PlainMessage<AuditEntries> | undefined, b: AuditEntries | PlainMessage<AuditEntries> | undefined): boolean {
+    return proto3.util.equals(AuditEntries, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.GradingBenchmark
+ */
+export class GradingBenchmark extends Message<GradingBenchmark> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 CourseID = 2;
+   */
+  CourseID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 AssignmentID = 3;
+   */
+  AssignmentID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 ReviewID = 4;
+   */
+  ReviewID = protoInt64.zero;
+
+  /**
+   * @generated from field: string heading = 5;
+   */
+  heading = "";
+
+  /**
+   * @generated from field: string comment = 6;
+   */
+  comment = "";
+
+  /**
+   * @generated from field: repeated qf.GradingCriterion criteria = 7;
+   */
+  criteria: GradingCriterion[] = [];
+
+  /**
+   * relative weight of the benchmark in the review score; 0 means unweighted
+   *
+   * @generated from field: uint32 weight = 8;
+   */
+  weight = 0;
+
+  constructor(data?: PartialMessage<GradingBenchmark>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.GradingBenchmark";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "AssignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "ReviewID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "heading", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "criteria", kind: "message", T: GradingCriterion, repeated: true },
+    { no: 8, name: "weight", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GradingBenchmark {
+    return new GradingBenchmark().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GradingBenchmark {
+    return new GradingBenchmark().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GradingBenchmark {
+    return new GradingBenchmark().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GradingBenchmark | PlainMessage<GradingBenchmark> | undefined, b: GradingBenchmark | PlainMessage<GradingBenchmark> | undefined): boolean {
+    return proto3.util.equals(GradingBenchmark, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.Benchmarks
+ */
+export class Benchmarks extends Message<Benchmarks> {
+  /**
+   * @generated from field: repeated qf.GradingBenchmark benchmarks = 1;
+   */
+  benchmarks: GradingBenchmark[] = [];
+
+  constructor(data?: PartialMessage<Benchmarks>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Benchmarks";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "benchmarks", kind: "message", T: GradingBenchmark, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Benchmarks {
+    return new Benchmarks().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Benchmarks {
+    return new Benchmarks().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Benchmarks {
+    return new Benchmarks().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Benchmarks | PlainMessage<Benchmarks> | undefined, b: Benchmarks | PlainMessage<Benchmarks> | undefined): boolean {
+    return proto3.util.equals(Benchmarks, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.GradingCriterion
+ */
+export class GradingCriterion extends Message<GradingCriterion> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 BenchmarkID = 2;
+   */
+  BenchmarkID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 CourseID = 3;
+   */
+  CourseID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 points = 4;
+   */
+  points = protoInt64.zero;
+
+  /**
+   * @generated from field: string description = 5;
+   */
+  description = "";
+
+  /**
+   * @generated from field: qf.GradingCriterion.Grade grade = 6;
+   */
+  grade = GradingCriterion_Grade.NONE;
+
+  /**
+   * @generated from field: string comment = 7;
+   */
+  comment = "";
+
+  /**
+   * if true, the criterion can be awarded between 0 and points
+   *
+   * @generated from field: bool partial = 8;
+   */
+  partial = false;
+
+  /**
+   * points awarded to a partial credit criterion
+   *
+   * @generated from field: uint64 awarded = 9;
+   */
+  awarded = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GradingCriterion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.GradingCriterion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "BenchmarkID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "points", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "grade", kind: "enum", T: proto3.getEnumType(GradingCriterion_Grade) },
+    { no: 7, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "partial", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "awarded", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GradingCriterion {
+    return new GradingCriterion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GradingCriterion {
+    return new GradingCriterion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GradingCriterion {
+    return new GradingCriterion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GradingCriterion | PlainMessage<GradingCriterion> | undefined, b: GradingCriterion | PlainMessage<GradingCriterion> | undefined): boolean {
+    return proto3.util.equals(GradingCriterion, a, b);
+  }
+}
+
+/**
+ * @generated from enum qf.GradingCriterion.Grade
+ */
+export enum GradingCriterion_Grade {
+  /**
+   * @generated from enum value: NONE = 0;
+   */
+  NONE = 0,
+
+  /**
+   * @generated from enum value: FAILED = 1;
+   */
+  FAILED = 1,
+
+  /**
+   * @generated from enum value: PASSED = 2;
+   */
+  PASSED = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(GradingCriterion_Grade)
+proto3.util.setEnumType(GradingCriterion_Grade, "qf.GradingCriterion.Grade", [
+  { no: 0, name: "NONE" },
+  { no: 1, name: "FAILED" },
+  { no: 2, name: "PASSED" },
+]);
+
+/**
+ * @generated from message qf.Review
+ */
+export class Review extends Message<Review> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 SubmissionID = 2;
+   */
+  SubmissionID = protoInt64.zero;
+
+  /**
+   * UserID of the reviewer
+   *
+   * @generated from field: uint64 ReviewerID = 3;
+   */
+  ReviewerID = protoInt64.zero;
+
+  /**
+   * @generated from field: string feedback = 4;
+   */
+  feedback = "";
+
+  /**
+   * @generated from field: bool ready = 5;
+   */
+  ready = false;
+
+  /**
+   * @generated from field: uint32 score = 6;
+   */
+  score = 0;
+
+  /**
+   * @generated from field: repeated qf.GradingBenchmark gradingBenchmarks = 7;
+   */
+  gradingBenchmarks: GradingBenchmark[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp edited = 8;
+   */
+  edited?: Timestamp;
+
+  /**
+   * true if this is the final review reconciled from the submission's other reviews
+   *
+   * @generated from field: bool final = 9;
+   */
+  final = false;
+
+  /**
+   * true if this is a peer review by a student; peer reviews do not affect the submission's score
+   *
+   * @generated from field: bool peer = 10;
+   */
+  peer = false;
+
+  constructor(data?: PartialMessage<Review>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.Review";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "SubmissionID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "ReviewerID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "feedback", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "ready", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "score", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "gradingBenchmarks", kind: "message", T: GradingBenchmark, repeated: true },
+    { no: 8, name: "edited", kind: "message", T: Timestamp },
+    { no: 9, name: "final", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "peer", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Review {
+    return new Review().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Review {
+    return new Review().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Review {
+    return new Review().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Review | PlainMessage<Review> | undefined, b: Review | PlainMessage<Review> | undefined): boolean {
+    return proto3.util.equals(Review, a, b);
+  }
+}
+
+/**
+ * LineComment is a reviewer's comment on a range of lines in a file of a submission's commit.
+ *
+ * @generated from message qf.LineComment
+ */
+export class LineComment extends Message<LineComment> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 CourseID = 2;
+   */
+  CourseID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 SubmissionID = 3;
+   */
+  SubmissionID = protoInt64.zero;
+
+  /**
+   * UserID of the comment's author
+   *
+   * @generated from field: uint64 AuthorID = 4;
+   */
+  AuthorID = protoInt64.zero;
+
+  /**
+   * commit the comment refers to; defaults to the submission's commit
+   *
+   * @generated from field: string commitHash = 5;
+   */
+  commitHash = "";
+
+  /**
+   * path of the commented file, relative to the repository root
+   *
+   * @generated from field: string path = 6;
+   */
+  path = "";
+
+  /**
+   * @generated from field: uint32 startLine = 7;
+   */
+  startLine = 0;
+
+  /**
+   * last commented line; equal to startLine for a single line
+   *
+   * @generated from field: uint32 endLine = 8;
+   */
+  endLine = 0;
+
+  /**
+   * @generated from field: string body = 9;
+   */
+  body = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp edited = 10;
+   */
+  edited?: Timestamp;
+
+  /**
+   * ID of the pull request review comment mirroring this comment, if any
+   *
+   * @generated from field: int64 ScmCommentID = 11;
+   */
+  ScmCommentID = protoInt64.zero;
+
+  constructor(data?: PartialMessage<LineComment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.LineComment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "SubmissionID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "AuthorID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "commitHash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "startLine", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "endLine", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 9, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "edited", kind: "message", T: Timestamp },
+    { no: 11, name: "ScmCommentID", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LineComment {
+    return new LineComment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LineComment {
+    return new LineComment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LineComment {
+    return new LineComment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LineComment | PlainMessage<LineComment> | undefined, b: LineComment | PlainMessage<LineComment> | undefined): boolean {
+    return proto3.util.equals(LineComment, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.LineComments
+ */
+export class LineComments extends Message<LineComments> {
+  /**
+   * @generated from field: repeated qf.LineComment comments = 1;
+   */
+  comments: LineComment[] = [];
+
+  constructor(data?: PartialMessage<LineComments>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.LineComments";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "comments", kind: "message", T: LineComment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LineComments {
+    return new LineComments().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LineComments {
+    return new LineComments().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LineComments {
+    return new LineComments().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LineComments | PlainMessage<LineComments> | undefined, b: LineComments | PlainMessage<LineComments> | undefined): boolean {
+    return proto3.util.equals(LineComments, a, b);
+  }
+}
+
+/**
+ * FeedbackSnippet is a reusable comment that reviewers can insert into criterion comments and review feedback.
+ *
+ * @generated from message qf.FeedbackSnippet
+ */
+export class FeedbackSnippet extends Message<FeedbackSnippet> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 CourseID = 2;
+   */
+  CourseID = protoInt64.zero;
 
   /**
-   * @generated from field: string title = 4;
+   * @generated from field: string title = 3;
    */
   title = "";
 
   /**
-   * @generated from field: string body = 5;
+   * @generated from field: string body = 4;
    */
   body = "";
 
   /**
-   * @generated from field: string name = 6;
+   * @generated from field: repeated string tags = 5;
    */
-  name = "";
+  tags: string[] = [];
 
   /**
-   * Issues that use this task as a benchmark
+   * number of times the snippet has been used in each assignment
    *
-   * @generated from field: repeated qf.Issue issues = 7;
+   * @generated from field: repeated qf.FeedbackSnippetUsage usage = 6;
    */
-  issues: Issue[] = [];
+  usage: FeedbackSnippetUsage[] = [];
 
-  constructor(data?: PartialMessage<Task>) {
+  constructor(data?: PartialMessage<FeedbackSnippet>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Task";
+  static readonly typeName = "qf.FeedbackSnippet";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "assignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "assignmentOrder", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 4, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "issues", kind: "message", T: Issue, repeated: true },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "usage", kind: "message", T: FeedbackSnippetUsage, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FeedbackSnippet {
+    return new FeedbackSnippet().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FeedbackSnippet {
+    return new FeedbackSnippet().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FeedbackSnippet {
+    return new FeedbackSnippet().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FeedbackSnippet | PlainMessage<FeedbackSnippet> | undefined, b: FeedbackSnippet | PlainMessage<FeedbackSnippet> | undefined): boolean {
+    return proto3.util.equals(FeedbackSnippet, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.FeedbackSnippetUsage
+ */
+export class FeedbackSnippetUsage extends Message<FeedbackSnippetUsage> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 FeedbackSnippetID = 2;
+   */
+  FeedbackSnippetID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 AssignmentID = 3;
+   */
+  AssignmentID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint32 count = 4;
+   */
+  count = 0;
+
+  constructor(data?: PartialMessage<FeedbackSnippetUsage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.FeedbackSnippetUsage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "FeedbackSnippetID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "AssignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FeedbackSnippetUsage {
+    return new FeedbackSnippetUsage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FeedbackSnippetUsage {
+    return new FeedbackSnippetUsage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FeedbackSnippetUsage {
+    return new FeedbackSnippetUsage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FeedbackSnippetUsage | PlainMessage<FeedbackSnippetUsage> | undefined, b: FeedbackSnippetUsage | PlainMessage<FeedbackSnippetUsage> | undefined): boolean {
+    return proto3.util.equals(FeedbackSnippetUsage, a, b);
+  }
+}
+
+/**
+ * @generated from message qf.FeedbackSnippets
+ */
+export class FeedbackSnippets extends Message<FeedbackSnippets> {
+  /**
+   * @generated from field: repeated qf.FeedbackSnippet snippets = 1;
+   */
+  snippets: FeedbackSnippet[] = [];
+
+  constructor(data?: PartialMessage<FeedbackSnippets>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.FeedbackSnippets";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "snippets", kind: "message", T: FeedbackSnippet, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FeedbackSnippets {
+    return new FeedbackSnippets().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FeedbackSnippets {
+    return new FeedbackSnippets().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FeedbackSnippets {
+    return new FeedbackSnippets().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FeedbackSnippets | PlainMessage<FeedbackSnippets> | undefined, b: FeedbackSnippets | PlainMessage<FeedbackSnippets> | undefined): boolean {
+    return proto3.util.equals(FeedbackSnippets, a, b);
+  }
+}
+
+/**
+ * RegradeRequest is a student's request to have the approval or review score of a submission reconsidered.
+ *
+ * @generated from message qf.RegradeRequest
+ */
+export class RegradeRequest extends Message<RegradeRequest> {
+  /**
+   * @generated from field: uint64 ID = 1;
+   */
+  ID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 CourseID = 2;
+   */
+  CourseID = protoInt64.zero;
+
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 SubmissionID = 3;
+   */
+  SubmissionID = protoInt64.zero;
+
+  /**
+   * UserID of the student requesting the regrade
+   *
+   * @generated from field: uint64 RequesterID = 4;
+   */
+  RequesterID = protoInt64.zero;
+
+  /**
+   * @generated from field: string reason = 5;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: qf.RegradeRequest.Status status = 6;
+   */
+  status = RegradeRequest_Status.PENDING;
+
+  /**
+   * UserID of the teacher who last changed the status
+   *
+   * @generated from field: uint64 ResolverID = 7;
+   */
+  ResolverID = protoInt64.zero;
+
+  /**
+   * the teacher's explanation of the decision
+   *
+   * @generated from field: string resolution = 8;
+   */
+  resolution = "";
+
+  /**
+   * the action taken when the request was resolved
+   *
+   * @generated from field: qf.RegradeRequest.Action action = 9;
+   */
+  action = RegradeRequest_Action.NONE;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created = 10;
+   */
+  created?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated = 11;
+   */
+  updated?: Timestamp;
+
+  constructor(data?: PartialMessage<RegradeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.RegradeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "SubmissionID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "RequesterID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "status", kind: "enum", T: proto3.getEnumType(RegradeRequest_Status) },
+    { no: 7, name: "ResolverID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 8, name: "resolution", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "action", kind: "enum", T: proto3.getEnumType(RegradeRequest_Action) },
+    { no: 10, name: "created", kind: "message", T: Timestamp },
+    { no: 11, name: "updated", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegradeRequest {
+    return new RegradeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegradeRequest {
+    return new RegradeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegradeRequest {
+    return new RegradeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RegradeRequest | PlainMessage<RegradeRequest> | undefined, b: RegradeRequest | PlainMessage<RegradeRequest> | undefined): boolean {
+    return proto3.util.equals(RegradeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from enum qf.RegradeRequest.Status
+ */
+export enum RegradeRequest_Status {
+  /**
+   * the request awaits a teacher's decision
+   *
+   * @generated from enum value: PENDING = 0;
+   */
+  PENDING = 0,
+
+  /**
+   * a teacher is reconsidering the submission
+   *
+   * @generated from enum value: ACCEPTED = 1;
+   */
+  ACCEPTED = 1,
+
+  /**
+   * the submission's approval and score are kept
+   *
+   * @generated from enum value: REJECTED = 2;
+   */
+  REJECTED = 2,
+
+  /**
+   * the submission has been reconsidered
+   *
+   * @generated from enum value: RESOLVED = 3;
+   */
+  RESOLVED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(RegradeRequest_Status)
+proto3.util.setEnumType(RegradeRequest_Status, "qf.RegradeRequest.Status", [
+  { no: 0, name: "PENDING" },
+  { no: 1, name: "ACCEPTED" },
+  { no: 2, name: "REJECTED" },
+  { no: 3, name: "RESOLVED" },
+]);
+
+/**
+ * @generated from enum qf.RegradeRequest.Action
+ */
+export enum RegradeRequest_Action {
+  /**
+   * the resolution does not change the submission
+   *
+   * @generated from enum value: NONE = 0;
+   */
+  NONE = 0,
+
+  /**
+   * the submission's tests are run again
+   *
+   * @generated from enum value: REBUILD = 1;
+   */
+  REBUILD = 1,
+
+  /**
+   * a new review of the submission is created by the resolver
+   *
+   * @generated from enum value: REVIEW = 2;
+   */
+  REVIEW = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(RegradeRequest_Action)
+proto3.util.setEnumType(RegradeRequest_Action, "qf.RegradeRequest.Action", [
+  { no: 0, name: "NONE" },
+  { no: 1, name: "REBUILD" },
+  { no: 2, name: "REVIEW" },
+]);
+
+/**
+ * @generated from message qf.RegradeRequests
+ */
+export class RegradeRequests extends Message<RegradeRequests> {
+  /**
+   * @generated from field: repeated qf.RegradeRequest requests = 1;
+   */
+  requests: RegradeRequest[] = [];
+
+  constructor(data?: PartialMessage<RegradeRequests>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.RegradeRequests";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "requests", kind: "message", T: RegradeRequest, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegradeRequests {
+    return new RegradeRequests().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegradeRequests {
+    return new RegradeRequests().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegradeRequests {
+    return new RegradeRequests().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RegradeRequests | PlainMessage<RegradeRequests> | undefined, b: RegradeRequests | PlainMessage<RegradeRequests> | undefined): boolean {
+    return proto3.util.equals(RegradeRequests, a, b);
+  }
+}
+
+/**
+ * CriterionDisagreement holds the points earned for a grading criterion in each of the reviews being reconciled.
+ *
+ * @generated from message qf.CriterionDisagreement
+ */
+export class CriterionDisagreement extends Message<CriterionDisagreement> {
+  /**
+   * heading of the criterion's grading benchmark
+   *
+   * @generated from field: string heading = 1;
+   */
+  heading = "";
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description = "";
+
+  /**
+   * points earned in each review; 1 for a passed criterion without points
+   *
+   * @generated from field: repeated uint64 earned = 3;
+   */
+  earned: bigint[] = [];
+
+  /**
+   * difference between the most and the least points earned
+   *
+   * @generated from field: uint64 spread = 4;
+   */
+  spread = protoInt64.zero;
+
+  constructor(data?: PartialMessage<CriterionDisagreement>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.CriterionDisagreement";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "heading", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "earned", kind: "scalar", T: 4 /* ScalarType.UINT64 */, repeated: true },
+    { no: 4, name: "spread", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Task {
-    return new Task().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CriterionDisagreement {
+    return new CriterionDisagreement().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Task {
-    return new Task().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CriterionDisagreement {
+    return new CriterionDisagreement().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Task {
-    return new Task().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CriterionDisagreement {
+    return new CriterionDisagreement().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Task | PlainMessage<Task> | undefined, b: Task | PlainMessage<Task> | undefined): boolean {
-    return proto3.util.equals(Task, a, b);
+  static equals(a: CriterionDisagreement | PlainMessage<CriterionDisagreement> | undefined, b: CriterionDisagreement | PlainMessage<CriterionDisagreement> | undefined): boolean {
+    return proto3.util.equals(CriterionDisagreement, a, b);
   }
 }
 
 /**
- * @generated from message qf.Issue
+ * @generated from message qf.Reconciliation
  */
-export class Issue extends Message<Issue> {
+export class Reconciliation extends Message<Reconciliation> {
   /**
-   * @generated from field: uint64 ID = 1;
+   * @generated from field: uint64 submissionID = 1;
    */
-  ID = protoInt64.zero;
+  submissionID = protoInt64.zero;
 
   /**
-   * Represents the internal ID of a repository
+   * ready reviews being reconciled
    *
-   * @generated from field: uint64 repositoryID = 2;
+   * @generated from field: repeated qf.Review reviews = 2;
    */
-  repositoryID = protoInt64.zero;
+  reviews: Review[] = [];
 
   /**
-   * Task that this issue draws its content from
+   * criteria the reviews disagree on
    *
-   * @generated from field: uint64 taskID = 3;
+   * @generated from field: repeated qf.CriterionDisagreement conflicts = 3;
    */
-  taskID = protoInt64.zero;
+  conflicts: CriterionDisagreement[] = [];
 
   /**
-   * Issue number on scm. Needed for associating db issue with scm issue
+   * final review proposed by the assignment's reconcile policy
    *
-   * @generated from field: uint64 ScmIssueNumber = 4;
+   * @generated from field: qf.Review final = 4;
    */
-  ScmIssueNumber = protoInt64.zero;
+  final?: Review;
 
-  constructor(data?: PartialMessage<Issue>) {
+  constructor(data?: PartialMessage<Reconciliation>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Issue";
+  static readonly typeName = "qf.Reconciliation";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "repositoryID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "taskID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 4, name: "ScmIssueNumber", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 1, name: "submissionID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "reviews", kind: "message", T: Review, repeated: true },
+    { no: 3, name: "conflicts", kind: "message", T: CriterionDisagreement, repeated: true },
+    { no: 4, name: "final", kind: "message", T: Review },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Issue {
-    return new Issue().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Reconciliation {
+    return new Reconciliation().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Issue {
-    return new Issue().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Reconciliation {
+    return new Reconciliation().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Issue {
-    return new Issue().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Reconciliation {
+    return new Reconciliation().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Issue | PlainMessage<Issue> | undefined, b: Issue | PlainMessage<Issue> | undefined): boolean {
-    return proto3.util.equals(Issue, a, b);
+  static equals(a: Reconciliation | PlainMessage<Reconciliation> | undefined, b: Reconciliation | PlainMessage<Reconciliation> | undefined): boolean {
+    return proto3.util.equals(Reconciliation, a, b);
   }
 }
 
 /**
- * @generated from message qf.PullRequest
+ * @generated from message qf.ReviewAllocation
  */
-export class PullRequest extends Message<PullRequest> {
+export class ReviewAllocation extends Message<ReviewAllocation> {
   /**
    * @generated from field: uint64 ID = 1;
    */
   ID = protoInt64.zero;
 
   /**
-   * Represents the scm repository ID
+   * foreign key
    *
-   * @generated from field: uint64 ScmRepositoryID = 2;
+   * @generated from field: uint64 CourseID = 2;
    */
-  ScmRepositoryID = protoInt64.zero;
+  CourseID = protoInt64.zero;
 
   /**
-   * Foreign key
+   * foreign key
    *
-   * @generated from field: uint64 taskID = 3;
+   * @generated from field: uint64 AssignmentID = 3;
    */
-  taskID = protoInt64.zero;
+  AssignmentID = protoInt64.zero;
 
   /**
-   * Foreign key
+   * foreign key
    *
-   * @generated from field: uint64 issueID = 4;
+   * @generated from field: uint64 SubmissionID = 4;
    */
-  issueID = protoInt64.zero;
+  SubmissionID = protoInt64.zero;
 
   /**
-   * The user who owns this PR
+   * UserID of the reviewer
    *
-   * @generated from field: uint64 userID = 5;
+   * @generated from field: uint64 ReviewerID = 5;
    */
-  userID = protoInt64.zero;
+  ReviewerID = protoInt64.zero;
 
-  /**
-   * Scm ID of the comment used for automatic feedback
-   *
-   * @generated from field: uint64 ScmCommentID = 6;
-   */
-  ScmCommentID = protoInt64.zero;
+  constructor(data?: PartialMessage<ReviewAllocation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * The source branch for this pull request
-   *
-   * @generated from field: string sourceBranch = 7;
-   */
-  sourceBranch = "";
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.ReviewAllocation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "AssignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "SubmissionID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "ReviewerID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
 
-  /**
-   * Pull request number
-   *
-   * @generated from field: uint64 number = 8;
-   */
-  number = protoInt64.zero;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReviewAllocation {
+    return new ReviewAllocation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReviewAllocation {
+    return new ReviewAllocation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReviewAllocation {
+    return new ReviewAllocation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReviewAllocation | PlainMessage<ReviewAllocation> | undefined, b: ReviewAllocation | PlainMessage<ReviewAllocation> | undefined): boolean {
+    return proto3.util.equals(ReviewAllocation, a, b);
+  }
+}
 
+/**
+ * @generated from message qf.ReviewAllocations
+ */
+export class ReviewAllocations extends Message<ReviewAllocations> {
   /**
-   * @generated from field: qf.PullRequest.Stage stage = 9;
+   * @generated from field: repeated qf.ReviewAllocation allocations = 1;
    */
-  stage = PullRequest_Stage.NONE;
+  allocations: ReviewAllocation[] = [];
 
-  constructor(data?: PartialMessage<PullRequest>) {
+  constructor(data?: PartialMessage<ReviewAllocations>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.PullRequest";
+  static readonly typeName = "qf.ReviewAllocations";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "ScmRepositoryID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "taskID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 4, name: "issueID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 5, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 6, name: "ScmCommentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 7, name: "sourceBranch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 8, name: "number", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 9, name: "stage", kind: "enum", T: proto3.getEnumType(PullRequest_Stage) },
+    { no: 1, name: "allocations", kind: "message", T: ReviewAllocation, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullRequest {
-    return new PullRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReviewAllocations {
+    return new ReviewAllocations().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullRequest {
-    return new PullRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReviewAllocations {
+    return new ReviewAllocations().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullRequest {
-    return new PullRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReviewAllocations {
+    return new ReviewAllocations().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PullRequest | PlainMessage<PullRequest> | undefined, b: PullRequest | PlainMessage<PullRequest> | undefined): boolean {
-    return proto3.util.equals(PullRequest, a, b);
+  static equals(a: ReviewAllocations | PlainMessage<ReviewAllocations> | undefined, b: ReviewAllocations | PlainMessage<ReviewAllocations> | undefined): boolean {
+    return proto3.util.equals(ReviewAllocations, a, b);
   }
 }
 
 /**
- * @generated from enum qf.PullRequest.Stage
+ * ReviewerLoad records the number of pull request reviews requested from a user.
+ * Teacher review loads are counted per course, and have GroupID 0.
+ * Student review loads are counted per group.
+ *
+ * @generated from message qf.ReviewerLoad
  */
-export enum PullRequest_Stage {
+export class ReviewerLoad extends Message<ReviewerLoad> {
   /**
-   * @generated from enum value: NONE = 0;
+   * @generated from field: uint64 ID = 1;
    */
-  NONE = 0,
+  ID = protoInt64.zero;
 
   /**
-   * @generated from enum value: DRAFT = 1;
+   * @generated from field: uint64 CourseID = 2;
    */
-  DRAFT = 1,
+  CourseID = protoInt64.zero;
 
   /**
-   * @generated from enum value: REVIEW = 2;
+   * @generated from field: uint64 GroupID = 3;
    */
-  REVIEW = 2,
+  GroupID = protoInt64.zero;
 
   /**
-   * @generated from enum value: APPROVED = 3;
+   * @generated from field: uint64 UserID = 4;
    */
-  APPROVED = 3,
+  UserID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint32 count = 5;
+   */
+  count = 0;
+
+  constructor(data?: PartialMessage<ReviewerLoad>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "qf.ReviewerLoad";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "GroupID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "UserID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReviewerLoad {
+    return new ReviewerLoad().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReviewerLoad {
+    return new ReviewerLoad().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReviewerLoad {
+    return new ReviewerLoad().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReviewerLoad | PlainMessage<ReviewerLoad> | undefined, b: ReviewerLoad | PlainMessage<ReviewerLoad> | undefined): boolean {
+    return proto3.util.equals(ReviewerLoad, a, b);
+  }
 }
-// Retrieve enum metadata with: proto3.getEnumType(PullRequest_Stage)
-proto3.util.setEnumType(PullRequest_Stage, "qf.PullRequest.Stage", [
-  { no: 0, name: "NONE" },
-  { no: 1, name: "DRAFT" },
-  { no: 2, name: "REVIEW" },
-  { no: 3, name: "APPROVED" },
-]);
 
 /**
- * @generated from message qf.Assignments
+ * @generated from message qf.ReviewerLoads
  */
-export class Assignments extends Message<Assignments> {
+export class ReviewerLoads extends Message<ReviewerLoads> {
   /**
-   * @generated from field: repeated qf.Assignment assignments = 1;
+   * @generated from field: repeated qf.ReviewerLoad loads = 1;
    */
-  assignments: Assignment[] = [];
+  loads: ReviewerLoad[] = [];
 
-  constructor(data?: PartialMessage<Assignments>) {
+  constructor(data?: PartialMessage<ReviewerLoads>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Assignments";
+  static readonly typeName = "qf.ReviewerLoads";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "assignments", kind: "message", T: Assignment, repeated: true },
+    { no: 1, name: "loads", kind: "message", T: ReviewerLoad, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Assignments {
-    return new Assignments().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReviewerLoads {
+    return new ReviewerLoads().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Assignments {
-    return new Assignments().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReviewerLoads {
+    return new ReviewerLoads().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Assignments {
-    return new Assignments().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReviewerLoads {
+    return new ReviewerLoads().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Assignments | PlainMessage<Assignments> | undefined, b: Assignments | PlainMessage<Assignments> | undefined): boolean {
-    return proto3.util.equals(Assignments, a, b);
+  static equals(a: ReviewerLoads | PlainMessage<ReviewerLoads> | undefined, b: ReviewerLoads | PlainMessage<ReviewerLoads> | undefined): boolean {
+    return proto3.util.equals(ReviewerLoads, a, b);
   }
 }
 
 /**
- * @generated from message qf.Submission
+ * PeerReview assigns a student to review another student's (or group's) submission in the peer review phase.
+ * The reviewer has read access to the submission's repository while the peer review is open.
+ *
+ * @generated from message qf.PeerReview
  */
-export class Submission extends Message<Submission> {
+export class PeerReview extends Message<PeerReview> {
   /**
    * @generated from field: uint64 ID = 1;
    */
@@ -1260,229 +4678,215 @@ export class Submission extends Message<Submission> {
   /**
    * foreign key
    *
-   * @generated from field: uint64 AssignmentID = 2;
-   */
-  AssignmentID = protoInt64.zero;
-
-  /**
-   * @generated from field: uint64 userID = 3;
-   */
-  userID = protoInt64.zero;
-
-  /**
-   * @generated from field: uint64 groupID = 4;
-   */
-  groupID = protoInt64.zero;
-
-  /**
-   * @generated from field: uint32 score = 5;
+   * @generated from field: uint64 CourseID = 2;
    */
-  score = 0;
+  CourseID = protoInt64.zero;
 
   /**
-   * @generated from field: string commitHash = 6;
+   * foreign key
+   *
+   * @generated from field: uint64 AssignmentID = 3;
    */
-  commitHash = "";
+  AssignmentID = protoInt64.zero;
 
   /**
-   * true => feedback is visible to the student or group members
+   * foreign key
    *
-   * @generated from field: bool released = 7;
+   * @generated from field: uint64 SubmissionID = 4;
    */
-  released = false;
+  SubmissionID = protoInt64.zero;
 
   /**
-   * @generated from field: repeated qf.Grade Grades = 8;
+   * UserID of the student reviewer
+   *
+   * @generated from field: uint64 ReviewerID = 5;
    */
-  Grades: Grade[] = [];
+  ReviewerID = protoInt64.zero;
 
   /**
-   * @generated from field: google.protobuf.Timestamp approvedDate = 9;
+   * the reviewer's peer review, if created
+   *
+   * @generated from field: uint64 ReviewID = 6;
    */
-  approvedDate?: Timestamp;
+  ReviewID = protoInt64.zero;
 
   /**
-   * reviews produced for this submission
+   * true while the reviewer can create and update the peer review
    *
-   * @generated from field: repeated qf.Review reviews = 10;
+   * @generated from field: bool open = 7;
    */
-  reviews: Review[] = [];
+  open = false;
 
   /**
-   * build info for tests
+   * the teacher's assessment of the peer review's quality; between 0 and 100
    *
-   * @generated from field: score.BuildInfo BuildInfo = 11;
+   * @generated from field: uint32 quality = 8;
    */
-  BuildInfo?: BuildInfo;
+  quality = 0;
 
   /**
-   * list of scores for different tests
+   * the reviewer's peer review, if created
    *
-   * @generated from field: repeated score.Score Scores = 12;
+   * @generated from field: qf.Review review = 9;
    */
-  Scores: Score[] = [];
+  review?: Review;
 
-  constructor(data?: PartialMessage<Submission>) {
+  constructor(data?: PartialMessage<PeerReview>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Submission";
+  static readonly typeName = "qf.PeerReview";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "AssignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 4, name: "groupID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 5, name: "score", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 6, name: "commitHash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "released", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 8, name: "Grades", kind: "message", T: Grade, repeated: true },
-    { no: 9, name: "approvedDate", kind: "message", T: Timestamp },
-    { no: 10, name: "reviews", kind: "message", T: Review, repeated: true },
-    { no: 11, name: "BuildInfo", kind: "message", T: BuildInfo },
-    { no: 12, name: "Scores", kind: "message", T: Score, repeated: true },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "AssignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "SubmissionID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "ReviewerID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "ReviewID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 7, name: "open", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "quality", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 9, name: "review", kind: "message", T: Review },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Submission {
-    return new Submission().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PeerReview {
+    return new PeerReview().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Submission {
-    return new Submission().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PeerReview {
+    return new PeerReview().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Submission {
-    return new Submission().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PeerReview {
+    return new PeerReview().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Submission | PlainMessage<Submission> | undefined, b: Submission | PlainMessage<Submission> | undefined): boolean {
-    return proto3.util.equals(Submission, a, b);
+  static equals(a: PeerReview | PlainMessage<PeerReview> | undefined, b: PeerReview | PlainMessage<PeerReview> | undefined): boolean {
+    return proto3.util.equals(PeerReview, a, b);
   }
 }
 
 /**
- * @generated from enum qf.Submission.Status
+ * PeerReviewScore is a student's score for the quality of the student's peer reviews in an assignment.
+ *
+ * @generated from message qf.PeerReviewScore
  */
-export enum Submission_Status {
+export class PeerReviewScore extends Message<PeerReviewScore> {
   /**
-   * @generated from enum value: NONE = 0;
+   * @generated from field: uint64 ID = 1;
    */
-  NONE = 0,
+  ID = protoInt64.zero;
 
   /**
-   * @generated from enum value: APPROVED = 1;
+   * foreign key
+   *
+   * @generated from field: uint64 CourseID = 2;
    */
-  APPROVED = 1,
+  CourseID = protoInt64.zero;
 
   /**
-   * @generated from enum value: REJECTED = 2;
+   * foreign key
+   *
+   * @generated from field: uint64 AssignmentID = 3;
    */
-  REJECTED = 2,
+  AssignmentID = protoInt64.zero;
 
   /**
-   * @generated from enum value: REVISION = 3;
+   * UserID of the student reviewer
+   *
+   * @generated from field: uint64 UserID = 4;
    */
-  REVISION = 3,
-}
-// Retrieve enum metadata with: proto3.getEnumType(Submission_Status)
-proto3.util.setEnumType(Submission_Status, "qf.Submission.Status", [
-  { no: 0, name: "NONE" },
-  { no: 1, name: "APPROVED" },
-  { no: 2, name: "REJECTED" },
-  { no: 3, name: "REVISION" },
-]);
+  UserID = protoInt64.zero;
 
-/**
- * @generated from message qf.Submissions
- */
-export class Submissions extends Message<Submissions> {
   /**
-   * @generated from field: repeated qf.Submission submissions = 1;
+   * average quality of the student's peer reviews; between 0 and 100
+   *
+   * @generated from field: uint32 score = 5;
    */
-  submissions: Submission[] = [];
+  score = 0;
 
-  constructor(data?: PartialMessage<Submissions>) {
+  constructor(data?: PartialMessage<PeerReviewScore>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Submissions";
+  static readonly typeName = "qf.PeerReviewScore";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "submissions", kind: "message", T: Submission, repeated: true },
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "AssignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "UserID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "score", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Submissions {
-    return new Submissions().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PeerReviewScore {
+    return new PeerReviewScore().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Submissions {
-    return new Submissions().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PeerReviewScore {
+    return new PeerReviewScore().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Submissions {
-    return new Submissions().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PeerReviewScore {
+    return new PeerReviewScore().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Submissions | PlainMessage<Submissions> | undefined, b: Submissions | PlainMessage<Submissions> | undefined): boolean {
-    return proto3.util.equals(Submissions, a, b);
+  static equals(a: PeerReviewScore | PlainMessage<PeerReviewScore> | undefined, b: PeerReviewScore | PlainMessage<PeerReviewScore> | undefined): boolean {
+    return proto3.util.equals(PeerReviewScore, a, b);
   }
 }
 
 /**
- * @generated from message qf.Grade
+ * @generated from message qf.PeerReviews
  */
-export class Grade extends Message<Grade> {
-  /**
-   * @generated from field: uint64 SubmissionID = 1;
-   */
-  SubmissionID = protoInt64.zero;
-
+export class PeerReviews extends Message<PeerReviews> {
   /**
-   * @generated from field: uint64 UserID = 2;
+   * @generated from field: repeated qf.PeerReview peerReviews = 1;
    */
-  UserID = protoInt64.zero;
+  peerReviews: PeerReview[] = [];
 
   /**
-   * @generated from field: qf.Submission.Status Status = 3;
+   * map: reviewer ID -> the reviewer's saved peer review score
+   *
+   * @generated from field: map<uint64, uint32> scores = 2;
    */
-  Status = Submission_Status.NONE;
+  scores: { [key: string]: number } = {};
 
-  constructor(data?: PartialMessage<Grade>) {
+  constructor(data?: PartialMessage<PeerReviews>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Grade";
+  static readonly typeName = "qf.PeerReviews";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "SubmissionID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "UserID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "Status", kind: "enum", T: proto3.getEnumType(Submission_Status) },
+    { no: 1, name: "peerReviews", kind: "message", T: PeerReview, repeated: true },
+    { no: 2, name: "scores", kind: "map", K: 4 /* ScalarType.UINT64 */, V: {kind: "scalar", T: 13 /* ScalarType.UINT32 */} },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Grade {
-    return new Grade().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PeerReviews {
+    return new PeerReviews().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Grade {
-    return new Grade().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PeerReviews {
+    return new PeerReviews().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Grade {
-    return new Grade().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PeerReviews {
+    return new PeerReviews().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Grade | PlainMessage<Grade> | undefined, b: Grade | PlainMessage<Grade> | undefined): boolean {
-    return proto3.util.equals(Grade, a, b);
+  static equals(a: PeerReviews | PlainMessage<PeerReviews> | undefined, b: PeerReviews | PlainMessage<PeerReviews> | undefined): boolean {
+    return proto3.util.equals(PeerReviews, a, b);
   }
 }
 
 /**
- * @generated from message qf.GradingBenchmark
+ * @generated from message qf.Quiz
  */
-export class GradingBenchmark extends Message<GradingBenchmark> {
+export class Quiz extends Message<Quiz> {
   /**
    * @generated from field: uint64 ID = 1;
    */
@@ -1503,102 +4907,140 @@ export class GradingBenchmark extends Message<GradingBenchmark> {
   AssignmentID = protoInt64.zero;
 
   /**
-   * foreign key
+   * time limit for each attempt in seconds
    *
-   * @generated from field: uint64 ReviewID = 4;
-   */
-  ReviewID = protoInt64.zero;
-
-  /**
-   * @generated from field: string heading = 5;
+   * @generated from field: uint32 timeLimit = 4;
    */
-  heading = "";
+  timeLimit = 0;
 
   /**
-   * @generated from field: string comment = 6;
+   * number of attempts allowed for each student
+   *
+   * @generated from field: uint32 maxAttempts = 5;
    */
-  comment = "";
+  maxAttempts = 0;
 
   /**
-   * @generated from field: repeated qf.GradingCriterion criteria = 7;
+   * @generated from field: repeated qf.QuizQuestion questions = 6;
    */
-  criteria: GradingCriterion[] = [];
+  questions: QuizQuestion[] = [];
 
-  constructor(data?: PartialMessage<GradingBenchmark>) {
+  constructor(data?: PartialMessage<Quiz>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.GradingBenchmark";
+  static readonly typeName = "qf.Quiz";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 2, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 3, name: "AssignmentID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 4, name: "ReviewID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 5, name: "heading", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "criteria", kind: "message", T: GradingCriterion, repeated: true },
+    { no: 4, name: "timeLimit", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "maxAttempts", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "questions", kind: "message", T: QuizQuestion, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GradingBenchmark {
-    return new GradingBenchmark().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Quiz {
+    return new Quiz().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GradingBenchmark {
-    return new GradingBenchmark().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Quiz {
+    return new Quiz().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GradingBenchmark {
-    return new GradingBenchmark().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Quiz {
+    return new Quiz().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GradingBenchmark | PlainMessage<GradingBenchmark> | undefined, b: GradingBenchmark | PlainMessage<GradingBenchmark> | undefined): boolean {
-    return proto3.util.equals(GradingBenchmark, a, b);
+  static equals(a: Quiz | PlainMessage<Quiz> | undefined, b: Quiz | PlainMessage<Quiz> | undefined): boolean {
+    return proto3.util.equals(Quiz, a, b);
   }
 }
 
 /**
- * @generated from message qf.Benchmarks
+ * @generated from message qf.QuizQuestion
  */
-export class Benchmarks extends Message<Benchmarks> {
+export class QuizQuestion extends Message<QuizQuestion> {
   /**
-   * @generated from field: repeated qf.GradingBenchmark benchmarks = 1;
+   * @generated from field: uint64 ID = 1;
    */
-  benchmarks: GradingBenchmark[] = [];
+  ID = protoInt64.zero;
 
-  constructor(data?: PartialMessage<Benchmarks>) {
+  /**
+   * foreign key
+   *
+   * @generated from field: uint64 QuizID = 2;
+   */
+  QuizID = protoInt64.zero;
+
+  /**
+   * @generated from field: uint32 number = 3;
+   */
+  number = 0;
+
+  /**
+   * @generated from field: string text = 4;
+   */
+  text = "";
+
+  /**
+   * choices are labeled a, b, c, ...
+   *
+   * @generated from field: repeated string choices = 5;
+   */
+  choices: string[] = [];
+
+  /**
+   * labels of the correct choices; never sent to students
+   *
+   * @generated from field: repeated string answers = 6;
+   */
+  answers: string[] = [];
+
+  /**
+   * @generated from field: uint32 weight = 7;
+   */
+  weight = 0;
+
+  constructor(data?: PartialMessage<QuizQuestion>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Benchmarks";
+  static readonly typeName = "qf.QuizQuestion";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "benchmarks", kind: "message", T: GradingBenchmark, repeated: true },
+    { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "QuizID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "choices", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "answers", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "weight", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Benchmarks {
-    return new Benchmarks().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuizQuestion {
+    return new QuizQuestion().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Benchmarks {
-    return new Benchmarks().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuizQuestion {
+    return new QuizQuestion().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Benchmarks {
-    return new Benchmarks().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuizQuestion {
+    return new QuizQuestion().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Benchmarks | PlainMessage<Benchmarks> | undefined, b: Benchmarks | PlainMessage<Benchmarks> | undefined): boolean {
-    return proto3.util.equals(Benchmarks, a, b);
+  static equals(a: QuizQuestion | PlainMessage<QuizQuestion> | undefined, b: QuizQuestion | PlainMessage<QuizQuestion> | undefined): boolean {
+    return proto3.util.equals(QuizQuestion, a, b);
   }
 }
 
 /**
- * @generated from message qf.GradingCriterion
+ * @generated from message qf.QuizAttempt
  */
-export class GradingCriterion extends Message<GradingCriterion> {
+export class QuizAttempt extends Message<QuizAttempt> {
   /**
    * @generated from field: uint64 ID = 1;
    */
@@ -1607,101 +5049,89 @@ export class GradingCriterion extends Message<GradingCriterion> {
   /**
    * foreign key
    *
-   * @generated from field: uint64 BenchmarkID = 2;
+   * @generated from field: uint64 QuizID = 2;
    */
-  BenchmarkID = protoInt64.zero;
+  QuizID = protoInt64.zero;
 
   /**
-   * foreign key
+   * @generated from field: uint64 userID = 3;
+   */
+  userID = protoInt64.zero;
+
+  /**
+   * attempt number, starting at 1
    *
-   * @generated from field: uint64 CourseID = 3;
+   * @generated from field: uint32 attempt = 4;
    */
-  CourseID = protoInt64.zero;
+  attempt = 0;
 
   /**
-   * @generated from field: uint64 points = 4;
+   * @generated from field: google.protobuf.Timestamp started = 5;
    */
-  points = protoInt64.zero;
+  started?: Timestamp;
 
   /**
-   * @generated from field: string description = 5;
+   * @generated from field: google.protobuf.Timestamp deadline = 6;
    */
-  description = "";
+  deadline?: Timestamp;
 
   /**
-   * @generated from field: qf.GradingCriterion.Grade grade = 6;
+   * @generated from field: google.protobuf.Timestamp submitted = 7;
    */
-  grade = GradingCriterion_Grade.NONE;
+  submitted?: Timestamp;
 
   /**
-   * @generated from field: string comment = 7;
+   * @generated from field: repeated qf.QuizAnswer answers = 8;
    */
-  comment = "";
+  answers: QuizAnswer[] = [];
 
-  constructor(data?: PartialMessage<GradingCriterion>) {
+  /**
+   * quiz questions without the correct answers
+   *
+   * @generated from field: qf.Quiz quiz = 9;
+   */
+  quiz?: Quiz;
+
+  constructor(data?: PartialMessage<QuizAttempt>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.GradingCriterion";
+  static readonly typeName = "qf.QuizAttempt";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "BenchmarkID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "CourseID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 4, name: "points", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 5, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "grade", kind: "enum", T: proto3.getEnumType(GradingCriterion_Grade) },
-    { no: 7, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "QuizID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "userID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "attempt", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "started", kind: "message", T: Timestamp },
+    { no: 6, name: "deadline", kind: "message", T: Timestamp },
+    { no: 7, name: "submitted", kind: "message", T: Timestamp },
+    { no: 8, name: "answers", kind: "message", T: QuizAnswer, repeated: true },
+    { no: 9, name: "quiz", kind: "message", T: Quiz },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GradingCriterion {
-    return new GradingCriterion().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuizAttempt {
+    return new QuizAttempt().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GradingCriterion {
-    return new GradingCriterion().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuizAttempt {
+    return new QuizAttempt().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GradingCriterion {
-    return new GradingCriterion().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuizAttempt {
+    return new QuizAttempt().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GradingCriterion | PlainMessage<GradingCriterion> | undefined, b: GradingCriterion | PlainMessage<GradingCriterion> | undefined): boolean {
-    return proto3.util.equals(GradingCriterion, a, b);
+  static equals(a: QuizAttempt | PlainMessage<QuizAttempt> | undefined, b: QuizAttempt | PlainMessage<QuizAttempt> | undefined): boolean {
+    return proto3.util.equals(QuizAttempt, a, b);
   }
 }
 
 /**
- * @generated from enum qf.GradingCriterion.Grade
- */
-export enum GradingCriterion_Grade {
-  /**
-   * @generated from enum value: NONE = 0;
-   */
-  NONE = 0,
-
-  /**
-   * @generated from enum value: FAILED = 1;
-   */
-  FAILED = 1,
-
-  /**
-   * @generated from enum value: PASSED = 2;
-   */
-  PASSED = 2,
-}
-// Retrieve enum metadata with: proto3.getEnumType(GradingCriterion_Grade)
-proto3.util.setEnumType(GradingCriterion_Grade, "qf.GradingCriterion.Grade", [
-  { no: 0, name: "NONE" },
-  { no: 1, name: "FAILED" },
-  { no: 2, name: "PASSED" },
-]);
-
-/**
- * @generated from message qf.Review
+ * @generated from message qf.QuizAnswer
  */
-export class Review extends Message<Review> {
+export class QuizAnswer extends Message<QuizAnswer> {
   /**
    * @generated from field: uint64 ID = 1;
    */
@@ -1710,74 +5140,52 @@ export class Review extends Message<Review> {
   /**
    * foreign key
    *
-   * @generated from field: uint64 SubmissionID = 2;
+   * @generated from field: uint64 AttemptID = 2;
    */
-  SubmissionID = protoInt64.zero;
+  AttemptID = protoInt64.zero;
 
   /**
-   * UserID of the reviewer
+   * question number
    *
-   * @generated from field: uint64 ReviewerID = 3;
-   */
-  ReviewerID = protoInt64.zero;
-
-  /**
-   * @generated from field: string feedback = 4;
-   */
-  feedback = "";
-
-  /**
-   * @generated from field: bool ready = 5;
-   */
-  ready = false;
-
-  /**
-   * @generated from field: uint32 score = 6;
-   */
-  score = 0;
-
-  /**
-   * @generated from field: repeated qf.GradingBenchmark gradingBenchmarks = 7;
+   * @generated from field: uint32 number = 3;
    */
-  gradingBenchmarks: GradingBenchmark[] = [];
+  number = 0;
 
   /**
-   * @generated from field: google.protobuf.Timestamp edited = 8;
+   * labels of the selected choices
+   *
+   * @generated from field: repeated string selected = 4;
    */
-  edited?: Timestamp;
+  selected: string[] = [];
 
-  constructor(data?: PartialMessage<Review>) {
+  constructor(data?: PartialMessage<QuizAnswer>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "qf.Review";
+  static readonly typeName = "qf.QuizAnswer";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "ID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 2, name: "SubmissionID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "ReviewerID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 4, name: "feedback", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "ready", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 6, name: "score", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 7, name: "gradingBenchmarks", kind: "message", T: GradingBenchmark, repeated: true },
-    { no: 8, name: "edited", kind: "message", T: Timestamp },
+    { no: 2, name: "AttemptID", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "selected", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Review {
-    return new Review().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuizAnswer {
+    return new QuizAnswer().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Review {
-    return new Review().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuizAnswer {
+    return new QuizAnswer().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Review {
-    return new Review().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuizAnswer {
+    return new QuizAnswer().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Review | PlainMessage<Review> | undefined, b: Review | PlainMessage<Review> | undefined): boolean {
-    return proto3.util.equals(Review, a, b);
+  static equals(a: QuizAnswer | PlainMessage<QuizAnswer> | undefined, b: QuizAnswer | PlainMessage<QuizAnswer> | undefined): boolean {
+    return proto3.util.equals(QuizAnswer, a, b);
   }
 }
 
@@ -108,6 +108,7 @@ export const EnrollmentStatus = {
     1: "Pending",
     2: "Student",
     3: "Teacher",
+    4: "Assistant",
 }
 
 // TODO: Could be computed on the backend (https://github.com/quickfeed/quickfeed/issues/420)
@@ -134,10 +135,15 @@ export const hasEnrollment = (enrollments: Enrollment[]): boolean => {
 
 export const isStudent = (enrollment: Enrollment): boolean => { return hasStudent(enrollment.status) }
 export const isTeacher = (enrollment: Enrollment): boolean => { return hasTeacher(enrollment.status) }
+export const isAssistant = (enrollment: Enrollment): boolean => { return hasAssistant(enrollment.status) }
+/** isStaff returns true if the user is a teacher or a teaching assistant in the course. */
+export const isStaff = (enrollment: Enrollment): boolean => { return hasStaff(enrollment.status) }
 export const isPending = (enrollment: Enrollment): boolean => { return hasPending(enrollment.status) }
 
 export const isPendingGroup = (group: Group): boolean => { return group.status === Group_GroupStatus.PENDING }
 export const isApprovedGroup = (group: Group): boolean => { return group.status === Group_GroupStatus.APPROVED }
+/** isInvitedGroup returns true if the group is waiting for invited members to respond, and cannot be approved yet. */
+export const isInvitedGroup = (group: Group): boolean => { return group.status === Group_GroupStatus.INVITED }
 
 /** isEnrolled returns true if the user is enrolled in the course, and is no longer pending. */
 export const isEnrolled = (enrollment: Enrollment): boolean => { return enrollment.status >= Enrollment_UserStatus.STUDENT }
@@ -146,6 +152,9 @@ export const hasNone = (status: Enrollment_UserStatus): boolean => { return stat
 export const hasPending = (status: Enrollment_UserStatus): boolean => { return status === Enrollment_UserStatus.PENDING }
 export const hasStudent = (status: Enrollment_UserStatus): boolean => { return status === Enrollment_UserStatus.STUDENT }
 export const hasTeacher = (status: Enrollment_UserStatus): boolean => { return status === Enrollment_UserStatus.TEACHER }
+export const hasAssistant = (status: Enrollment_UserStatus): boolean => { return status === Enrollment_UserStatus.ASSISTANT }
+/** hasStaff returns true if the status is teacher or teaching assistant. */
+export const hasStaff = (status: Enrollment_UserStatus): boolean => { return hasTeacher(status) || hasAssistant(status) }
 
 /** hasEnrolled returns true if user has enrolled in course, or is pending approval. */
 export const hasEnrolled = (status: Enrollment_UserStatus): boolean => { return status >= Enrollment_UserStatus.PENDING }
@@ -256,6 +265,7 @@ export const EnrollmentStatusBadge = {
     1: "badge badge-info",
     2: "badge badge-primary",
     3: "badge badge-danger",
+    4: "badge badge-warning",
 }
 
 /** SubmissionStatus returns a string with the status of the submission, given the status number, ex. Submission.Status.APPROVED -> "Approved" */
@@ -57,6 +57,8 @@ describe("UpdateEnrollment", () => {
         { desc: "Pending student gets accepted", courseID: BigInt(2), userID: BigInt(2), want: Enrollment_UserStatus.STUDENT },
         { desc: "Demote teacher to student", courseID: BigInt(2), userID: BigInt(1), want: Enrollment_UserStatus.STUDENT },
         { desc: "Promote student to teacher", courseID: BigInt(1), userID: BigInt(2), want: Enrollment_UserStatus.TEACHER },
+        { desc: "Change teacher to teaching assistant", courseID: BigInt(1), userID: BigInt(2), want: Enrollment_UserStatus.ASSISTANT },
+        { desc: "Demote teaching assistant to student", courseID: BigInt(1), userID: BigInt(2), want: Enrollment_UserStatus.STUDENT },
     ]
 
 
@@ -61,6 +61,27 @@ export const generateRow = (enrollment: Enrollment | Group, assignments: Assignm
     return row
 }
 
+// externalComponents returns the names of the external grade components, such as a written exam,
+// that any of the given enrollments has a score for.
+export const externalComponents = (enrollments: Enrollment[]): string[] => {
+    const components = new Set<string>()
+    enrollments.forEach(enrollment => enrollment.externalGrades.forEach(grade => components.add(grade.component)))
+    return Array.from(components).sort()
+}
+
+// generateExternalGradeCells appends a column for each external grade component to the header and rows.
+export const generateExternalGradeCells = (enrollments: Enrollment[], header: Row, rows: Row[]): void => {
+    const components = externalComponents(enrollments)
+    components.forEach(component => header.push({ value: component }))
+    rows.forEach((row, index) => {
+        const grades = enrollments[index].externalGrades
+        components.forEach(component => {
+            const grade = grades.find(g => g.component === component)
+            row.push({ value: grade ? `${grade.score} %` : "" })
+        })
+    })
+}
+
 export const generateAssignmentsHeader = (assignments: Assignment[], group: boolean): Row => {
     const isCourseManuallyGraded = useAppState((state) => state.isCourseManuallyGraded)
     const actions = useActions()
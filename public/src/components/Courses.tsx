@@ -66,6 +66,7 @@ const Courses = (overview: overview): JSX.Element => {
                             student.push(courseCard)
                             break
                         case Enrollment_UserStatus.TEACHER:
+                        case Enrollment_UserStatus.ASSISTANT:
                             teacher.push(courseCard)
                             break
                     }
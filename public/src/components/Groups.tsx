@@ -1,10 +1,13 @@
-import React from "react"
+import React, { useState } from "react"
 import { Group, Group_GroupStatus } from "../../proto/qf/types_pb"
-import { Color, getCourseID, hasEnrollments, isApprovedGroup, isPendingGroup } from "../Helpers"
+import { Color, getCourseID, hasEnrollments, isApprovedGroup, isInvitedGroup, isPendingGroup } from "../Helpers"
 import { useActions, useAppState } from "../overmind"
 import Button, { ButtonType } from "./admin/Button"
 import DynamicButton from "./DynamicButton"
+import GroupContributions from "./group/GroupContributions"
 import GroupForm from "./group/GroupForm"
+import GroupFormation from "./group/GroupFormation"
+import GroupHistory from "./group/GroupHistory"
 import Search from "./Search"
 
 
@@ -13,6 +16,7 @@ const Groups = (): JSX.Element => {
     const state = useAppState()
     const actions = useActions()
     const courseID = getCourseID()
+    const [analyzedGroup, setAnalyzedGroup] = useState<Group | null>(null)
 
     const groupSearch = (group: Group) => {
         // Show all groups if query is empty
@@ -37,7 +41,7 @@ const Groups = (): JSX.Element => {
 
     const GroupButtons = ({ group }: { group: Group }) => {
         const buttons: JSX.Element[] = []
-        if (isPendingGroup(group)) {
+        if (isPendingGroup(group) && !state.isAssistant) {
             buttons.push(
                 <DynamicButton
                     text={"Approve"}
@@ -47,6 +51,20 @@ const Groups = (): JSX.Element => {
                 />
             )
         }
+        if (isApprovedGroup(group)) {
+            buttons.push(
+                <Button
+                    text={"Contributions"}
+                    color={Color.BLUE}
+                    type={ButtonType.BADGE}
+                    onClick={() => setAnalyzedGroup(group)}
+                />
+            )
+        }
+        if (state.isAssistant) {
+            // Teaching assistants can view groups and their contributions, but cannot manage them
+            return <td className="d-flex">{buttons}</td>
+        }
         buttons.push(
             <Button
                 text={"Edit"}
@@ -91,6 +109,7 @@ const Groups = (): JSX.Element => {
                 <td key={group.ID.toString()}>
                     {group.name}
                     <span className="badge badge-warning ml-2">{isPendingGroup(group) ? "Pending" : null}</span>
+                    <span className="badge badge-info ml-2">{isInvitedGroup(group) ? "Awaiting members" : null}</span>
                 </td>
                 <GroupMembers group={group} />
                 <GroupButtons group={group} />
@@ -103,6 +122,11 @@ const Groups = (): JSX.Element => {
         return <GroupRow key={group.ID.toString()} group={group} />
     })
 
+    // Groups waiting for invited members cannot be approved until all members have responded
+    const InvitedGroups = state.groups[courseID.toString()]?.filter(group => isInvitedGroup(group)).map(group => {
+        return <GroupRow key={group.ID.toString()} group={group} />
+    })
+
     const ApprovedGroups = state.groups[courseID.toString()]?.filter(group => isApprovedGroup(group)).map(group => {
         return <GroupRow key={group.ID.toString()} group={group} />
     })
@@ -114,6 +138,9 @@ const Groups = (): JSX.Element => {
 
     return (
         <div className="box">
+            {state.isAssistant ? null : <GroupFormation />}
+            {state.isAssistant ? null : <GroupHistory />}
+            {analyzedGroup ? <GroupContributions group={analyzedGroup} onClose={() => setAnalyzedGroup(null)} /> : null}
             <div className="pb-2">
                 <Search />
             </div>
@@ -125,6 +152,7 @@ const Groups = (): JSX.Element => {
                 </thead>
                 <tbody>
                     {PendingGroups}
+                    {InvitedGroups}
                     {ApprovedGroups}
                 </tbody>
             </table>
@@ -1,5 +1,5 @@
 import React, { useState } from "react"
-import { Color, EnrollmentSort, EnrollmentStatus, EnrollmentStatusBadge, getCourseID, getFormattedTime, isPending, isTeacher, sortEnrollments } from "../Helpers"
+import { Color, EnrollmentSort, EnrollmentStatus, EnrollmentStatusBadge, getCourseID, getFormattedTime, isAssistant, isPending, isTeacher, sortEnrollments } from "../Helpers"
 import { useAppState, useActions } from "../overmind"
 import { Enrollment, Enrollment_UserStatus } from "../../proto/qf/types_pb"
 import Search from "./Search"
@@ -50,16 +50,28 @@ const Members = (): JSX.Element => {
         data.push(enrollment.totalApproved.toString())
         data.push(enrollment.slipDaysRemaining.toString())
 
-        if (isPending(enrollment)) {
+        if (state.isAssistant) {
+            // Teaching assistants can view course members, but cannot change their enrollments
+            data.push(
+                <i className={EnrollmentStatusBadge[enrollment.status]}>
+                    {EnrollmentStatus[enrollment.status]}
+                </i>
+            )
+        } else if (isPending(enrollment)) {
             data.push(
                 <div className="d-flex">
-                    <DynamicButton
-                        text={"Accept"}
-                        color={Color.GREEN}
-                        type={ButtonType.BADGE}
-                        className="mr-2"
-                        onClick={() => actions.updateEnrollment({ enrollment, status: Enrollment_UserStatus.STUDENT })}
-                    />
+                    {enrollment.waitlisted ? (
+                        // waitlisted enrollments are approved when the course has free seats
+                        <span className="badge badge-secondary mr-2">Waitlisted</span>
+                    ) : (
+                        <DynamicButton
+                            text={"Accept"}
+                            color={Color.GREEN}
+                            type={ButtonType.BADGE}
+                            className="mr-2"
+                            onClick={() => actions.updateEnrollment({ enrollment, status: Enrollment_UserStatus.STUDENT })}
+                        />
+                    )}
                     <DynamicButton
                         text={"Reject"}
                         color={Color.RED}
@@ -77,6 +89,13 @@ const Members = (): JSX.Element => {
                         className="mr-2"
                         onClick={() => actions.updateEnrollment({ enrollment, status: isTeacher(enrollment) ? Enrollment_UserStatus.STUDENT : Enrollment_UserStatus.TEACHER })}
                     />
+                    <DynamicButton
+                        text={isAssistant(enrollment) ? "Remove TA" : "Make TA"}
+                        color={isAssistant(enrollment) ? Color.YELLOW : Color.BLUE}
+                        type={ButtonType.BADGE}
+                        className="mr-2"
+                        onClick={() => actions.updateEnrollment({ enrollment, status: isAssistant(enrollment) ? Enrollment_UserStatus.STUDENT : Enrollment_UserStatus.ASSISTANT })}
+                    />
                     <DynamicButton
                         text={"Reject"}
                         color={Color.RED}
@@ -98,7 +117,7 @@ const Members = (): JSX.Element => {
                 <div className="col-md-6">
                     <Search />
                 </div>
-                <div className="ml-auto">
+                <div className="ml-auto" hidden={state.isAssistant}>
                     <Button
                         text={edit ? "Done" : "Edit"}
                         color={edit ? Color.RED : Color.BLUE}
@@ -106,7 +125,7 @@ const Members = (): JSX.Element => {
                         onClick={() => setEditing(!edit)}
                     />
                 </div>
-                {pending?.length > 0 ?
+                {pending?.length > 0 && !state.isAssistant ?
                     <div style={{ marginLeft: "10px" }}>
                         <DynamicButton
                             text="Approve All"
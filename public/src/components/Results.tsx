@@ -4,7 +4,7 @@ import { Enrollment, Group, Submission } from "../../proto/qf/types_pb"
 import { Color, getCourseID, getSubmissionCellColor } from "../Helpers"
 import { useActions, useAppState } from "../overmind"
 import Button, { ButtonType } from "./admin/Button"
-import { generateAssignmentsHeader, generateSubmissionRows } from "./ComponentsHelpers"
+import { generateAssignmentsHeader, generateExternalGradeCells, generateSubmissionRows } from "./ComponentsHelpers"
 import DynamicTable, { CellElement, RowElement } from "./DynamicTable"
 import TableSort from "./forms/TableSort"
 import LabResult from "./LabResult"
@@ -120,6 +120,10 @@ const Results = ({ review }: { review: boolean }): JSX.Element => {
 
     const generator = review ? generateReviewCell : getSubmissionCell
     const rows = generateSubmissionRows(members, generator)
+    if (!groupView && !review && state.review.assignmentID <= 0) {
+        // show the students' scores for external grade components after the assignments
+        generateExternalGradeCells(members as Enrollment[], header, rows)
+    }
 
 
     return (
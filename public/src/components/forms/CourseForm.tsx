@@ -1,6 +1,7 @@
-import React, { useState } from "react"
+import React, { useEffect, useState } from "react"
+import { Timestamp } from "@bufbuild/protobuf"
 import { useActions } from "../../overmind"
-import { Course } from "../../../proto/qf/types_pb"
+import { Course, EnrollmentPolicy } from "../../../proto/qf/types_pb"
 import FormInput from "./FormInput"
 import { useHistory } from "react-router"
 
@@ -18,6 +19,39 @@ const CourseForm = ({ courseToEdit }: { courseToEdit: Course }): JSX.Element | n
 
     // Local state containing the course to be created or edited (if any)
     const [course, setCourse] = useState(courseToEdit.clone())
+    // The course's enrollment policy is not included in the course, and must be fetched separately
+    const [policy, setPolicy] = useState<EnrollmentPolicy | null>(null)
+
+    useEffect(() => {
+        if (course.ID > 0n) {
+            actions.getEnrollmentPolicy(course.ID).then(setPolicy)
+        }
+    }, [course.ID])
+
+    const handlePolicyChange = (event: React.FormEvent<HTMLInputElement>) => {
+        if (!policy) {
+            return
+        }
+        const { name, value } = event.currentTarget
+        switch (name) {
+            case "joinCode":
+                policy.joinCode = value.trim()
+                break
+            case "emailDomains":
+                policy.emailDomains = value.split(",").map(domain => domain.trim()).filter(domain => domain !== "")
+                break
+            case "capacity":
+                policy.capacity = Number(value)
+                break
+            case "opens":
+                policy.opens = value ? Timestamp.fromDate(new Date(value)) : undefined
+                break
+            case "closes":
+                policy.closes = value ? Timestamp.fromDate(new Date(value)) : undefined
+                break
+        }
+        setPolicy(policy)
+    }
 
     const handleChange = (event: React.FormEvent<HTMLInputElement>) => {
         const { name, value } = event.currentTarget
@@ -37,6 +71,15 @@ const CourseForm = ({ courseToEdit }: { courseToEdit: Course }): JSX.Element | n
             case "slipDays":
                 course.slipDays = Number(value)
                 break
+            case "minGroupSize":
+                course.minGroupSize = Number(value)
+                break
+            case "maxGroupSize":
+                course.maxGroupSize = Number(value)
+                break
+            case "legacyScoreFormat":
+                course.legacyScoreFormat = event.currentTarget.checked
+                break
         }
         setCourse(course)
     }
@@ -44,6 +87,9 @@ const CourseForm = ({ courseToEdit }: { courseToEdit: Course }): JSX.Element | n
     // Creates a new course if no course is being edited, otherwise updates the existing course
     const submitHandler = async (e: React.FormEvent<HTMLFormElement>) => {
         e.preventDefault()
+        if (policy) {
+            course.enrollmentPolicy = policy
+        }
         await actions.editCourse({ course })
         history.push(`/course/${course.ID}`)
     }
@@ -93,10 +139,98 @@ const CourseForm = ({ courseToEdit }: { courseToEdit: Course }): JSX.Element | n
                             type="number"
                         />
                     </div>
+                    <div className="row">
+                        <FormInput
+                            prepend="Min group size"
+                            name="minGroupSize"
+                            placeholder={"(ex. 2)"}
+                            defaultValue={course.minGroupSize.toString()}
+                            onChange={handleChange}
+                            type="number"
+                        />
+                        <FormInput
+                            prepend="Max group size"
+                            name="maxGroupSize"
+                            placeholder={"(ex. 3)"}
+                            defaultValue={course.maxGroupSize.toString()}
+                            onChange={handleChange}
+                            type="number"
+                        />
+                    </div>
+                    <div className="row">
+                        <div className="form-check mb-3 ml-3">
+                            <input
+                                className="form-check-input"
+                                type="checkbox"
+                                id="legacyScoreFormat"
+                                name="legacyScoreFormat"
+                                defaultChecked={course.legacyScoreFormat ?? false}
+                                onChange={handleChange}
+                            />
+                            <label className="form-check-label" htmlFor="legacyScoreFormat">
+                                Accept the legacy score format from tests using an older version of the score package
+                            </label>
+                        </div>
+                    </div>
+                    {policy ? (
+                        <>
+                            <div className="row">
+                                <FormInput
+                                    prepend="Join code"
+                                    name="joinCode"
+                                    placeholder={"(empty: no code required)"}
+                                    defaultValue={policy.joinCode}
+                                    onChange={handlePolicyChange}
+                                />
+                                <FormInput
+                                    prepend="Capacity"
+                                    name="capacity"
+                                    placeholder={"(0: unlimited)"}
+                                    defaultValue={policy.capacity.toString()}
+                                    onChange={handlePolicyChange}
+                                    type="number"
+                                />
+                            </div>
+                            <div className="row">
+                                <FormInput
+                                    prepend="Approve email domains"
+                                    name="emailDomains"
+                                    placeholder={"(ex. uis.no, stud.uis.no)"}
+                                    defaultValue={policy.emailDomains.join(", ")}
+                                    onChange={handlePolicyChange}
+                                />
+                            </div>
+                            <div className="row">
+                                <FormInput
+                                    prepend="Enrollment opens"
+                                    name="opens"
+                                    defaultValue={toLocalDateTime(policy.opens)}
+                                    onChange={handlePolicyChange}
+                                    type="datetime-local"
+                                />
+                                <FormInput
+                                    prepend="Enrollment closes"
+                                    name="closes"
+                                    defaultValue={toLocalDateTime(policy.closes)}
+                                    onChange={handlePolicyChange}
+                                    type="datetime-local"
+                                />
+                            </div>
+                        </>
+                    ) : null}
                     <input className="btn btn-primary" type="submit" value={"Save"} />
                 </form>
         </div>
     )
 }
 
+/** toLocalDateTime formats the timestamp as the value of a datetime-local input, in the browser's time zone. */
+const toLocalDateTime = (timestamp: Timestamp | undefined): string => {
+    if (!timestamp) {
+        return ""
+    }
+    const date = timestamp.toDate()
+    return new Date(date.getTime() - date.getTimezoneOffset() * 60000).toISOString().slice(0, 16)
+}
+
 export default CourseForm
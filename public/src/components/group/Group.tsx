@@ -1,5 +1,5 @@
 import React from "react"
-import { getCourseID, isPendingGroup } from "../../Helpers"
+import { getCourseID, isInvitedGroup, isPendingGroup } from "../../Helpers"
 import { useAppState } from "../../overmind"
 
 
@@ -10,6 +10,7 @@ const GroupComponent = (): JSX.Element => {
     const group = state.userGroup[courseID.toString()]
 
     const pendingIcon = isPendingGroup(group) ? <span className="badge badge-warning ml-2">Pending</span> : null
+    const invitedIcon = isInvitedGroup(group) ? <span className="badge badge-info ml-2">Awaiting members</span> : null
     const members = group.users.map(user =>
         <li key={user.ID.toString()} className="list-group-item">
             <img src={user.AvatarURL} style={{ width: "23px", marginRight: "10px", borderRadius: "50%" }} alt="" />
@@ -19,7 +20,7 @@ const GroupComponent = (): JSX.Element => {
 
     return (
         <div>
-            <li className="list-group-item active">{group.name}{pendingIcon}</li>
+            <li className="list-group-item active">{group.name}{pendingIcon}{invitedIcon}</li>
             {members}
         </div>
     )
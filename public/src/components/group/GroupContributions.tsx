@@ -0,0 +1,82 @@
+import React, { useState } from "react"
+import { Group, GroupContributions as Contributions } from "../../../proto/qf/types_pb"
+import { Color, getCourseID } from "../../Helpers"
+import { useActions } from "../../overmind"
+import Button, { ButtonType } from "../admin/Button"
+import DynamicButton from "../DynamicButton"
+
+
+/** GroupContributions lets teachers analyze the commits in a group's repository, to see how much each member
+ *  contributed to each assignment, and when. Members with a small share of the changed lines are flagged,
+ *  such that the teacher can adjust their grades. */
+const GroupContributions = ({ group, onClose }: { group: Group, onClose: () => void }): JSX.Element => {
+    const actions = useActions()
+    const courseID = getCourseID()
+
+    const [threshold, setThreshold] = useState<number>(50)
+    const [contributions, setContributions] = useState<Contributions | null>(null)
+
+    const analyze = async () => {
+        setContributions(await actions.getGroupContributions({ courseID, groupID: group.ID, threshold }))
+    }
+
+    const rows = contributions?.members.map(member => {
+        const assignments = member.assignments.map(a => `${a.folder}: ${a.commits} commits, +${a.linesAdded}/-${a.linesRemoved}`)
+        // activity dates are midnight UTC, so the ISO date is the day of the commits
+        const activity = member.activity.map(a => `${a.date?.toDate().toISOString().slice(0, 10)}: ${a.commits}`)
+        return (
+            <tr key={member.userID.toString()} className={member.flagged ? "table-danger" : undefined}>
+                <td>
+                    {member.user?.Name}
+                    {member.flagged ? <span className="badge badge-danger ml-2">Below threshold</span> : null}
+                </td>
+                <td>{member.commits}</td>
+                <td>+{member.linesAdded} / -{member.linesRemoved}</td>
+                <td>{member.share}%</td>
+                <td>{assignments.join("; ")}</td>
+                <td>{activity.join(", ")}</td>
+            </tr>
+        )
+    })
+
+    return (
+        <div className="mb-3">
+            <h4>Contributions to {group.name}</h4>
+            <div className="input-group mb-2">
+                <div className="input-group-prepend">
+                    <span className="input-group-text">Flag members below (% of an equal share)</span>
+                </div>
+                <input
+                    className="form-control"
+                    type="number"
+                    min={1}
+                    max={100}
+                    value={threshold}
+                    onChange={e => setThreshold(Number(e.target.value))}
+                />
+            </div>
+            <DynamicButton text={"Analyze Repository"} color={Color.BLUE} type={ButtonType.OUTLINE} onClick={analyze} />
+            {contributions ?
+                <>
+                    <table className="table table-striped table-grp table-hover mt-2">
+                        <thead className="thead-dark">
+                            <th>Member</th>
+                            <th>Commits</th>
+                            <th>Lines</th>
+                            <th>Share</th>
+                            <th>Assignments</th>
+                            <th>Activity (commits per day)</th>
+                        </thead>
+                        <tbody>{rows}</tbody>
+                    </table>
+                    {contributions.unattributedCommits > 0 ?
+                        <p>{contributions.unattributedCommits} commits could not be matched to a member by login or email.</p>
+                        : null}
+                </>
+                : null}
+            <Button text={"Close"} color={Color.BLUE} type={ButtonType.OUTLINE} className="ml-2" onClick={onClose} />
+        </div>
+    )
+}
+
+export default GroupContributions
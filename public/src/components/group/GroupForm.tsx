@@ -171,12 +171,25 @@ const GroupForm = (): JSX.Element | null => {
                                 />
                             </div>
                             :
-                            <DynamicButton
-                                text={"Create Group"}
-                                color={Color.GREEN}
-                                type={ButtonType.BUTTON}
-                                onClick={() => actions.createGroup({ courseID, users: userIds, name: group.name })}
-                            />
+                            <div className="row justify-content-md-center">
+                                <DynamicButton
+                                    text={"Create Group"}
+                                    color={Color.GREEN}
+                                    type={ButtonType.BUTTON}
+                                    onClick={() => actions.createGroup({ courseID, users: userIds, name: group.name })}
+                                />
+                                {isTeacher ? null :
+                                    // Students who do not form a group themselves may state their preferred partners
+                                    // for when the teacher forms groups
+                                    <DynamicButton
+                                        text={"Save as Preferred Partners"}
+                                        color={Color.BLUE}
+                                        type={ButtonType.OUTLINE}
+                                        className="ml-2"
+                                        onClick={() => actions.updateGroupPreference({ courseID, users: userIds, name: group.name })}
+                                    />
+                                }
+                            </div>
                         }
                     </div>
                 </div>
@@ -0,0 +1,65 @@
+import React, { useState } from "react"
+import { GroupFormationRequest_Strategy } from "../../../proto/qf/requests_pb"
+import { Group } from "../../../proto/qf/types_pb"
+import { Color, getCourseID } from "../../Helpers"
+import { useActions } from "../../overmind"
+import Button, { ButtonType } from "../admin/Button"
+import DynamicButton from "../DynamicButton"
+
+
+/** GroupFormation lets teachers propose groups for the students who are not in a group,
+ *  preview the proposal, and create all the proposed groups at once. */
+const GroupFormation = (): JSX.Element => {
+    const actions = useActions()
+    const courseID = getCourseID()
+
+    const [strategy, setStrategy] = useState<GroupFormationRequest_Strategy>(GroupFormationRequest_Strategy.RANDOM)
+    const [proposal, setProposal] = useState<Group[] | null>(null)
+
+    const propose = async () => {
+        setProposal(await actions.proposeGroups({ courseID, strategy }))
+    }
+
+    const commit = async () => {
+        if (!proposal || !confirm(`Create ${proposal.length} groups and their repositories?`)) {
+            return
+        }
+        if (await actions.createGroups({ courseID, groups: proposal })) {
+            setProposal(null)
+        }
+    }
+
+    return (
+        <div className="mb-3">
+            <div className="input-group mb-2">
+                <div className="input-group-prepend">
+                    <div className="input-group-text">Form groups</div>
+                </div>
+                <select className="form-control" value={strategy} onChange={e => setStrategy(Number(e.currentTarget.value))}>
+                    <option value={GroupFormationRequest_Strategy.RANDOM}>Random</option>
+                    <option value={GroupFormationRequest_Strategy.BALANCED}>Balanced by points</option>
+                    <option value={GroupFormationRequest_Strategy.PREFERENCES}>Partner preferences</option>
+                </select>
+                <div className="input-group-append">
+                    <DynamicButton text={"Propose"} color={Color.BLUE} type={ButtonType.BUTTON} onClick={propose} />
+                </div>
+            </div>
+            {proposal ? (
+                <>
+                    <ul className="list-group mb-2">
+                        {proposal.length === 0 ? <li className="list-group-item">All students are in a group</li> : null}
+                        {proposal.map(group => (
+                            <li key={group.name} className="list-group-item">
+                                <strong>{group.name}</strong>: {group.users.map(user => user.Name).join(", ")}
+                            </li>
+                        ))}
+                    </ul>
+                    {proposal.length > 0 ? <DynamicButton text={"Create Groups"} color={Color.GREEN} type={ButtonType.BUTTON} className="mr-2" onClick={commit} /> : null}
+                    <Button text={"Discard"} color={Color.RED} type={ButtonType.OUTLINE} onClick={() => setProposal(null)} />
+                </>
+            ) : null}
+        </div>
+    )
+}
+
+export default GroupFormation
@@ -0,0 +1,53 @@
+import React, { useState } from "react"
+import { GroupMembership } from "../../../proto/qf/types_pb"
+import { Color, getCourseID, getFormattedTime } from "../../Helpers"
+import { useActions } from "../../overmind"
+import Button, { ButtonType } from "../admin/Button"
+import DynamicButton from "../DynamicButton"
+
+
+/** GroupHistory lets teachers see which students were members of which groups, and when,
+ *  including groups that have been deleted. */
+const GroupHistory = (): JSX.Element => {
+    const actions = useActions()
+    const courseID = getCourseID()
+
+    const [memberships, setMemberships] = useState<GroupMembership[] | null>(null)
+
+    const show = async () => {
+        setMemberships(await actions.getGroupMemberships(courseID))
+    }
+
+    if (!memberships) {
+        return (
+            <div className="mb-3">
+                <DynamicButton text={"Show Membership History"} color={Color.BLUE} type={ButtonType.OUTLINE} onClick={show} />
+            </div>
+        )
+    }
+
+    const rows = memberships.map(membership => (
+        <tr key={membership.ID.toString()}>
+            <td>{membership.groupName}</td>
+            <td>{membership.user?.Name}</td>
+            <td>{getFormattedTime(membership.joined)}</td>
+            <td>{membership.left ? getFormattedTime(membership.left) : "Current member"}</td>
+        </tr>
+    ))
+    return (
+        <div className="mb-3">
+            <table className="table table-striped table-grp table-hover">
+                <thead className="thead-dark">
+                    <th>Group</th>
+                    <th>Student</th>
+                    <th>Joined</th>
+                    <th>Left</th>
+                </thead>
+                <tbody>{rows}</tbody>
+            </table>
+            <Button text={"Hide Membership History"} color={Color.BLUE} type={ButtonType.OUTLINE} onClick={() => setMemberships(null)} />
+        </div>
+    )
+}
+
+export default GroupHistory
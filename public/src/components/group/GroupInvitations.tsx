@@ -0,0 +1,60 @@
+import React from "react"
+import { GroupInvitation, GroupInvitation_Status } from "../../../proto/qf/types_pb"
+import { Color, getCourseID } from "../../Helpers"
+import { useActions, useAppState } from "../../overmind"
+import { ButtonType } from "../admin/Button"
+import DynamicButton from "../DynamicButton"
+
+
+const statusText: { [status: number]: string } = {
+    [GroupInvitation_Status.PENDING]: "Waiting for response",
+    [GroupInvitation_Status.ACCEPTED]: "Accepted",
+    [GroupInvitation_Status.DECLINED]: "Declined",
+    [GroupInvitation_Status.EXPIRED]: "Expired",
+}
+
+/** GroupInvitations lists the current user's pending invitations to groups, which the user can accept or decline,
+ *  and the status of the invitations to the user's own group. */
+const GroupInvitations = (): JSX.Element | null => {
+    const state = useAppState()
+    const actions = useActions()
+    const courseID = getCourseID()
+
+    const invitations = state.groupInvitations[courseID.toString()] ?? []
+    const received = invitations.filter(invitation => invitation.userID === state.self.ID && invitation.status === GroupInvitation_Status.PENDING)
+    const sent = invitations.filter(invitation => invitation.userID !== state.self.ID)
+    if (received.length === 0 && sent.length === 0) {
+        return null
+    }
+
+    const respond = (invitation: GroupInvitation, status: GroupInvitation_Status) => {
+        return actions.respondToGroupInvitation({ invitation, status })
+    }
+
+    const receivedRows = received.map(invitation =>
+        <li key={invitation.ID.toString()} className="list-group-item d-flex justify-content-between">
+            <span>{invitation.inviter?.Name} invited you to join <b>{invitation.group?.name}</b></span>
+            <span>
+                <DynamicButton text="Accept" color={Color.GREEN} type={ButtonType.BADGE} onClick={() => respond(invitation, GroupInvitation_Status.ACCEPTED)} />
+                <DynamicButton text="Decline" color={Color.RED} type={ButtonType.BADGE} className="ml-2" onClick={() => respond(invitation, GroupInvitation_Status.DECLINED)} />
+            </span>
+        </li>
+    )
+    const sentRows = sent.map(invitation =>
+        <li key={invitation.ID.toString()} className="list-group-item d-flex justify-content-between">
+            <span>{invitation.user?.Name}</span>
+            <span className="badge badge-info">{statusText[invitation.status]}</span>
+        </li>
+    )
+
+    return (
+        <div className="mb-3">
+            {receivedRows.length > 0 ? <li className="list-group-item active">Group invitations</li> : null}
+            {receivedRows}
+            {sentRows.length > 0 ? <li className="list-group-item active">Invited members</li> : null}
+            {sentRows}
+        </div>
+    )
+}
+
+export default GroupInvitations
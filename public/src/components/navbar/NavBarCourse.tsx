@@ -2,7 +2,7 @@ import React from "react"
 import { useHistory } from "react-router"
 import { Enrollment } from "../../../proto/qf/types_pb"
 import { Status } from "../../consts"
-import { isStudent, isTeacher } from "../../Helpers"
+import { isStaff, isStudent } from "../../Helpers"
 import { useActions, useAppState } from "../../overmind"
 import NavBarLabs from "./NavBarLabs"
 import NavBarTeacher from "./NavBarTeacher"
@@ -39,7 +39,7 @@ const NavBarCourse = ({ enrollment }: { enrollment: Enrollment }): JSX.Element =
             </li>
             <div className={active ? Status.ActiveLab : Status.Inactive}>
                 {active && isStudent(enrollment) ? <NavBarLabs /> : null}
-                {active && isTeacher(enrollment) ? <NavBarTeacher /> : null}
+                {active && isStaff(enrollment) ? <NavBarTeacher /> : null}
             </div>
         </>
     )
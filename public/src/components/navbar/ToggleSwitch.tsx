@@ -1,7 +1,6 @@
 import React, { useEffect } from "react"
-import { Enrollment_UserStatus } from "../../../proto/qf/types_pb"
 import { useActions, useAppState } from "../../overmind"
-import { hasTeacher } from "../../Helpers"
+import { hasStaff } from "../../Helpers"
 import { useHistory } from "react-router"
 
 const ToggleSwitch = () => {
@@ -19,10 +18,7 @@ const ToggleSwitch = () => {
     })
 
     const isTeacher = () => {
-        return (
-            enrollmentsByCourseID[activeCourse.toString()].status ===
-            Enrollment_UserStatus.TEACHER
-        )
+        return hasStaff(enrollmentsByCourseID[activeCourse.toString()].status)
     }
 
     const updateStatus = (isTeacher: boolean) => {
@@ -37,7 +33,7 @@ const ToggleSwitch = () => {
         })
     }
 
-    if (!hasTeacher(status[activeCourse.toString()])) {
+    if (!hasStaff(status[activeCourse.toString()])) {
         return null
     }
 
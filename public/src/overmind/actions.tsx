@@ -1,23 +1,28 @@
 import { Code, ConnectError } from "@bufbuild/connect"
 import { Context } from "."
-import { Organization, SubmissionRequest_SubmissionType, } from "../../proto/qf/requests_pb"
+import { GroupFormationRequest_Strategy, Organization, SubmissionRequest_SubmissionType, } from "../../proto/qf/requests_pb"
 import {
     Assignment,
     Course,
     Enrollment,
     Enrollment_DisplayState,
     Enrollment_UserStatus,
+    EnrollmentPolicy,
     Grade,
     GradingBenchmark,
     GradingCriterion,
     Group,
     Group_GroupStatus,
+    GroupContributions,
+    GroupInvitation,
+    GroupInvitation_Status,
+    GroupMembership,
     Submission,
     Submission_Status,
     User
 } from "../../proto/qf/types_pb"
 import { Response } from "../client"
-import { Color, ConnStatus, getStatusByUser, hasAllStatus, hasStudent, hasTeacher, isPending, isStudent, isTeacher, isVisible, newID, setStatusAll, setStatusByUser, SubmissionSort, SubmissionStatus, validateGroup } from "../Helpers"
+import { Color, ConnStatus, getStatusByUser, hasAllStatus, hasStaff, hasStudent, isAssistant, isPending, isStaff, isStudent, isVisible, newID, setStatusAll, setStatusByUser, SubmissionSort, SubmissionStatus, validateGroup } from "../Helpers"
 import * as internalActions from "./internalActions"
 import { Alert, CourseGroup, SubmissionOwner } from "./state"
 
@@ -269,11 +274,14 @@ export const updateEnrollment = async ({ state, actions, effects }: Context, { e
             break
         case Enrollment_UserStatus.STUDENT:
             // If the enrollment is pending, don't ask for confirmation
-            confirmed = isPending(enrollment) || confirm(`Warning! ${enrollment.user.Name} is a teacher. Are sure you want to demote?`)
+            confirmed = isPending(enrollment) || confirm(`Warning! ${enrollment.user.Name} is a ${isAssistant(enrollment) ? "teaching assistant" : "teacher"}. Are sure you want to demote?`)
             break
         case Enrollment_UserStatus.TEACHER:
             confirmed = confirm(`Are you sure you want to promote ${enrollment.user.Name} to teacher status?`)
             break
+        case Enrollment_UserStatus.ASSISTANT:
+            confirmed = confirm(`Are you sure you want to make ${enrollment.user.Name} a teaching assistant? Teaching assistants can review and grade submissions, but cannot manage the course.`)
+            break
         case Enrollment_UserStatus.PENDING:
             // Status pending should never be set by this function.
             // If the intent is to accept a pending enrollment, status should be set to student.
@@ -319,7 +327,9 @@ export const approvePendingEnrollments = async ({ state, actions, effects }: Con
     // Clone and set status to student for all pending enrollments.
     // We need to clone the enrollments to avoid modifying the state directly.
     // We do not want to update set the enrollment status before the update is successful.
-    const enrollments = state.pendingEnrollments.map(e => {
+    // Waitlisted enrollments cannot be approved until the course has free seats.
+    const approved = state.pendingEnrollments.filter(e => !e.waitlisted)
+    const enrollments = approved.map(e => {
         const temp = e.clone()
         temp.status = Enrollment_UserStatus.STUDENT
         return temp
@@ -332,7 +342,7 @@ export const approvePendingEnrollments = async ({ state, actions, effects }: Con
         await actions.getEnrollmentsByCourse({ courseID: state.activeCourse, statuses: [Enrollment_UserStatus.PENDING] })
         return
     }
-    for (const enrollment of state.pendingEnrollments) {
+    for (const enrollment of approved) {
         enrollment.status = Enrollment_UserStatus.STUDENT
     }
 }
@@ -402,6 +412,98 @@ export const createGroup = async ({ state, actions, effects }: Context, group: C
     state.activeGroup = null
 }
 
+/** getGroupInvitations fetches the current user's invitations to groups in the course, and the invitations to the user's group. */
+export const getGroupInvitations = async ({ state, effects }: Context, courseID: bigint): Promise<void> => {
+    const response = await effects.api.client.getGroupInvitations({ courseID, userID: state.self.ID })
+    if (response.error) {
+        return
+    }
+    state.groupInvitations[courseID.toString()] = response.message.invitations
+}
+
+/** respondToGroupInvitation accepts or declines the current user's invitation to a group. */
+export const respondToGroupInvitation = async ({ actions, effects }: Context, { invitation, status }: { invitation: GroupInvitation, status: GroupInvitation_Status }): Promise<void> => {
+    const response = await effects.api.client.respondToGroupInvitation({
+        ID: invitation.ID,
+        courseID: invitation.courseID,
+        userID: invitation.userID,
+        status,
+    })
+    if (response.error) {
+        return
+    }
+    actions.receiveGroupInvitation(new GroupInvitation({ ...invitation, status }))
+}
+
+/** receiveGroupInvitation stores a new or changed group invitation, and refreshes the user's group when the user joins a group. */
+export const receiveGroupInvitation = async ({ state, actions }: Context, invitation: GroupInvitation): Promise<void> => {
+    const courseID = invitation.courseID.toString()
+    const invitations = state.groupInvitations[courseID] ?? []
+    const index = invitations.findIndex(i => i.ID === invitation.ID)
+    if (index === -1) {
+        state.groupInvitations[courseID] = [...invitations, invitation]
+    } else {
+        state.groupInvitations[courseID][index] = invitation
+    }
+    const joined = invitation.userID === state.self.ID && invitation.status === GroupInvitation_Status.ACCEPTED
+    const group = state.userGroup[courseID]
+    if (joined || group?.ID === invitation.groupID) {
+        // the group's members or status may have changed
+        await actions.getGroup(new Enrollment({ courseID: invitation.courseID, groupID: invitation.groupID }))
+    }
+}
+
+/** updateGroupPreference saves the group's users, except the current user, as the current user's preferred group partners. */
+export const updateGroupPreference = async ({ state, actions, effects }: Context, group: CourseGroup): Promise<void> => {
+    const response = await effects.api.client.updateGroupPreference({
+        courseID: group.courseID,
+        userID: state.self.ID,
+        partnerIDs: group.users.filter(userID => userID !== state.self.ID),
+    })
+    if (response.error) {
+        return
+    }
+    actions.alert({ text: "Your preferred group partners have been saved", color: Color.GREEN, delay: 5000 })
+}
+
+/** proposeGroups returns groups proposed for the course's students who are not in a group. The proposal is not saved. */
+export const proposeGroups = async ({ effects }: Context, { courseID, strategy }: { courseID: bigint, strategy: GroupFormationRequest_Strategy }): Promise<Group[] | null> => {
+    const response = await effects.api.client.proposeGroups({ courseID, strategy })
+    if (response.error) {
+        return null
+    }
+    return response.message.groups
+}
+
+/** createGroups creates and approves the given groups, and adds them to state. Returns true if the groups were created. */
+export const createGroups = async ({ state, effects }: Context, { courseID, groups }: { courseID: bigint, groups: Group[] }): Promise<boolean> => {
+    const response = await effects.api.client.createGroups({ courseID, groups })
+    if (response.error) {
+        return false
+    }
+    state.groups[courseID.toString()] = [...(state.groups[courseID.toString()] ?? []), ...response.message.groups]
+    return true
+}
+
+/** getGroupMemberships returns the membership history of the course's groups, or null if it could not be fetched. */
+export const getGroupMemberships = async ({ effects }: Context, courseID: bigint): Promise<GroupMembership[] | null> => {
+    const response = await effects.api.client.getGroupMemberships({ courseID })
+    if (response.error) {
+        return null
+    }
+    return response.message.memberships
+}
+
+/** getGroupContributions returns the contributions of the group's members to the group's repository,
+ *  flagging members whose share is below the threshold, or null if the repository could not be analyzed. */
+export const getGroupContributions = async ({ effects }: Context, { courseID, groupID, threshold }: { courseID: bigint, groupID: bigint, threshold: number }): Promise<GroupContributions | null> => {
+    const response = await effects.api.client.getGroupContributions({ courseID, groupID, threshold })
+    if (response.error) {
+        return null
+    }
+    return response.message
+}
+
 /** getOrganization returns the organization object for orgName retrieved from the server. */
 export const getOrganization = async ({ effects }: Context, orgName: string): Promise<Response<Organization>> => {
     return await effects.api.client.getOrganization({ ScmOrganizationName: orgName })
@@ -416,6 +518,15 @@ export const editCourse = async ({ actions, effects }: Context, { course }: { co
     await actions.getCourses()
 }
 
+/** getEnrollmentPolicy returns the enrollment policy of the given course, or null if it could not be fetched. */
+export const getEnrollmentPolicy = async ({ effects }: Context, courseID: bigint): Promise<EnrollmentPolicy | null> => {
+    const response = await effects.api.client.getEnrollmentPolicy({ courseID })
+    if (response.error) {
+        return null
+    }
+    return response.message
+}
+
 /** Fetches and stores all submissions of a given course into state. Triggers the loading spinner. */
 export const loadCourseSubmissions = async ({ state, actions }: Context, courseID: bigint): Promise<void> => {
     state.isLoading = true
@@ -564,10 +675,22 @@ export const rebuildAllSubmissions = async ({ effects }: Context, { courseID, as
 
 /** Enrolls a user (self) in a course given by courseID. Refreshes enrollments in state if enroll is successful. */
 export const enroll = async ({ state, effects }: Context, courseID: bigint): Promise<void> => {
-    const response = await effects.api.client.createEnrollment({
+    let response = await effects.api.client.createEnrollment({
         courseID,
         userID: state.self.ID,
     })
+    if (response.error?.code === Code.PermissionDenied) {
+        // The course requires a join code; ask for it and try again
+        const joinCode = prompt("This course requires a join code. Please enter the code given by your teacher.")
+        if (!joinCode) {
+            return
+        }
+        response = await effects.api.client.createEnrollment({
+            courseID,
+            userID: state.self.ID,
+            joinCode,
+        })
+    }
     if (response.error) {
         return
     }
@@ -737,6 +860,14 @@ export const startSubmissionStream = ({ actions, effects }: Context) => {
     })
 }
 
+export const startGroupInvitationStream = ({ actions, effects }: Context) => {
+    effects.streamService.groupInvitationStream({
+        onStatusChange: actions.setConnectionStatus,
+        onMessage: actions.receiveGroupInvitation,
+        onError: actions.handleStreamError,
+    })
+}
+
 export const updateAssignments = async ({ actions, effects }: Context, courseID: bigint): Promise<void> => {
     const response = await effects.api.client.updateAssignments({ courseID })
     if (response.error) {
@@ -761,7 +892,7 @@ export const fetchUserData = async ({ state, actions }: Context): Promise<boolea
     const results = []
     for (const enrollment of state.enrollments) {
         const courseID = enrollment.courseID
-        if (isStudent(enrollment) || isTeacher(enrollment)) {
+        if (isStudent(enrollment) || isStaff(enrollment)) {
             results.push(actions.getUserSubmissions(courseID))
             results.push(actions.getGroupSubmissions(courseID))
             const statuses = isStudent(enrollment) ? [Enrollment_UserStatus.STUDENT, Enrollment_UserStatus.TEACHER] : []
@@ -770,7 +901,10 @@ export const fetchUserData = async ({ state, actions }: Context): Promise<boolea
                 results.push(actions.getGroup(enrollment))
             }
         }
-        if (isTeacher(enrollment)) {
+        if (isStudent(enrollment)) {
+            results.push(actions.getGroupInvitations(courseID))
+        }
+        if (isStaff(enrollment)) {
             results.push(actions.getGroupsByCourse(courseID))
         }
     }
@@ -780,6 +914,7 @@ export const fetchUserData = async ({ state, actions }: Context): Promise<boolea
     }
     await actions.getRepositories()
     actions.startSubmissionStream()
+    actions.startGroupInvitationStream()
     // End loading screen.
     state.isLoading = false
     return true
@@ -796,15 +931,16 @@ export const changeView = async ({ state, effects }: Context, courseID: bigint):
                 case: "userID",
                 value: state.self.ID,
             },
-            statuses: [Enrollment_UserStatus.TEACHER],
+            statuses: [Enrollment_UserStatus.TEACHER, Enrollment_UserStatus.ASSISTANT],
         })
         if (response.error) {
             return
         }
-        if (response.message.enrollments.find(enrol => enrol.courseID === courseID && hasTeacher(enrol.status))) {
-            enrollment.status = Enrollment_UserStatus.TEACHER
+        const staff = response.message.enrollments.find(enrol => enrol.courseID === courseID && hasStaff(enrol.status))
+        if (staff) {
+            enrollment.status = staff.status
         }
-    } else if (hasTeacher(enrollment.status)) {
+    } else if (hasStaff(enrollment.status)) {
         enrollment.status = Enrollment_UserStatus.STUDENT
     }
 }
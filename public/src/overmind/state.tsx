@@ -1,7 +1,7 @@
 import { derived } from "overmind"
 import { Context } from "."
-import { Assignment, Course, Enrollment, Enrollment_UserStatus, Group, Group_GroupStatus, Submission, User } from "../../proto/qf/types_pb"
-import { Color, ConnStatus, getNumApproved, getSubmissionsScore, isAllApproved, isManuallyGraded, isPending, isPendingGroup, isTeacher, SubmissionsForCourse, SubmissionsForUser, SubmissionSort } from "../Helpers"
+import { Assignment, Course, Enrollment, Enrollment_UserStatus, Group, Group_GroupStatus, GroupInvitation, Submission, User } from "../../proto/qf/types_pb"
+import { Color, ConnStatus, getNumApproved, getSubmissionsScore, isAllApproved, isManuallyGraded, isAssistant, isPending, isPendingGroup, isStaff, SubmissionsForCourse, SubmissionsForUser, SubmissionSort } from "../Helpers"
 
 export interface CourseGroup {
     courseID: bigint
@@ -55,6 +55,9 @@ export type State = {
     /* Contains all the groups the user is a member of, indexed by course ID */
     userGroup: { [courseID: string]: Group },
 
+    /* Contains the user's invitations to groups, and the invitations to the user's group, indexed by course ID */
+    groupInvitations: { [courseID: string]: GroupInvitation[] },
+
     /* Contains all submissions for the user, indexed by course ID */
     // The individual submissions for a given course are indexed by assignment order - 1
     submissions: SubmissionsForUser,
@@ -62,10 +65,14 @@ export type State = {
     /* Current enrollment status of the user for a given course */
     status: { [courseID: string]: Enrollment_UserStatus }
 
-    /* Indicates if the user is a teacher of the current course */
+    /* Indicates if the user is a teacher or teaching assistant of the current course */
     // derived from enrollmentsByCourseID
     isTeacher: boolean
 
+    /* Indicates if the user is a teaching assistant of the current course */
+    // derived from enrollmentsByCourseID
+    isAssistant: boolean
+
     /* Indicates if the user is the course creator of the current course */
     // derived from courses
     isCourseCreator: boolean
@@ -102,7 +109,7 @@ export type State = {
      *  values of sortSubmissionsBy, sortAscending, and submissionFilters */
     courseMembers: Enrollment[] | Group[],
 
-    /* Course teachers, indexed by user ID */
+    /* Course teachers and teaching assistants, indexed by user ID */
     /* Derived from enrollments for selected course */
     courseTeachers: { [userID: string]: User }
 
@@ -221,10 +228,17 @@ export const state: State = {
     }),
     submissions: new SubmissionsForUser(),
     userGroup: {},
+    groupInvitations: {},
 
     isTeacher: derived(({ enrollmentsByCourseID, activeCourse }: State) => {
         if (activeCourse > 0 && enrollmentsByCourseID[activeCourse.toString()]) {
-            return isTeacher(enrollmentsByCourseID[activeCourse.toString()])
+            return isStaff(enrollmentsByCourseID[activeCourse.toString()])
+        }
+        return false
+    }),
+    isAssistant: derived(({ enrollmentsByCourseID, activeCourse }: State) => {
+        if (activeCourse > 0 && enrollmentsByCourseID[activeCourse.toString()]) {
+            return isAssistant(enrollmentsByCourseID[activeCourse.toString()])
         }
         return false
     }),
@@ -243,7 +257,7 @@ export const state: State = {
         }
         const teachersMap: { [userID: string]: User } = {}
         courseEnrollments[activeCourse.toString()].forEach(enrollment => {
-            if (isTeacher(enrollment) && enrollment.user) {
+            if (isStaff(enrollment) && enrollment.user) {
                 teachersMap[enrollment.userID.toString()] = enrollment.user
             }
         })
@@ -282,7 +296,7 @@ export const state: State = {
             switch (filter) {
                 case "teachers":
                     filtered = filtered.filter(el => {
-                        return el.status !== Enrollment_UserStatus.TEACHER
+                        return el.status !== Enrollment_UserStatus.TEACHER && el.status !== Enrollment_UserStatus.ASSISTANT
                     })
                     break
                 case "approved":
@@ -1,6 +1,6 @@
 import React, { useEffect } from "react"
 import { Redirect } from "react-router"
-import { getCourseID, isEnrolled, isTeacher } from "../Helpers"
+import { getCourseID, isEnrolled, isStaff } from "../Helpers"
 import { useActions, useAppState } from "../overmind"
 import StudentPage from "./StudentPage"
 import TeacherPage from "./TeacherPage"
@@ -22,7 +22,7 @@ const CoursePage = (): JSX.Element => {
     }, [courseID])
 
     if (state.enrollmentsByCourseID[courseID.toString()] && isEnrolled(enrollment)) {
-        if (isTeacher(enrollment)) {
+        if (isStaff(enrollment)) {
             return <TeacherPage />
         }
         return <StudentPage />
@@ -4,6 +4,7 @@ import { getCourseID } from "../Helpers"
 import Groups from "../components/Groups"
 import GroupComponent from "../components/group/Group"
 import GroupForm from "../components/group/GroupForm"
+import GroupInvitations from "../components/group/GroupInvitations"
 
 
 const GroupPage = (): JSX.Element => {
@@ -14,10 +15,12 @@ const GroupPage = (): JSX.Element => {
         return <Groups />
     }
 
-    if (!state.hasGroup(courseID.toString())) {
-        return <GroupForm />
-    }
-    return <GroupComponent />
+    return (
+        <div>
+            <GroupInvitations />
+            {state.hasGroup(courseID.toString()) ? <GroupComponent /> : <GroupForm />}
+        </div>
+    )
 }
 
 export default GroupPage
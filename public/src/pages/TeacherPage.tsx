@@ -13,7 +13,8 @@ import Alerts from "../components/alerts/Alerts"
 const ReviewResults = () => <Results review />
 const RegularResults = () => <Results review={false} />
 
-/* TeacherPage enables routes to be accessed by the teacher only, and displays an overview of the different features available to the teacher. */
+/* TeacherPage enables routes to be accessed by the teacher only, and displays an overview of the different features available to the teacher.
+ * Teaching assistants share the page, but cannot manage the course assignments. */
 const TeacherPage = (): JSX.Element => {
     const state = useAppState()
     const actions = useActions()
@@ -48,15 +49,15 @@ const TeacherPage = (): JSX.Element => {
                 <Card {...results} />
                 <Card {...groups} />
                 <Card {...members} />
-                <Card {...assignments} />
-                <Card {...updateAssignments} />
+                {state.isAssistant ? null : <Card {...assignments} />}
+                {state.isAssistant ? null : <Card {...updateAssignments} />}
             </div>
             <Switch>
                 <Route path={`/course/:id/groups`} exact component={GroupPage} />
                 <Route path={"/course/:id/members"} component={Members} />
                 <Route path={"/course/:id/review"} component={ReviewResults} />
                 <Route path={"/course/:id/results"} component={RegularResults} />
-                <Route path={"/course/:id/assignments"} component={Assignments} />
+                {state.isAssistant ? null : <Route path={"/course/:id/assignments"} component={Assignments} />}
             </Switch>
         </div>
     )
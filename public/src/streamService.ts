@@ -1,8 +1,13 @@
 import { QuickFeedService } from '../proto/qf/quickfeed_connectweb'
-import { Submission } from '../proto/qf/types_pb'
+import { GroupInvitation, Submission } from '../proto/qf/types_pb'
 import { Code, createConnectTransport, createPromiseClient, PromiseClient } from "@bufbuild/connect-web"
 import { ConnStatus } from './Helpers'
 
+type StreamOptions<T> = {
+    onMessage: (payload?: T | undefined) => void,
+    onError: (error: Error) => void
+    onStatusChange: (status: ConnStatus) => void
+}
 
 export class StreamService {
     private service: PromiseClient<typeof QuickFeedService>
@@ -17,12 +22,17 @@ export class StreamService {
         return new Promise(resolve => setTimeout(resolve, this.backoff))
     }
 
-    public async submissionStream(options: {
-        onMessage: (payload?: Submission | undefined) => void,
-        onError: (error: Error) => void
-        onStatusChange: (status: ConnStatus) => void
-    }) {
-        const stream = this.service.submissionStream({})
+    public async submissionStream(options: StreamOptions<Submission>) {
+        this.run(() => this.service.submissionStream({}), options)
+    }
+
+    public async groupInvitationStream(options: StreamOptions<GroupInvitation>) {
+        this.run(() => this.service.groupInvitationStream({}), options)
+    }
+
+    // run passes the messages of the stream opened by open to options, and reopens the stream if the connection fails
+    private async run<T>(open: () => AsyncIterable<T>, options: StreamOptions<T>) {
+        const stream = open()
         try {
             options.onStatusChange(ConnStatus.CONNECTED)
             for await (const msg of stream) {
@@ -43,7 +53,7 @@ export class StreamService {
                 // Attempt to reconnect after a backoff
                 options.onStatusChange(ConnStatus.RECONNECTING)
                 await this.timeout()
-                this.submissionStream(options)
+                this.run(open, options)
                 this.backoff *= 2
             } else {
                 this.backoff = 1000
@@ -0,0 +1,55 @@
+package qf
+
+// Anonymize replaces the user and group IDs of the submission with its pseudonym,
+// and removes the user IDs from the submission's grades and the build log,
+// which may contain the students' logins and paths.
+// Submissions without a pseudonym are not changed.
+func (s *Submission) Anonymize() {
+	if s.GetPseudonym() == 0 {
+		return
+	}
+	if s.GetUserID() > 0 {
+		s.UserID = s.GetPseudonym()
+	}
+	if s.GetGroupID() > 0 {
+		s.GroupID = s.GetPseudonym()
+	}
+	s.CommitHash = ""
+	if s.GetBuildInfo() != nil {
+		s.BuildInfo.BuildLog = ""
+	}
+	for _, grade := range s.GetGrades() {
+		grade.UserID = 0
+	}
+}
+
+// Anonymize anonymizes every submission with a pseudonym.
+func (s *Submissions) Anonymize() {
+	for _, submission := range s.GetSubmissions() {
+		submission.Anonymize()
+	}
+}
+
+// Anonymize anonymizes every submission with a pseudonym, and moves it from the
+// entry of its enrollment or group to a separate entry keyed by its pseudonym.
+func (s *CourseSubmissions) Anonymize() {
+	anonymous := make(map[uint64]*Submissions)
+	for _, submissions := range s.GetSubmissions() {
+		var kept []*Submission
+		for _, submission := range submissions.GetSubmissions() {
+			if submission.GetPseudonym() == 0 {
+				kept = append(kept, submission)
+				continue
+			}
+			submission.Anonymize()
+			if _, ok := anonymous[submission.GetPseudonym()]; !ok {
+				anonymous[submission.GetPseudonym()] = &Submissions{}
+			}
+			anonymous[submission.GetPseudonym()].Submissions = append(anonymous[submission.GetPseudonym()].Submissions, submission)
+		}
+		submissions.Submissions = kept
+	}
+	for pseudonym, submissions := range anonymous {
+		s.Submissions[pseudonym] = submissions
+	}
+}
@@ -0,0 +1,46 @@
+package qf_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestCourseSubmissionsAnonymize(t *testing.T) {
+	const pseudonym = 1<<63 | 42
+	courseSubmissions := &qf.CourseSubmissions{
+		Submissions: map[uint64]*qf.Submissions{
+			1: {Submissions: []*qf.Submission{
+				{ID: 1, AssignmentID: 1, UserID: 2, CommitHash: "abc", Grades: []*qf.Grade{{SubmissionID: 1, UserID: 2}}},
+				{ID: 2, AssignmentID: 2, UserID: 2, CommitHash: "def", Grades: []*qf.Grade{{SubmissionID: 2, UserID: 2}}, Pseudonym: pseudonym},
+			}},
+		},
+	}
+	want := &qf.CourseSubmissions{
+		Submissions: map[uint64]*qf.Submissions{
+			1: {Submissions: []*qf.Submission{
+				{ID: 1, AssignmentID: 1, UserID: 2, CommitHash: "abc", Grades: []*qf.Grade{{SubmissionID: 1, UserID: 2}}},
+			}},
+			pseudonym: {Submissions: []*qf.Submission{
+				{ID: 2, AssignmentID: 2, UserID: pseudonym, Grades: []*qf.Grade{{SubmissionID: 2}}, Pseudonym: pseudonym},
+			}},
+		},
+	}
+	courseSubmissions.Anonymize()
+	if diff := cmp.Diff(want, courseSubmissions, protocmp.Transform()); diff != "" {
+		t.Errorf("Anonymize() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSubmissionAnonymizeGroup(t *testing.T) {
+	const pseudonym = 1<<63 | 7
+	submission := &qf.Submission{ID: 1, GroupID: 3, Grades: []*qf.Grade{{UserID: 4}, {UserID: 5}}, Pseudonym: pseudonym, BuildInfo: &score.BuildInfo{BuildLog: "/quickfeed/alice-labs", ExecTime: 5}}
+	want := &qf.Submission{ID: 1, GroupID: pseudonym, Grades: []*qf.Grade{{}, {}}, Pseudonym: pseudonym, BuildInfo: &score.BuildInfo{ExecTime: 5}}
+	submission.Anonymize()
+	if diff := cmp.Diff(want, submission, protocmp.Transform()); diff != "" {
+		t.Errorf("Anonymize() mismatch (-want +got):\n%s", diff)
+	}
+}
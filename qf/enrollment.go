@@ -96,6 +96,16 @@ func (m *Enrollment) IsTeacher() bool {
 	return m.GetStatus() == Enrollment_TEACHER
 }
 
+// IsAssistant returns true if the enrolled user is a teaching assistant in the course.
+func (m *Enrollment) IsAssistant() bool {
+	return m.GetStatus() == Enrollment_ASSISTANT
+}
+
+// IsStaff returns true if the enrolled user is a teacher or a teaching assistant in the course.
+func (m *Enrollment) IsStaff() bool {
+	return m.IsTeacher() || m.IsAssistant()
+}
+
 // IsAdmin returns true if the enrolled user is an admin.
 func (m *Enrollment) IsAdmin() bool {
 	return m.GetUser().GetIsAdmin()
@@ -106,6 +116,15 @@ func (m *Enrollment) Name() string {
 	return m.GetUser().GetName()
 }
 
+// ExternalScores returns the enrollment's scores for external grade components, keyed by component name.
+func (m *Enrollment) ExternalScores() map[string]uint32 {
+	scores := make(map[string]uint32)
+	for _, grade := range m.GetExternalGrades() {
+		scores[grade.GetComponent()] = grade.GetScore()
+	}
+	return scores
+}
+
 // GetCourseID returns the course ID for a slice of enrollments
 func (m *Enrollments) GetCourseID() uint64 {
 	enrollments := m.GetEnrollments()
@@ -0,0 +1,46 @@
+package qf
+
+import (
+	"crypto/subtle"
+	"strings"
+	"time"
+)
+
+// IsOpen returns true if the policy allows enrolling at the given time.
+func (p *EnrollmentPolicy) IsOpen(now time.Time) bool {
+	if p.GetOpens() != nil && now.Before(p.GetOpens().AsTime()) {
+		return false
+	}
+	return p.GetCloses() == nil || now.Before(p.GetCloses().AsTime())
+}
+
+// IsJoinCode returns true if the policy has no join code, or if the given code is the join code.
+func (p *EnrollmentPolicy) IsJoinCode(code string) bool {
+	if p.GetJoinCode() == "" {
+		return true
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(code)), []byte(p.GetJoinCode())) == 1
+}
+
+// ApprovesEmail returns true if the user has a verified email in one of the policy's email domains.
+func (p *EnrollmentPolicy) ApprovesEmail(user *User) bool {
+	if !user.GetEmailVerified() {
+		return false
+	}
+	_, domain, found := strings.Cut(user.GetEmail(), "@")
+	if !found {
+		return false
+	}
+	for _, d := range p.GetEmailDomains() {
+		if strings.EqualFold(domain, strings.TrimPrefix(d, "@")) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFull returns true if the policy has a capacity, and the given number
+// of students and pending enrollments has reached the capacity.
+func (p *EnrollmentPolicy) IsFull(seats int) bool {
+	return p.GetCapacity() > 0 && seats >= int(p.GetCapacity())
+}
@@ -0,0 +1,69 @@
+package qf_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEnrollmentPolicyIsOpen(t *testing.T) {
+	opens := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	closes := time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)
+	window := &qf.EnrollmentPolicy{Opens: timestamppb.New(opens), Closes: timestamppb.New(closes)}
+	tests := []struct {
+		name   string
+		policy *qf.EnrollmentPolicy
+		now    time.Time
+		want   bool
+	}{
+		{name: "no policy", policy: nil, now: opens, want: true},
+		{name: "no window", policy: &qf.EnrollmentPolicy{}, now: opens, want: true},
+		{name: "before window", policy: window, now: opens.Add(-time.Second), want: false},
+		{name: "window opens", policy: window, now: opens, want: true},
+		{name: "window closes", policy: window, now: closes, want: false},
+		{name: "no closing time", policy: &qf.EnrollmentPolicy{Opens: timestamppb.New(opens)}, now: closes, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.IsOpen(tt.now); got != tt.want {
+				t.Errorf("IsOpen(%v) = %t, want %t", tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnrollmentPolicyApprovesEmail(t *testing.T) {
+	policy := &qf.EnrollmentPolicy{EmailDomains: []string{"uis.no", "@stud.uis.no"}}
+	tests := []struct {
+		user *qf.User
+		want bool
+	}{
+		{user: &qf.User{Email: "alice@uis.no", EmailVerified: true}, want: true},
+		{user: &qf.User{Email: "bob@Stud.UiS.no", EmailVerified: true}, want: true},
+		{user: &qf.User{Email: "alice@uis.no", EmailVerified: false}, want: false},
+		{user: &qf.User{Email: "mallory@evil-uis.no", EmailVerified: true}, want: false},
+		{user: &qf.User{Email: "mallory@uis.no.example.com", EmailVerified: true}, want: false},
+		{user: &qf.User{Email: "uis.no", EmailVerified: true}, want: false},
+	}
+	for _, tt := range tests {
+		if got := policy.ApprovesEmail(tt.user); got != tt.want {
+			t.Errorf("ApprovesEmail(%q, verified=%t) = %t, want %t", tt.user.GetEmail(), tt.user.GetEmailVerified(), got, tt.want)
+		}
+	}
+}
+
+func TestEnrollmentPolicyJoinCodeAndCapacity(t *testing.T) {
+	var none *qf.EnrollmentPolicy
+	if !none.IsJoinCode("") || none.IsFull(1000) {
+		t.Error("nil policy must accept any join code and have unlimited capacity")
+	}
+	policy := &qf.EnrollmentPolicy{JoinCode: "secret", Capacity: 2}
+	if policy.IsJoinCode("") || policy.IsJoinCode("Secret") || !policy.IsJoinCode(" secret ") {
+		t.Error("IsJoinCode() must only accept the join code")
+	}
+	if policy.IsFull(1) || !policy.IsFull(2) {
+		t.Error("IsFull() must be true when the capacity is reached")
+	}
+}
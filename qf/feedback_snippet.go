@@ -0,0 +1,49 @@
+package qf
+
+import (
+	"sort"
+	"strings"
+)
+
+// HasTag returns true if the snippet has the given tag. Tags are matched ignoring case.
+func (s *FeedbackSnippet) HasTag(tag string) bool {
+	tag = strings.TrimSpace(tag)
+	for _, t := range s.GetTags() {
+		if strings.EqualFold(strings.TrimSpace(t), tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// UsageCount returns the number of times the snippet has been used in the given assignment.
+func (s *FeedbackSnippet) UsageCount(assignmentID uint64) uint32 {
+	for _, usage := range s.GetUsage() {
+		if usage.GetAssignmentID() == assignmentID {
+			return usage.GetCount()
+		}
+	}
+	return 0
+}
+
+// FilterByTag removes the snippets that do not have the given tag.
+func (s *FeedbackSnippets) FilterByTag(tag string) {
+	if tag == "" {
+		return
+	}
+	var snippets []*FeedbackSnippet
+	for _, snippet := range s.GetSnippets() {
+		if snippet.HasTag(tag) {
+			snippets = append(snippets, snippet)
+		}
+	}
+	s.Snippets = snippets
+}
+
+// SortByUsage sorts the snippets by their number of uses in the given assignment, most used first.
+// Snippets with the same number of uses keep their relative order.
+func (s *FeedbackSnippets) SortByUsage(assignmentID uint64) {
+	sort.SliceStable(s.Snippets, func(i, j int) bool {
+		return s.Snippets[i].UsageCount(assignmentID) > s.Snippets[j].UsageCount(assignmentID)
+	})
+}
@@ -0,0 +1,64 @@
+package qf_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestFeedbackSnippetsFilterByTag(t *testing.T) {
+	errorHandling := &qf.FeedbackSnippet{ID: 1, Body: "Missing error handling.", Tags: []string{"errors", "Style"}}
+	goroutineLeak := &qf.FeedbackSnippet{ID: 2, Body: "Goroutine leak.", Tags: []string{"concurrency"}}
+	noTags := &qf.FeedbackSnippet{ID: 3, Body: "Nice work!"}
+	all := []*qf.FeedbackSnippet{errorHandling, goroutineLeak, noTags}
+
+	tests := []struct {
+		tag  string
+		want []*qf.FeedbackSnippet
+	}{
+		{tag: "", want: all},
+		{tag: "errors", want: []*qf.FeedbackSnippet{errorHandling}},
+		{tag: "style", want: []*qf.FeedbackSnippet{errorHandling}},
+		{tag: " Concurrency ", want: []*qf.FeedbackSnippet{goroutineLeak}},
+		{tag: "performance", want: nil},
+	}
+	for _, tt := range tests {
+		snippets := &qf.FeedbackSnippets{Snippets: all}
+		snippets.FilterByTag(tt.tag)
+		if diff := cmp.Diff(tt.want, snippets.GetSnippets(), protocmp.Transform()); diff != "" {
+			t.Errorf("FilterByTag(%q) mismatch (-want +got):\n%s", tt.tag, diff)
+		}
+	}
+}
+
+func TestFeedbackSnippetsSortByUsage(t *testing.T) {
+	usage := func(counts map[uint64]uint32) []*qf.FeedbackSnippetUsage {
+		var u []*qf.FeedbackSnippetUsage
+		for assignmentID, count := range counts {
+			u = append(u, &qf.FeedbackSnippetUsage{AssignmentID: assignmentID, Count: count})
+		}
+		return u
+	}
+	a := &qf.FeedbackSnippet{ID: 1, Usage: usage(map[uint64]uint32{1: 2, 2: 9})}
+	b := &qf.FeedbackSnippet{ID: 2, Usage: usage(map[uint64]uint32{1: 5})}
+	c := &qf.FeedbackSnippet{ID: 3}
+	d := &qf.FeedbackSnippet{ID: 4, Usage: usage(map[uint64]uint32{1: 2})}
+
+	tests := []struct {
+		assignmentID uint64
+		want         []*qf.FeedbackSnippet
+	}{
+		{assignmentID: 1, want: []*qf.FeedbackSnippet{b, a, d, c}},
+		{assignmentID: 2, want: []*qf.FeedbackSnippet{a, b, c, d}},
+		{assignmentID: 3, want: []*qf.FeedbackSnippet{a, b, c, d}},
+	}
+	for _, tt := range tests {
+		snippets := &qf.FeedbackSnippets{Snippets: []*qf.FeedbackSnippet{a, b, c, d}}
+		snippets.SortByUsage(tt.assignmentID)
+		if diff := cmp.Diff(tt.want, snippets.GetSnippets(), protocmp.Transform()); diff != "" {
+			t.Errorf("SortByUsage(%d) mismatch (-want +got):\n%s", tt.assignmentID, diff)
+		}
+	}
+}
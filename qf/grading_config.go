@@ -0,0 +1,152 @@
+package qf
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+)
+
+// Scheme returns the configuration's letter grading scheme, or nil if no letter grades are configured.
+func (c *GradingConfig) Scheme() *score.GradingScheme {
+	if len(c.GetGradeNames()) == 0 {
+		return nil
+	}
+	return &score.GradingScheme{
+		Name:        c.GetSchemeName(),
+		GradePoints: c.GetGradePoints(),
+		GradeNames:  c.GetGradeNames(),
+	}
+}
+
+// IsValidScheme returns true if the configuration has no letter grades, or if it has a point
+// threshold for each letter grade, with the thresholds in descending order between 0 and 100.
+func (c *GradingConfig) IsValidScheme() bool {
+	points, names := c.GetGradePoints(), c.GetGradeNames()
+	if len(names) != len(points) {
+		return false
+	}
+	for i, p := range points {
+		if p > 100 || (i > 0 && p >= points[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasPassRequirement returns true if students must have a minimum number of approved assignments,
+// or must have specific assignments approved, to pass. Without any requirement, every student passes.
+func (c *GradingConfig) HasPassRequirement() bool {
+	if c.GetMinApproved() > 0 {
+		return true
+	}
+	for _, w := range c.GetWeights() {
+		if w.GetRequired() {
+			return true
+		}
+	}
+	return false
+}
+
+// weightFor returns the weight of the given assignment and whether it must be approved to pass.
+func (c *GradingConfig) weightFor(assignmentID uint64) (weight uint32, required bool) {
+	for _, w := range c.GetWeights() {
+		if w.GetAssignmentID() == assignmentID {
+			return w.GetWeight(), w.GetRequired()
+		}
+	}
+	return 1, false
+}
+
+// FinalGrade computes the enrollment's final grade from the given course assignments, the enrollment's
+// submissions, and the enrollment's scores for the external components, keyed by component name.
+// The points are the weighted average of the assignment and external component scores, rounded to
+// the nearest integer. The computation only depends on its arguments, such that the same grade is
+// computed for the same configuration, assignments, submissions and external scores.
+func (c *GradingConfig) FinalGrade(enrollment *Enrollment, assignments []*Assignment, submissions []*Submission, external map[string]uint32) *FinalGrade {
+	assignments = slices.Clone(assignments)
+	slices.SortFunc(assignments, func(a, b *Assignment) int {
+		if a.GetOrder() != b.GetOrder() {
+			return cmp.Compare(a.GetOrder(), b.GetOrder())
+		}
+		return cmp.Compare(a.GetID(), b.GetID())
+	})
+	grade := &FinalGrade{
+		EnrollmentID: enrollment.GetID(),
+		UserID:       enrollment.GetUserID(),
+		Passed:       true,
+	}
+	var total, totalWeight uint64
+	for _, assignment := range assignments {
+		weight, required := c.weightFor(assignment.GetID())
+		part := &GradePart{
+			AssignmentID: assignment.GetID(),
+			Name:         assignment.GetName(),
+			Weight:       weight,
+			Required:     required,
+		}
+		if submission := bestSubmission(enrollment.GetUserID(), assignment.GetID(), submissions); submission != nil {
+			part.Score = submission.GetScore()
+			part.Approved = submission.IsApproved(enrollment.GetUserID())
+		}
+		if part.GetApproved() {
+			grade.Approved++
+		} else if required {
+			grade.Passed = false
+		}
+		total += uint64(part.GetWeight()) * uint64(part.GetScore())
+		totalWeight += uint64(part.GetWeight())
+		grade.Parts = append(grade.Parts, part)
+	}
+	for _, component := range c.GetComponents() {
+		part := &GradePart{
+			Name:   component.GetName(),
+			Weight: component.GetWeight(),
+			Score:  min(external[component.GetName()], 100),
+		}
+		total += uint64(part.GetWeight()) * uint64(part.GetScore())
+		totalWeight += uint64(part.GetWeight())
+		grade.Parts = append(grade.Parts, part)
+	}
+	if grade.GetApproved() < c.GetMinApproved() {
+		grade.Passed = false
+	}
+	if totalWeight > 0 {
+		grade.Points = uint32((total + totalWeight/2) / totalWeight)
+	}
+	if scheme := c.Scheme(); scheme != nil {
+		if grade.GetPassed() {
+			grade.Grade = scheme.Grade(grade.GetPoints())
+		} else {
+			grade.Grade = scheme.GradeNames[len(scheme.GradeNames)-1]
+		}
+	}
+	return grade
+}
+
+// bestSubmission returns the user's submission for the given assignment, preferring
+// an approved submission over an unapproved one, and a higher score over a lower one.
+// There may be more than one submission for an assignment, e.g., if the user has
+// submitted both individually and as a group member.
+func bestSubmission(userID, assignmentID uint64, submissions []*Submission) *Submission {
+	var best *Submission
+	for _, submission := range submissions {
+		if submission.GetAssignmentID() != assignmentID {
+			continue
+		}
+		if best == nil {
+			best = submission
+			continue
+		}
+		approved, bestApproved := submission.IsApproved(userID), best.IsApproved(userID)
+		switch {
+		case approved && !bestApproved:
+			best = submission
+		case approved == bestApproved && submission.GetScore() > best.GetScore():
+			best = submission
+		case approved == bestApproved && submission.GetScore() == best.GetScore() && submission.GetID() < best.GetID():
+			best = submission
+		}
+	}
+	return best
+}
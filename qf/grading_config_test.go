@@ -0,0 +1,137 @@
+package qf_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestGradingConfigFinalGrade(t *testing.T) {
+	const userID = 1
+	enrollment := &qf.Enrollment{ID: 10, UserID: userID}
+	assignments := []*qf.Assignment{
+		{ID: 2, Name: "lab2", Order: 2},
+		{ID: 1, Name: "lab1", Order: 1},
+		{ID: 3, Name: "lab3", Order: 3},
+	}
+	approved := func(id, assignmentID uint64, score uint32) *qf.Submission {
+		return &qf.Submission{ID: id, AssignmentID: assignmentID, UserID: userID, Score: score, Grades: []*qf.Grade{{UserID: userID, Status: qf.Submission_APPROVED}}}
+	}
+	rejected := func(id, assignmentID uint64, score uint32) *qf.Submission {
+		return &qf.Submission{ID: id, AssignmentID: assignmentID, UserID: userID, Score: score, Grades: []*qf.Grade{{UserID: userID, Status: qf.Submission_REJECTED}}}
+	}
+	letters := &qf.GradingConfig{
+		Weights: []*qf.AssignmentWeight{
+			{AssignmentID: 1, Weight: 1},
+			{AssignmentID: 2, Weight: 2, Required: true},
+			{AssignmentID: 3, Weight: 0},
+		},
+		MinApproved: 2,
+		Components:  []*qf.ExternalComponent{{Name: "exam", Weight: 3}},
+		GradePoints: []uint32{90, 80, 60, 0},
+		GradeNames:  []string{"A", "B", "C", "F"},
+	}
+
+	tests := []struct {
+		name        string
+		config      *qf.GradingConfig
+		submissions []*qf.Submission
+		external    map[string]uint32
+		want        *qf.FinalGrade
+	}{
+		{
+			name:        "no configuration",
+			config:      &qf.GradingConfig{},
+			submissions: []*qf.Submission{approved(1, 1, 80), rejected(2, 2, 40)},
+			want: &qf.FinalGrade{EnrollmentID: 10, UserID: userID, Points: 40, Approved: 1, Passed: true, Parts: []*qf.GradePart{
+				{AssignmentID: 1, Name: "lab1", Weight: 1, Score: 80, Approved: true},
+				{AssignmentID: 2, Name: "lab2", Weight: 1, Score: 40},
+				{AssignmentID: 3, Name: "lab3", Weight: 1},
+			}},
+		},
+		{
+			name:   "letter grade",
+			config: letters,
+			// the approved group submission is preferred over the individual submission
+			submissions: []*qf.Submission{approved(1, 1, 70), rejected(2, 2, 95), approved(3, 2, 90), approved(4, 3, 10)},
+			external:    map[string]uint32{"exam": 85},
+			want: &qf.FinalGrade{EnrollmentID: 10, UserID: userID, Points: 84, Approved: 3, Passed: true, Grade: "B", Parts: []*qf.GradePart{
+				{AssignmentID: 1, Name: "lab1", Weight: 1, Score: 70, Approved: true},
+				{AssignmentID: 2, Name: "lab2", Weight: 2, Score: 90, Approved: true, Required: true},
+				{AssignmentID: 3, Name: "lab3", Weight: 0, Score: 10, Approved: true},
+				{Name: "exam", Weight: 3, Score: 85},
+			}},
+		},
+		{
+			name:        "required assignment not approved",
+			config:      letters,
+			submissions: []*qf.Submission{approved(1, 1, 100), rejected(2, 2, 100), approved(4, 3, 100)},
+			external:    map[string]uint32{"exam": 100},
+			want: &qf.FinalGrade{EnrollmentID: 10, UserID: userID, Points: 100, Approved: 2, Passed: false, Grade: "F", Parts: []*qf.GradePart{
+				{AssignmentID: 1, Name: "lab1", Weight: 1, Score: 100, Approved: true},
+				{AssignmentID: 2, Name: "lab2", Weight: 2, Score: 100, Required: true},
+				{AssignmentID: 3, Name: "lab3", Weight: 0, Score: 100, Approved: true},
+				{Name: "exam", Weight: 3, Score: 100},
+			}},
+		},
+		{
+			name:        "too few approved assignments",
+			config:      letters,
+			submissions: []*qf.Submission{approved(3, 2, 90)},
+			want: &qf.FinalGrade{EnrollmentID: 10, UserID: userID, Points: 30, Approved: 1, Passed: false, Grade: "F", Parts: []*qf.GradePart{
+				{AssignmentID: 1, Name: "lab1", Weight: 1},
+				{AssignmentID: 2, Name: "lab2", Weight: 2, Score: 90, Approved: true, Required: true},
+				{AssignmentID: 3, Name: "lab3", Weight: 0},
+				{Name: "exam", Weight: 3},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.config.FinalGrade(enrollment, assignments, tt.submissions, tt.external)
+			if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("FinalGrade() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGradingConfigIsValidScheme(t *testing.T) {
+	tests := []struct {
+		points []uint32
+		names  []string
+		want   bool
+	}{
+		{points: nil, names: nil, want: true},
+		{points: []uint32{60, 0}, names: []string{"Pass", "Fail"}, want: true},
+		{points: []uint32{90, 80, 0}, names: []string{"A", "B", "F"}, want: true},
+		{points: []uint32{60}, names: []string{"Pass", "Fail"}, want: false},
+		{points: []uint32{60, 90}, names: []string{"B", "A"}, want: false},
+		{points: []uint32{101, 0}, names: []string{"A", "F"}, want: false},
+	}
+	for _, tt := range tests {
+		config := &qf.GradingConfig{GradePoints: tt.points, GradeNames: tt.names}
+		if got := config.IsValidScheme(); got != tt.want {
+			t.Errorf("IsValidScheme(%v, %v) = %t, want %t", tt.points, tt.names, got, tt.want)
+		}
+	}
+}
+
+func TestGradingConfigHasPassRequirement(t *testing.T) {
+	tests := []struct {
+		config *qf.GradingConfig
+		want   bool
+	}{
+		{config: &qf.GradingConfig{}, want: false},
+		{config: &qf.GradingConfig{Weights: []*qf.AssignmentWeight{{AssignmentID: 1, Weight: 2}}}, want: false},
+		{config: &qf.GradingConfig{MinApproved: 1}, want: true},
+		{config: &qf.GradingConfig{Weights: []*qf.AssignmentWeight{{AssignmentID: 1, Weight: 2, Required: true}}}, want: true},
+	}
+	for _, tt := range tests {
+		if got := tt.config.HasPassRequirement(); got != tt.want {
+			t.Errorf("HasPassRequirement(%v) = %t, want %t", tt.config, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package qf
+
+import "time"
+
+// IsExpired returns true if the invitation is pending, and has expired at the given time.
+func (i *GroupInvitation) IsExpired(now time.Time) bool {
+	return i.GetStatus() == GroupInvitation_PENDING && i.GetExpires() != nil && !now.Before(i.GetExpires().AsTime())
+}
+
+// UserIDs returns the IDs of the invited user and the inviter, who are notified when the invitation changes.
+func (i *GroupInvitation) UserIDs() []uint64 {
+	return []uint64{i.GetUserID(), i.GetInviterID()}
+}
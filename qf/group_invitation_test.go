@@ -0,0 +1,31 @@
+package qf_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGroupInvitationIsExpired(t *testing.T) {
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		invitation *qf.GroupInvitation
+		want       bool
+	}{
+		{name: "NoExpiry", invitation: &qf.GroupInvitation{}, want: false},
+		{name: "NotYetExpired", invitation: &qf.GroupInvitation{Expires: timestamppb.New(now.Add(time.Minute))}, want: false},
+		{name: "ExpiresNow", invitation: &qf.GroupInvitation{Expires: timestamppb.New(now)}, want: true},
+		{name: "Expired", invitation: &qf.GroupInvitation{Expires: timestamppb.New(now.Add(-time.Minute))}, want: true},
+		{name: "AcceptedBeforeExpiry", invitation: &qf.GroupInvitation{Status: qf.GroupInvitation_ACCEPTED, Expires: timestamppb.New(now.Add(-time.Minute))}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.invitation.IsExpired(now); got != tt.want {
+				t.Errorf("IsExpired() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
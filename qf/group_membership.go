@@ -0,0 +1,11 @@
+package qf
+
+import "time"
+
+// IsMemberAt returns true if the student was a member of the group at the given time.
+func (m *GroupMembership) IsMemberAt(t time.Time) bool {
+	if m.GetJoined() == nil || t.Before(m.GetJoined().AsTime()) {
+		return false
+	}
+	return m.GetLeft() == nil || t.Before(m.GetLeft().AsTime())
+}
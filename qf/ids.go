@@ -106,8 +106,12 @@ func (r *RepositoryRequest) IDFor(_ string) uint64 {
 	return r.GetCourseID()
 }
 
-// IDFor returns course ID.
-func (r *ReviewRequest) IDFor(_ string) uint64 {
+// IDFor returns submission ID, or course ID.
+func (r *ReviewRequest) IDFor(role string) uint64 {
+	switch role {
+	case "submission":
+		return r.GetReview().GetSubmissionID()
+	}
 	return r.GetCourseID()
 }
 
@@ -125,3 +129,136 @@ func (r *GradingCriterion) IDFor(_ string) uint64 {
 func (*Organization) IDFor(_ string) uint64 {
 	return 0
 }
+
+// IDFor returns course ID.
+func (r *ReconcileRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *ReviewAllocationRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *PeerReviewRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *PeerReview) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *QuizRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *QuizSubmission) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *LineCommentRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *LineComment) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *FeedbackSnippetRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *FeedbackSnippet) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *FeedbackSnippetUsageRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *RegradeRequestQuery) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns requester or course ID.
+func (r *RegradeRequest) IDFor(role string) uint64 {
+	switch role {
+	case "user":
+		return r.GetRequesterID()
+	case "course":
+		return r.GetCourseID()
+	}
+	return 0
+}
+
+// IDFor returns course ID.
+func (r *ScheduledJob) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *GradingConfig) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *GradeExportRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *ExternalGradeImportRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *RosterImportRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *GroupFormationRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *CreateGroupsRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns course ID.
+func (r *ContributionRequest) IDFor(_ string) uint64 {
+	return r.GetCourseID()
+}
+
+// IDFor returns user or course ID.
+func (r *GroupPreference) IDFor(role string) uint64 {
+	switch role {
+	case "user":
+		return r.GetUserID()
+	case "course":
+		return r.GetCourseID()
+	}
+	return 0
+}
+
+// IDFor returns user or course ID.
+func (r *GroupInvitation) IDFor(role string) uint64 {
+	switch role {
+	case "user":
+		return r.GetUserID()
+	case "course":
+		return r.GetCourseID()
+	}
+	return 0
+}
@@ -57,6 +57,30 @@ const (
 	// QuickFeedServiceDeleteGroupProcedure is the fully-qualified name of the QuickFeedService's
 	// DeleteGroup RPC.
 	QuickFeedServiceDeleteGroupProcedure = "/qf.QuickFeedService/DeleteGroup"
+	// QuickFeedServiceProposeGroupsProcedure is the fully-qualified name of the QuickFeedService's
+	// ProposeGroups RPC.
+	QuickFeedServiceProposeGroupsProcedure = "/qf.QuickFeedService/ProposeGroups"
+	// QuickFeedServiceCreateGroupsProcedure is the fully-qualified name of the QuickFeedService's
+	// CreateGroups RPC.
+	QuickFeedServiceCreateGroupsProcedure = "/qf.QuickFeedService/CreateGroups"
+	// QuickFeedServiceGetGroupPreferenceProcedure is the fully-qualified name of the QuickFeedService's
+	// GetGroupPreference RPC.
+	QuickFeedServiceGetGroupPreferenceProcedure = "/qf.QuickFeedService/GetGroupPreference"
+	// QuickFeedServiceUpdateGroupPreferenceProcedure is the fully-qualified name of the
+	// QuickFeedService's UpdateGroupPreference RPC.
+	QuickFeedServiceUpdateGroupPreferenceProcedure = "/qf.QuickFeedService/UpdateGroupPreference"
+	// QuickFeedServiceGetGroupInvitationsProcedure is the fully-qualified name of the
+	// QuickFeedService's GetGroupInvitations RPC.
+	QuickFeedServiceGetGroupInvitationsProcedure = "/qf.QuickFeedService/GetGroupInvitations"
+	// QuickFeedServiceRespondToGroupInvitationProcedure is the fully-qualified name of the
+	// QuickFeedService's RespondToGroupInvitation RPC.
+	QuickFeedServiceRespondToGroupInvitationProcedure = "/qf.QuickFeedService/RespondToGroupInvitation"
+	// QuickFeedServiceGetGroupMembershipsProcedure is the fully-qualified name of the
+	// QuickFeedService's GetGroupMemberships RPC.
+	QuickFeedServiceGetGroupMembershipsProcedure = "/qf.QuickFeedService/GetGroupMemberships"
+	// QuickFeedServiceGetGroupContributionsProcedure is the fully-qualified name of the
+	// QuickFeedService's GetGroupContributions RPC.
+	QuickFeedServiceGetGroupContributionsProcedure = "/qf.QuickFeedService/GetGroupContributions"
 	// QuickFeedServiceGetCourseProcedure is the fully-qualified name of the QuickFeedService's
 	// GetCourse RPC.
 	QuickFeedServiceGetCourseProcedure = "/qf.QuickFeedService/GetCourse"
@@ -69,6 +93,21 @@ const (
 	// QuickFeedServiceUpdateCourseVisibilityProcedure is the fully-qualified name of the
 	// QuickFeedService's UpdateCourseVisibility RPC.
 	QuickFeedServiceUpdateCourseVisibilityProcedure = "/qf.QuickFeedService/UpdateCourseVisibility"
+	// QuickFeedServiceGetGradingConfigProcedure is the fully-qualified name of the QuickFeedService's
+	// GetGradingConfig RPC.
+	QuickFeedServiceGetGradingConfigProcedure = "/qf.QuickFeedService/GetGradingConfig"
+	// QuickFeedServiceUpdateGradingConfigProcedure is the fully-qualified name of the
+	// QuickFeedService's UpdateGradingConfig RPC.
+	QuickFeedServiceUpdateGradingConfigProcedure = "/qf.QuickFeedService/UpdateGradingConfig"
+	// QuickFeedServiceComputeFinalGradesProcedure is the fully-qualified name of the QuickFeedService's
+	// ComputeFinalGrades RPC.
+	QuickFeedServiceComputeFinalGradesProcedure = "/qf.QuickFeedService/ComputeFinalGrades"
+	// QuickFeedServiceExportGradesProcedure is the fully-qualified name of the QuickFeedService's
+	// ExportGrades RPC.
+	QuickFeedServiceExportGradesProcedure = "/qf.QuickFeedService/ExportGrades"
+	// QuickFeedServiceImportExternalGradesProcedure is the fully-qualified name of the
+	// QuickFeedService's ImportExternalGrades RPC.
+	QuickFeedServiceImportExternalGradesProcedure = "/qf.QuickFeedService/ImportExternalGrades"
 	// QuickFeedServiceGetAssignmentsProcedure is the fully-qualified name of the QuickFeedService's
 	// GetAssignments RPC.
 	QuickFeedServiceGetAssignmentsProcedure = "/qf.QuickFeedService/GetAssignments"
@@ -84,6 +123,15 @@ const (
 	// QuickFeedServiceUpdateEnrollmentsProcedure is the fully-qualified name of the QuickFeedService's
 	// UpdateEnrollments RPC.
 	QuickFeedServiceUpdateEnrollmentsProcedure = "/qf.QuickFeedService/UpdateEnrollments"
+	// QuickFeedServiceImportRosterProcedure is the fully-qualified name of the QuickFeedService's
+	// ImportRoster RPC.
+	QuickFeedServiceImportRosterProcedure = "/qf.QuickFeedService/ImportRoster"
+	// QuickFeedServiceGetRosterCoverageProcedure is the fully-qualified name of the QuickFeedService's
+	// GetRosterCoverage RPC.
+	QuickFeedServiceGetRosterCoverageProcedure = "/qf.QuickFeedService/GetRosterCoverage"
+	// QuickFeedServiceGetEnrollmentPolicyProcedure is the fully-qualified name of the
+	// QuickFeedService's GetEnrollmentPolicy RPC.
+	QuickFeedServiceGetEnrollmentPolicyProcedure = "/qf.QuickFeedService/GetEnrollmentPolicy"
 	// QuickFeedServiceGetSubmissionProcedure is the fully-qualified name of the QuickFeedService's
 	// GetSubmission RPC.
 	QuickFeedServiceGetSubmissionProcedure = "/qf.QuickFeedService/GetSubmission"
@@ -102,6 +150,18 @@ const (
 	// QuickFeedServiceRebuildSubmissionsProcedure is the fully-qualified name of the QuickFeedService's
 	// RebuildSubmissions RPC.
 	QuickFeedServiceRebuildSubmissionsProcedure = "/qf.QuickFeedService/RebuildSubmissions"
+	// QuickFeedServiceGetScheduledJobsProcedure is the fully-qualified name of the QuickFeedService's
+	// GetScheduledJobs RPC.
+	QuickFeedServiceGetScheduledJobsProcedure = "/qf.QuickFeedService/GetScheduledJobs"
+	// QuickFeedServiceScheduleJobProcedure is the fully-qualified name of the QuickFeedService's
+	// ScheduleJob RPC.
+	QuickFeedServiceScheduleJobProcedure = "/qf.QuickFeedService/ScheduleJob"
+	// QuickFeedServiceCancelScheduledJobProcedure is the fully-qualified name of the QuickFeedService's
+	// CancelScheduledJob RPC.
+	QuickFeedServiceCancelScheduledJobProcedure = "/qf.QuickFeedService/CancelScheduledJob"
+	// QuickFeedServiceGetAuditEntriesProcedure is the fully-qualified name of the QuickFeedService's
+	// GetAuditEntries RPC.
+	QuickFeedServiceGetAuditEntriesProcedure = "/qf.QuickFeedService/GetAuditEntries"
 	// QuickFeedServiceCreateBenchmarkProcedure is the fully-qualified name of the QuickFeedService's
 	// CreateBenchmark RPC.
 	QuickFeedServiceCreateBenchmarkProcedure = "/qf.QuickFeedService/CreateBenchmark"
@@ -126,6 +186,84 @@ const (
 	// QuickFeedServiceUpdateReviewProcedure is the fully-qualified name of the QuickFeedService's
 	// UpdateReview RPC.
 	QuickFeedServiceUpdateReviewProcedure = "/qf.QuickFeedService/UpdateReview"
+	// QuickFeedServiceGetLineCommentsProcedure is the fully-qualified name of the QuickFeedService's
+	// GetLineComments RPC.
+	QuickFeedServiceGetLineCommentsProcedure = "/qf.QuickFeedService/GetLineComments"
+	// QuickFeedServiceCreateLineCommentProcedure is the fully-qualified name of the QuickFeedService's
+	// CreateLineComment RPC.
+	QuickFeedServiceCreateLineCommentProcedure = "/qf.QuickFeedService/CreateLineComment"
+	// QuickFeedServiceUpdateLineCommentProcedure is the fully-qualified name of the QuickFeedService's
+	// UpdateLineComment RPC.
+	QuickFeedServiceUpdateLineCommentProcedure = "/qf.QuickFeedService/UpdateLineComment"
+	// QuickFeedServiceDeleteLineCommentProcedure is the fully-qualified name of the QuickFeedService's
+	// DeleteLineComment RPC.
+	QuickFeedServiceDeleteLineCommentProcedure = "/qf.QuickFeedService/DeleteLineComment"
+	// QuickFeedServiceGetFeedbackSnippetsProcedure is the fully-qualified name of the
+	// QuickFeedService's GetFeedbackSnippets RPC.
+	QuickFeedServiceGetFeedbackSnippetsProcedure = "/qf.QuickFeedService/GetFeedbackSnippets"
+	// QuickFeedServiceCreateFeedbackSnippetProcedure is the fully-qualified name of the
+	// QuickFeedService's CreateFeedbackSnippet RPC.
+	QuickFeedServiceCreateFeedbackSnippetProcedure = "/qf.QuickFeedService/CreateFeedbackSnippet"
+	// QuickFeedServiceUpdateFeedbackSnippetProcedure is the fully-qualified name of the
+	// QuickFeedService's UpdateFeedbackSnippet RPC.
+	QuickFeedServiceUpdateFeedbackSnippetProcedure = "/qf.QuickFeedService/UpdateFeedbackSnippet"
+	// QuickFeedServiceDeleteFeedbackSnippetProcedure is the fully-qualified name of the
+	// QuickFeedService's DeleteFeedbackSnippet RPC.
+	QuickFeedServiceDeleteFeedbackSnippetProcedure = "/qf.QuickFeedService/DeleteFeedbackSnippet"
+	// QuickFeedServiceUseFeedbackSnippetProcedure is the fully-qualified name of the QuickFeedService's
+	// UseFeedbackSnippet RPC.
+	QuickFeedServiceUseFeedbackSnippetProcedure = "/qf.QuickFeedService/UseFeedbackSnippet"
+	// QuickFeedServiceGetRegradeRequestsProcedure is the fully-qualified name of the QuickFeedService's
+	// GetRegradeRequests RPC.
+	QuickFeedServiceGetRegradeRequestsProcedure = "/qf.QuickFeedService/GetRegradeRequests"
+	// QuickFeedServiceCreateRegradeRequestProcedure is the fully-qualified name of the
+	// QuickFeedService's CreateRegradeRequest RPC.
+	QuickFeedServiceCreateRegradeRequestProcedure = "/qf.QuickFeedService/CreateRegradeRequest"
+	// QuickFeedServiceUpdateRegradeRequestProcedure is the fully-qualified name of the
+	// QuickFeedService's UpdateRegradeRequest RPC.
+	QuickFeedServiceUpdateRegradeRequestProcedure = "/qf.QuickFeedService/UpdateRegradeRequest"
+	// QuickFeedServiceGetReconciliationProcedure is the fully-qualified name of the QuickFeedService's
+	// GetReconciliation RPC.
+	QuickFeedServiceGetReconciliationProcedure = "/qf.QuickFeedService/GetReconciliation"
+	// QuickFeedServiceReconcileReviewsProcedure is the fully-qualified name of the QuickFeedService's
+	// ReconcileReviews RPC.
+	QuickFeedServiceReconcileReviewsProcedure = "/qf.QuickFeedService/ReconcileReviews"
+	// QuickFeedServiceAllocateReviewersProcedure is the fully-qualified name of the QuickFeedService's
+	// AllocateReviewers RPC.
+	QuickFeedServiceAllocateReviewersProcedure = "/qf.QuickFeedService/AllocateReviewers"
+	// QuickFeedServiceReassignReviewsProcedure is the fully-qualified name of the QuickFeedService's
+	// ReassignReviews RPC.
+	QuickFeedServiceReassignReviewsProcedure = "/qf.QuickFeedService/ReassignReviews"
+	// QuickFeedServiceGetReviewQueueProcedure is the fully-qualified name of the QuickFeedService's
+	// GetReviewQueue RPC.
+	QuickFeedServiceGetReviewQueueProcedure = "/qf.QuickFeedService/GetReviewQueue"
+	// QuickFeedServiceGetReviewerLoadsProcedure is the fully-qualified name of the QuickFeedService's
+	// GetReviewerLoads RPC.
+	QuickFeedServiceGetReviewerLoadsProcedure = "/qf.QuickFeedService/GetReviewerLoads"
+	// QuickFeedServiceStartPeerReviewProcedure is the fully-qualified name of the QuickFeedService's
+	// StartPeerReview RPC.
+	QuickFeedServiceStartPeerReviewProcedure = "/qf.QuickFeedService/StartPeerReview"
+	// QuickFeedServiceEndPeerReviewProcedure is the fully-qualified name of the QuickFeedService's
+	// EndPeerReview RPC.
+	QuickFeedServiceEndPeerReviewProcedure = "/qf.QuickFeedService/EndPeerReview"
+	// QuickFeedServiceGetPeerReviewsProcedure is the fully-qualified name of the QuickFeedService's
+	// GetPeerReviews RPC.
+	QuickFeedServiceGetPeerReviewsProcedure = "/qf.QuickFeedService/GetPeerReviews"
+	// QuickFeedServiceGradePeerReviewProcedure is the fully-qualified name of the QuickFeedService's
+	// GradePeerReview RPC.
+	QuickFeedServiceGradePeerReviewProcedure = "/qf.QuickFeedService/GradePeerReview"
+	// QuickFeedServiceCreatePeerReviewProcedure is the fully-qualified name of the QuickFeedService's
+	// CreatePeerReview RPC.
+	QuickFeedServiceCreatePeerReviewProcedure = "/qf.QuickFeedService/CreatePeerReview"
+	// QuickFeedServiceUpdatePeerReviewProcedure is the fully-qualified name of the QuickFeedService's
+	// UpdatePeerReview RPC.
+	QuickFeedServiceUpdatePeerReviewProcedure = "/qf.QuickFeedService/UpdatePeerReview"
+	// QuickFeedServiceStartQuizProcedure is the fully-qualified name of the QuickFeedService's
+	// StartQuiz RPC.
+	QuickFeedServiceStartQuizProcedure = "/qf.QuickFeedService/StartQuiz"
+	// QuickFeedServiceSubmitQuizProcedure is the fully-qualified name of the QuickFeedService's
+	// SubmitQuiz RPC.
+	QuickFeedServiceSubmitQuizProcedure = "/qf.QuickFeedService/SubmitQuiz"
 	// QuickFeedServiceGetOrganizationProcedure is the fully-qualified name of the QuickFeedService's
 	// GetOrganization RPC.
 	QuickFeedServiceGetOrganizationProcedure = "/qf.QuickFeedService/GetOrganization"
@@ -138,46 +276,100 @@ const (
 	// QuickFeedServiceSubmissionStreamProcedure is the fully-qualified name of the QuickFeedService's
 	// SubmissionStream RPC.
 	QuickFeedServiceSubmissionStreamProcedure = "/qf.QuickFeedService/SubmissionStream"
+	// QuickFeedServiceRegradeRequestStreamProcedure is the fully-qualified name of the
+	// QuickFeedService's RegradeRequestStream RPC.
+	QuickFeedServiceRegradeRequestStreamProcedure = "/qf.QuickFeedService/RegradeRequestStream"
+	// QuickFeedServiceGroupInvitationStreamProcedure is the fully-qualified name of the
+	// QuickFeedService's GroupInvitationStream RPC.
+	QuickFeedServiceGroupInvitationStreamProcedure = "/qf.QuickFeedService/GroupInvitationStream"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	quickFeedServiceServiceDescriptor                      = qf.File_qf_quickfeed_proto.Services().ByName("QuickFeedService")
-	quickFeedServiceGetUserMethodDescriptor                = quickFeedServiceServiceDescriptor.Methods().ByName("GetUser")
-	quickFeedServiceGetUsersMethodDescriptor               = quickFeedServiceServiceDescriptor.Methods().ByName("GetUsers")
-	quickFeedServiceUpdateUserMethodDescriptor             = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateUser")
-	quickFeedServiceGetGroupMethodDescriptor               = quickFeedServiceServiceDescriptor.Methods().ByName("GetGroup")
-	quickFeedServiceGetGroupsByCourseMethodDescriptor      = quickFeedServiceServiceDescriptor.Methods().ByName("GetGroupsByCourse")
-	quickFeedServiceCreateGroupMethodDescriptor            = quickFeedServiceServiceDescriptor.Methods().ByName("CreateGroup")
-	quickFeedServiceUpdateGroupMethodDescriptor            = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateGroup")
-	quickFeedServiceDeleteGroupMethodDescriptor            = quickFeedServiceServiceDescriptor.Methods().ByName("DeleteGroup")
-	quickFeedServiceGetCourseMethodDescriptor              = quickFeedServiceServiceDescriptor.Methods().ByName("GetCourse")
-	quickFeedServiceGetCoursesMethodDescriptor             = quickFeedServiceServiceDescriptor.Methods().ByName("GetCourses")
-	quickFeedServiceUpdateCourseMethodDescriptor           = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateCourse")
-	quickFeedServiceUpdateCourseVisibilityMethodDescriptor = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateCourseVisibility")
-	quickFeedServiceGetAssignmentsMethodDescriptor         = quickFeedServiceServiceDescriptor.Methods().ByName("GetAssignments")
-	quickFeedServiceUpdateAssignmentsMethodDescriptor      = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateAssignments")
-	quickFeedServiceGetEnrollmentsMethodDescriptor         = quickFeedServiceServiceDescriptor.Methods().ByName("GetEnrollments")
-	quickFeedServiceCreateEnrollmentMethodDescriptor       = quickFeedServiceServiceDescriptor.Methods().ByName("CreateEnrollment")
-	quickFeedServiceUpdateEnrollmentsMethodDescriptor      = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateEnrollments")
-	quickFeedServiceGetSubmissionMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("GetSubmission")
-	quickFeedServiceGetSubmissionsMethodDescriptor         = quickFeedServiceServiceDescriptor.Methods().ByName("GetSubmissions")
-	quickFeedServiceGetSubmissionsByCourseMethodDescriptor = quickFeedServiceServiceDescriptor.Methods().ByName("GetSubmissionsByCourse")
-	quickFeedServiceUpdateSubmissionMethodDescriptor       = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateSubmission")
-	quickFeedServiceUpdateSubmissionsMethodDescriptor      = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateSubmissions")
-	quickFeedServiceRebuildSubmissionsMethodDescriptor     = quickFeedServiceServiceDescriptor.Methods().ByName("RebuildSubmissions")
-	quickFeedServiceCreateBenchmarkMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("CreateBenchmark")
-	quickFeedServiceUpdateBenchmarkMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateBenchmark")
-	quickFeedServiceDeleteBenchmarkMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("DeleteBenchmark")
-	quickFeedServiceCreateCriterionMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("CreateCriterion")
-	quickFeedServiceUpdateCriterionMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateCriterion")
-	quickFeedServiceDeleteCriterionMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("DeleteCriterion")
-	quickFeedServiceCreateReviewMethodDescriptor           = quickFeedServiceServiceDescriptor.Methods().ByName("CreateReview")
-	quickFeedServiceUpdateReviewMethodDescriptor           = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateReview")
-	quickFeedServiceGetOrganizationMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("GetOrganization")
-	quickFeedServiceGetRepositoriesMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("GetRepositories")
-	quickFeedServiceIsEmptyRepoMethodDescriptor            = quickFeedServiceServiceDescriptor.Methods().ByName("IsEmptyRepo")
-	quickFeedServiceSubmissionStreamMethodDescriptor       = quickFeedServiceServiceDescriptor.Methods().ByName("SubmissionStream")
+	quickFeedServiceServiceDescriptor                        = qf.File_qf_quickfeed_proto.Services().ByName("QuickFeedService")
+	quickFeedServiceGetUserMethodDescriptor                  = quickFeedServiceServiceDescriptor.Methods().ByName("GetUser")
+	quickFeedServiceGetUsersMethodDescriptor                 = quickFeedServiceServiceDescriptor.Methods().ByName("GetUsers")
+	quickFeedServiceUpdateUserMethodDescriptor               = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateUser")
+	quickFeedServiceGetGroupMethodDescriptor                 = quickFeedServiceServiceDescriptor.Methods().ByName("GetGroup")
+	quickFeedServiceGetGroupsByCourseMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("GetGroupsByCourse")
+	quickFeedServiceCreateGroupMethodDescriptor              = quickFeedServiceServiceDescriptor.Methods().ByName("CreateGroup")
+	quickFeedServiceUpdateGroupMethodDescriptor              = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateGroup")
+	quickFeedServiceDeleteGroupMethodDescriptor              = quickFeedServiceServiceDescriptor.Methods().ByName("DeleteGroup")
+	quickFeedServiceProposeGroupsMethodDescriptor            = quickFeedServiceServiceDescriptor.Methods().ByName("ProposeGroups")
+	quickFeedServiceCreateGroupsMethodDescriptor             = quickFeedServiceServiceDescriptor.Methods().ByName("CreateGroups")
+	quickFeedServiceGetGroupPreferenceMethodDescriptor       = quickFeedServiceServiceDescriptor.Methods().ByName("GetGroupPreference")
+	quickFeedServiceUpdateGroupPreferenceMethodDescriptor    = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateGroupPreference")
+	quickFeedServiceGetGroupInvitationsMethodDescriptor      = quickFeedServiceServiceDescriptor.Methods().ByName("GetGroupInvitations")
+	quickFeedServiceRespondToGroupInvitationMethodDescriptor = quickFeedServiceServiceDescriptor.Methods().ByName("RespondToGroupInvitation")
+	quickFeedServiceGetGroupMembershipsMethodDescriptor      = quickFeedServiceServiceDescriptor.Methods().ByName("GetGroupMemberships")
+	quickFeedServiceGetGroupContributionsMethodDescriptor    = quickFeedServiceServiceDescriptor.Methods().ByName("GetGroupContributions")
+	quickFeedServiceGetCourseMethodDescriptor                = quickFeedServiceServiceDescriptor.Methods().ByName("GetCourse")
+	quickFeedServiceGetCoursesMethodDescriptor               = quickFeedServiceServiceDescriptor.Methods().ByName("GetCourses")
+	quickFeedServiceUpdateCourseMethodDescriptor             = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateCourse")
+	quickFeedServiceUpdateCourseVisibilityMethodDescriptor   = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateCourseVisibility")
+	quickFeedServiceGetGradingConfigMethodDescriptor         = quickFeedServiceServiceDescriptor.Methods().ByName("GetGradingConfig")
+	quickFeedServiceUpdateGradingConfigMethodDescriptor      = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateGradingConfig")
+	quickFeedServiceComputeFinalGradesMethodDescriptor       = quickFeedServiceServiceDescriptor.Methods().ByName("ComputeFinalGrades")
+	quickFeedServiceExportGradesMethodDescriptor             = quickFeedServiceServiceDescriptor.Methods().ByName("ExportGrades")
+	quickFeedServiceImportExternalGradesMethodDescriptor     = quickFeedServiceServiceDescriptor.Methods().ByName("ImportExternalGrades")
+	quickFeedServiceGetAssignmentsMethodDescriptor           = quickFeedServiceServiceDescriptor.Methods().ByName("GetAssignments")
+	quickFeedServiceUpdateAssignmentsMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateAssignments")
+	quickFeedServiceGetEnrollmentsMethodDescriptor           = quickFeedServiceServiceDescriptor.Methods().ByName("GetEnrollments")
+	quickFeedServiceCreateEnrollmentMethodDescriptor         = quickFeedServiceServiceDescriptor.Methods().ByName("CreateEnrollment")
+	quickFeedServiceUpdateEnrollmentsMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateEnrollments")
+	quickFeedServiceImportRosterMethodDescriptor             = quickFeedServiceServiceDescriptor.Methods().ByName("ImportRoster")
+	quickFeedServiceGetRosterCoverageMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("GetRosterCoverage")
+	quickFeedServiceGetEnrollmentPolicyMethodDescriptor      = quickFeedServiceServiceDescriptor.Methods().ByName("GetEnrollmentPolicy")
+	quickFeedServiceGetSubmissionMethodDescriptor            = quickFeedServiceServiceDescriptor.Methods().ByName("GetSubmission")
+	quickFeedServiceGetSubmissionsMethodDescriptor           = quickFeedServiceServiceDescriptor.Methods().ByName("GetSubmissions")
+	quickFeedServiceGetSubmissionsByCourseMethodDescriptor   = quickFeedServiceServiceDescriptor.Methods().ByName("GetSubmissionsByCourse")
+	quickFeedServiceUpdateSubmissionMethodDescriptor         = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateSubmission")
+	quickFeedServiceUpdateSubmissionsMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateSubmissions")
+	quickFeedServiceRebuildSubmissionsMethodDescriptor       = quickFeedServiceServiceDescriptor.Methods().ByName("RebuildSubmissions")
+	quickFeedServiceGetScheduledJobsMethodDescriptor         = quickFeedServiceServiceDescriptor.Methods().ByName("GetScheduledJobs")
+	quickFeedServiceScheduleJobMethodDescriptor              = quickFeedServiceServiceDescriptor.Methods().ByName("ScheduleJob")
+	quickFeedServiceCancelScheduledJobMethodDescriptor       = quickFeedServiceServiceDescriptor.Methods().ByName("CancelScheduledJob")
+	quickFeedServiceGetAuditEntriesMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("GetAuditEntries")
+	quickFeedServiceCreateBenchmarkMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("CreateBenchmark")
+	quickFeedServiceUpdateBenchmarkMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateBenchmark")
+	quickFeedServiceDeleteBenchmarkMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("DeleteBenchmark")
+	quickFeedServiceCreateCriterionMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("CreateCriterion")
+	quickFeedServiceUpdateCriterionMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateCriterion")
+	quickFeedServiceDeleteCriterionMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("DeleteCriterion")
+	quickFeedServiceCreateReviewMethodDescriptor             = quickFeedServiceServiceDescriptor.Methods().ByName("CreateReview")
+	quickFeedServiceUpdateReviewMethodDescriptor             = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateReview")
+	quickFeedServiceGetLineCommentsMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("GetLineComments")
+	quickFeedServiceCreateLineCommentMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("CreateLineComment")
+	quickFeedServiceUpdateLineCommentMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateLineComment")
+	quickFeedServiceDeleteLineCommentMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("DeleteLineComment")
+	quickFeedServiceGetFeedbackSnippetsMethodDescriptor      = quickFeedServiceServiceDescriptor.Methods().ByName("GetFeedbackSnippets")
+	quickFeedServiceCreateFeedbackSnippetMethodDescriptor    = quickFeedServiceServiceDescriptor.Methods().ByName("CreateFeedbackSnippet")
+	quickFeedServiceUpdateFeedbackSnippetMethodDescriptor    = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateFeedbackSnippet")
+	quickFeedServiceDeleteFeedbackSnippetMethodDescriptor    = quickFeedServiceServiceDescriptor.Methods().ByName("DeleteFeedbackSnippet")
+	quickFeedServiceUseFeedbackSnippetMethodDescriptor       = quickFeedServiceServiceDescriptor.Methods().ByName("UseFeedbackSnippet")
+	quickFeedServiceGetRegradeRequestsMethodDescriptor       = quickFeedServiceServiceDescriptor.Methods().ByName("GetRegradeRequests")
+	quickFeedServiceCreateRegradeRequestMethodDescriptor     = quickFeedServiceServiceDescriptor.Methods().ByName("CreateRegradeRequest")
+	quickFeedServiceUpdateRegradeRequestMethodDescriptor     = quickFeedServiceServiceDescriptor.Methods().ByName("UpdateRegradeRequest")
+	quickFeedServiceGetReconciliationMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("GetReconciliation")
+	quickFeedServiceReconcileReviewsMethodDescriptor         = quickFeedServiceServiceDescriptor.Methods().ByName("ReconcileReviews")
+	quickFeedServiceAllocateReviewersMethodDescriptor        = quickFeedServiceServiceDescriptor.Methods().ByName("AllocateReviewers")
+	quickFeedServiceReassignReviewsMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("ReassignReviews")
+	quickFeedServiceGetReviewQueueMethodDescriptor           = quickFeedServiceServiceDescriptor.Methods().ByName("GetReviewQueue")
+	quickFeedServiceGetReviewerLoadsMethodDescriptor         = quickFeedServiceServiceDescriptor.Methods().ByName("GetReviewerLoads")
+	quickFeedServiceStartPeerReviewMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("StartPeerReview")
+	quickFeedServiceEndPeerReviewMethodDescriptor            = quickFeedServiceServiceDescriptor.Methods().ByName("EndPeerReview")
+	quickFeedServiceGetPeerReviewsMethodDescriptor           = quickFeedServiceServiceDescriptor.Methods().ByName("GetPeerReviews")
+	quickFeedServiceGradePeerReviewMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("GradePeerReview")
+	quickFeedServiceCreatePeerReviewMethodDescriptor         = quickFeedServiceServiceDescriptor.Methods().ByName("CreatePeerReview")
+	quickFeedServiceUpdatePeerReviewMethodDescriptor         = quickFeedServiceServiceDescriptor.Methods().ByName("UpdatePeerReview")
+	quickFeedServiceStartQuizMethodDescriptor                = quickFeedServiceServiceDescriptor.Methods().ByName("StartQuiz")
+	quickFeedServiceSubmitQuizMethodDescriptor               = quickFeedServiceServiceDescriptor.Methods().ByName("SubmitQuiz")
+	quickFeedServiceGetOrganizationMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("GetOrganization")
+	quickFeedServiceGetRepositoriesMethodDescriptor          = quickFeedServiceServiceDescriptor.Methods().ByName("GetRepositories")
+	quickFeedServiceIsEmptyRepoMethodDescriptor              = quickFeedServiceServiceDescriptor.Methods().ByName("IsEmptyRepo")
+	quickFeedServiceSubmissionStreamMethodDescriptor         = quickFeedServiceServiceDescriptor.Methods().ByName("SubmissionStream")
+	quickFeedServiceRegradeRequestStreamMethodDescriptor     = quickFeedServiceServiceDescriptor.Methods().ByName("RegradeRequestStream")
+	quickFeedServiceGroupInvitationStreamMethodDescriptor    = quickFeedServiceServiceDescriptor.Methods().ByName("GroupInvitationStream")
 )
 
 // QuickFeedServiceClient is a client for the qf.QuickFeedService service.
@@ -191,15 +383,53 @@ type QuickFeedServiceClient interface {
 	CreateGroup(context.Context, *connect.Request[qf.Group]) (*connect.Response[qf.Group], error)
 	UpdateGroup(context.Context, *connect.Request[qf.Group]) (*connect.Response[qf.Group], error)
 	DeleteGroup(context.Context, *connect.Request[qf.GroupRequest]) (*connect.Response[qf.Void], error)
+	// ProposeGroups proposes groups for the course's students who are not in a group.
+	// The proposal is not stored; the teacher may edit it before committing it with CreateGroups.
+	ProposeGroups(context.Context, *connect.Request[qf.GroupFormationRequest]) (*connect.Response[qf.Groups], error)
+	// CreateGroups creates and approves the given groups, and creates their repositories.
+	CreateGroups(context.Context, *connect.Request[qf.CreateGroupsRequest]) (*connect.Response[qf.Groups], error)
+	// GetGroupPreference returns the student's preferred group partners.
+	GetGroupPreference(context.Context, *connect.Request[qf.GroupRequest]) (*connect.Response[qf.GroupPreference], error)
+	// UpdateGroupPreference replaces the student's preferred group partners.
+	UpdateGroupPreference(context.Context, *connect.Request[qf.GroupPreference]) (*connect.Response[qf.Void], error)
+	// GetGroupInvitations returns the student's invitations to groups, and the invitations to the student's group.
+	GetGroupInvitations(context.Context, *connect.Request[qf.GroupRequest]) (*connect.Response[qf.GroupInvitations], error)
+	// RespondToGroupInvitation accepts or declines the student's invitation to a group.
+	RespondToGroupInvitation(context.Context, *connect.Request[qf.GroupInvitation]) (*connect.Response[qf.Void], error)
+	// GetGroupMemberships returns the membership history of the course's groups.
+	GetGroupMemberships(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.GroupMemberships], error)
+	// GetGroupContributions analyzes the commits in a group's repository and returns each member's contributions.
+	GetGroupContributions(context.Context, *connect.Request[qf.ContributionRequest]) (*connect.Response[qf.GroupContributions], error)
 	GetCourse(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Course], error)
 	GetCourses(context.Context, *connect.Request[qf.Void]) (*connect.Response[qf.Courses], error)
 	UpdateCourse(context.Context, *connect.Request[qf.Course]) (*connect.Response[qf.Void], error)
 	UpdateCourseVisibility(context.Context, *connect.Request[qf.Enrollment]) (*connect.Response[qf.Void], error)
+	// GetGradingConfig returns the course's grading configuration.
+	GetGradingConfig(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.GradingConfig], error)
+	// UpdateGradingConfig replaces the course's grading configuration.
+	UpdateGradingConfig(context.Context, *connect.Request[qf.GradingConfig]) (*connect.Response[qf.GradingConfig], error)
+	// ComputeFinalGrades computes the final grade of each student in the course,
+	// with a per-assignment breakdown, using the course's grading configuration.
+	ComputeFinalGrades(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.FinalGrades], error)
+	// ExportGrades returns a CSV or XLSX file with the final grades of the course's students,
+	// or fills in the given institution roster, and reports mismatches between the roster and the course.
+	ExportGrades(context.Context, *connect.Request[qf.GradeExportRequest]) (*connect.Response[qf.GradeExport], error)
+	// ImportExternalGrades imports the students' scores for the external components
+	// of the course's grading configuration from a CSV file.
+	ImportExternalGrades(context.Context, *connect.Request[qf.ExternalGradeImportRequest]) (*connect.Response[qf.ExternalGradeImport], error)
 	GetAssignments(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Assignments], error)
 	UpdateAssignments(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Void], error)
 	GetEnrollments(context.Context, *connect.Request[qf.EnrollmentRequest]) (*connect.Response[qf.Enrollments], error)
 	CreateEnrollment(context.Context, *connect.Request[qf.Enrollment]) (*connect.Response[qf.Void], error)
 	UpdateEnrollments(context.Context, *connect.Request[qf.Enrollments]) (*connect.Response[qf.Void], error)
+	// ImportRoster replaces the course's institution roster with the students in the given CSV file,
+	// approves the pending enrollments that match the roster, and returns the roster coverage.
+	ImportRoster(context.Context, *connect.Request[qf.RosterImportRequest]) (*connect.Response[qf.RosterCoverage], error)
+	// GetRosterCoverage reports which students on the course's roster have not signed up,
+	// and which pending enrollments do not match the roster.
+	GetRosterCoverage(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.RosterCoverage], error)
+	// GetEnrollmentPolicy returns the course's enrollment policy.
+	GetEnrollmentPolicy(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.EnrollmentPolicy], error)
 	GetSubmission(context.Context, *connect.Request[qf.SubmissionRequest]) (*connect.Response[qf.Submission], error)
 	// Get latest submissions for all course assignments for a user or a group.
 	GetSubmissions(context.Context, *connect.Request[qf.SubmissionRequest]) (*connect.Response[qf.Submissions], error)
@@ -208,6 +438,15 @@ type QuickFeedServiceClient interface {
 	UpdateSubmission(context.Context, *connect.Request[qf.UpdateSubmissionRequest]) (*connect.Response[qf.Void], error)
 	UpdateSubmissions(context.Context, *connect.Request[qf.UpdateSubmissionsRequest]) (*connect.Response[qf.Void], error)
 	RebuildSubmissions(context.Context, *connect.Request[qf.RebuildRequest]) (*connect.Response[qf.Void], error)
+	// GetScheduledJobs returns the course's scheduled bulk changes to submissions.
+	GetScheduledJobs(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ScheduledJobs], error)
+	// ScheduleJob schedules a bulk change to the assignment's submissions,
+	// replacing any earlier job of the same type for the assignment.
+	ScheduleJob(context.Context, *connect.Request[qf.ScheduledJob]) (*connect.Response[qf.ScheduledJob], error)
+	// CancelScheduledJob removes a scheduled job that has not yet run.
+	CancelScheduledJob(context.Context, *connect.Request[qf.ScheduledJob]) (*connect.Response[qf.Void], error)
+	// GetAuditEntries returns the course's audit log of bulk changes to submissions.
+	GetAuditEntries(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.AuditEntries], error)
 	CreateBenchmark(context.Context, *connect.Request[qf.GradingBenchmark]) (*connect.Response[qf.GradingBenchmark], error)
 	UpdateBenchmark(context.Context, *connect.Request[qf.GradingBenchmark]) (*connect.Response[qf.Void], error)
 	DeleteBenchmark(context.Context, *connect.Request[qf.GradingBenchmark]) (*connect.Response[qf.Void], error)
@@ -216,12 +455,67 @@ type QuickFeedServiceClient interface {
 	DeleteCriterion(context.Context, *connect.Request[qf.GradingCriterion]) (*connect.Response[qf.Void], error)
 	CreateReview(context.Context, *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error)
 	UpdateReview(context.Context, *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error)
+	// GetLineComments returns the line comments on the given submission.
+	// Students only get the line comments on their own submissions after the submission is released.
+	GetLineComments(context.Context, *connect.Request[qf.LineCommentRequest]) (*connect.Response[qf.LineComments], error)
+	CreateLineComment(context.Context, *connect.Request[qf.LineComment]) (*connect.Response[qf.LineComment], error)
+	UpdateLineComment(context.Context, *connect.Request[qf.LineComment]) (*connect.Response[qf.LineComment], error)
+	DeleteLineComment(context.Context, *connect.Request[qf.LineComment]) (*connect.Response[qf.Void], error)
+	// GetFeedbackSnippets returns the course's feedback snippets, optionally filtered by tag.
+	GetFeedbackSnippets(context.Context, *connect.Request[qf.FeedbackSnippetRequest]) (*connect.Response[qf.FeedbackSnippets], error)
+	CreateFeedbackSnippet(context.Context, *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.FeedbackSnippet], error)
+	UpdateFeedbackSnippet(context.Context, *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.FeedbackSnippet], error)
+	DeleteFeedbackSnippet(context.Context, *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.Void], error)
+	// UseFeedbackSnippet records that the snippet was inserted into a review of the given assignment,
+	// and returns the snippet with its updated usage.
+	UseFeedbackSnippet(context.Context, *connect.Request[qf.FeedbackSnippetUsageRequest]) (*connect.Response[qf.FeedbackSnippet], error)
+	// GetRegradeRequests returns the course's regrade requests; students only get their own requests.
+	GetRegradeRequests(context.Context, *connect.Request[qf.RegradeRequestQuery]) (*connect.Response[qf.RegradeRequests], error)
+	// CreateRegradeRequest creates a regrade request for one of the current user's submissions.
+	CreateRegradeRequest(context.Context, *connect.Request[qf.RegradeRequest]) (*connect.Response[qf.RegradeRequest], error)
+	// UpdateRegradeRequest accepts, rejects or resolves a regrade request.
+	// Resolving a request may rebuild the submission or create a new review of the submission.
+	UpdateRegradeRequest(context.Context, *connect.Request[qf.RegradeRequest]) (*connect.Response[qf.RegradeRequest], error)
+	// GetReconciliation returns the disagreements between the submission's ready reviews,
+	// and the final review proposed by the assignment's reconcile policy.
+	GetReconciliation(context.Context, *connect.Request[qf.ReconcileRequest]) (*connect.Response[qf.Reconciliation], error)
+	// ReconcileReviews creates the final review for the submission, whose score becomes the submission's score.
+	ReconcileReviews(context.Context, *connect.Request[qf.ReconcileRequest]) (*connect.Response[qf.Review], error)
+	// AllocateReviewers allocates reviewers among the course's teachers to the assignment's submissions,
+	// such that each submission gets the assignment's number of reviewers.
+	AllocateReviewers(context.Context, *connect.Request[qf.ReviewAllocationRequest]) (*connect.Response[qf.ReviewAllocations], error)
+	// ReassignReviews moves the given reviewer's pending reviews for the assignment to other reviewers.
+	ReassignReviews(context.Context, *connect.Request[qf.ReviewAllocationRequest]) (*connect.Response[qf.ReviewAllocations], error)
+	// GetReviewQueue returns the pending review allocations of the current user in the given course.
+	GetReviewQueue(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ReviewAllocations], error)
+	// GetReviewerLoads returns the number of pull request reviews requested from each reviewer in the course.
+	GetReviewerLoads(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ReviewerLoads], error)
+	// StartPeerReview opens the peer review phase for the assignment after its deadline,
+	// assigning each student the assignment's number of peer submissions to review.
+	StartPeerReview(context.Context, *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error)
+	// EndPeerReview closes the peer review phase for the assignment, revoking the reviewers' access.
+	EndPeerReview(context.Context, *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error)
+	// GetPeerReviews returns the assignment's peer reviews; students only get their own peer reviews.
+	GetPeerReviews(context.Context, *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error)
+	// GradePeerReview sets the teacher's assessment of a peer review's quality.
+	GradePeerReview(context.Context, *connect.Request[qf.PeerReview]) (*connect.Response[qf.PeerReview], error)
+	// CreatePeerReview creates a peer review of a submission assigned to the current user.
+	CreatePeerReview(context.Context, *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error)
+	// UpdatePeerReview updates the current user's peer review of a submission.
+	UpdatePeerReview(context.Context, *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error)
+	// StartQuiz starts a new timed attempt for the quiz of the given assignment,
+	// or returns the current attempt if it has not yet been submitted or expired.
+	StartQuiz(context.Context, *connect.Request[qf.QuizRequest]) (*connect.Response[qf.QuizAttempt], error)
+	// SubmitQuiz records the answers for the given attempt as a submission for the quiz's assignment.
+	SubmitQuiz(context.Context, *connect.Request[qf.QuizSubmission]) (*connect.Response[qf.Submission], error)
 	// GetOrganization returns the organization with the given organization name.
 	// Note that organization ID is not used in the request, but it is populated in the response.
 	GetOrganization(context.Context, *connect.Request[qf.Organization]) (*connect.Response[qf.Organization], error)
 	GetRepositories(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Repositories], error)
 	IsEmptyRepo(context.Context, *connect.Request[qf.RepositoryRequest]) (*connect.Response[qf.Void], error)
 	SubmissionStream(context.Context, *connect.Request[qf.Void]) (*connect.ServerStreamForClient[qf.Submission], error)
+	RegradeRequestStream(context.Context, *connect.Request[qf.Void]) (*connect.ServerStreamForClient[qf.RegradeRequest], error)
+	GroupInvitationStream(context.Context, *connect.Request[qf.Void]) (*connect.ServerStreamForClient[qf.GroupInvitation], error)
 }
 
 // NewQuickFeedServiceClient constructs a client for the qf.QuickFeedService service. By default, it
@@ -282,6 +576,54 @@ func NewQuickFeedServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(quickFeedServiceDeleteGroupMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		proposeGroups: connect.NewClient[qf.GroupFormationRequest, qf.Groups](
+			httpClient,
+			baseURL+QuickFeedServiceProposeGroupsProcedure,
+			connect.WithSchema(quickFeedServiceProposeGroupsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createGroups: connect.NewClient[qf.CreateGroupsRequest, qf.Groups](
+			httpClient,
+			baseURL+QuickFeedServiceCreateGroupsProcedure,
+			connect.WithSchema(quickFeedServiceCreateGroupsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getGroupPreference: connect.NewClient[qf.GroupRequest, qf.GroupPreference](
+			httpClient,
+			baseURL+QuickFeedServiceGetGroupPreferenceProcedure,
+			connect.WithSchema(quickFeedServiceGetGroupPreferenceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateGroupPreference: connect.NewClient[qf.GroupPreference, qf.Void](
+			httpClient,
+			baseURL+QuickFeedServiceUpdateGroupPreferenceProcedure,
+			connect.WithSchema(quickFeedServiceUpdateGroupPreferenceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getGroupInvitations: connect.NewClient[qf.GroupRequest, qf.GroupInvitations](
+			httpClient,
+			baseURL+QuickFeedServiceGetGroupInvitationsProcedure,
+			connect.WithSchema(quickFeedServiceGetGroupInvitationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		respondToGroupInvitation: connect.NewClient[qf.GroupInvitation, qf.Void](
+			httpClient,
+			baseURL+QuickFeedServiceRespondToGroupInvitationProcedure,
+			connect.WithSchema(quickFeedServiceRespondToGroupInvitationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getGroupMemberships: connect.NewClient[qf.CourseRequest, qf.GroupMemberships](
+			httpClient,
+			baseURL+QuickFeedServiceGetGroupMembershipsProcedure,
+			connect.WithSchema(quickFeedServiceGetGroupMembershipsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getGroupContributions: connect.NewClient[qf.ContributionRequest, qf.GroupContributions](
+			httpClient,
+			baseURL+QuickFeedServiceGetGroupContributionsProcedure,
+			connect.WithSchema(quickFeedServiceGetGroupContributionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getCourse: connect.NewClient[qf.CourseRequest, qf.Course](
 			httpClient,
 			baseURL+QuickFeedServiceGetCourseProcedure,
@@ -306,6 +648,36 @@ func NewQuickFeedServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(quickFeedServiceUpdateCourseVisibilityMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getGradingConfig: connect.NewClient[qf.CourseRequest, qf.GradingConfig](
+			httpClient,
+			baseURL+QuickFeedServiceGetGradingConfigProcedure,
+			connect.WithSchema(quickFeedServiceGetGradingConfigMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateGradingConfig: connect.NewClient[qf.GradingConfig, qf.GradingConfig](
+			httpClient,
+			baseURL+QuickFeedServiceUpdateGradingConfigProcedure,
+			connect.WithSchema(quickFeedServiceUpdateGradingConfigMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		computeFinalGrades: connect.NewClient[qf.CourseRequest, qf.FinalGrades](
+			httpClient,
+			baseURL+QuickFeedServiceComputeFinalGradesProcedure,
+			connect.WithSchema(quickFeedServiceComputeFinalGradesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		exportGrades: connect.NewClient[qf.GradeExportRequest, qf.GradeExport](
+			httpClient,
+			baseURL+QuickFeedServiceExportGradesProcedure,
+			connect.WithSchema(quickFeedServiceExportGradesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		importExternalGrades: connect.NewClient[qf.ExternalGradeImportRequest, qf.ExternalGradeImport](
+			httpClient,
+			baseURL+QuickFeedServiceImportExternalGradesProcedure,
+			connect.WithSchema(quickFeedServiceImportExternalGradesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getAssignments: connect.NewClient[qf.CourseRequest, qf.Assignments](
 			httpClient,
 			baseURL+QuickFeedServiceGetAssignmentsProcedure,
@@ -336,6 +708,24 @@ func NewQuickFeedServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(quickFeedServiceUpdateEnrollmentsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		importRoster: connect.NewClient[qf.RosterImportRequest, qf.RosterCoverage](
+			httpClient,
+			baseURL+QuickFeedServiceImportRosterProcedure,
+			connect.WithSchema(quickFeedServiceImportRosterMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getRosterCoverage: connect.NewClient[qf.CourseRequest, qf.RosterCoverage](
+			httpClient,
+			baseURL+QuickFeedServiceGetRosterCoverageProcedure,
+			connect.WithSchema(quickFeedServiceGetRosterCoverageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getEnrollmentPolicy: connect.NewClient[qf.CourseRequest, qf.EnrollmentPolicy](
+			httpClient,
+			baseURL+QuickFeedServiceGetEnrollmentPolicyProcedure,
+			connect.WithSchema(quickFeedServiceGetEnrollmentPolicyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getSubmission: connect.NewClient[qf.SubmissionRequest, qf.Submission](
 			httpClient,
 			baseURL+QuickFeedServiceGetSubmissionProcedure,
@@ -372,6 +762,30 @@ func NewQuickFeedServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(quickFeedServiceRebuildSubmissionsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getScheduledJobs: connect.NewClient[qf.CourseRequest, qf.ScheduledJobs](
+			httpClient,
+			baseURL+QuickFeedServiceGetScheduledJobsProcedure,
+			connect.WithSchema(quickFeedServiceGetScheduledJobsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		scheduleJob: connect.NewClient[qf.ScheduledJob, qf.ScheduledJob](
+			httpClient,
+			baseURL+QuickFeedServiceScheduleJobProcedure,
+			connect.WithSchema(quickFeedServiceScheduleJobMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		cancelScheduledJob: connect.NewClient[qf.ScheduledJob, qf.Void](
+			httpClient,
+			baseURL+QuickFeedServiceCancelScheduledJobProcedure,
+			connect.WithSchema(quickFeedServiceCancelScheduledJobMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAuditEntries: connect.NewClient[qf.CourseRequest, qf.AuditEntries](
+			httpClient,
+			baseURL+QuickFeedServiceGetAuditEntriesProcedure,
+			connect.WithSchema(quickFeedServiceGetAuditEntriesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		createBenchmark: connect.NewClient[qf.GradingBenchmark, qf.GradingBenchmark](
 			httpClient,
 			baseURL+QuickFeedServiceCreateBenchmarkProcedure,
@@ -420,6 +834,162 @@ func NewQuickFeedServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(quickFeedServiceUpdateReviewMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getLineComments: connect.NewClient[qf.LineCommentRequest, qf.LineComments](
+			httpClient,
+			baseURL+QuickFeedServiceGetLineCommentsProcedure,
+			connect.WithSchema(quickFeedServiceGetLineCommentsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createLineComment: connect.NewClient[qf.LineComment, qf.LineComment](
+			httpClient,
+			baseURL+QuickFeedServiceCreateLineCommentProcedure,
+			connect.WithSchema(quickFeedServiceCreateLineCommentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateLineComment: connect.NewClient[qf.LineComment, qf.LineComment](
+			httpClient,
+			baseURL+QuickFeedServiceUpdateLineCommentProcedure,
+			connect.WithSchema(quickFeedServiceUpdateLineCommentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteLineComment: connect.NewClient[qf.LineComment, qf.Void](
+			httpClient,
+			baseURL+QuickFeedServiceDeleteLineCommentProcedure,
+			connect.WithSchema(quickFeedServiceDeleteLineCommentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getFeedbackSnippets: connect.NewClient[qf.FeedbackSnippetRequest, qf.FeedbackSnippets](
+			httpClient,
+			baseURL+QuickFeedServiceGetFeedbackSnippetsProcedure,
+			connect.WithSchema(quickFeedServiceGetFeedbackSnippetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createFeedbackSnippet: connect.NewClient[qf.FeedbackSnippet, qf.FeedbackSnippet](
+			httpClient,
+			baseURL+QuickFeedServiceCreateFeedbackSnippetProcedure,
+			connect.WithSchema(quickFeedServiceCreateFeedbackSnippetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateFeedbackSnippet: connect.NewClient[qf.FeedbackSnippet, qf.FeedbackSnippet](
+			httpClient,
+			baseURL+QuickFeedServiceUpdateFeedbackSnippetProcedure,
+			connect.WithSchema(quickFeedServiceUpdateFeedbackSnippetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFeedbackSnippet: connect.NewClient[qf.FeedbackSnippet, qf.Void](
+			httpClient,
+			baseURL+QuickFeedServiceDeleteFeedbackSnippetProcedure,
+			connect.WithSchema(quickFeedServiceDeleteFeedbackSnippetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		useFeedbackSnippet: connect.NewClient[qf.FeedbackSnippetUsageRequest, qf.FeedbackSnippet](
+			httpClient,
+			baseURL+QuickFeedServiceUseFeedbackSnippetProcedure,
+			connect.WithSchema(quickFeedServiceUseFeedbackSnippetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getRegradeRequests: connect.NewClient[qf.RegradeRequestQuery, qf.RegradeRequests](
+			httpClient,
+			baseURL+QuickFeedServiceGetRegradeRequestsProcedure,
+			connect.WithSchema(quickFeedServiceGetRegradeRequestsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createRegradeRequest: connect.NewClient[qf.RegradeRequest, qf.RegradeRequest](
+			httpClient,
+			baseURL+QuickFeedServiceCreateRegradeRequestProcedure,
+			connect.WithSchema(quickFeedServiceCreateRegradeRequestMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateRegradeRequest: connect.NewClient[qf.RegradeRequest, qf.RegradeRequest](
+			httpClient,
+			baseURL+QuickFeedServiceUpdateRegradeRequestProcedure,
+			connect.WithSchema(quickFeedServiceUpdateRegradeRequestMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getReconciliation: connect.NewClient[qf.ReconcileRequest, qf.Reconciliation](
+			httpClient,
+			baseURL+QuickFeedServiceGetReconciliationProcedure,
+			connect.WithSchema(quickFeedServiceGetReconciliationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		reconcileReviews: connect.NewClient[qf.ReconcileRequest, qf.Review](
+			httpClient,
+			baseURL+QuickFeedServiceReconcileReviewsProcedure,
+			connect.WithSchema(quickFeedServiceReconcileReviewsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		allocateReviewers: connect.NewClient[qf.ReviewAllocationRequest, qf.ReviewAllocations](
+			httpClient,
+			baseURL+QuickFeedServiceAllocateReviewersProcedure,
+			connect.WithSchema(quickFeedServiceAllocateReviewersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		reassignReviews: connect.NewClient[qf.ReviewAllocationRequest, qf.ReviewAllocations](
+			httpClient,
+			baseURL+QuickFeedServiceReassignReviewsProcedure,
+			connect.WithSchema(quickFeedServiceReassignReviewsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getReviewQueue: connect.NewClient[qf.CourseRequest, qf.ReviewAllocations](
+			httpClient,
+			baseURL+QuickFeedServiceGetReviewQueueProcedure,
+			connect.WithSchema(quickFeedServiceGetReviewQueueMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getReviewerLoads: connect.NewClient[qf.CourseRequest, qf.ReviewerLoads](
+			httpClient,
+			baseURL+QuickFeedServiceGetReviewerLoadsProcedure,
+			connect.WithSchema(quickFeedServiceGetReviewerLoadsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		startPeerReview: connect.NewClient[qf.PeerReviewRequest, qf.PeerReviews](
+			httpClient,
+			baseURL+QuickFeedServiceStartPeerReviewProcedure,
+			connect.WithSchema(quickFeedServiceStartPeerReviewMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		endPeerReview: connect.NewClient[qf.PeerReviewRequest, qf.PeerReviews](
+			httpClient,
+			baseURL+QuickFeedServiceEndPeerReviewProcedure,
+			connect.WithSchema(quickFeedServiceEndPeerReviewMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getPeerReviews: connect.NewClient[qf.PeerReviewRequest, qf.PeerReviews](
+			httpClient,
+			baseURL+QuickFeedServiceGetPeerReviewsProcedure,
+			connect.WithSchema(quickFeedServiceGetPeerReviewsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		gradePeerReview: connect.NewClient[qf.PeerReview, qf.PeerReview](
+			httpClient,
+			baseURL+QuickFeedServiceGradePeerReviewProcedure,
+			connect.WithSchema(quickFeedServiceGradePeerReviewMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createPeerReview: connect.NewClient[qf.ReviewRequest, qf.Review](
+			httpClient,
+			baseURL+QuickFeedServiceCreatePeerReviewProcedure,
+			connect.WithSchema(quickFeedServiceCreatePeerReviewMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updatePeerReview: connect.NewClient[qf.ReviewRequest, qf.Review](
+			httpClient,
+			baseURL+QuickFeedServiceUpdatePeerReviewProcedure,
+			connect.WithSchema(quickFeedServiceUpdatePeerReviewMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		startQuiz: connect.NewClient[qf.QuizRequest, qf.QuizAttempt](
+			httpClient,
+			baseURL+QuickFeedServiceStartQuizProcedure,
+			connect.WithSchema(quickFeedServiceStartQuizMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		submitQuiz: connect.NewClient[qf.QuizSubmission, qf.Submission](
+			httpClient,
+			baseURL+QuickFeedServiceSubmitQuizProcedure,
+			connect.WithSchema(quickFeedServiceSubmitQuizMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getOrganization: connect.NewClient[qf.Organization, qf.Organization](
 			httpClient,
 			baseURL+QuickFeedServiceGetOrganizationProcedure,
@@ -444,46 +1014,106 @@ func NewQuickFeedServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(quickFeedServiceSubmissionStreamMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		regradeRequestStream: connect.NewClient[qf.Void, qf.RegradeRequest](
+			httpClient,
+			baseURL+QuickFeedServiceRegradeRequestStreamProcedure,
+			connect.WithSchema(quickFeedServiceRegradeRequestStreamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		groupInvitationStream: connect.NewClient[qf.Void, qf.GroupInvitation](
+			httpClient,
+			baseURL+QuickFeedServiceGroupInvitationStreamProcedure,
+			connect.WithSchema(quickFeedServiceGroupInvitationStreamMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // quickFeedServiceClient implements QuickFeedServiceClient.
 type quickFeedServiceClient struct {
-	getUser                *connect.Client[qf.Void, qf.User]
-	getUsers               *connect.Client[qf.Void, qf.Users]
-	updateUser             *connect.Client[qf.User, qf.Void]
-	getGroup               *connect.Client[qf.GroupRequest, qf.Group]
-	getGroupsByCourse      *connect.Client[qf.CourseRequest, qf.Groups]
-	createGroup            *connect.Client[qf.Group, qf.Group]
-	updateGroup            *connect.Client[qf.Group, qf.Group]
-	deleteGroup            *connect.Client[qf.GroupRequest, qf.Void]
-	getCourse              *connect.Client[qf.CourseRequest, qf.Course]
-	getCourses             *connect.Client[qf.Void, qf.Courses]
-	updateCourse           *connect.Client[qf.Course, qf.Void]
-	updateCourseVisibility *connect.Client[qf.Enrollment, qf.Void]
-	getAssignments         *connect.Client[qf.CourseRequest, qf.Assignments]
-	updateAssignments      *connect.Client[qf.CourseRequest, qf.Void]
-	getEnrollments         *connect.Client[qf.EnrollmentRequest, qf.Enrollments]
-	createEnrollment       *connect.Client[qf.Enrollment, qf.Void]
-	updateEnrollments      *connect.Client[qf.Enrollments, qf.Void]
-	getSubmission          *connect.Client[qf.SubmissionRequest, qf.Submission]
-	getSubmissions         *connect.Client[qf.SubmissionRequest, qf.Submissions]
-	getSubmissionsByCourse *connect.Client[qf.SubmissionRequest, qf.CourseSubmissions]
-	updateSubmission       *connect.Client[qf.UpdateSubmissionRequest, qf.Void]
-	updateSubmissions      *connect.Client[qf.UpdateSubmissionsRequest, qf.Void]
-	rebuildSubmissions     *connect.Client[qf.RebuildRequest, qf.Void]
-	createBenchmark        *connect.Client[qf.GradingBenchmark, qf.GradingBenchmark]
-	updateBenchmark        *connect.Client[qf.GradingBenchmark, qf.Void]
-	deleteBenchmark        *connect.Client[qf.GradingBenchmark, qf.Void]
-	createCriterion        *connect.Client[qf.GradingCriterion, qf.GradingCriterion]
-	updateCriterion        *connect.Client[qf.GradingCriterion, qf.Void]
-	deleteCriterion        *connect.Client[qf.GradingCriterion, qf.Void]
-	createReview           *connect.Client[qf.ReviewRequest, qf.Review]
-	updateReview           *connect.Client[qf.ReviewRequest, qf.Review]
-	getOrganization        *connect.Client[qf.Organization, qf.Organization]
-	getRepositories        *connect.Client[qf.CourseRequest, qf.Repositories]
-	isEmptyRepo            *connect.Client[qf.RepositoryRequest, qf.Void]
-	submissionStream       *connect.Client[qf.Void, qf.Submission]
+	getUser                  *connect.Client[qf.Void, qf.User]
+	getUsers                 *connect.Client[qf.Void, qf.Users]
+	updateUser               *connect.Client[qf.User, qf.Void]
+	getGroup                 *connect.Client[qf.GroupRequest, qf.Group]
+	getGroupsByCourse        *connect.Client[qf.CourseRequest, qf.Groups]
+	createGroup              *connect.Client[qf.Group, qf.Group]
+	updateGroup              *connect.Client[qf.Group, qf.Group]
+	deleteGroup              *connect.Client[qf.GroupRequest, qf.Void]
+	proposeGroups            *connect.Client[qf.GroupFormationRequest, qf.Groups]
+	createGroups             *connect.Client[qf.CreateGroupsRequest, qf.Groups]
+	getGroupPreference       *connect.Client[qf.GroupRequest, qf.GroupPreference]
+	updateGroupPreference    *connect.Client[qf.GroupPreference, qf.Void]
+	getGroupInvitations      *connect.Client[qf.GroupRequest, qf.GroupInvitations]
+	respondToGroupInvitation *connect.Client[qf.GroupInvitation, qf.Void]
+	getGroupMemberships      *connect.Client[qf.CourseRequest, qf.GroupMemberships]
+	getGroupContributions    *connect.Client[qf.ContributionRequest, qf.GroupContributions]
+	getCourse                *connect.Client[qf.CourseRequest, qf.Course]
+	getCourses               *connect.Client[qf.Void, qf.Courses]
+	updateCourse             *connect.Client[qf.Course, qf.Void]
+	updateCourseVisibility   *connect.Client[qf.Enrollment, qf.Void]
+	getGradingConfig         *connect.Client[qf.CourseRequest, qf.GradingConfig]
+	updateGradingConfig      *connect.Client[qf.GradingConfig, qf.GradingConfig]
+	computeFinalGrades       *connect.Client[qf.CourseRequest, qf.FinalGrades]
+	exportGrades             *connect.Client[qf.GradeExportRequest, qf.GradeExport]
+	importExternalGrades     *connect.Client[qf.ExternalGradeImportRequest, qf.ExternalGradeImport]
+	getAssignments           *connect.Client[qf.CourseRequest, qf.Assignments]
+	updateAssignments        *connect.Client[qf.CourseRequest, qf.Void]
+	getEnrollments           *connect.Client[qf.EnrollmentRequest, qf.Enrollments]
+	createEnrollment         *connect.Client[qf.Enrollment, qf.Void]
+	updateEnrollments        *connect.Client[qf.Enrollments, qf.Void]
+	importRoster             *connect.Client[qf.RosterImportRequest, qf.RosterCoverage]
+	getRosterCoverage        *connect.Client[qf.CourseRequest, qf.RosterCoverage]
+	getEnrollmentPolicy      *connect.Client[qf.CourseRequest, qf.EnrollmentPolicy]
+	getSubmission            *connect.Client[qf.SubmissionRequest, qf.Submission]
+	getSubmissions           *connect.Client[qf.SubmissionRequest, qf.Submissions]
+	getSubmissionsByCourse   *connect.Client[qf.SubmissionRequest, qf.CourseSubmissions]
+	updateSubmission         *connect.Client[qf.UpdateSubmissionRequest, qf.Void]
+	updateSubmissions        *connect.Client[qf.UpdateSubmissionsRequest, qf.Void]
+	rebuildSubmissions       *connect.Client[qf.RebuildRequest, qf.Void]
+	getScheduledJobs         *connect.Client[qf.CourseRequest, qf.ScheduledJobs]
+	scheduleJob              *connect.Client[qf.ScheduledJob, qf.ScheduledJob]
+	cancelScheduledJob       *connect.Client[qf.ScheduledJob, qf.Void]
+	getAuditEntries          *connect.Client[qf.CourseRequest, qf.AuditEntries]
+	createBenchmark          *connect.Client[qf.GradingBenchmark, qf.GradingBenchmark]
+	updateBenchmark          *connect.Client[qf.GradingBenchmark, qf.Void]
+	deleteBenchmark          *connect.Client[qf.GradingBenchmark, qf.Void]
+	createCriterion          *connect.Client[qf.GradingCriterion, qf.GradingCriterion]
+	updateCriterion          *connect.Client[qf.GradingCriterion, qf.Void]
+	deleteCriterion          *connect.Client[qf.GradingCriterion, qf.Void]
+	createReview             *connect.Client[qf.ReviewRequest, qf.Review]
+	updateReview             *connect.Client[qf.ReviewRequest, qf.Review]
+	getLineComments          *connect.Client[qf.LineCommentRequest, qf.LineComments]
+	createLineComment        *connect.Client[qf.LineComment, qf.LineComment]
+	updateLineComment        *connect.Client[qf.LineComment, qf.LineComment]
+	deleteLineComment        *connect.Client[qf.LineComment, qf.Void]
+	getFeedbackSnippets      *connect.Client[qf.FeedbackSnippetRequest, qf.FeedbackSnippets]
+	createFeedbackSnippet    *connect.Client[qf.FeedbackSnippet, qf.FeedbackSnippet]
+	updateFeedbackSnippet    *connect.Client[qf.FeedbackSnippet, qf.FeedbackSnippet]
+	deleteFeedbackSnippet    *connect.Client[qf.FeedbackSnippet, qf.Void]
+	useFeedbackSnippet       *connect.Client[qf.FeedbackSnippetUsageRequest, qf.FeedbackSnippet]
+	getRegradeRequests       *connect.Client[qf.RegradeRequestQuery, qf.RegradeRequests]
+	createRegradeRequest     *connect.Client[qf.RegradeRequest, qf.RegradeRequest]
+	updateRegradeRequest     *connect.Client[qf.RegradeRequest, qf.RegradeRequest]
+	getReconciliation        *connect.Client[qf.ReconcileRequest, qf.Reconciliation]
+	reconcileReviews         *connect.Client[qf.ReconcileRequest, qf.Review]
+	allocateReviewers        *connect.Client[qf.ReviewAllocationRequest, qf.ReviewAllocations]
+	reassignReviews          *connect.Client[qf.ReviewAllocationRequest, qf.ReviewAllocations]
+	getReviewQueue           *connect.Client[qf.CourseRequest, qf.ReviewAllocations]
+	getReviewerLoads         *connect.Client[qf.CourseRequest, qf.ReviewerLoads]
+	startPeerReview          *connect.Client[qf.PeerReviewRequest, qf.PeerReviews]
+	endPeerReview            *connect.Client[qf.PeerReviewRequest, qf.PeerReviews]
+	getPeerReviews           *connect.Client[qf.PeerReviewRequest, qf.PeerReviews]
+	gradePeerReview          *connect.Client[qf.PeerReview, qf.PeerReview]
+	createPeerReview         *connect.Client[qf.ReviewRequest, qf.Review]
+	updatePeerReview         *connect.Client[qf.ReviewRequest, qf.Review]
+	startQuiz                *connect.Client[qf.QuizRequest, qf.QuizAttempt]
+	submitQuiz               *connect.Client[qf.QuizSubmission, qf.Submission]
+	getOrganization          *connect.Client[qf.Organization, qf.Organization]
+	getRepositories          *connect.Client[qf.CourseRequest, qf.Repositories]
+	isEmptyRepo              *connect.Client[qf.RepositoryRequest, qf.Void]
+	submissionStream         *connect.Client[qf.Void, qf.Submission]
+	regradeRequestStream     *connect.Client[qf.Void, qf.RegradeRequest]
+	groupInvitationStream    *connect.Client[qf.Void, qf.GroupInvitation]
 }
 
 // GetUser calls qf.QuickFeedService.GetUser.
@@ -526,6 +1156,46 @@ func (c *quickFeedServiceClient) DeleteGroup(ctx context.Context, req *connect.R
 	return c.deleteGroup.CallUnary(ctx, req)
 }
 
+// ProposeGroups calls qf.QuickFeedService.ProposeGroups.
+func (c *quickFeedServiceClient) ProposeGroups(ctx context.Context, req *connect.Request[qf.GroupFormationRequest]) (*connect.Response[qf.Groups], error) {
+	return c.proposeGroups.CallUnary(ctx, req)
+}
+
+// CreateGroups calls qf.QuickFeedService.CreateGroups.
+func (c *quickFeedServiceClient) CreateGroups(ctx context.Context, req *connect.Request[qf.CreateGroupsRequest]) (*connect.Response[qf.Groups], error) {
+	return c.createGroups.CallUnary(ctx, req)
+}
+
+// GetGroupPreference calls qf.QuickFeedService.GetGroupPreference.
+func (c *quickFeedServiceClient) GetGroupPreference(ctx context.Context, req *connect.Request[qf.GroupRequest]) (*connect.Response[qf.GroupPreference], error) {
+	return c.getGroupPreference.CallUnary(ctx, req)
+}
+
+// UpdateGroupPreference calls qf.QuickFeedService.UpdateGroupPreference.
+func (c *quickFeedServiceClient) UpdateGroupPreference(ctx context.Context, req *connect.Request[qf.GroupPreference]) (*connect.Response[qf.Void], error) {
+	return c.updateGroupPreference.CallUnary(ctx, req)
+}
+
+// GetGroupInvitations calls qf.QuickFeedService.GetGroupInvitations.
+func (c *quickFeedServiceClient) GetGroupInvitations(ctx context.Context, req *connect.Request[qf.GroupRequest]) (*connect.Response[qf.GroupInvitations], error) {
+	return c.getGroupInvitations.CallUnary(ctx, req)
+}
+
+// RespondToGroupInvitation calls qf.QuickFeedService.RespondToGroupInvitation.
+func (c *quickFeedServiceClient) RespondToGroupInvitation(ctx context.Context, req *connect.Request[qf.GroupInvitation]) (*connect.Response[qf.Void], error) {
+	return c.respondToGroupInvitation.CallUnary(ctx, req)
+}
+
+// GetGroupMemberships calls qf.QuickFeedService.GetGroupMemberships.
+func (c *quickFeedServiceClient) GetGroupMemberships(ctx context.Context, req *connect.Request[qf.CourseRequest]) (*connect.Response[qf.GroupMemberships], error) {
+	return c.getGroupMemberships.CallUnary(ctx, req)
+}
+
+// GetGroupContributions calls qf.QuickFeedService.GetGroupContributions.
+func (c *quickFeedServiceClient) GetGroupContributions(ctx context.Context, req *connect.Request[qf.ContributionRequest]) (*connect.Response[qf.GroupContributions], error) {
+	return c.getGroupContributions.CallUnary(ctx, req)
+}
+
 // GetCourse calls qf.QuickFeedService.GetCourse.
 func (c *quickFeedServiceClient) GetCourse(ctx context.Context, req *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Course], error) {
 	return c.getCourse.CallUnary(ctx, req)
@@ -546,6 +1216,31 @@ func (c *quickFeedServiceClient) UpdateCourseVisibility(ctx context.Context, req
 	return c.updateCourseVisibility.CallUnary(ctx, req)
 }
 
+// GetGradingConfig calls qf.QuickFeedService.GetGradingConfig.
+func (c *quickFeedServiceClient) GetGradingConfig(ctx context.Context, req *connect.Request[qf.CourseRequest]) (*connect.Response[qf.GradingConfig], error) {
+	return c.getGradingConfig.CallUnary(ctx, req)
+}
+
+// UpdateGradingConfig calls qf.QuickFeedService.UpdateGradingConfig.
+func (c *quickFeedServiceClient) UpdateGradingConfig(ctx context.Context, req *connect.Request[qf.GradingConfig]) (*connect.Response[qf.GradingConfig], error) {
+	return c.updateGradingConfig.CallUnary(ctx, req)
+}
+
+// ComputeFinalGrades calls qf.QuickFeedService.ComputeFinalGrades.
+func (c *quickFeedServiceClient) ComputeFinalGrades(ctx context.Context, req *connect.Request[qf.CourseRequest]) (*connect.Response[qf.FinalGrades], error) {
+	return c.computeFinalGrades.CallUnary(ctx, req)
+}
+
+// ExportGrades calls qf.QuickFeedService.ExportGrades.
+func (c *quickFeedServiceClient) ExportGrades(ctx context.Context, req *connect.Request[qf.GradeExportRequest]) (*connect.Response[qf.GradeExport], error) {
+	return c.exportGrades.CallUnary(ctx, req)
+}
+
+// ImportExternalGrades calls qf.QuickFeedService.ImportExternalGrades.
+func (c *quickFeedServiceClient) ImportExternalGrades(ctx context.Context, req *connect.Request[qf.ExternalGradeImportRequest]) (*connect.Response[qf.ExternalGradeImport], error) {
+	return c.importExternalGrades.CallUnary(ctx, req)
+}
+
 // GetAssignments calls qf.QuickFeedService.GetAssignments.
 func (c *quickFeedServiceClient) GetAssignments(ctx context.Context, req *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Assignments], error) {
 	return c.getAssignments.CallUnary(ctx, req)
@@ -571,6 +1266,21 @@ func (c *quickFeedServiceClient) UpdateEnrollments(ctx context.Context, req *con
 	return c.updateEnrollments.CallUnary(ctx, req)
 }
 
+// ImportRoster calls qf.QuickFeedService.ImportRoster.
+func (c *quickFeedServiceClient) ImportRoster(ctx context.Context, req *connect.Request[qf.RosterImportRequest]) (*connect.Response[qf.RosterCoverage], error) {
+	return c.importRoster.CallUnary(ctx, req)
+}
+
+// GetRosterCoverage calls qf.QuickFeedService.GetRosterCoverage.
+func (c *quickFeedServiceClient) GetRosterCoverage(ctx context.Context, req *connect.Request[qf.CourseRequest]) (*connect.Response[qf.RosterCoverage], error) {
+	return c.getRosterCoverage.CallUnary(ctx, req)
+}
+
+// GetEnrollmentPolicy calls qf.QuickFeedService.GetEnrollmentPolicy.
+func (c *quickFeedServiceClient) GetEnrollmentPolicy(ctx context.Context, req *connect.Request[qf.CourseRequest]) (*connect.Response[qf.EnrollmentPolicy], error) {
+	return c.getEnrollmentPolicy.CallUnary(ctx, req)
+}
+
 // GetSubmission calls qf.QuickFeedService.GetSubmission.
 func (c *quickFeedServiceClient) GetSubmission(ctx context.Context, req *connect.Request[qf.SubmissionRequest]) (*connect.Response[qf.Submission], error) {
 	return c.getSubmission.CallUnary(ctx, req)
@@ -601,6 +1311,26 @@ func (c *quickFeedServiceClient) RebuildSubmissions(ctx context.Context, req *co
 	return c.rebuildSubmissions.CallUnary(ctx, req)
 }
 
+// GetScheduledJobs calls qf.QuickFeedService.GetScheduledJobs.
+func (c *quickFeedServiceClient) GetScheduledJobs(ctx context.Context, req *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ScheduledJobs], error) {
+	return c.getScheduledJobs.CallUnary(ctx, req)
+}
+
+// ScheduleJob calls qf.QuickFeedService.ScheduleJob.
+func (c *quickFeedServiceClient) ScheduleJob(ctx context.Context, req *connect.Request[qf.ScheduledJob]) (*connect.Response[qf.ScheduledJob], error) {
+	return c.scheduleJob.CallUnary(ctx, req)
+}
+
+// CancelScheduledJob calls qf.QuickFeedService.CancelScheduledJob.
+func (c *quickFeedServiceClient) CancelScheduledJob(ctx context.Context, req *connect.Request[qf.ScheduledJob]) (*connect.Response[qf.Void], error) {
+	return c.cancelScheduledJob.CallUnary(ctx, req)
+}
+
+// GetAuditEntries calls qf.QuickFeedService.GetAuditEntries.
+func (c *quickFeedServiceClient) GetAuditEntries(ctx context.Context, req *connect.Request[qf.CourseRequest]) (*connect.Response[qf.AuditEntries], error) {
+	return c.getAuditEntries.CallUnary(ctx, req)
+}
+
 // CreateBenchmark calls qf.QuickFeedService.CreateBenchmark.
 func (c *quickFeedServiceClient) CreateBenchmark(ctx context.Context, req *connect.Request[qf.GradingBenchmark]) (*connect.Response[qf.GradingBenchmark], error) {
 	return c.createBenchmark.CallUnary(ctx, req)
@@ -641,6 +1371,136 @@ func (c *quickFeedServiceClient) UpdateReview(ctx context.Context, req *connect.
 	return c.updateReview.CallUnary(ctx, req)
 }
 
+// GetLineComments calls qf.QuickFeedService.GetLineComments.
+func (c *quickFeedServiceClient) GetLineComments(ctx context.Context, req *connect.Request[qf.LineCommentRequest]) (*connect.Response[qf.LineComments], error) {
+	return c.getLineComments.CallUnary(ctx, req)
+}
+
+// CreateLineComment calls qf.QuickFeedService.CreateLineComment.
+func (c *quickFeedServiceClient) CreateLineComment(ctx context.Context, req *connect.Request[qf.LineComment]) (*connect.Response[qf.LineComment], error) {
+	return c.createLineComment.CallUnary(ctx, req)
+}
+
+// UpdateLineComment calls qf.QuickFeedService.UpdateLineComment.
+func (c *quickFeedServiceClient) UpdateLineComment(ctx context.Context, req *connect.Request[qf.LineComment]) (*connect.Response[qf.LineComment], error) {
+	return c.updateLineComment.CallUnary(ctx, req)
+}
+
+// DeleteLineComment calls qf.QuickFeedService.DeleteLineComment.
+func (c *quickFeedServiceClient) DeleteLineComment(ctx context.Context, req *connect.Request[qf.LineComment]) (*connect.Response[qf.Void], error) {
+	return c.deleteLineComment.CallUnary(ctx, req)
+}
+
+// GetFeedbackSnippets calls qf.QuickFeedService.GetFeedbackSnippets.
+func (c *quickFeedServiceClient) GetFeedbackSnippets(ctx context.Context, req *connect.Request[qf.FeedbackSnippetRequest]) (*connect.Response[qf.FeedbackSnippets], error) {
+	return c.getFeedbackSnippets.CallUnary(ctx, req)
+}
+
+// CreateFeedbackSnippet calls qf.QuickFeedService.CreateFeedbackSnippet.
+func (c *quickFeedServiceClient) CreateFeedbackSnippet(ctx context.Context, req *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.FeedbackSnippet], error) {
+	return c.createFeedbackSnippet.CallUnary(ctx, req)
+}
+
+// UpdateFeedbackSnippet calls qf.QuickFeedService.UpdateFeedbackSnippet.
+func (c *quickFeedServiceClient) UpdateFeedbackSnippet(ctx context.Context, req *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.FeedbackSnippet], error) {
+	return c.updateFeedbackSnippet.CallUnary(ctx, req)
+}
+
+// DeleteFeedbackSnippet calls qf.QuickFeedService.DeleteFeedbackSnippet.
+func (c *quickFeedServiceClient) DeleteFeedbackSnippet(ctx context.Context, req *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.Void], error) {
+	return c.deleteFeedbackSnippet.CallUnary(ctx, req)
+}
+
+// UseFeedbackSnippet calls qf.QuickFeedService.UseFeedbackSnippet.
+func (c *quickFeedServiceClient) UseFeedbackSnippet(ctx context.Context, req *connect.Request[qf.FeedbackSnippetUsageRequest]) (*connect.Response[qf.FeedbackSnippet], error) {
+	return c.useFeedbackSnippet.CallUnary(ctx, req)
+}
+
+// GetRegradeRequests calls qf.QuickFeedService.GetRegradeRequests.
+func (c *quickFeedServiceClient) GetRegradeRequests(ctx context.Context, req *connect.Request[qf.RegradeRequestQuery]) (*connect.Response[qf.RegradeRequests], error) {
+	return c.getRegradeRequests.CallUnary(ctx, req)
+}
+
+// CreateRegradeRequest calls qf.QuickFeedService.CreateRegradeRequest.
+func (c *quickFeedServiceClient) CreateRegradeRequest(ctx context.Context, req *connect.Request[qf.RegradeRequest]) (*connect.Response[qf.RegradeRequest], error) {
+	return c.createRegradeRequest.CallUnary(ctx, req)
+}
+
+// UpdateRegradeRequest calls qf.QuickFeedService.UpdateRegradeRequest.
+func (c *quickFeedServiceClient) UpdateRegradeRequest(ctx context.Context, req *connect.Request[qf.RegradeRequest]) (*connect.Response[qf.RegradeRequest], error) {
+	return c.updateRegradeRequest.CallUnary(ctx, req)
+}
+
+// GetReconciliation calls qf.QuickFeedService.GetReconciliation.
+func (c *quickFeedServiceClient) GetReconciliation(ctx context.Context, req *connect.Request[qf.ReconcileRequest]) (*connect.Response[qf.Reconciliation], error) {
+	return c.getReconciliation.CallUnary(ctx, req)
+}
+
+// ReconcileReviews calls qf.QuickFeedService.ReconcileReviews.
+func (c *quickFeedServiceClient) ReconcileReviews(ctx context.Context, req *connect.Request[qf.ReconcileRequest]) (*connect.Response[qf.Review], error) {
+	return c.reconcileReviews.CallUnary(ctx, req)
+}
+
+// AllocateReviewers calls qf.QuickFeedService.AllocateReviewers.
+func (c *quickFeedServiceClient) AllocateReviewers(ctx context.Context, req *connect.Request[qf.ReviewAllocationRequest]) (*connect.Response[qf.ReviewAllocations], error) {
+	return c.allocateReviewers.CallUnary(ctx, req)
+}
+
+// ReassignReviews calls qf.QuickFeedService.ReassignReviews.
+func (c *quickFeedServiceClient) ReassignReviews(ctx context.Context, req *connect.Request[qf.ReviewAllocationRequest]) (*connect.Response[qf.ReviewAllocations], error) {
+	return c.reassignReviews.CallUnary(ctx, req)
+}
+
+// GetReviewQueue calls qf.QuickFeedService.GetReviewQueue.
+func (c *quickFeedServiceClient) GetReviewQueue(ctx context.Context, req *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ReviewAllocations], error) {
+	return c.getReviewQueue.CallUnary(ctx, req)
+}
+
+// GetReviewerLoads calls qf.QuickFeedService.GetReviewerLoads.
+func (c *quickFeedServiceClient) GetReviewerLoads(ctx context.Context, req *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ReviewerLoads], error) {
+	return c.getReviewerLoads.CallUnary(ctx, req)
+}
+
+// StartPeerReview calls qf.QuickFeedService.StartPeerReview.
+func (c *quickFeedServiceClient) StartPeerReview(ctx context.Context, req *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error) {
+	return c.startPeerReview.CallUnary(ctx, req)
+}
+
+// EndPeerReview calls qf.QuickFeedService.EndPeerReview.
+func (c *quickFeedServiceClient) EndPeerReview(ctx context.Context, req *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error) {
+	return c.endPeerReview.CallUnary(ctx, req)
+}
+
+// GetPeerReviews calls qf.QuickFeedService.GetPeerReviews.
+func (c *quickFeedServiceClient) GetPeerReviews(ctx context.Context, req *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error) {
+	return c.getPeerReviews.CallUnary(ctx, req)
+}
+
+// GradePeerReview calls qf.QuickFeedService.GradePeerReview.
+func (c *quickFeedServiceClient) GradePeerReview(ctx context.Context, req *connect.Request[qf.PeerReview]) (*connect.Response[qf.PeerReview], error) {
+	return c.gradePeerReview.CallUnary(ctx, req)
+}
+
+// CreatePeerReview calls qf.QuickFeedService.CreatePeerReview.
+func (c *quickFeedServiceClient) CreatePeerReview(ctx context.Context, req *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error) {
+	return c.createPeerReview.CallUnary(ctx, req)
+}
+
+// UpdatePeerReview calls qf.QuickFeedService.UpdatePeerReview.
+func (c *quickFeedServiceClient) UpdatePeerReview(ctx context.Context, req *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error) {
+	return c.updatePeerReview.CallUnary(ctx, req)
+}
+
+// StartQuiz calls qf.QuickFeedService.StartQuiz.
+func (c *quickFeedServiceClient) StartQuiz(ctx context.Context, req *connect.Request[qf.QuizRequest]) (*connect.Response[qf.QuizAttempt], error) {
+	return c.startQuiz.CallUnary(ctx, req)
+}
+
+// SubmitQuiz calls qf.QuickFeedService.SubmitQuiz.
+func (c *quickFeedServiceClient) SubmitQuiz(ctx context.Context, req *connect.Request[qf.QuizSubmission]) (*connect.Response[qf.Submission], error) {
+	return c.submitQuiz.CallUnary(ctx, req)
+}
+
 // GetOrganization calls qf.QuickFeedService.GetOrganization.
 func (c *quickFeedServiceClient) GetOrganization(ctx context.Context, req *connect.Request[qf.Organization]) (*connect.Response[qf.Organization], error) {
 	return c.getOrganization.CallUnary(ctx, req)
@@ -661,6 +1521,16 @@ func (c *quickFeedServiceClient) SubmissionStream(ctx context.Context, req *conn
 	return c.submissionStream.CallServerStream(ctx, req)
 }
 
+// RegradeRequestStream calls qf.QuickFeedService.RegradeRequestStream.
+func (c *quickFeedServiceClient) RegradeRequestStream(ctx context.Context, req *connect.Request[qf.Void]) (*connect.ServerStreamForClient[qf.RegradeRequest], error) {
+	return c.regradeRequestStream.CallServerStream(ctx, req)
+}
+
+// GroupInvitationStream calls qf.QuickFeedService.GroupInvitationStream.
+func (c *quickFeedServiceClient) GroupInvitationStream(ctx context.Context, req *connect.Request[qf.Void]) (*connect.ServerStreamForClient[qf.GroupInvitation], error) {
+	return c.groupInvitationStream.CallServerStream(ctx, req)
+}
+
 // QuickFeedServiceHandler is an implementation of the qf.QuickFeedService service.
 type QuickFeedServiceHandler interface {
 	GetUser(context.Context, *connect.Request[qf.Void]) (*connect.Response[qf.User], error)
@@ -672,15 +1542,53 @@ type QuickFeedServiceHandler interface {
 	CreateGroup(context.Context, *connect.Request[qf.Group]) (*connect.Response[qf.Group], error)
 	UpdateGroup(context.Context, *connect.Request[qf.Group]) (*connect.Response[qf.Group], error)
 	DeleteGroup(context.Context, *connect.Request[qf.GroupRequest]) (*connect.Response[qf.Void], error)
+	// ProposeGroups proposes groups for the course's students who are not in a group.
+	// The proposal is not stored; the teacher may edit it before committing it with CreateGroups.
+	ProposeGroups(context.Context, *connect.Request[qf.GroupFormationRequest]) (*connect.Response[qf.Groups], error)
+	// CreateGroups creates and approves the given groups, and creates their repositories.
+	CreateGroups(context.Context, *connect.Request[qf.CreateGroupsRequest]) (*connect.Response[qf.Groups], error)
+	// GetGroupPreference returns the student's preferred group partners.
+	GetGroupPreference(context.Context, *connect.Request[qf.GroupRequest]) (*connect.Response[qf.GroupPreference], error)
+	// UpdateGroupPreference replaces the student's preferred group partners.
+	UpdateGroupPreference(context.Context, *connect.Request[qf.GroupPreference]) (*connect.Response[qf.Void], error)
+	// GetGroupInvitations returns the student's invitations to groups, and the invitations to the student's group.
+	GetGroupInvitations(context.Context, *connect.Request[qf.GroupRequest]) (*connect.Response[qf.GroupInvitations], error)
+	// RespondToGroupInvitation accepts or declines the student's invitation to a group.
+	RespondToGroupInvitation(context.Context, *connect.Request[qf.GroupInvitation]) (*connect.Response[qf.Void], error)
+	// GetGroupMemberships returns the membership history of the course's groups.
+	GetGroupMemberships(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.GroupMemberships], error)
+	// GetGroupContributions analyzes the commits in a group's repository and returns each member's contributions.
+	GetGroupContributions(context.Context, *connect.Request[qf.ContributionRequest]) (*connect.Response[qf.GroupContributions], error)
 	GetCourse(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Course], error)
 	GetCourses(context.Context, *connect.Request[qf.Void]) (*connect.Response[qf.Courses], error)
 	UpdateCourse(context.Context, *connect.Request[qf.Course]) (*connect.Response[qf.Void], error)
 	UpdateCourseVisibility(context.Context, *connect.Request[qf.Enrollment]) (*connect.Response[qf.Void], error)
+	// GetGradingConfig returns the course's grading configuration.
+	GetGradingConfig(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.GradingConfig], error)
+	// UpdateGradingConfig replaces the course's grading configuration.
+	UpdateGradingConfig(context.Context, *connect.Request[qf.GradingConfig]) (*connect.Response[qf.GradingConfig], error)
+	// ComputeFinalGrades computes the final grade of each student in the course,
+	// with a per-assignment breakdown, using the course's grading configuration.
+	ComputeFinalGrades(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.FinalGrades], error)
+	// ExportGrades returns a CSV or XLSX file with the final grades of the course's students,
+	// or fills in the given institution roster, and reports mismatches between the roster and the course.
+	ExportGrades(context.Context, *connect.Request[qf.GradeExportRequest]) (*connect.Response[qf.GradeExport], error)
+	// ImportExternalGrades imports the students' scores for the external components
+	// of the course's grading configuration from a CSV file.
+	ImportExternalGrades(context.Context, *connect.Request[qf.ExternalGradeImportRequest]) (*connect.Response[qf.ExternalGradeImport], error)
 	GetAssignments(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Assignments], error)
 	UpdateAssignments(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Void], error)
 	GetEnrollments(context.Context, *connect.Request[qf.EnrollmentRequest]) (*connect.Response[qf.Enrollments], error)
 	CreateEnrollment(context.Context, *connect.Request[qf.Enrollment]) (*connect.Response[qf.Void], error)
 	UpdateEnrollments(context.Context, *connect.Request[qf.Enrollments]) (*connect.Response[qf.Void], error)
+	// ImportRoster replaces the course's institution roster with the students in the given CSV file,
+	// approves the pending enrollments that match the roster, and returns the roster coverage.
+	ImportRoster(context.Context, *connect.Request[qf.RosterImportRequest]) (*connect.Response[qf.RosterCoverage], error)
+	// GetRosterCoverage reports which students on the course's roster have not signed up,
+	// and which pending enrollments do not match the roster.
+	GetRosterCoverage(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.RosterCoverage], error)
+	// GetEnrollmentPolicy returns the course's enrollment policy.
+	GetEnrollmentPolicy(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.EnrollmentPolicy], error)
 	GetSubmission(context.Context, *connect.Request[qf.SubmissionRequest]) (*connect.Response[qf.Submission], error)
 	// Get latest submissions for all course assignments for a user or a group.
 	GetSubmissions(context.Context, *connect.Request[qf.SubmissionRequest]) (*connect.Response[qf.Submissions], error)
@@ -689,6 +1597,15 @@ type QuickFeedServiceHandler interface {
 	UpdateSubmission(context.Context, *connect.Request[qf.UpdateSubmissionRequest]) (*connect.Response[qf.Void], error)
 	UpdateSubmissions(context.Context, *connect.Request[qf.UpdateSubmissionsRequest]) (*connect.Response[qf.Void], error)
 	RebuildSubmissions(context.Context, *connect.Request[qf.RebuildRequest]) (*connect.Response[qf.Void], error)
+	// GetScheduledJobs returns the course's scheduled bulk changes to submissions.
+	GetScheduledJobs(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ScheduledJobs], error)
+	// ScheduleJob schedules a bulk change to the assignment's submissions,
+	// replacing any earlier job of the same type for the assignment.
+	ScheduleJob(context.Context, *connect.Request[qf.ScheduledJob]) (*connect.Response[qf.ScheduledJob], error)
+	// CancelScheduledJob removes a scheduled job that has not yet run.
+	CancelScheduledJob(context.Context, *connect.Request[qf.ScheduledJob]) (*connect.Response[qf.Void], error)
+	// GetAuditEntries returns the course's audit log of bulk changes to submissions.
+	GetAuditEntries(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.AuditEntries], error)
 	CreateBenchmark(context.Context, *connect.Request[qf.GradingBenchmark]) (*connect.Response[qf.GradingBenchmark], error)
 	UpdateBenchmark(context.Context, *connect.Request[qf.GradingBenchmark]) (*connect.Response[qf.Void], error)
 	DeleteBenchmark(context.Context, *connect.Request[qf.GradingBenchmark]) (*connect.Response[qf.Void], error)
@@ -697,12 +1614,67 @@ type QuickFeedServiceHandler interface {
 	DeleteCriterion(context.Context, *connect.Request[qf.GradingCriterion]) (*connect.Response[qf.Void], error)
 	CreateReview(context.Context, *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error)
 	UpdateReview(context.Context, *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error)
+	// GetLineComments returns the line comments on the given submission.
+	// Students only get the line comments on their own submissions after the submission is released.
+	GetLineComments(context.Context, *connect.Request[qf.LineCommentRequest]) (*connect.Response[qf.LineComments], error)
+	CreateLineComment(context.Context, *connect.Request[qf.LineComment]) (*connect.Response[qf.LineComment], error)
+	UpdateLineComment(context.Context, *connect.Request[qf.LineComment]) (*connect.Response[qf.LineComment], error)
+	DeleteLineComment(context.Context, *connect.Request[qf.LineComment]) (*connect.Response[qf.Void], error)
+	// GetFeedbackSnippets returns the course's feedback snippets, optionally filtered by tag.
+	GetFeedbackSnippets(context.Context, *connect.Request[qf.FeedbackSnippetRequest]) (*connect.Response[qf.FeedbackSnippets], error)
+	CreateFeedbackSnippet(context.Context, *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.FeedbackSnippet], error)
+	UpdateFeedbackSnippet(context.Context, *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.FeedbackSnippet], error)
+	DeleteFeedbackSnippet(context.Context, *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.Void], error)
+	// UseFeedbackSnippet records that the snippet was inserted into a review of the given assignment,
+	// and returns the snippet with its updated usage.
+	UseFeedbackSnippet(context.Context, *connect.Request[qf.FeedbackSnippetUsageRequest]) (*connect.Response[qf.FeedbackSnippet], error)
+	// GetRegradeRequests returns the course's regrade requests; students only get their own requests.
+	GetRegradeRequests(context.Context, *connect.Request[qf.RegradeRequestQuery]) (*connect.Response[qf.RegradeRequests], error)
+	// CreateRegradeRequest creates a regrade request for one of the current user's submissions.
+	CreateRegradeRequest(context.Context, *connect.Request[qf.RegradeRequest]) (*connect.Response[qf.RegradeRequest], error)
+	// UpdateRegradeRequest accepts, rejects or resolves a regrade request.
+	// Resolving a request may rebuild the submission or create a new review of the submission.
+	UpdateRegradeRequest(context.Context, *connect.Request[qf.RegradeRequest]) (*connect.Response[qf.RegradeRequest], error)
+	// GetReconciliation returns the disagreements between the submission's ready reviews,
+	// and the final review proposed by the assignment's reconcile policy.
+	GetReconciliation(context.Context, *connect.Request[qf.ReconcileRequest]) (*connect.Response[qf.Reconciliation], error)
+	// ReconcileReviews creates the final review for the submission, whose score becomes the submission's score.
+	ReconcileReviews(context.Context, *connect.Request[qf.ReconcileRequest]) (*connect.Response[qf.Review], error)
+	// AllocateReviewers allocates reviewers among the course's teachers to the assignment's submissions,
+	// such that each submission gets the assignment's number of reviewers.
+	AllocateReviewers(context.Context, *connect.Request[qf.ReviewAllocationRequest]) (*connect.Response[qf.ReviewAllocations], error)
+	// ReassignReviews moves the given reviewer's pending reviews for the assignment to other reviewers.
+	ReassignReviews(context.Context, *connect.Request[qf.ReviewAllocationRequest]) (*connect.Response[qf.ReviewAllocations], error)
+	// GetReviewQueue returns the pending review allocations of the current user in the given course.
+	GetReviewQueue(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ReviewAllocations], error)
+	// GetReviewerLoads returns the number of pull request reviews requested from each reviewer in the course.
+	GetReviewerLoads(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ReviewerLoads], error)
+	// StartPeerReview opens the peer review phase for the assignment after its deadline,
+	// assigning each student the assignment's number of peer submissions to review.
+	StartPeerReview(context.Context, *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error)
+	// EndPeerReview closes the peer review phase for the assignment, revoking the reviewers' access.
+	EndPeerReview(context.Context, *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error)
+	// GetPeerReviews returns the assignment's peer reviews; students only get their own peer reviews.
+	GetPeerReviews(context.Context, *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error)
+	// GradePeerReview sets the teacher's assessment of a peer review's quality.
+	GradePeerReview(context.Context, *connect.Request[qf.PeerReview]) (*connect.Response[qf.PeerReview], error)
+	// CreatePeerReview creates a peer review of a submission assigned to the current user.
+	CreatePeerReview(context.Context, *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error)
+	// UpdatePeerReview updates the current user's peer review of a submission.
+	UpdatePeerReview(context.Context, *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error)
+	// StartQuiz starts a new timed attempt for the quiz of the given assignment,
+	// or returns the current attempt if it has not yet been submitted or expired.
+	StartQuiz(context.Context, *connect.Request[qf.QuizRequest]) (*connect.Response[qf.QuizAttempt], error)
+	// SubmitQuiz records the answers for the given attempt as a submission for the quiz's assignment.
+	SubmitQuiz(context.Context, *connect.Request[qf.QuizSubmission]) (*connect.Response[qf.Submission], error)
 	// GetOrganization returns the organization with the given organization name.
 	// Note that organization ID is not used in the request, but it is populated in the response.
 	GetOrganization(context.Context, *connect.Request[qf.Organization]) (*connect.Response[qf.Organization], error)
 	GetRepositories(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Repositories], error)
 	IsEmptyRepo(context.Context, *connect.Request[qf.RepositoryRequest]) (*connect.Response[qf.Void], error)
 	SubmissionStream(context.Context, *connect.Request[qf.Void], *connect.ServerStream[qf.Submission]) error
+	RegradeRequestStream(context.Context, *connect.Request[qf.Void], *connect.ServerStream[qf.RegradeRequest]) error
+	GroupInvitationStream(context.Context, *connect.Request[qf.Void], *connect.ServerStream[qf.GroupInvitation]) error
 }
 
 // NewQuickFeedServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -759,6 +1731,54 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 		connect.WithSchema(quickFeedServiceDeleteGroupMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	quickFeedServiceProposeGroupsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceProposeGroupsProcedure,
+		svc.ProposeGroups,
+		connect.WithSchema(quickFeedServiceProposeGroupsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceCreateGroupsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceCreateGroupsProcedure,
+		svc.CreateGroups,
+		connect.WithSchema(quickFeedServiceCreateGroupsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetGroupPreferenceHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetGroupPreferenceProcedure,
+		svc.GetGroupPreference,
+		connect.WithSchema(quickFeedServiceGetGroupPreferenceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceUpdateGroupPreferenceHandler := connect.NewUnaryHandler(
+		QuickFeedServiceUpdateGroupPreferenceProcedure,
+		svc.UpdateGroupPreference,
+		connect.WithSchema(quickFeedServiceUpdateGroupPreferenceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetGroupInvitationsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetGroupInvitationsProcedure,
+		svc.GetGroupInvitations,
+		connect.WithSchema(quickFeedServiceGetGroupInvitationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceRespondToGroupInvitationHandler := connect.NewUnaryHandler(
+		QuickFeedServiceRespondToGroupInvitationProcedure,
+		svc.RespondToGroupInvitation,
+		connect.WithSchema(quickFeedServiceRespondToGroupInvitationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetGroupMembershipsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetGroupMembershipsProcedure,
+		svc.GetGroupMemberships,
+		connect.WithSchema(quickFeedServiceGetGroupMembershipsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetGroupContributionsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetGroupContributionsProcedure,
+		svc.GetGroupContributions,
+		connect.WithSchema(quickFeedServiceGetGroupContributionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	quickFeedServiceGetCourseHandler := connect.NewUnaryHandler(
 		QuickFeedServiceGetCourseProcedure,
 		svc.GetCourse,
@@ -783,6 +1803,36 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 		connect.WithSchema(quickFeedServiceUpdateCourseVisibilityMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	quickFeedServiceGetGradingConfigHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetGradingConfigProcedure,
+		svc.GetGradingConfig,
+		connect.WithSchema(quickFeedServiceGetGradingConfigMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceUpdateGradingConfigHandler := connect.NewUnaryHandler(
+		QuickFeedServiceUpdateGradingConfigProcedure,
+		svc.UpdateGradingConfig,
+		connect.WithSchema(quickFeedServiceUpdateGradingConfigMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceComputeFinalGradesHandler := connect.NewUnaryHandler(
+		QuickFeedServiceComputeFinalGradesProcedure,
+		svc.ComputeFinalGrades,
+		connect.WithSchema(quickFeedServiceComputeFinalGradesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceExportGradesHandler := connect.NewUnaryHandler(
+		QuickFeedServiceExportGradesProcedure,
+		svc.ExportGrades,
+		connect.WithSchema(quickFeedServiceExportGradesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceImportExternalGradesHandler := connect.NewUnaryHandler(
+		QuickFeedServiceImportExternalGradesProcedure,
+		svc.ImportExternalGrades,
+		connect.WithSchema(quickFeedServiceImportExternalGradesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	quickFeedServiceGetAssignmentsHandler := connect.NewUnaryHandler(
 		QuickFeedServiceGetAssignmentsProcedure,
 		svc.GetAssignments,
@@ -813,6 +1863,24 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 		connect.WithSchema(quickFeedServiceUpdateEnrollmentsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	quickFeedServiceImportRosterHandler := connect.NewUnaryHandler(
+		QuickFeedServiceImportRosterProcedure,
+		svc.ImportRoster,
+		connect.WithSchema(quickFeedServiceImportRosterMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetRosterCoverageHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetRosterCoverageProcedure,
+		svc.GetRosterCoverage,
+		connect.WithSchema(quickFeedServiceGetRosterCoverageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetEnrollmentPolicyHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetEnrollmentPolicyProcedure,
+		svc.GetEnrollmentPolicy,
+		connect.WithSchema(quickFeedServiceGetEnrollmentPolicyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	quickFeedServiceGetSubmissionHandler := connect.NewUnaryHandler(
 		QuickFeedServiceGetSubmissionProcedure,
 		svc.GetSubmission,
@@ -849,6 +1917,30 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 		connect.WithSchema(quickFeedServiceRebuildSubmissionsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	quickFeedServiceGetScheduledJobsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetScheduledJobsProcedure,
+		svc.GetScheduledJobs,
+		connect.WithSchema(quickFeedServiceGetScheduledJobsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceScheduleJobHandler := connect.NewUnaryHandler(
+		QuickFeedServiceScheduleJobProcedure,
+		svc.ScheduleJob,
+		connect.WithSchema(quickFeedServiceScheduleJobMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceCancelScheduledJobHandler := connect.NewUnaryHandler(
+		QuickFeedServiceCancelScheduledJobProcedure,
+		svc.CancelScheduledJob,
+		connect.WithSchema(quickFeedServiceCancelScheduledJobMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetAuditEntriesHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetAuditEntriesProcedure,
+		svc.GetAuditEntries,
+		connect.WithSchema(quickFeedServiceGetAuditEntriesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	quickFeedServiceCreateBenchmarkHandler := connect.NewUnaryHandler(
 		QuickFeedServiceCreateBenchmarkProcedure,
 		svc.CreateBenchmark,
@@ -897,6 +1989,162 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 		connect.WithSchema(quickFeedServiceUpdateReviewMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	quickFeedServiceGetLineCommentsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetLineCommentsProcedure,
+		svc.GetLineComments,
+		connect.WithSchema(quickFeedServiceGetLineCommentsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceCreateLineCommentHandler := connect.NewUnaryHandler(
+		QuickFeedServiceCreateLineCommentProcedure,
+		svc.CreateLineComment,
+		connect.WithSchema(quickFeedServiceCreateLineCommentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceUpdateLineCommentHandler := connect.NewUnaryHandler(
+		QuickFeedServiceUpdateLineCommentProcedure,
+		svc.UpdateLineComment,
+		connect.WithSchema(quickFeedServiceUpdateLineCommentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceDeleteLineCommentHandler := connect.NewUnaryHandler(
+		QuickFeedServiceDeleteLineCommentProcedure,
+		svc.DeleteLineComment,
+		connect.WithSchema(quickFeedServiceDeleteLineCommentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetFeedbackSnippetsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetFeedbackSnippetsProcedure,
+		svc.GetFeedbackSnippets,
+		connect.WithSchema(quickFeedServiceGetFeedbackSnippetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceCreateFeedbackSnippetHandler := connect.NewUnaryHandler(
+		QuickFeedServiceCreateFeedbackSnippetProcedure,
+		svc.CreateFeedbackSnippet,
+		connect.WithSchema(quickFeedServiceCreateFeedbackSnippetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceUpdateFeedbackSnippetHandler := connect.NewUnaryHandler(
+		QuickFeedServiceUpdateFeedbackSnippetProcedure,
+		svc.UpdateFeedbackSnippet,
+		connect.WithSchema(quickFeedServiceUpdateFeedbackSnippetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceDeleteFeedbackSnippetHandler := connect.NewUnaryHandler(
+		QuickFeedServiceDeleteFeedbackSnippetProcedure,
+		svc.DeleteFeedbackSnippet,
+		connect.WithSchema(quickFeedServiceDeleteFeedbackSnippetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceUseFeedbackSnippetHandler := connect.NewUnaryHandler(
+		QuickFeedServiceUseFeedbackSnippetProcedure,
+		svc.UseFeedbackSnippet,
+		connect.WithSchema(quickFeedServiceUseFeedbackSnippetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetRegradeRequestsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetRegradeRequestsProcedure,
+		svc.GetRegradeRequests,
+		connect.WithSchema(quickFeedServiceGetRegradeRequestsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceCreateRegradeRequestHandler := connect.NewUnaryHandler(
+		QuickFeedServiceCreateRegradeRequestProcedure,
+		svc.CreateRegradeRequest,
+		connect.WithSchema(quickFeedServiceCreateRegradeRequestMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceUpdateRegradeRequestHandler := connect.NewUnaryHandler(
+		QuickFeedServiceUpdateRegradeRequestProcedure,
+		svc.UpdateRegradeRequest,
+		connect.WithSchema(quickFeedServiceUpdateRegradeRequestMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetReconciliationHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetReconciliationProcedure,
+		svc.GetReconciliation,
+		connect.WithSchema(quickFeedServiceGetReconciliationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceReconcileReviewsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceReconcileReviewsProcedure,
+		svc.ReconcileReviews,
+		connect.WithSchema(quickFeedServiceReconcileReviewsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceAllocateReviewersHandler := connect.NewUnaryHandler(
+		QuickFeedServiceAllocateReviewersProcedure,
+		svc.AllocateReviewers,
+		connect.WithSchema(quickFeedServiceAllocateReviewersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceReassignReviewsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceReassignReviewsProcedure,
+		svc.ReassignReviews,
+		connect.WithSchema(quickFeedServiceReassignReviewsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetReviewQueueHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetReviewQueueProcedure,
+		svc.GetReviewQueue,
+		connect.WithSchema(quickFeedServiceGetReviewQueueMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetReviewerLoadsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetReviewerLoadsProcedure,
+		svc.GetReviewerLoads,
+		connect.WithSchema(quickFeedServiceGetReviewerLoadsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceStartPeerReviewHandler := connect.NewUnaryHandler(
+		QuickFeedServiceStartPeerReviewProcedure,
+		svc.StartPeerReview,
+		connect.WithSchema(quickFeedServiceStartPeerReviewMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceEndPeerReviewHandler := connect.NewUnaryHandler(
+		QuickFeedServiceEndPeerReviewProcedure,
+		svc.EndPeerReview,
+		connect.WithSchema(quickFeedServiceEndPeerReviewMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGetPeerReviewsHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGetPeerReviewsProcedure,
+		svc.GetPeerReviews,
+		connect.WithSchema(quickFeedServiceGetPeerReviewsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGradePeerReviewHandler := connect.NewUnaryHandler(
+		QuickFeedServiceGradePeerReviewProcedure,
+		svc.GradePeerReview,
+		connect.WithSchema(quickFeedServiceGradePeerReviewMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceCreatePeerReviewHandler := connect.NewUnaryHandler(
+		QuickFeedServiceCreatePeerReviewProcedure,
+		svc.CreatePeerReview,
+		connect.WithSchema(quickFeedServiceCreatePeerReviewMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceUpdatePeerReviewHandler := connect.NewUnaryHandler(
+		QuickFeedServiceUpdatePeerReviewProcedure,
+		svc.UpdatePeerReview,
+		connect.WithSchema(quickFeedServiceUpdatePeerReviewMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceStartQuizHandler := connect.NewUnaryHandler(
+		QuickFeedServiceStartQuizProcedure,
+		svc.StartQuiz,
+		connect.WithSchema(quickFeedServiceStartQuizMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceSubmitQuizHandler := connect.NewUnaryHandler(
+		QuickFeedServiceSubmitQuizProcedure,
+		svc.SubmitQuiz,
+		connect.WithSchema(quickFeedServiceSubmitQuizMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	quickFeedServiceGetOrganizationHandler := connect.NewUnaryHandler(
 		QuickFeedServiceGetOrganizationProcedure,
 		svc.GetOrganization,
@@ -921,6 +2169,18 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 		connect.WithSchema(quickFeedServiceSubmissionStreamMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	quickFeedServiceRegradeRequestStreamHandler := connect.NewServerStreamHandler(
+		QuickFeedServiceRegradeRequestStreamProcedure,
+		svc.RegradeRequestStream,
+		connect.WithSchema(quickFeedServiceRegradeRequestStreamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	quickFeedServiceGroupInvitationStreamHandler := connect.NewServerStreamHandler(
+		QuickFeedServiceGroupInvitationStreamProcedure,
+		svc.GroupInvitationStream,
+		connect.WithSchema(quickFeedServiceGroupInvitationStreamMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/qf.QuickFeedService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case QuickFeedServiceGetUserProcedure:
@@ -939,6 +2199,22 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 			quickFeedServiceUpdateGroupHandler.ServeHTTP(w, r)
 		case QuickFeedServiceDeleteGroupProcedure:
 			quickFeedServiceDeleteGroupHandler.ServeHTTP(w, r)
+		case QuickFeedServiceProposeGroupsProcedure:
+			quickFeedServiceProposeGroupsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceCreateGroupsProcedure:
+			quickFeedServiceCreateGroupsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetGroupPreferenceProcedure:
+			quickFeedServiceGetGroupPreferenceHandler.ServeHTTP(w, r)
+		case QuickFeedServiceUpdateGroupPreferenceProcedure:
+			quickFeedServiceUpdateGroupPreferenceHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetGroupInvitationsProcedure:
+			quickFeedServiceGetGroupInvitationsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceRespondToGroupInvitationProcedure:
+			quickFeedServiceRespondToGroupInvitationHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetGroupMembershipsProcedure:
+			quickFeedServiceGetGroupMembershipsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetGroupContributionsProcedure:
+			quickFeedServiceGetGroupContributionsHandler.ServeHTTP(w, r)
 		case QuickFeedServiceGetCourseProcedure:
 			quickFeedServiceGetCourseHandler.ServeHTTP(w, r)
 		case QuickFeedServiceGetCoursesProcedure:
@@ -947,6 +2223,16 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 			quickFeedServiceUpdateCourseHandler.ServeHTTP(w, r)
 		case QuickFeedServiceUpdateCourseVisibilityProcedure:
 			quickFeedServiceUpdateCourseVisibilityHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetGradingConfigProcedure:
+			quickFeedServiceGetGradingConfigHandler.ServeHTTP(w, r)
+		case QuickFeedServiceUpdateGradingConfigProcedure:
+			quickFeedServiceUpdateGradingConfigHandler.ServeHTTP(w, r)
+		case QuickFeedServiceComputeFinalGradesProcedure:
+			quickFeedServiceComputeFinalGradesHandler.ServeHTTP(w, r)
+		case QuickFeedServiceExportGradesProcedure:
+			quickFeedServiceExportGradesHandler.ServeHTTP(w, r)
+		case QuickFeedServiceImportExternalGradesProcedure:
+			quickFeedServiceImportExternalGradesHandler.ServeHTTP(w, r)
 		case QuickFeedServiceGetAssignmentsProcedure:
 			quickFeedServiceGetAssignmentsHandler.ServeHTTP(w, r)
 		case QuickFeedServiceUpdateAssignmentsProcedure:
@@ -957,6 +2243,12 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 			quickFeedServiceCreateEnrollmentHandler.ServeHTTP(w, r)
 		case QuickFeedServiceUpdateEnrollmentsProcedure:
 			quickFeedServiceUpdateEnrollmentsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceImportRosterProcedure:
+			quickFeedServiceImportRosterHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetRosterCoverageProcedure:
+			quickFeedServiceGetRosterCoverageHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetEnrollmentPolicyProcedure:
+			quickFeedServiceGetEnrollmentPolicyHandler.ServeHTTP(w, r)
 		case QuickFeedServiceGetSubmissionProcedure:
 			quickFeedServiceGetSubmissionHandler.ServeHTTP(w, r)
 		case QuickFeedServiceGetSubmissionsProcedure:
@@ -969,6 +2261,14 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 			quickFeedServiceUpdateSubmissionsHandler.ServeHTTP(w, r)
 		case QuickFeedServiceRebuildSubmissionsProcedure:
 			quickFeedServiceRebuildSubmissionsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetScheduledJobsProcedure:
+			quickFeedServiceGetScheduledJobsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceScheduleJobProcedure:
+			quickFeedServiceScheduleJobHandler.ServeHTTP(w, r)
+		case QuickFeedServiceCancelScheduledJobProcedure:
+			quickFeedServiceCancelScheduledJobHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetAuditEntriesProcedure:
+			quickFeedServiceGetAuditEntriesHandler.ServeHTTP(w, r)
 		case QuickFeedServiceCreateBenchmarkProcedure:
 			quickFeedServiceCreateBenchmarkHandler.ServeHTTP(w, r)
 		case QuickFeedServiceUpdateBenchmarkProcedure:
@@ -985,6 +2285,58 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 			quickFeedServiceCreateReviewHandler.ServeHTTP(w, r)
 		case QuickFeedServiceUpdateReviewProcedure:
 			quickFeedServiceUpdateReviewHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetLineCommentsProcedure:
+			quickFeedServiceGetLineCommentsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceCreateLineCommentProcedure:
+			quickFeedServiceCreateLineCommentHandler.ServeHTTP(w, r)
+		case QuickFeedServiceUpdateLineCommentProcedure:
+			quickFeedServiceUpdateLineCommentHandler.ServeHTTP(w, r)
+		case QuickFeedServiceDeleteLineCommentProcedure:
+			quickFeedServiceDeleteLineCommentHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetFeedbackSnippetsProcedure:
+			quickFeedServiceGetFeedbackSnippetsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceCreateFeedbackSnippetProcedure:
+			quickFeedServiceCreateFeedbackSnippetHandler.ServeHTTP(w, r)
+		case QuickFeedServiceUpdateFeedbackSnippetProcedure:
+			quickFeedServiceUpdateFeedbackSnippetHandler.ServeHTTP(w, r)
+		case QuickFeedServiceDeleteFeedbackSnippetProcedure:
+			quickFeedServiceDeleteFeedbackSnippetHandler.ServeHTTP(w, r)
+		case QuickFeedServiceUseFeedbackSnippetProcedure:
+			quickFeedServiceUseFeedbackSnippetHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetRegradeRequestsProcedure:
+			quickFeedServiceGetRegradeRequestsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceCreateRegradeRequestProcedure:
+			quickFeedServiceCreateRegradeRequestHandler.ServeHTTP(w, r)
+		case QuickFeedServiceUpdateRegradeRequestProcedure:
+			quickFeedServiceUpdateRegradeRequestHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetReconciliationProcedure:
+			quickFeedServiceGetReconciliationHandler.ServeHTTP(w, r)
+		case QuickFeedServiceReconcileReviewsProcedure:
+			quickFeedServiceReconcileReviewsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceAllocateReviewersProcedure:
+			quickFeedServiceAllocateReviewersHandler.ServeHTTP(w, r)
+		case QuickFeedServiceReassignReviewsProcedure:
+			quickFeedServiceReassignReviewsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetReviewQueueProcedure:
+			quickFeedServiceGetReviewQueueHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetReviewerLoadsProcedure:
+			quickFeedServiceGetReviewerLoadsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceStartPeerReviewProcedure:
+			quickFeedServiceStartPeerReviewHandler.ServeHTTP(w, r)
+		case QuickFeedServiceEndPeerReviewProcedure:
+			quickFeedServiceEndPeerReviewHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGetPeerReviewsProcedure:
+			quickFeedServiceGetPeerReviewsHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGradePeerReviewProcedure:
+			quickFeedServiceGradePeerReviewHandler.ServeHTTP(w, r)
+		case QuickFeedServiceCreatePeerReviewProcedure:
+			quickFeedServiceCreatePeerReviewHandler.ServeHTTP(w, r)
+		case QuickFeedServiceUpdatePeerReviewProcedure:
+			quickFeedServiceUpdatePeerReviewHandler.ServeHTTP(w, r)
+		case QuickFeedServiceStartQuizProcedure:
+			quickFeedServiceStartQuizHandler.ServeHTTP(w, r)
+		case QuickFeedServiceSubmitQuizProcedure:
+			quickFeedServiceSubmitQuizHandler.ServeHTTP(w, r)
 		case QuickFeedServiceGetOrganizationProcedure:
 			quickFeedServiceGetOrganizationHandler.ServeHTTP(w, r)
 		case QuickFeedServiceGetRepositoriesProcedure:
@@ -993,6 +2345,10 @@ func NewQuickFeedServiceHandler(svc QuickFeedServiceHandler, opts ...connect.Han
 			quickFeedServiceIsEmptyRepoHandler.ServeHTTP(w, r)
 		case QuickFeedServiceSubmissionStreamProcedure:
 			quickFeedServiceSubmissionStreamHandler.ServeHTTP(w, r)
+		case QuickFeedServiceRegradeRequestStreamProcedure:
+			quickFeedServiceRegradeRequestStreamHandler.ServeHTTP(w, r)
+		case QuickFeedServiceGroupInvitationStreamProcedure:
+			quickFeedServiceGroupInvitationStreamHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1034,6 +2390,38 @@ func (UnimplementedQuickFeedServiceHandler) DeleteGroup(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.DeleteGroup is not implemented"))
 }
 
+func (UnimplementedQuickFeedServiceHandler) ProposeGroups(context.Context, *connect.Request[qf.GroupFormationRequest]) (*connect.Response[qf.Groups], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.ProposeGroups is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) CreateGroups(context.Context, *connect.Request[qf.CreateGroupsRequest]) (*connect.Response[qf.Groups], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.CreateGroups is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetGroupPreference(context.Context, *connect.Request[qf.GroupRequest]) (*connect.Response[qf.GroupPreference], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetGroupPreference is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) UpdateGroupPreference(context.Context, *connect.Request[qf.GroupPreference]) (*connect.Response[qf.Void], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.UpdateGroupPreference is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetGroupInvitations(context.Context, *connect.Request[qf.GroupRequest]) (*connect.Response[qf.GroupInvitations], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetGroupInvitations is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) RespondToGroupInvitation(context.Context, *connect.Request[qf.GroupInvitation]) (*connect.Response[qf.Void], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.RespondToGroupInvitation is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetGroupMemberships(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.GroupMemberships], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetGroupMemberships is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetGroupContributions(context.Context, *connect.Request[qf.ContributionRequest]) (*connect.Response[qf.GroupContributions], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetGroupContributions is not implemented"))
+}
+
 func (UnimplementedQuickFeedServiceHandler) GetCourse(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Course], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetCourse is not implemented"))
 }
@@ -1050,6 +2438,26 @@ func (UnimplementedQuickFeedServiceHandler) UpdateCourseVisibility(context.Conte
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.UpdateCourseVisibility is not implemented"))
 }
 
+func (UnimplementedQuickFeedServiceHandler) GetGradingConfig(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.GradingConfig], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetGradingConfig is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) UpdateGradingConfig(context.Context, *connect.Request[qf.GradingConfig]) (*connect.Response[qf.GradingConfig], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.UpdateGradingConfig is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) ComputeFinalGrades(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.FinalGrades], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.ComputeFinalGrades is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) ExportGrades(context.Context, *connect.Request[qf.GradeExportRequest]) (*connect.Response[qf.GradeExport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.ExportGrades is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) ImportExternalGrades(context.Context, *connect.Request[qf.ExternalGradeImportRequest]) (*connect.Response[qf.ExternalGradeImport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.ImportExternalGrades is not implemented"))
+}
+
 func (UnimplementedQuickFeedServiceHandler) GetAssignments(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Assignments], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetAssignments is not implemented"))
 }
@@ -1070,6 +2478,18 @@ func (UnimplementedQuickFeedServiceHandler) UpdateEnrollments(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.UpdateEnrollments is not implemented"))
 }
 
+func (UnimplementedQuickFeedServiceHandler) ImportRoster(context.Context, *connect.Request[qf.RosterImportRequest]) (*connect.Response[qf.RosterCoverage], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.ImportRoster is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetRosterCoverage(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.RosterCoverage], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetRosterCoverage is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetEnrollmentPolicy(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.EnrollmentPolicy], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetEnrollmentPolicy is not implemented"))
+}
+
 func (UnimplementedQuickFeedServiceHandler) GetSubmission(context.Context, *connect.Request[qf.SubmissionRequest]) (*connect.Response[qf.Submission], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetSubmission is not implemented"))
 }
@@ -1094,6 +2514,22 @@ func (UnimplementedQuickFeedServiceHandler) RebuildSubmissions(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.RebuildSubmissions is not implemented"))
 }
 
+func (UnimplementedQuickFeedServiceHandler) GetScheduledJobs(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ScheduledJobs], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetScheduledJobs is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) ScheduleJob(context.Context, *connect.Request[qf.ScheduledJob]) (*connect.Response[qf.ScheduledJob], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.ScheduleJob is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) CancelScheduledJob(context.Context, *connect.Request[qf.ScheduledJob]) (*connect.Response[qf.Void], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.CancelScheduledJob is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetAuditEntries(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.AuditEntries], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetAuditEntries is not implemented"))
+}
+
 func (UnimplementedQuickFeedServiceHandler) CreateBenchmark(context.Context, *connect.Request[qf.GradingBenchmark]) (*connect.Response[qf.GradingBenchmark], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.CreateBenchmark is not implemented"))
 }
@@ -1126,6 +2562,110 @@ func (UnimplementedQuickFeedServiceHandler) UpdateReview(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.UpdateReview is not implemented"))
 }
 
+func (UnimplementedQuickFeedServiceHandler) GetLineComments(context.Context, *connect.Request[qf.LineCommentRequest]) (*connect.Response[qf.LineComments], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetLineComments is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) CreateLineComment(context.Context, *connect.Request[qf.LineComment]) (*connect.Response[qf.LineComment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.CreateLineComment is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) UpdateLineComment(context.Context, *connect.Request[qf.LineComment]) (*connect.Response[qf.LineComment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.UpdateLineComment is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) DeleteLineComment(context.Context, *connect.Request[qf.LineComment]) (*connect.Response[qf.Void], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.DeleteLineComment is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetFeedbackSnippets(context.Context, *connect.Request[qf.FeedbackSnippetRequest]) (*connect.Response[qf.FeedbackSnippets], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetFeedbackSnippets is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) CreateFeedbackSnippet(context.Context, *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.FeedbackSnippet], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.CreateFeedbackSnippet is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) UpdateFeedbackSnippet(context.Context, *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.FeedbackSnippet], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.UpdateFeedbackSnippet is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) DeleteFeedbackSnippet(context.Context, *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.Void], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.DeleteFeedbackSnippet is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) UseFeedbackSnippet(context.Context, *connect.Request[qf.FeedbackSnippetUsageRequest]) (*connect.Response[qf.FeedbackSnippet], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.UseFeedbackSnippet is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetRegradeRequests(context.Context, *connect.Request[qf.RegradeRequestQuery]) (*connect.Response[qf.RegradeRequests], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetRegradeRequests is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) CreateRegradeRequest(context.Context, *connect.Request[qf.RegradeRequest]) (*connect.Response[qf.RegradeRequest], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.CreateRegradeRequest is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) UpdateRegradeRequest(context.Context, *connect.Request[qf.RegradeRequest]) (*connect.Response[qf.RegradeRequest], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.UpdateRegradeRequest is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetReconciliation(context.Context, *connect.Request[qf.ReconcileRequest]) (*connect.Response[qf.Reconciliation], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetReconciliation is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) ReconcileReviews(context.Context, *connect.Request[qf.ReconcileRequest]) (*connect.Response[qf.Review], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.ReconcileReviews is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) AllocateReviewers(context.Context, *connect.Request[qf.ReviewAllocationRequest]) (*connect.Response[qf.ReviewAllocations], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.AllocateReviewers is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) ReassignReviews(context.Context, *connect.Request[qf.ReviewAllocationRequest]) (*connect.Response[qf.ReviewAllocations], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.ReassignReviews is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetReviewQueue(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ReviewAllocations], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetReviewQueue is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetReviewerLoads(context.Context, *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ReviewerLoads], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetReviewerLoads is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) StartPeerReview(context.Context, *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.StartPeerReview is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) EndPeerReview(context.Context, *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.EndPeerReview is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GetPeerReviews(context.Context, *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetPeerReviews is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GradePeerReview(context.Context, *connect.Request[qf.PeerReview]) (*connect.Response[qf.PeerReview], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GradePeerReview is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) CreatePeerReview(context.Context, *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.CreatePeerReview is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) UpdatePeerReview(context.Context, *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.UpdatePeerReview is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) StartQuiz(context.Context, *connect.Request[qf.QuizRequest]) (*connect.Response[qf.QuizAttempt], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.StartQuiz is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) SubmitQuiz(context.Context, *connect.Request[qf.QuizSubmission]) (*connect.Response[qf.Submission], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.SubmitQuiz is not implemented"))
+}
+
 func (UnimplementedQuickFeedServiceHandler) GetOrganization(context.Context, *connect.Request[qf.Organization]) (*connect.Response[qf.Organization], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GetOrganization is not implemented"))
 }
@@ -1141,3 +2681,11 @@ func (UnimplementedQuickFeedServiceHandler) IsEmptyRepo(context.Context, *connec
 func (UnimplementedQuickFeedServiceHandler) SubmissionStream(context.Context, *connect.Request[qf.Void], *connect.ServerStream[qf.Submission]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.SubmissionStream is not implemented"))
 }
+
+func (UnimplementedQuickFeedServiceHandler) RegradeRequestStream(context.Context, *connect.Request[qf.Void], *connect.ServerStream[qf.RegradeRequest]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.RegradeRequestStream is not implemented"))
+}
+
+func (UnimplementedQuickFeedServiceHandler) GroupInvitationStream(context.Context, *connect.Request[qf.Void], *connect.ServerStream[qf.GroupInvitation]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("qf.QuickFeedService.GroupInvitationStream is not implemented"))
+}
@@ -25,7 +25,7 @@ var file_qf_quickfeed_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x71, 0x66, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x71, 0x66, 0x1a, 0x0e, 0x71, 0x66, 0x2f, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x71, 0x66, 0x2f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x94, 0x0e, 0x0a, 0x10,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xac, 0x25, 0x0a, 0x10,
 	0x51, 0x75, 0x69, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x12, 0x1f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x08, 0x2e, 0x71, 0x66,
 	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22,
@@ -47,132 +47,358 @@ var file_qf_quickfeed_proto_rawDesc = []byte{
 	0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74,
 	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75,
 	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x25, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x12, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0b, 0x2e, 0x71, 0x66, 0x2e,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x0c, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
-	0x00, 0x12, 0x34, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x2e, 0x71, 0x66,
-	0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x71,
-	0x66, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12,
-	0x32, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x69, 0x64, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x19, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x00, 0x12, 0x35,
+	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x17,
+	0x2e, 0x71, 0x66, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x2e, 0x71, 0x66,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x13, 0x2e,
+	0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12,
+	0x3b, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x71, 0x66,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x73, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x22, 0x00, 0x12, 0x4a,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x71, 0x66, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x1a, 0x0b, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12,
+	0x26, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12,
+	0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x1a, 0x08, 0x2e, 0x71, 0x66,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x1a, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x11,
+	0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x71,
+	0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
 	0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69,
-	0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x71,
-	0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12,
-	0x2e, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
-	0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12,
-	0x30, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
-	0x00, 0x12, 0x38, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e,
-	0x71, 0x66, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x12, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x00, 0x12, 0x3b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x71, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d,
-	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x71, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x34, 0x0a,
-	0x12, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x12, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69,
-	0x64, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x6e,
-	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x14, 0x2e, 0x71,
-	0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65,
-	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e,
-	0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x71,
-	0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3f,
-	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
-	0x6e, 0x12, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12,
-	0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
-	0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72,
-	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e,
-	0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x71,
-	0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e,
-	0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10,
-	0x2e, 0x71, 0x66, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x10, 0x2e, 0x71, 0x66, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x71, 0x66, 0x2e,
-	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x30,
-	0x0a, 0x0b, 0x49, 0x73, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x15, 0x2e,
-	0x71, 0x66, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
-	0x12, 0x30, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x12, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x0e,
-	0x2e, 0x71, 0x66, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00,
-	0x30, 0x01, 0x42, 0x26, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b,
-	0x66, 0x65, 0x65, 0x64, 0x2f, 0x71, 0x66, 0xba, 0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x11, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x10, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08,
+	0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x11, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x71,
+	0x66, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x2e, 0x71,
+	0x66, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
+	0x2e, 0x71, 0x66, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x00, 0x12, 0x48, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x42, 0x79, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x71, 0x66,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x10, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x2e, 0x71, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x71,
+	0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e,
+	0x71, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x12, 0x52, 0x65, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x2e,
+	0x71, 0x66, 0x2e, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62,
+	0x73, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x10, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x1a, 0x10, 0x2e, 0x71, 0x66, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x22, 0x00, 0x12, 0x32,
+	0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4a, 0x6f, 0x62, 0x12, 0x10, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x22, 0x00, 0x12, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x71, 0x66, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12,
+	0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a,
+	0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x12, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x08, 0x2e, 0x71, 0x66,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x71, 0x66, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x1a, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x71, 0x66,
+	0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f,
+	0x6e, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x33, 0x0a,
+	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x71, 0x66, 0x2e, 0x4c, 0x69, 0x6e,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x71, 0x66, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x4c, 0x69,
+	0x6e, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x4c,
+	0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x1a, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0f, 0x2e, 0x71, 0x66, 0x2e,
+	0x4c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x73, 0x12, 0x1a,
+	0x2e, 0x71, 0x66, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x71, 0x66, 0x2e,
+	0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x73,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x71, 0x66,
+	0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x1a, 0x13, 0x2e, 0x71, 0x66, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x12, 0x13, 0x2e, 0x71, 0x66, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x1a, 0x13, 0x2e, 0x71, 0x66, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x15,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x71, 0x66, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x46, 0x65, 0x65,
+	0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x71,
+	0x66, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x71, 0x66, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70,
+	0x65, 0x74, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x71, 0x66, 0x2e,
+	0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x1a, 0x13, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x14, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x71, 0x66, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12,
+	0x36, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x12, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x71,
+	0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x1b, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x11, 0x2e,
+	0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x11, 0x2e,
+	0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f,
+	0x61, 0x64, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x22, 0x00, 0x12, 0x39, 0x0a, 0x0d, 0x45, 0x6e, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x71, 0x66, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12,
+	0x15, 0x2e, 0x71, 0x66, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0f, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x2e, 0x71,
+	0x66, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0x0e, 0x2e, 0x71,
+	0x66, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x71, 0x66, 0x2e,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x51, 0x75, 0x69, 0x7a, 0x12, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x51, 0x75, 0x69, 0x7a,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x12, 0x12, 0x2e, 0x71, 0x66, 0x2e, 0x51, 0x75, 0x69,
+	0x7a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x0e, 0x2e, 0x71, 0x66,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x37, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x10, 0x2e, 0x71, 0x66, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x10, 0x2e, 0x71, 0x66, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x71, 0x66, 0x2e, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x71,
+	0x66, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00,
+	0x12, 0x30, 0x0a, 0x0b, 0x49, 0x73, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x12,
+	0x15, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x22, 0x00, 0x12, 0x30, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x1a, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x08, 0x2e, 0x71,
+	0x66, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x12, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3a,
+	0x0a, 0x15, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x1a, 0x13, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x42, 0x26, 0x5a, 0x21, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65,
+	0x65, 0x64, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x71, 0x66, 0xba,
+	0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_qf_quickfeed_proto_goTypes = []interface{}{
-	(*Void)(nil),                     // 0: qf.Void
-	(*User)(nil),                     // 1: qf.User
-	(*GroupRequest)(nil),             // 2: qf.GroupRequest
-	(*CourseRequest)(nil),            // 3: qf.CourseRequest
-	(*Group)(nil),                    // 4: qf.Group
-	(*Course)(nil),                   // 5: qf.Course
-	(*Enrollment)(nil),               // 6: qf.Enrollment
-	(*EnrollmentRequest)(nil),        // 7: qf.EnrollmentRequest
-	(*Enrollments)(nil),              // 8: qf.Enrollments
-	(*SubmissionRequest)(nil),        // 9: qf.SubmissionRequest
-	(*UpdateSubmissionRequest)(nil),  // 10: qf.UpdateSubmissionRequest
-	(*UpdateSubmissionsRequest)(nil), // 11: qf.UpdateSubmissionsRequest
-	(*RebuildRequest)(nil),           // 12: qf.RebuildRequest
-	(*GradingBenchmark)(nil),         // 13: qf.GradingBenchmark
-	(*GradingCriterion)(nil),         // 14: qf.GradingCriterion
-	(*ReviewRequest)(nil),            // 15: qf.ReviewRequest
-	(*Organization)(nil),             // 16: qf.Organization
-	(*RepositoryRequest)(nil),        // 17: qf.RepositoryRequest
-	(*Users)(nil),                    // 18: qf.Users
-	(*Groups)(nil),                   // 19: qf.Groups
-	(*Courses)(nil),                  // 20: qf.Courses
-	(*Assignments)(nil),              // 21: qf.Assignments
-	(*Submission)(nil),               // 22: qf.Submission
-	(*Submissions)(nil),              // 23: qf.Submissions
-	(*CourseSubmissions)(nil),        // 24: qf.CourseSubmissions
-	(*Review)(nil),                   // 25: qf.Review
-	(*Repositories)(nil),             // 26: qf.Repositories
+	(*Void)(nil),                        // 0: qf.Void
+	(*User)(nil),                        // 1: qf.User
+	(*GroupRequest)(nil),                // 2: qf.GroupRequest
+	(*CourseRequest)(nil),               // 3: qf.CourseRequest
+	(*Group)(nil),                       // 4: qf.Group
+	(*GroupFormationRequest)(nil),       // 5: qf.GroupFormationRequest
+	(*CreateGroupsRequest)(nil),         // 6: qf.CreateGroupsRequest
+	(*GroupPreference)(nil),             // 7: qf.GroupPreference
+	(*GroupInvitation)(nil),             // 8: qf.GroupInvitation
+	(*ContributionRequest)(nil),         // 9: qf.ContributionRequest
+	(*Course)(nil),                      // 10: qf.Course
+	(*Enrollment)(nil),                  // 11: qf.Enrollment
+	(*GradingConfig)(nil),               // 12: qf.GradingConfig
+	(*GradeExportRequest)(nil),          // 13: qf.GradeExportRequest
+	(*ExternalGradeImportRequest)(nil),  // 14: qf.ExternalGradeImportRequest
+	(*EnrollmentRequest)(nil),           // 15: qf.EnrollmentRequest
+	(*Enrollments)(nil),                 // 16: qf.Enrollments
+	(*RosterImportRequest)(nil),         // 17: qf.RosterImportRequest
+	(*SubmissionRequest)(nil),           // 18: qf.SubmissionRequest
+	(*UpdateSubmissionRequest)(nil),     // 19: qf.UpdateSubmissionRequest
+	(*UpdateSubmissionsRequest)(nil),    // 20: qf.UpdateSubmissionsRequest
+	(*RebuildRequest)(nil),              // 21: qf.RebuildRequest
+	(*ScheduledJob)(nil),                // 22: qf.ScheduledJob
+	(*GradingBenchmark)(nil),            // 23: qf.GradingBenchmark
+	(*GradingCriterion)(nil),            // 24: qf.GradingCriterion
+	(*ReviewRequest)(nil),               // 25: qf.ReviewRequest
+	(*LineCommentRequest)(nil),          // 26: qf.LineCommentRequest
+	(*LineComment)(nil),                 // 27: qf.LineComment
+	(*FeedbackSnippetRequest)(nil),      // 28: qf.FeedbackSnippetRequest
+	(*FeedbackSnippet)(nil),             // 29: qf.FeedbackSnippet
+	(*FeedbackSnippetUsageRequest)(nil), // 30: qf.FeedbackSnippetUsageRequest
+	(*RegradeRequestQuery)(nil),         // 31: qf.RegradeRequestQuery
+	(*RegradeRequest)(nil),              // 32: qf.RegradeRequest
+	(*ReconcileRequest)(nil),            // 33: qf.ReconcileRequest
+	(*ReviewAllocationRequest)(nil),     // 34: qf.ReviewAllocationRequest
+	(*PeerReviewRequest)(nil),           // 35: qf.PeerReviewRequest
+	(*PeerReview)(nil),                  // 36: qf.PeerReview
+	(*QuizRequest)(nil),                 // 37: qf.QuizRequest
+	(*QuizSubmission)(nil),              // 38: qf.QuizSubmission
+	(*Organization)(nil),                // 39: qf.Organization
+	(*RepositoryRequest)(nil),           // 40: qf.RepositoryRequest
+	(*Users)(nil),                       // 41: qf.Users
+	(*Groups)(nil),                      // 42: qf.Groups
+	(*GroupInvitations)(nil),            // 43: qf.GroupInvitations
+	(*GroupMemberships)(nil),            // 44: qf.GroupMemberships
+	(*GroupContributions)(nil),          // 45: qf.GroupContributions
+	(*Courses)(nil),                     // 46: qf.Courses
+	(*FinalGrades)(nil),                 // 47: qf.FinalGrades
+	(*GradeExport)(nil),                 // 48: qf.GradeExport
+	(*ExternalGradeImport)(nil),         // 49: qf.ExternalGradeImport
+	(*Assignments)(nil),                 // 50: qf.Assignments
+	(*RosterCoverage)(nil),              // 51: qf.RosterCoverage
+	(*EnrollmentPolicy)(nil),            // 52: qf.EnrollmentPolicy
+	(*Submission)(nil),                  // 53: qf.Submission
+	(*Submissions)(nil),                 // 54: qf.Submissions
+	(*CourseSubmissions)(nil),           // 55: qf.CourseSubmissions
+	(*ScheduledJobs)(nil),               // 56: qf.ScheduledJobs
+	(*AuditEntries)(nil),                // 57: qf.AuditEntries
+	(*Review)(nil),                      // 58: qf.Review
+	(*LineComments)(nil),                // 59: qf.LineComments
+	(*FeedbackSnippets)(nil),            // 60: qf.FeedbackSnippets
+	(*RegradeRequests)(nil),             // 61: qf.RegradeRequests
+	(*Reconciliation)(nil),              // 62: qf.Reconciliation
+	(*ReviewAllocations)(nil),           // 63: qf.ReviewAllocations
+	(*ReviewerLoads)(nil),               // 64: qf.ReviewerLoads
+	(*PeerReviews)(nil),                 // 65: qf.PeerReviews
+	(*QuizAttempt)(nil),                 // 66: qf.QuizAttempt
+	(*Repositories)(nil),                // 67: qf.Repositories
 }
 var file_qf_quickfeed_proto_depIdxs = []int32{
 	0,  // 0: qf.QuickFeedService.GetUser:input_type -> qf.Void
@@ -183,70 +409,166 @@ var file_qf_quickfeed_proto_depIdxs = []int32{
 	4,  // 5: qf.QuickFeedService.CreateGroup:input_type -> qf.Group
 	4,  // 6: qf.QuickFeedService.UpdateGroup:input_type -> qf.Group
 	2,  // 7: qf.QuickFeedService.DeleteGroup:input_type -> qf.GroupRequest
-	3,  // 8: qf.QuickFeedService.GetCourse:input_type -> qf.CourseRequest
-	0,  // 9: qf.QuickFeedService.GetCourses:input_type -> qf.Void
-	5,  // 10: qf.QuickFeedService.UpdateCourse:input_type -> qf.Course
-	6,  // 11: qf.QuickFeedService.UpdateCourseVisibility:input_type -> qf.Enrollment
-	3,  // 12: qf.QuickFeedService.GetAssignments:input_type -> qf.CourseRequest
-	3,  // 13: qf.QuickFeedService.UpdateAssignments:input_type -> qf.CourseRequest
-	7,  // 14: qf.QuickFeedService.GetEnrollments:input_type -> qf.EnrollmentRequest
-	6,  // 15: qf.QuickFeedService.CreateEnrollment:input_type -> qf.Enrollment
-	8,  // 16: qf.QuickFeedService.UpdateEnrollments:input_type -> qf.Enrollments
-	9,  // 17: qf.QuickFeedService.GetSubmission:input_type -> qf.SubmissionRequest
-	9,  // 18: qf.QuickFeedService.GetSubmissions:input_type -> qf.SubmissionRequest
-	9,  // 19: qf.QuickFeedService.GetSubmissionsByCourse:input_type -> qf.SubmissionRequest
-	10, // 20: qf.QuickFeedService.UpdateSubmission:input_type -> qf.UpdateSubmissionRequest
-	11, // 21: qf.QuickFeedService.UpdateSubmissions:input_type -> qf.UpdateSubmissionsRequest
-	12, // 22: qf.QuickFeedService.RebuildSubmissions:input_type -> qf.RebuildRequest
-	13, // 23: qf.QuickFeedService.CreateBenchmark:input_type -> qf.GradingBenchmark
-	13, // 24: qf.QuickFeedService.UpdateBenchmark:input_type -> qf.GradingBenchmark
-	13, // 25: qf.QuickFeedService.DeleteBenchmark:input_type -> qf.GradingBenchmark
-	14, // 26: qf.QuickFeedService.CreateCriterion:input_type -> qf.GradingCriterion
-	14, // 27: qf.QuickFeedService.UpdateCriterion:input_type -> qf.GradingCriterion
-	14, // 28: qf.QuickFeedService.DeleteCriterion:input_type -> qf.GradingCriterion
-	15, // 29: qf.QuickFeedService.CreateReview:input_type -> qf.ReviewRequest
-	15, // 30: qf.QuickFeedService.UpdateReview:input_type -> qf.ReviewRequest
-	16, // 31: qf.QuickFeedService.GetOrganization:input_type -> qf.Organization
-	3,  // 32: qf.QuickFeedService.GetRepositories:input_type -> qf.CourseRequest
-	17, // 33: qf.QuickFeedService.IsEmptyRepo:input_type -> qf.RepositoryRequest
-	0,  // 34: qf.QuickFeedService.SubmissionStream:input_type -> qf.Void
-	1,  // 35: qf.QuickFeedService.GetUser:output_type -> qf.User
-	18, // 36: qf.QuickFeedService.GetUsers:output_type -> qf.Users
-	0,  // 37: qf.QuickFeedService.UpdateUser:output_type -> qf.Void
-	4,  // 38: qf.QuickFeedService.GetGroup:output_type -> qf.Group
-	19, // 39: qf.QuickFeedService.GetGroupsByCourse:output_type -> qf.Groups
-	4,  // 40: qf.QuickFeedService.CreateGroup:output_type -> qf.Group
-	4,  // 41: qf.QuickFeedService.UpdateGroup:output_type -> qf.Group
-	0,  // 42: qf.QuickFeedService.DeleteGroup:output_type -> qf.Void
-	5,  // 43: qf.QuickFeedService.GetCourse:output_type -> qf.Course
-	20, // 44: qf.QuickFeedService.GetCourses:output_type -> qf.Courses
-	0,  // 45: qf.QuickFeedService.UpdateCourse:output_type -> qf.Void
-	0,  // 46: qf.QuickFeedService.UpdateCourseVisibility:output_type -> qf.Void
-	21, // 47: qf.QuickFeedService.GetAssignments:output_type -> qf.Assignments
-	0,  // 48: qf.QuickFeedService.UpdateAssignments:output_type -> qf.Void
-	8,  // 49: qf.QuickFeedService.GetEnrollments:output_type -> qf.Enrollments
-	0,  // 50: qf.QuickFeedService.CreateEnrollment:output_type -> qf.Void
-	0,  // 51: qf.QuickFeedService.UpdateEnrollments:output_type -> qf.Void
-	22, // 52: qf.QuickFeedService.GetSubmission:output_type -> qf.Submission
-	23, // 53: qf.QuickFeedService.GetSubmissions:output_type -> qf.Submissions
-	24, // 54: qf.QuickFeedService.GetSubmissionsByCourse:output_type -> qf.CourseSubmissions
-	0,  // 55: qf.QuickFeedService.UpdateSubmission:output_type -> qf.Void
-	0,  // 56: qf.QuickFeedService.UpdateSubmissions:output_type -> qf.Void
-	0,  // 57: qf.QuickFeedService.RebuildSubmissions:output_type -> qf.Void
-	13, // 58: qf.QuickFeedService.CreateBenchmark:output_type -> qf.GradingBenchmark
-	0,  // 59: qf.QuickFeedService.UpdateBenchmark:output_type -> qf.Void
-	0,  // 60: qf.QuickFeedService.DeleteBenchmark:output_type -> qf.Void
-	14, // 61: qf.QuickFeedService.CreateCriterion:output_type -> qf.GradingCriterion
-	0,  // 62: qf.QuickFeedService.UpdateCriterion:output_type -> qf.Void
-	0,  // 63: qf.QuickFeedService.DeleteCriterion:output_type -> qf.Void
-	25, // 64: qf.QuickFeedService.CreateReview:output_type -> qf.Review
-	25, // 65: qf.QuickFeedService.UpdateReview:output_type -> qf.Review
-	16, // 66: qf.QuickFeedService.GetOrganization:output_type -> qf.Organization
-	26, // 67: qf.QuickFeedService.GetRepositories:output_type -> qf.Repositories
-	0,  // 68: qf.QuickFeedService.IsEmptyRepo:output_type -> qf.Void
-	22, // 69: qf.QuickFeedService.SubmissionStream:output_type -> qf.Submission
-	35, // [35:70] is the sub-list for method output_type
-	0,  // [0:35] is the sub-list for method input_type
+	5,  // 8: qf.QuickFeedService.ProposeGroups:input_type -> qf.GroupFormationRequest
+	6,  // 9: qf.QuickFeedService.CreateGroups:input_type -> qf.CreateGroupsRequest
+	2,  // 10: qf.QuickFeedService.GetGroupPreference:input_type -> qf.GroupRequest
+	7,  // 11: qf.QuickFeedService.UpdateGroupPreference:input_type -> qf.GroupPreference
+	2,  // 12: qf.QuickFeedService.GetGroupInvitations:input_type -> qf.GroupRequest
+	8,  // 13: qf.QuickFeedService.RespondToGroupInvitation:input_type -> qf.GroupInvitation
+	3,  // 14: qf.QuickFeedService.GetGroupMemberships:input_type -> qf.CourseRequest
+	9,  // 15: qf.QuickFeedService.GetGroupContributions:input_type -> qf.ContributionRequest
+	3,  // 16: qf.QuickFeedService.GetCourse:input_type -> qf.CourseRequest
+	0,  // 17: qf.QuickFeedService.GetCourses:input_type -> qf.Void
+	10, // 18: qf.QuickFeedService.UpdateCourse:input_type -> qf.Course
+	11, // 19: qf.QuickFeedService.UpdateCourseVisibility:input_type -> qf.Enrollment
+	3,  // 20: qf.QuickFeedService.GetGradingConfig:input_type -> qf.CourseRequest
+	12, // 21: qf.QuickFeedService.UpdateGradingConfig:input_type -> qf.GradingConfig
+	3,  // 22: qf.QuickFeedService.ComputeFinalGrades:input_type -> qf.CourseRequest
+	13, // 23: qf.QuickFeedService.ExportGrades:input_type -> qf.GradeExportRequest
+	14, // 24: qf.QuickFeedService.ImportExternalGrades:input_type -> qf.ExternalGradeImportRequest
+	3,  // 25: qf.QuickFeedService.GetAssignments:input_type -> qf.CourseRequest
+	3,  // 26: qf.QuickFeedService.UpdateAssignments:input_type -> qf.CourseRequest
+	15, // 27: qf.QuickFeedService.GetEnrollments:input_type -> qf.EnrollmentRequest
+	11, // 28: qf.QuickFeedService.CreateEnrollment:input_type -> qf.Enrollment
+	16, // 29: qf.QuickFeedService.UpdateEnrollments:input_type -> qf.Enrollments
+	17, // 30: qf.QuickFeedService.ImportRoster:input_type -> qf.RosterImportRequest
+	3,  // 31: qf.QuickFeedService.GetRosterCoverage:input_type -> qf.CourseRequest
+	3,  // 32: qf.QuickFeedService.GetEnrollmentPolicy:input_type -> qf.CourseRequest
+	18, // 33: qf.QuickFeedService.GetSubmission:input_type -> qf.SubmissionRequest
+	18, // 34: qf.QuickFeedService.GetSubmissions:input_type -> qf.SubmissionRequest
+	18, // 35: qf.QuickFeedService.GetSubmissionsByCourse:input_type -> qf.SubmissionRequest
+	19, // 36: qf.QuickFeedService.UpdateSubmission:input_type -> qf.UpdateSubmissionRequest
+	20, // 37: qf.QuickFeedService.UpdateSubmissions:input_type -> qf.UpdateSubmissionsRequest
+	21, // 38: qf.QuickFeedService.RebuildSubmissions:input_type -> qf.RebuildRequest
+	3,  // 39: qf.QuickFeedService.GetScheduledJobs:input_type -> qf.CourseRequest
+	22, // 40: qf.QuickFeedService.ScheduleJob:input_type -> qf.ScheduledJob
+	22, // 41: qf.QuickFeedService.CancelScheduledJob:input_type -> qf.ScheduledJob
+	3,  // 42: qf.QuickFeedService.GetAuditEntries:input_type -> qf.CourseRequest
+	23, // 43: qf.QuickFeedService.CreateBenchmark:input_type -> qf.GradingBenchmark
+	23, // 44: qf.QuickFeedService.UpdateBenchmark:input_type -> qf.GradingBenchmark
+	23, // 45: qf.QuickFeedService.DeleteBenchmark:input_type -> qf.GradingBenchmark
+	24, // 46: qf.QuickFeedService.CreateCriterion:input_type -> qf.GradingCriterion
+	24, // 47: qf.QuickFeedService.UpdateCriterion:input_type -> qf.GradingCriterion
+	24, // 48: qf.QuickFeedService.DeleteCriterion:input_type -> qf.GradingCriterion
+	25, // 49: qf.QuickFeedService.CreateReview:input_type -> qf.ReviewRequest
+	25, // 50: qf.QuickFeedService.UpdateReview:input_type -> qf.ReviewRequest
+	26, // 51: qf.QuickFeedService.GetLineComments:input_type -> qf.LineCommentRequest
+	27, // 52: qf.QuickFeedService.CreateLineComment:input_type -> qf.LineComment
+	27, // 53: qf.QuickFeedService.UpdateLineComment:input_type -> qf.LineComment
+	27, // 54: qf.QuickFeedService.DeleteLineComment:input_type -> qf.LineComment
+	28, // 55: qf.QuickFeedService.GetFeedbackSnippets:input_type -> qf.FeedbackSnippetRequest
+	29, // 56: qf.QuickFeedService.CreateFeedbackSnippet:input_type -> qf.FeedbackSnippet
+	29, // 57: qf.QuickFeedService.UpdateFeedbackSnippet:input_type -> qf.FeedbackSnippet
+	29, // 58: qf.QuickFeedService.DeleteFeedbackSnippet:input_type -> qf.FeedbackSnippet
+	30, // 59: qf.QuickFeedService.UseFeedbackSnippet:input_type -> qf.FeedbackSnippetUsageRequest
+	31, // 60: qf.QuickFeedService.GetRegradeRequests:input_type -> qf.RegradeRequestQuery
+	32, // 61: qf.QuickFeedService.CreateRegradeRequest:input_type -> qf.RegradeRequest
+	32, // 62: qf.QuickFeedService.UpdateRegradeRequest:input_type -> qf.RegradeRequest
+	33, // 63: qf.QuickFeedService.GetReconciliation:input_type -> qf.ReconcileRequest
+	33, // 64: qf.QuickFeedService.ReconcileReviews:input_type -> qf.ReconcileRequest
+	34, // 65: qf.QuickFeedService.AllocateReviewers:input_type -> qf.ReviewAllocationRequest
+	34, // 66: qf.QuickFeedService.ReassignReviews:input_type -> qf.ReviewAllocationRequest
+	3,  // 67: qf.QuickFeedService.GetReviewQueue:input_type -> qf.CourseRequest
+	3,  // 68: qf.QuickFeedService.GetReviewerLoads:input_type -> qf.CourseRequest
+	35, // 69: qf.QuickFeedService.StartPeerReview:input_type -> qf.PeerReviewRequest
+	35, // 70: qf.QuickFeedService.EndPeerReview:input_type -> qf.PeerReviewRequest
+	35, // 71: qf.QuickFeedService.GetPeerReviews:input_type -> qf.PeerReviewRequest
+	36, // 72: qf.QuickFeedService.GradePeerReview:input_type -> qf.PeerReview
+	25, // 73: qf.QuickFeedService.CreatePeerReview:input_type -> qf.ReviewRequest
+	25, // 74: qf.QuickFeedService.UpdatePeerReview:input_type -> qf.ReviewRequest
+	37, // 75: qf.QuickFeedService.StartQuiz:input_type -> qf.QuizRequest
+	38, // 76: qf.QuickFeedService.SubmitQuiz:input_type -> qf.QuizSubmission
+	39, // 77: qf.QuickFeedService.GetOrganization:input_type -> qf.Organization
+	3,  // 78: qf.QuickFeedService.GetRepositories:input_type -> qf.CourseRequest
+	40, // 79: qf.QuickFeedService.IsEmptyRepo:input_type -> qf.RepositoryRequest
+	0,  // 80: qf.QuickFeedService.SubmissionStream:input_type -> qf.Void
+	0,  // 81: qf.QuickFeedService.RegradeRequestStream:input_type -> qf.Void
+	0,  // 82: qf.QuickFeedService.GroupInvitationStream:input_type -> qf.Void
+	1,  // 83: qf.QuickFeedService.GetUser:output_type -> qf.User
+	41, // 84: qf.QuickFeedService.GetUsers:output_type -> qf.Users
+	0,  // 85: qf.QuickFeedService.UpdateUser:output_type -> qf.Void
+	4,  // 86: qf.QuickFeedService.GetGroup:output_type -> qf.Group
+	42, // 87: qf.QuickFeedService.GetGroupsByCourse:output_type -> qf.Groups
+	4,  // 88: qf.QuickFeedService.CreateGroup:output_type -> qf.Group
+	4,  // 89: qf.QuickFeedService.UpdateGroup:output_type -> qf.Group
+	0,  // 90: qf.QuickFeedService.DeleteGroup:output_type -> qf.Void
+	42, // 91: qf.QuickFeedService.ProposeGroups:output_type -> qf.Groups
+	42, // 92: qf.QuickFeedService.CreateGroups:output_type -> qf.Groups
+	7,  // 93: qf.QuickFeedService.GetGroupPreference:output_type -> qf.GroupPreference
+	0,  // 94: qf.QuickFeedService.UpdateGroupPreference:output_type -> qf.Void
+	43, // 95: qf.QuickFeedService.GetGroupInvitations:output_type -> qf.GroupInvitations
+	0,  // 96: qf.QuickFeedService.RespondToGroupInvitation:output_type -> qf.Void
+	44, // 97: qf.QuickFeedService.GetGroupMemberships:output_type -> qf.GroupMemberships
+	45, // 98: qf.QuickFeedService.GetGroupContributions:output_type -> qf.GroupContributions
+	10, // 99: qf.QuickFeedService.GetCourse:output_type -> qf.Course
+	46, // 100: qf.QuickFeedService.GetCourses:output_type -> qf.Courses
+	0,  // 101: qf.QuickFeedService.UpdateCourse:output_type -> qf.Void
+	0,  // 102: qf.QuickFeedService.UpdateCourseVisibility:output_type -> qf.Void
+	12, // 103: qf.QuickFeedService.GetGradingConfig:output_type -> qf.GradingConfig
+	12, // 104: qf.QuickFeedService.UpdateGradingConfig:output_type -> qf.GradingConfig
+	47, // 105: qf.QuickFeedService.ComputeFinalGrades:output_type -> qf.FinalGrades
+	48, // 106: qf.QuickFeedService.ExportGrades:output_type -> qf.GradeExport
+	49, // 107: qf.QuickFeedService.ImportExternalGrades:output_type -> qf.ExternalGradeImport
+	50, // 108: qf.QuickFeedService.GetAssignments:output_type -> qf.Assignments
+	0,  // 109: qf.QuickFeedService.UpdateAssignments:output_type -> qf.Void
+	16, // 110: qf.QuickFeedService.GetEnrollments:output_type -> qf.Enrollments
+	0,  // 111: qf.QuickFeedService.CreateEnrollment:output_type -> qf.Void
+	0,  // 112: qf.QuickFeedService.UpdateEnrollments:output_type -> qf.Void
+	51, // 113: qf.QuickFeedService.ImportRoster:output_type -> qf.RosterCoverage
+	51, // 114: qf.QuickFeedService.GetRosterCoverage:output_type -> qf.RosterCoverage
+	52, // 115: qf.QuickFeedService.GetEnrollmentPolicy:output_type -> qf.EnrollmentPolicy
+	53, // 116: qf.QuickFeedService.GetSubmission:output_type -> qf.Submission
+	54, // 117: qf.QuickFeedService.GetSubmissions:output_type -> qf.Submissions
+	55, // 118: qf.QuickFeedService.GetSubmissionsByCourse:output_type -> qf.CourseSubmissions
+	0,  // 119: qf.QuickFeedService.UpdateSubmission:output_type -> qf.Void
+	0,  // 120: qf.QuickFeedService.UpdateSubmissions:output_type -> qf.Void
+	0,  // 121: qf.QuickFeedService.RebuildSubmissions:output_type -> qf.Void
+	56, // 122: qf.QuickFeedService.GetScheduledJobs:output_type -> qf.ScheduledJobs
+	22, // 123: qf.QuickFeedService.ScheduleJob:output_type -> qf.ScheduledJob
+	0,  // 124: qf.QuickFeedService.CancelScheduledJob:output_type -> qf.Void
+	57, // 125: qf.QuickFeedService.GetAuditEntries:output_type -> qf.AuditEntries
+	23, // 126: qf.QuickFeedService.CreateBenchmark:output_type -> qf.GradingBenchmark
+	0,  // 127: qf.QuickFeedService.UpdateBenchmark:output_type -> qf.Void
+	0,  // 128: qf.QuickFeedService.DeleteBenchmark:output_type -> qf.Void
+	24, // 129: qf.QuickFeedService.CreateCriterion:output_type -> qf.GradingCriterion
+	0,  // 130: qf.QuickFeedService.UpdateCriterion:output_type -> qf.Void
+	0,  // 131: qf.QuickFeedService.DeleteCriterion:output_type -> qf.Void
+	58, // 132: qf.QuickFeedService.CreateReview:output_type -> qf.Review
+	58, // 133: qf.QuickFeedService.UpdateReview:output_type -> qf.Review
+	59, // 134: qf.QuickFeedService.GetLineComments:output_type -> qf.LineComments
+	27, // 135: qf.QuickFeedService.CreateLineComment:output_type -> qf.LineComment
+	27, // 136: qf.QuickFeedService.UpdateLineComment:output_type -> qf.LineComment
+	0,  // 137: qf.QuickFeedService.DeleteLineComment:output_type -> qf.Void
+	60, // 138: qf.QuickFeedService.GetFeedbackSnippets:output_type -> qf.FeedbackSnippets
+	29, // 139: qf.QuickFeedService.CreateFeedbackSnippet:output_type -> qf.FeedbackSnippet
+	29, // 140: qf.QuickFeedService.UpdateFeedbackSnippet:output_type -> qf.FeedbackSnippet
+	0,  // 141: qf.QuickFeedService.DeleteFeedbackSnippet:output_type -> qf.Void
+	29, // 142: qf.QuickFeedService.UseFeedbackSnippet:output_type -> qf.FeedbackSnippet
+	61, // 143: qf.QuickFeedService.GetRegradeRequests:output_type -> qf.RegradeRequests
+	32, // 144: qf.QuickFeedService.CreateRegradeRequest:output_type -> qf.RegradeRequest
+	32, // 145: qf.QuickFeedService.UpdateRegradeRequest:output_type -> qf.RegradeRequest
+	62, // 146: qf.QuickFeedService.GetReconciliation:output_type -> qf.Reconciliation
+	58, // 147: qf.QuickFeedService.ReconcileReviews:output_type -> qf.Review
+	63, // 148: qf.QuickFeedService.AllocateReviewers:output_type -> qf.ReviewAllocations
+	63, // 149: qf.QuickFeedService.ReassignReviews:output_type -> qf.ReviewAllocations
+	63, // 150: qf.QuickFeedService.GetReviewQueue:output_type -> qf.ReviewAllocations
+	64, // 151: qf.QuickFeedService.GetReviewerLoads:output_type -> qf.ReviewerLoads
+	65, // 152: qf.QuickFeedService.StartPeerReview:output_type -> qf.PeerReviews
+	65, // 153: qf.QuickFeedService.EndPeerReview:output_type -> qf.PeerReviews
+	65, // 154: qf.QuickFeedService.GetPeerReviews:output_type -> qf.PeerReviews
+	36, // 155: qf.QuickFeedService.GradePeerReview:output_type -> qf.PeerReview
+	58, // 156: qf.QuickFeedService.CreatePeerReview:output_type -> qf.Review
+	58, // 157: qf.QuickFeedService.UpdatePeerReview:output_type -> qf.Review
+	66, // 158: qf.QuickFeedService.StartQuiz:output_type -> qf.QuizAttempt
+	53, // 159: qf.QuickFeedService.SubmitQuiz:output_type -> qf.Submission
+	39, // 160: qf.QuickFeedService.GetOrganization:output_type -> qf.Organization
+	67, // 161: qf.QuickFeedService.GetRepositories:output_type -> qf.Repositories
+	0,  // 162: qf.QuickFeedService.IsEmptyRepo:output_type -> qf.Void
+	53, // 163: qf.QuickFeedService.SubmissionStream:output_type -> qf.Submission
+	32, // 164: qf.QuickFeedService.RegradeRequestStream:output_type -> qf.RegradeRequest
+	8,  // 165: qf.QuickFeedService.GroupInvitationStream:output_type -> qf.GroupInvitation
+	83, // [83:166] is the sub-list for method output_type
+	0,  // [0:83] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
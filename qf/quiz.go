@@ -0,0 +1,73 @@
+package qf
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+	"google.golang.org/protobuf/proto"
+)
+
+// QuizSubmitGrace is the time allowed after an attempt's deadline for the answers
+// to be submitted, to account for network latency.
+const QuizSubmitGrace = 30 * time.Second
+
+// QuizChoiceLabels returns the labels of a question's n choices: a, b, c, and so on.
+func QuizChoiceLabels(n int) []string {
+	labels := make([]string, n)
+	for i := range labels {
+		labels[i] = string(rune('a' + i))
+	}
+	return labels
+}
+
+// CloneWithoutAnswers returns a deep copy of the quiz without the correct answers.
+func (q *Quiz) CloneWithoutAnswers() *Quiz {
+	clone := proto.Clone(q).(*Quiz)
+	for _, question := range clone.GetQuestions() {
+		question.Answers = nil
+	}
+	return clone
+}
+
+// Scores returns a score for each of the quiz's questions, computed from the given answers.
+// Each correct choice selected gives one point, and each incorrect choice selected deducts one point,
+// such that a question's score is never less than zero nor more than its number of correct choices.
+// The question's weight is used as the score's weight.
+func (q *Quiz) Scores(answers []*QuizAnswer) []*score.Score {
+	selected := make(map[uint32][]string)
+	for _, answer := range answers {
+		selected[answer.GetNumber()] = answer.GetSelected()
+	}
+	scores := make([]*score.Score, 0, len(q.GetQuestions()))
+	for _, question := range q.GetQuestions() {
+		points := 0
+		labels := slices.Clone(selected[question.GetNumber()])
+		slices.Sort(labels)
+		for _, label := range slices.Compact(labels) {
+			if slices.Contains(question.GetAnswers(), label) {
+				points++
+			} else {
+				points--
+			}
+		}
+		scores = append(scores, &score.Score{
+			TestName: fmt.Sprintf("Question %d", question.GetNumber()),
+			Score:    int32(max(points, 0)),
+			MaxScore: int32(len(question.GetAnswers())),
+			Weight:   int32(question.GetWeight()),
+		})
+	}
+	return scores
+}
+
+// IsSubmitted returns true if the attempt has been submitted.
+func (a *QuizAttempt) IsSubmitted() bool {
+	return a.GetSubmitted() != nil
+}
+
+// IsExpired returns true if the attempt's deadline, including the grace period, has passed.
+func (a *QuizAttempt) IsExpired(now time.Time) bool {
+	return now.After(a.GetDeadline().AsTime().Add(QuizSubmitGrace))
+}
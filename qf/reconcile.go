@@ -0,0 +1,162 @@
+package qf
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Reconcile returns the disagreements between the given reviews of the submission,
+// and the final review proposed by the assignment's reconcile policy.
+// Only ready reviews that are neither final reviews nor peer reviews are reconciled.
+// With the manual policy, the proposed final review holds the criteria the reviews agree on,
+// leaving the criteria they disagree on ungraded for the teacher to decide.
+// No final review is proposed if the assignment has no reconcile policy or there are no reviews to reconcile.
+func (a *Assignment) Reconcile(submissionID uint64, reviews []*Review) *Reconciliation {
+	reconciliation := &Reconciliation{SubmissionID: submissionID}
+	for _, review := range reviews {
+		if review.GetReady() && !review.GetFinal() && !review.GetPeer() {
+			reconciliation.Reviews = append(reconciliation.Reviews, review)
+		}
+	}
+	if len(reconciliation.Reviews) == 0 {
+		return reconciliation
+	}
+
+	template := reconciliation.Reviews[0]
+	final := newFinalReview(template)
+	for i, bm := range template.GetGradingBenchmarks() {
+		for j, c := range bm.GetCriteria() {
+			criteria := matchingCriteria(reconciliation.Reviews, bm.GetHeading(), c.GetDescription())
+			disagreement := newCriterionDisagreement(bm.GetHeading(), c.GetDescription(), criteria)
+			if disagreement.GetSpread() > 0 {
+				reconciliation.Conflicts = append(reconciliation.Conflicts, disagreement)
+			}
+			finalCriterion := final.GradingBenchmarks[i].Criteria[j]
+			switch {
+			case disagreement.GetSpread() == 0:
+				// the reviews agree; keep the template's grade
+			case a.GetReconcilePolicy() == Assignment_AVERAGE:
+				averageCriterion(finalCriterion, criteria)
+			case a.GetReconcilePolicy() == Assignment_MANUAL:
+				finalCriterion.Grade = GradingCriterion_NONE
+				finalCriterion.Awarded = 0
+			}
+		}
+	}
+
+	switch a.GetReconcilePolicy() {
+	case Assignment_MAX:
+		best := template
+		for _, review := range reconciliation.Reviews {
+			if review.GetScore() > best.GetScore() {
+				best = review
+			}
+		}
+		final = newFinalReview(best)
+	case Assignment_AVERAGE, Assignment_MANUAL:
+		final.ComputeScore()
+	default:
+		return reconciliation
+	}
+	reconciliation.Final = final
+	return reconciliation
+}
+
+// HasSameCriteria returns true if the review has the same grading benchmarks and criteria as the other review.
+func (r *Review) HasSameCriteria(other *Review) bool {
+	if len(r.GetGradingBenchmarks()) != len(other.GetGradingBenchmarks()) {
+		return false
+	}
+	for i, bm := range r.GetGradingBenchmarks() {
+		otherBm := other.GetGradingBenchmarks()[i]
+		if bm.GetHeading() != otherBm.GetHeading() || len(bm.GetCriteria()) != len(otherBm.GetCriteria()) {
+			return false
+		}
+		for j, c := range bm.GetCriteria() {
+			if c.GetDescription() != otherBm.GetCriteria()[j].GetDescription() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// newFinalReview returns a copy of the given review, without IDs, comments and feedback, marked as final.
+func newFinalReview(review *Review) *Review {
+	final := proto.Clone(review).(*Review)
+	final.ID = 0
+	final.ReviewerID = 0
+	final.Feedback = ""
+	final.Edited = nil
+	final.Ready = true
+	final.Final = true
+	for _, bm := range final.GetGradingBenchmarks() {
+		bm.ID = 0
+		bm.ReviewID = 0
+		bm.Comment = ""
+		for _, c := range bm.GetCriteria() {
+			c.ID = 0
+			c.BenchmarkID = 0
+			c.Comment = ""
+		}
+	}
+	return final
+}
+
+// matchingCriteria returns the criterion with the given heading and description from each of the reviews.
+func matchingCriteria(reviews []*Review, heading, description string) []*GradingCriterion {
+	var criteria []*GradingCriterion
+	for _, review := range reviews {
+		for _, bm := range review.GetGradingBenchmarks() {
+			if bm.GetHeading() != heading {
+				continue
+			}
+			for _, c := range bm.GetCriteria() {
+				if c.GetDescription() == description {
+					criteria = append(criteria, c)
+				}
+			}
+		}
+	}
+	return criteria
+}
+
+func newCriterionDisagreement(heading, description string, criteria []*GradingCriterion) *CriterionDisagreement {
+	disagreement := &CriterionDisagreement{Heading: heading, Description: description}
+	least, most := uint64(math.MaxUint64), uint64(0)
+	for _, c := range criteria {
+		earned := c.EarnedPoints()
+		if c.GetPoints() == 0 && c.GetGrade() == GradingCriterion_PASSED {
+			earned = 1
+		}
+		disagreement.Earned = append(disagreement.Earned, earned)
+		least, most = min(least, earned), max(most, earned)
+	}
+	if len(criteria) > 0 {
+		disagreement.Spread = most - least
+	}
+	return disagreement
+}
+
+// averageCriterion sets the final criterion to the average of the given criteria.
+// A partial credit criterion is awarded the average of the awarded points, rounded to the nearest point.
+// A pass/fail criterion is passed if at least half of the criteria are passed.
+func averageCriterion(final *GradingCriterion, criteria []*GradingCriterion) {
+	var awarded uint64
+	passed := 0
+	for _, c := range criteria {
+		awarded += c.EarnedPoints()
+		if c.GetGrade() == GradingCriterion_PASSED {
+			passed++
+		}
+	}
+	if final.GetPartial() {
+		final.Awarded = uint64(math.Round(float64(awarded) / float64(len(criteria))))
+		return
+	}
+	final.Grade = GradingCriterion_FAILED
+	if 2*passed >= len(criteria) {
+		final.Grade = GradingCriterion_PASSED
+	}
+}
@@ -0,0 +1,92 @@
+package qf_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func newReconcileReview(id uint64, ready bool, style qf.GradingCriterion_Grade, awarded uint64) *qf.Review {
+	review := &qf.Review{
+		ID:    id,
+		Ready: ready,
+		GradingBenchmarks: []*qf.GradingBenchmark{
+			{
+				Heading: "Code",
+				Criteria: []*qf.GradingCriterion{
+					{Description: "Compiles", Points: 5, Grade: qf.GradingCriterion_PASSED},
+					{Description: "Style", Points: 5, Grade: style},
+					{Description: "Quality", Points: 10, Partial: true, Awarded: awarded},
+				},
+			},
+		},
+	}
+	review.ComputeScore()
+	return review
+}
+
+func TestReconcile(t *testing.T) {
+	reviews := []*qf.Review{
+		newReconcileReview(1, true, qf.GradingCriterion_PASSED, 4), // score 14
+		newReconcileReview(2, true, qf.GradingCriterion_FAILED, 7), // score 12
+		newReconcileReview(3, false, qf.GradingCriterion_FAILED, 0),
+		newReconcileReview(4, true, qf.GradingCriterion_PASSED, 10),
+	}
+	reviews[3].Peer = true // peer reviews are not reconciled
+	wantConflicts := []*qf.CriterionDisagreement{
+		{Heading: "Code", Description: "Style", Earned: []uint64{5, 0}, Spread: 5},
+		{Heading: "Code", Description: "Quality", Earned: []uint64{4, 7}, Spread: 3},
+	}
+	ignoreIDs := protocmp.IgnoreFields(&qf.Review{}, "ID")
+
+	tests := []struct {
+		policy    qf.Assignment_ReconcilePolicy
+		wantFinal *qf.Review
+	}{
+		{qf.Assignment_NONE, nil},
+		{qf.Assignment_AVERAGE, &qf.Review{Ready: true, Final: true, Score: 16, GradingBenchmarks: []*qf.GradingBenchmark{{
+			Heading: "Code",
+			Criteria: []*qf.GradingCriterion{
+				{Description: "Compiles", Points: 5, Grade: qf.GradingCriterion_PASSED},
+				{Description: "Style", Points: 5, Grade: qf.GradingCriterion_PASSED}, // half of the reviews passed
+				{Description: "Quality", Points: 10, Partial: true, Awarded: 6},      // 5.5 rounded
+			},
+		}}}},
+		{qf.Assignment_MAX, &qf.Review{Ready: true, Final: true, Score: 14, GradingBenchmarks: []*qf.GradingBenchmark{{
+			Heading: "Code",
+			Criteria: []*qf.GradingCriterion{
+				{Description: "Compiles", Points: 5, Grade: qf.GradingCriterion_PASSED},
+				{Description: "Style", Points: 5, Grade: qf.GradingCriterion_PASSED},
+				{Description: "Quality", Points: 10, Partial: true, Awarded: 4},
+			},
+		}}}},
+		{qf.Assignment_MANUAL, &qf.Review{Ready: true, Final: true, Score: 5, GradingBenchmarks: []*qf.GradingBenchmark{{
+			Heading: "Code",
+			Criteria: []*qf.GradingCriterion{
+				{Description: "Compiles", Points: 5, Grade: qf.GradingCriterion_PASSED},
+				{Description: "Style", Points: 5},
+				{Description: "Quality", Points: 10, Partial: true},
+			},
+		}}}},
+	}
+	for _, tt := range tests {
+		assignment := &qf.Assignment{ReconcilePolicy: tt.policy}
+		got := assignment.Reconcile(1, reviews)
+		if diff := cmp.Diff(reviews[:2], got.GetReviews(), protocmp.Transform()); diff != "" {
+			t.Errorf("Reconcile(%v) reviews mismatch (-want +got):\n%s", tt.policy, diff)
+		}
+		if diff := cmp.Diff(wantConflicts, got.GetConflicts(), protocmp.Transform()); diff != "" {
+			t.Errorf("Reconcile(%v) conflicts mismatch (-want +got):\n%s", tt.policy, diff)
+		}
+		if diff := cmp.Diff(tt.wantFinal, got.GetFinal(), protocmp.Transform(), ignoreIDs); diff != "" {
+			t.Errorf("Reconcile(%v) final review mismatch (-want +got):\n%s", tt.policy, diff)
+		}
+	}
+
+	// the reconciled reviews are unchanged
+	if got := reviews[0].GetGradingBenchmarks()[0].GetCriteria()[1].GetGrade(); got != qf.GradingCriterion_PASSED {
+		t.Errorf("Reconcile() changed review criterion grade to %v", got)
+	}
+}
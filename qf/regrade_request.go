@@ -0,0 +1,15 @@
+package qf
+
+// IsOpen returns true if the regrade request has not yet been rejected or resolved.
+func (r *RegradeRequest) IsOpen() bool {
+	return r.GetStatus() == RegradeRequest_PENDING || r.GetStatus() == RegradeRequest_ACCEPTED
+}
+
+// CanTransitionTo returns true if the regrade request's status can be changed to the given status.
+// Open requests can be accepted, rejected or resolved; rejected and resolved requests are final.
+func (r *RegradeRequest) CanTransitionTo(status RegradeRequest_Status) bool {
+	if !r.IsOpen() || status == r.GetStatus() {
+		return false
+	}
+	return status != RegradeRequest_PENDING
+}
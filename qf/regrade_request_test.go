@@ -0,0 +1,41 @@
+package qf_test
+
+import (
+	"testing"
+
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+func TestRegradeRequestCanTransitionTo(t *testing.T) {
+	const (
+		pending  = qf.RegradeRequest_PENDING
+		accepted = qf.RegradeRequest_ACCEPTED
+		rejected = qf.RegradeRequest_REJECTED
+		resolved = qf.RegradeRequest_RESOLVED
+	)
+	tests := []struct {
+		from, to qf.RegradeRequest_Status
+		want     bool
+	}{
+		{from: pending, to: pending, want: false},
+		{from: pending, to: accepted, want: true},
+		{from: pending, to: rejected, want: true},
+		{from: pending, to: resolved, want: true},
+		{from: accepted, to: pending, want: false},
+		{from: accepted, to: accepted, want: false},
+		{from: accepted, to: rejected, want: true},
+		{from: accepted, to: resolved, want: true},
+		{from: rejected, to: pending, want: false},
+		{from: rejected, to: accepted, want: false},
+		{from: rejected, to: resolved, want: false},
+		{from: resolved, to: pending, want: false},
+		{from: resolved, to: accepted, want: false},
+		{from: resolved, to: rejected, want: false},
+	}
+	for _, tt := range tests {
+		request := &qf.RegradeRequest{Status: tt.from}
+		if got := request.CanTransitionTo(tt.to); got != tt.want {
+			t.Errorf("(%v).CanTransitionTo(%v) = %t, want %t", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
@@ -32,6 +32,34 @@ func (g *Groups) RemoveRemoteID() {
 	}
 }
 
+// RemoveRemoteID removes remote identities of the invited user, the inviter and the group's members
+func (i *GroupInvitation) RemoveRemoteID() {
+	i.GetUser().RemoveRemoteID()
+	i.GetInviter().RemoveRemoteID()
+	i.GetGroup().RemoveRemoteID()
+}
+
+// RemoveRemoteID removes remote identities for every group invitation
+func (i *GroupInvitations) RemoveRemoteID() {
+	for _, invitation := range i.GetInvitations() {
+		invitation.RemoveRemoteID()
+	}
+}
+
+// RemoveRemoteID removes remote identities of the members in the group membership history
+func (m *GroupMemberships) RemoveRemoteID() {
+	for _, membership := range m.GetMemberships() {
+		membership.GetUser().RemoveRemoteID()
+	}
+}
+
+// RemoveRemoteID removes remote identities of the group's members
+func (c *GroupContributions) RemoveRemoteID() {
+	for _, member := range c.GetMembers() {
+		member.GetUser().RemoveRemoteID()
+	}
+}
+
 // RemoveRemoteID removes remote identity of the enrolled user
 func (e *Enrollment) RemoveRemoteID() {
 	e.GetUser().RemoveRemoteID()
@@ -65,3 +93,10 @@ func (c *Courses) RemoveRemoteID() {
 		crs.RemoveRemoteID()
 	}
 }
+
+// RemoveRemoteID removes remote identities for the unmatched enrollments
+func (r *RosterCoverage) RemoveRemoteID() {
+	for _, enr := range r.GetUnmatched() {
+		enr.RemoveRemoteID()
+	}
+}
@@ -1,27 +1,104 @@
 package qf
 
+import "math"
+
 // ComputeScore computes the total score for the review and assigns it to r.
-// If the grading criteria have predefined points, the score is the sum of these points.
-// Otherwise, each criterion is given equal weight, such that the max score is 100.
+// If any of the grading benchmarks have a weight, the score is the weighted average
+// of the benchmarks' scores, scaled such that the max score is 100.
+// Otherwise, if the grading criteria have predefined points, the score is the sum of
+// the points earned for each criterion. Otherwise, each criterion is given equal weight,
+// such that the max score is 100.
 func (r *Review) ComputeScore() {
-	scorePoints := 0
+	if r.isWeighted() {
+		r.computeWeightedScore()
+		return
+	}
+	scorePoints := uint64(0)
+	totalPoints := uint64(0)
 	totalCriteria := 0
 	passedCriteria := 0
 	for _, bm := range r.GradingBenchmarks {
 		for _, c := range bm.Criteria {
 			totalCriteria++
+			totalPoints += c.GetPoints()
+			scorePoints += c.EarnedPoints()
 			if c.Grade == GradingCriterion_PASSED {
 				passedCriteria++
-				scorePoints += int(c.Points)
 			}
 		}
 	}
 	if totalCriteria == 0 {
 		return
 	}
-	if scorePoints == 0 {
+	if totalPoints == 0 {
 		r.Score = uint32(100 * passedCriteria / totalCriteria)
 	} else {
 		r.Score = uint32(scorePoints)
 	}
 }
+
+// isWeighted returns true if any of the review's benchmarks have a weight.
+func (r *Review) isWeighted() bool {
+	for _, bm := range r.GetGradingBenchmarks() {
+		if bm.GetWeight() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// computeWeightedScore computes the weighted average of the benchmarks' scores.
+// Benchmarks without a weight are given weight 1, and benchmarks without criteria are ignored.
+func (r *Review) computeWeightedScore() {
+	var weightedScore, totalWeight float64
+	for _, bm := range r.GetGradingBenchmarks() {
+		if len(bm.GetCriteria()) == 0 {
+			continue
+		}
+		weight := float64(bm.GetWeight())
+		if weight == 0 {
+			weight = 1
+		}
+		weightedScore += weight * bm.Score()
+		totalWeight += weight
+	}
+	if totalWeight == 0 {
+		return
+	}
+	r.Score = uint32(math.Round(100 * weightedScore / totalWeight))
+}
+
+// Score returns the benchmark's score as a fraction between 0 and 1.
+// If the benchmark's criteria have predefined points, the score is the fraction of points earned.
+// Otherwise, the score is the fraction of passed criteria.
+func (bm *GradingBenchmark) Score() float64 {
+	var earnedPoints, totalPoints uint64
+	passedCriteria := 0
+	for _, c := range bm.GetCriteria() {
+		earnedPoints += c.EarnedPoints()
+		totalPoints += c.GetPoints()
+		if c.GetGrade() == GradingCriterion_PASSED {
+			passedCriteria++
+		}
+	}
+	if totalPoints > 0 {
+		return float64(earnedPoints) / float64(totalPoints)
+	}
+	if len(bm.GetCriteria()) == 0 {
+		return 0
+	}
+	return float64(passedCriteria) / float64(len(bm.GetCriteria()))
+}
+
+// EarnedPoints returns the points earned for the criterion.
+// A partial credit criterion earns its awarded points, limited to the criterion's points.
+// A pass/fail criterion earns all its points if passed, and no points otherwise.
+func (c *GradingCriterion) EarnedPoints() uint64 {
+	if c.GetPartial() {
+		return min(c.GetAwarded(), c.GetPoints())
+	}
+	if c.GetGrade() == GradingCriterion_PASSED {
+		return c.GetPoints()
+	}
+	return 0
+}
@@ -103,6 +103,93 @@ var reviewScoreTests = []struct {
 			},
 		},
 	},
+	{
+		"Test 13/15 score with partial credit",
+		13,
+		&qf.Review{
+			ID: 4,
+			GradingBenchmarks: []*qf.GradingBenchmark{
+				{
+					Criteria: []*qf.GradingCriterion{
+						{
+							Points: 5,
+							Grade:  qf.GradingCriterion_PASSED,
+						},
+						{
+							Points:  5,
+							Partial: true,
+							Awarded: 3,
+							Grade:   qf.GradingCriterion_PASSED,
+						},
+						{
+							Points:  5,
+							Partial: true,
+							Awarded: 7, // more than the criterion's points
+							Grade:   qf.GradingCriterion_FAILED,
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		"Test 70% weighted score",
+		70,
+		&qf.Review{
+			ID: 5,
+			GradingBenchmarks: []*qf.GradingBenchmark{
+				{
+					Weight: 3,
+					Criteria: []*qf.GradingCriterion{
+						{
+							Points:  10,
+							Partial: true,
+							Awarded: 6,
+						},
+					},
+				},
+				{
+					// unweighted benchmark in a weighted review has weight 1
+					Criteria: []*qf.GradingCriterion{
+						{
+							Grade: qf.GradingCriterion_PASSED,
+						},
+					},
+				},
+				{
+					// benchmark without criteria is ignored
+					Weight: 5,
+				},
+			},
+		},
+	},
+	{
+		"Test 50% weighted score with pass/fail criteria",
+		50,
+		&qf.Review{
+			ID: 6,
+			GradingBenchmarks: []*qf.GradingBenchmark{
+				{
+					Weight: 1,
+					Criteria: []*qf.GradingCriterion{
+						{
+							Points: 1,
+							Grade:  qf.GradingCriterion_PASSED,
+						},
+					},
+				},
+				{
+					Weight: 1,
+					Criteria: []*qf.GradingCriterion{
+						{
+							Points: 10,
+							Grade:  qf.GradingCriterion_FAILED,
+						},
+					},
+				},
+			},
+		},
+	},
 }
 
 func TestComputeScore(t *testing.T) {
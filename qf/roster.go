@@ -0,0 +1,18 @@
+package qf
+
+import "strings"
+
+// Matches returns true if the user's student ID is the roster entry's student ID, and the user's
+// GitHub login matches the entry's login, or, if the entry has no login, the user's verified email
+// matches the entry's email. Since users enter their own student ID and email, neither the student ID
+// nor an unverified email is enough to match. Logins and emails are matched regardless of case.
+func (e *RosterEntry) Matches(user *User) bool {
+	studentID := strings.TrimSpace(user.GetStudentID())
+	if e.GetStudentID() == "" || studentID != e.GetStudentID() {
+		return false
+	}
+	if e.GetLogin() != "" {
+		return strings.EqualFold(user.GetLogin(), e.GetLogin())
+	}
+	return e.GetEmail() != "" && user.GetEmailVerified() && strings.EqualFold(strings.TrimSpace(user.GetEmail()), e.GetEmail())
+}
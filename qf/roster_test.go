@@ -0,0 +1,33 @@
+package qf_test
+
+import (
+	"testing"
+
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+func TestRosterEntryMatches(t *testing.T) {
+	withLogin := &qf.RosterEntry{StudentID: "1001", Email: "alice@uis.no", Login: "Alice"}
+	withoutLogin := &qf.RosterEntry{StudentID: "1001", Email: "alice@uis.no"}
+	tests := []struct {
+		name  string
+		entry *qf.RosterEntry
+		user  *qf.User
+		want  bool
+	}{
+		{name: "login", entry: withLogin, user: &qf.User{StudentID: "1001", Login: "alice", Email: "other@example.com"}, want: true},
+		{name: "login mismatch", entry: withLogin, user: &qf.User{StudentID: "1001", Login: "bob", Email: "alice@uis.no"}, want: false},
+		{name: "email", entry: withoutLogin, user: &qf.User{StudentID: " 1001 ", Login: "alice", Email: "Alice@UiS.no", EmailVerified: true}, want: true},
+		{name: "unverified email", entry: withoutLogin, user: &qf.User{StudentID: "1001", Login: "mallory", Email: "alice@uis.no"}, want: false},
+		{name: "email mismatch", entry: withoutLogin, user: &qf.User{StudentID: "1001", Login: "alice", Email: "bob@uis.no", EmailVerified: true}, want: false},
+		{name: "student ID mismatch", entry: withLogin, user: &qf.User{StudentID: "1002", Login: "alice", Email: "alice@uis.no"}, want: false},
+		{name: "no student ID", entry: &qf.RosterEntry{Email: "alice@uis.no"}, user: &qf.User{Email: "alice@uis.no"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.entry.Matches(tt.user); got != tt.want {
+				t.Errorf("Matches() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
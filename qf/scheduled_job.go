@@ -0,0 +1,32 @@
+package qf
+
+import "time"
+
+// DueAt returns the time at which the job should run for the given assignment.
+// Release jobs run at their scheduled time, and grade jobs run at the assignment's
+// deadline plus the job's grace period. The zero time is returned if the job
+// cannot be scheduled, e.g., a grade job for an assignment without a deadline.
+func (j *ScheduledJob) DueAt(assignment *Assignment) time.Time {
+	switch j.GetType() {
+	case ScheduledJob_RELEASE:
+		if j.GetRunAt() == nil {
+			return time.Time{}
+		}
+		return j.GetRunAt().AsTime()
+	case ScheduledJob_GRADE:
+		if assignment.GetDeadline() == nil {
+			return time.Time{}
+		}
+		return assignment.GetDeadline().AsTime().Add(time.Duration(j.GetGraceHours()) * time.Hour)
+	}
+	return time.Time{}
+}
+
+// IsDue returns true if the job has not yet run and its due time for the given assignment has passed.
+func (j *ScheduledJob) IsDue(assignment *Assignment, now time.Time) bool {
+	if j.GetCompleted() != nil {
+		return false
+	}
+	due := j.DueAt(assignment)
+	return !due.IsZero() && !now.Before(due)
+}
@@ -0,0 +1,38 @@
+package qf_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestScheduledJobIsDue(t *testing.T) {
+	deadline := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	assignment := &qf.Assignment{Deadline: timestamppb.New(deadline)}
+	noDeadline := &qf.Assignment{}
+
+	tests := []struct {
+		name       string
+		job        *qf.ScheduledJob
+		assignment *qf.Assignment
+		now        time.Time
+		want       bool
+	}{
+		{name: "release before runAt", job: &qf.ScheduledJob{Type: qf.ScheduledJob_RELEASE, RunAt: timestamppb.New(deadline)}, assignment: assignment, now: deadline.Add(-time.Minute), want: false},
+		{name: "release at runAt", job: &qf.ScheduledJob{Type: qf.ScheduledJob_RELEASE, RunAt: timestamppb.New(deadline)}, assignment: assignment, now: deadline, want: true},
+		{name: "release without runAt", job: &qf.ScheduledJob{Type: qf.ScheduledJob_RELEASE}, assignment: assignment, now: deadline, want: false},
+		{name: "grade within grace period", job: &qf.ScheduledJob{Type: qf.ScheduledJob_GRADE, GraceHours: 48}, assignment: assignment, now: deadline.Add(47 * time.Hour), want: false},
+		{name: "grade after grace period", job: &qf.ScheduledJob{Type: qf.ScheduledJob_GRADE, GraceHours: 48}, assignment: assignment, now: deadline.Add(48 * time.Hour), want: true},
+		{name: "grade without deadline", job: &qf.ScheduledJob{Type: qf.ScheduledJob_GRADE}, assignment: noDeadline, now: deadline, want: false},
+		{name: "completed job", job: &qf.ScheduledJob{Type: qf.ScheduledJob_GRADE, Completed: timestamppb.New(deadline)}, assignment: assignment, now: deadline.Add(time.Hour), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.job.IsDue(tt.assignment, tt.now); got != tt.want {
+				t.Errorf("IsDue() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -75,6 +75,25 @@ func (s *Submission) ByGroup(groupID uint64) bool {
 	return s.GetUserID() == 0 && s.GetGroupID() > 0 && s.GetGroupID() == groupID
 }
 
+// ByGroupMember returns true if the submission is a group submission credited to the given user,
+// i.e., the user was a member of the group when the submission was delivered.
+func (s *Submission) ByGroupMember(userID uint64) bool {
+	return s.GetUserID() == 0 && s.GetGroupID() > 0 && s.BelongsTo(userID)
+}
+
+// BelongsTo returns true if the submission was made by the given user, either alone or as a group member.
+func (s *Submission) BelongsTo(userID uint64) bool {
+	if s.GetUserID() == userID {
+		return true
+	}
+	for _, grade := range s.GetGrades() {
+		if grade.GetUserID() == userID {
+			return true
+		}
+	}
+	return false
+}
+
 // Clean removes any score or reviews from the submission if it is not released.
 // This is to prevent users from seeing the score or reviews of a submission that has not been released.
 func (s *Submissions) Clean(userID uint64) {
@@ -28,6 +28,7 @@ type Group_GroupStatus int32
 const (
 	Group_PENDING  Group_GroupStatus = 0
 	Group_APPROVED Group_GroupStatus = 1
+	Group_INVITED  Group_GroupStatus = 2 // waiting for invited members to respond; cannot be approved
 )
 
 // Enum value maps for Group_GroupStatus.
@@ -35,10 +36,12 @@ var (
 	Group_GroupStatus_name = map[int32]string{
 		0: "PENDING",
 		1: "APPROVED",
+		2: "INVITED",
 	}
 	Group_GroupStatus_value = map[string]int32{
 		"PENDING":  0,
 		"APPROVED": 1,
+		"INVITED":  2,
 	}
 )
 
@@ -69,6 +72,113 @@ func (Group_GroupStatus) EnumDescriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{2, 0}
 }
 
+type GroupInvitation_Status int32
+
+const (
+	GroupInvitation_PENDING  GroupInvitation_Status = 0
+	GroupInvitation_ACCEPTED GroupInvitation_Status = 1
+	GroupInvitation_DECLINED GroupInvitation_Status = 2
+	GroupInvitation_EXPIRED  GroupInvitation_Status = 3
+)
+
+// Enum value maps for GroupInvitation_Status.
+var (
+	GroupInvitation_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "ACCEPTED",
+		2: "DECLINED",
+		3: "EXPIRED",
+	}
+	GroupInvitation_Status_value = map[string]int32{
+		"PENDING":  0,
+		"ACCEPTED": 1,
+		"DECLINED": 2,
+		"EXPIRED":  3,
+	}
+)
+
+func (x GroupInvitation_Status) Enum() *GroupInvitation_Status {
+	p := new(GroupInvitation_Status)
+	*p = x
+	return p
+}
+
+func (x GroupInvitation_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupInvitation_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_qf_types_proto_enumTypes[1].Descriptor()
+}
+
+func (GroupInvitation_Status) Type() protoreflect.EnumType {
+	return &file_qf_types_proto_enumTypes[1]
+}
+
+func (x GroupInvitation_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupInvitation_Status.Descriptor instead.
+func (GroupInvitation_Status) EnumDescriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type RosterMismatch_Kind int32
+
+const (
+	RosterMismatch_NOT_IN_QUICKFEED     RosterMismatch_Kind = 0 // the student is in the roster, but not enrolled in the course
+	RosterMismatch_NOT_IN_ROSTER        RosterMismatch_Kind = 1 // the student is enrolled in the course, but not in the roster
+	RosterMismatch_DUPLICATE_STUDENT_ID RosterMismatch_Kind = 2 // the student ID is used more than once, either in the roster or in the course
+	RosterMismatch_UNKNOWN_COMPONENT    RosterMismatch_Kind = 3 // the component is not an external component of the course's grading configuration
+	RosterMismatch_INVALID_SCORE        RosterMismatch_Kind = 4 // the score is not a number between 0 and 100
+)
+
+// Enum value maps for RosterMismatch_Kind.
+var (
+	RosterMismatch_Kind_name = map[int32]string{
+		0: "NOT_IN_QUICKFEED",
+		1: "NOT_IN_ROSTER",
+		2: "DUPLICATE_STUDENT_ID",
+		3: "UNKNOWN_COMPONENT",
+		4: "INVALID_SCORE",
+	}
+	RosterMismatch_Kind_value = map[string]int32{
+		"NOT_IN_QUICKFEED":     0,
+		"NOT_IN_ROSTER":        1,
+		"DUPLICATE_STUDENT_ID": 2,
+		"UNKNOWN_COMPONENT":    3,
+		"INVALID_SCORE":        4,
+	}
+)
+
+func (x RosterMismatch_Kind) Enum() *RosterMismatch_Kind {
+	p := new(RosterMismatch_Kind)
+	*p = x
+	return p
+}
+
+func (x RosterMismatch_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RosterMismatch_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_qf_types_proto_enumTypes[2].Descriptor()
+}
+
+func (RosterMismatch_Kind) Type() protoreflect.EnumType {
+	return &file_qf_types_proto_enumTypes[2]
+}
+
+func (x RosterMismatch_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RosterMismatch_Kind.Descriptor instead.
+func (RosterMismatch_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{24, 0}
+}
+
 type Repository_Type int32
 
 const (
@@ -111,11 +221,11 @@ func (x Repository_Type) String() string {
 }
 
 func (Repository_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_qf_types_proto_enumTypes[1].Descriptor()
+	return file_qf_types_proto_enumTypes[3].Descriptor()
 }
 
 func (Repository_Type) Type() protoreflect.EnumType {
-	return &file_qf_types_proto_enumTypes[1]
+	return &file_qf_types_proto_enumTypes[3]
 }
 
 func (x Repository_Type) Number() protoreflect.EnumNumber {
@@ -124,16 +234,17 @@ func (x Repository_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Repository_Type.Descriptor instead.
 func (Repository_Type) EnumDescriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{6, 0}
+	return file_qf_types_proto_rawDescGZIP(), []int{25, 0}
 }
 
 type Enrollment_UserStatus int32
 
 const (
-	Enrollment_NONE    Enrollment_UserStatus = 0
-	Enrollment_PENDING Enrollment_UserStatus = 1
-	Enrollment_STUDENT Enrollment_UserStatus = 2
-	Enrollment_TEACHER Enrollment_UserStatus = 3
+	Enrollment_NONE      Enrollment_UserStatus = 0
+	Enrollment_PENDING   Enrollment_UserStatus = 1
+	Enrollment_STUDENT   Enrollment_UserStatus = 2
+	Enrollment_TEACHER   Enrollment_UserStatus = 3
+	Enrollment_ASSISTANT Enrollment_UserStatus = 4 // teaching assistant; can review, grade and rebuild submissions, but not manage the course
 )
 
 // Enum value maps for Enrollment_UserStatus.
@@ -143,12 +254,14 @@ var (
 		1: "PENDING",
 		2: "STUDENT",
 		3: "TEACHER",
+		4: "ASSISTANT",
 	}
 	Enrollment_UserStatus_value = map[string]int32{
-		"NONE":    0,
-		"PENDING": 1,
-		"STUDENT": 2,
-		"TEACHER": 3,
+		"NONE":      0,
+		"PENDING":   1,
+		"STUDENT":   2,
+		"TEACHER":   3,
+		"ASSISTANT": 4,
 	}
 )
 
@@ -163,11 +276,11 @@ func (x Enrollment_UserStatus) String() string {
 }
 
 func (Enrollment_UserStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_qf_types_proto_enumTypes[2].Descriptor()
+	return file_qf_types_proto_enumTypes[4].Descriptor()
 }
 
 func (Enrollment_UserStatus) Type() protoreflect.EnumType {
-	return &file_qf_types_proto_enumTypes[2]
+	return &file_qf_types_proto_enumTypes[4]
 }
 
 func (x Enrollment_UserStatus) Number() protoreflect.EnumNumber {
@@ -176,7 +289,7 @@ func (x Enrollment_UserStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Enrollment_UserStatus.Descriptor instead.
 func (Enrollment_UserStatus) EnumDescriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{7, 0}
+	return file_qf_types_proto_rawDescGZIP(), []int{26, 0}
 }
 
 type Enrollment_DisplayState int32
@@ -215,11 +328,11 @@ func (x Enrollment_DisplayState) String() string {
 }
 
 func (Enrollment_DisplayState) Descriptor() protoreflect.EnumDescriptor {
-	return file_qf_types_proto_enumTypes[3].Descriptor()
+	return file_qf_types_proto_enumTypes[5].Descriptor()
 }
 
 func (Enrollment_DisplayState) Type() protoreflect.EnumType {
-	return &file_qf_types_proto_enumTypes[3]
+	return &file_qf_types_proto_enumTypes[5]
 }
 
 func (x Enrollment_DisplayState) Number() protoreflect.EnumNumber {
@@ -228,7 +341,59 @@ func (x Enrollment_DisplayState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Enrollment_DisplayState.Descriptor instead.
 func (Enrollment_DisplayState) EnumDescriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{7, 1}
+	return file_qf_types_proto_rawDescGZIP(), []int{26, 1}
+}
+
+type Assignment_ReconcilePolicy int32
+
+const (
+	Assignment_NONE    Assignment_ReconcilePolicy = 0 // the submission score is the score of the last updated review
+	Assignment_AVERAGE Assignment_ReconcilePolicy = 1 // the final score is the average score of the ready reviews
+	Assignment_MAX     Assignment_ReconcilePolicy = 2 // the final review is the ready review with the highest score
+	Assignment_MANUAL  Assignment_ReconcilePolicy = 3 // the final review is decided by a teacher
+)
+
+// Enum value maps for Assignment_ReconcilePolicy.
+var (
+	Assignment_ReconcilePolicy_name = map[int32]string{
+		0: "NONE",
+		1: "AVERAGE",
+		2: "MAX",
+		3: "MANUAL",
+	}
+	Assignment_ReconcilePolicy_value = map[string]int32{
+		"NONE":    0,
+		"AVERAGE": 1,
+		"MAX":     2,
+		"MANUAL":  3,
+	}
+)
+
+func (x Assignment_ReconcilePolicy) Enum() *Assignment_ReconcilePolicy {
+	p := new(Assignment_ReconcilePolicy)
+	*p = x
+	return p
+}
+
+func (x Assignment_ReconcilePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Assignment_ReconcilePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_qf_types_proto_enumTypes[6].Descriptor()
+}
+
+func (Assignment_ReconcilePolicy) Type() protoreflect.EnumType {
+	return &file_qf_types_proto_enumTypes[6]
+}
+
+func (x Assignment_ReconcilePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Assignment_ReconcilePolicy.Descriptor instead.
+func (Assignment_ReconcilePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{32, 0}
 }
 
 type PullRequest_Stage int32
@@ -267,11 +432,11 @@ func (x PullRequest_Stage) String() string {
 }
 
 func (PullRequest_Stage) Descriptor() protoreflect.EnumDescriptor {
-	return file_qf_types_proto_enumTypes[4].Descriptor()
+	return file_qf_types_proto_enumTypes[7].Descriptor()
 }
 
 func (PullRequest_Stage) Type() protoreflect.EnumType {
-	return &file_qf_types_proto_enumTypes[4]
+	return &file_qf_types_proto_enumTypes[7]
 }
 
 func (x PullRequest_Stage) Number() protoreflect.EnumNumber {
@@ -280,7 +445,7 @@ func (x PullRequest_Stage) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PullRequest_Stage.Descriptor instead.
 func (PullRequest_Stage) EnumDescriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{13, 0}
+	return file_qf_types_proto_rawDescGZIP(), []int{35, 0}
 }
 
 type Submission_Status int32
@@ -319,11 +484,11 @@ func (x Submission_Status) String() string {
 }
 
 func (Submission_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_qf_types_proto_enumTypes[5].Descriptor()
+	return file_qf_types_proto_enumTypes[8].Descriptor()
 }
 
 func (Submission_Status) Type() protoreflect.EnumType {
-	return &file_qf_types_proto_enumTypes[5]
+	return &file_qf_types_proto_enumTypes[8]
 }
 
 func (x Submission_Status) Number() protoreflect.EnumNumber {
@@ -332,7 +497,53 @@ func (x Submission_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Submission_Status.Descriptor instead.
 func (Submission_Status) EnumDescriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{15, 0}
+	return file_qf_types_proto_rawDescGZIP(), []int{37, 0}
+}
+
+type ScheduledJob_Type int32
+
+const (
+	ScheduledJob_RELEASE ScheduledJob_Type = 0 // release all submissions with a ready review at runAt
+	ScheduledJob_GRADE   ScheduledJob_Type = 1 // at the deadline plus grace period, approve ungraded submissions with score at least scoreLimit, and reject the rest
+)
+
+// Enum value maps for ScheduledJob_Type.
+var (
+	ScheduledJob_Type_name = map[int32]string{
+		0: "RELEASE",
+		1: "GRADE",
+	}
+	ScheduledJob_Type_value = map[string]int32{
+		"RELEASE": 0,
+		"GRADE":   1,
+	}
+)
+
+func (x ScheduledJob_Type) Enum() *ScheduledJob_Type {
+	p := new(ScheduledJob_Type)
+	*p = x
+	return p
+}
+
+func (x ScheduledJob_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledJob_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_qf_types_proto_enumTypes[9].Descriptor()
+}
+
+func (ScheduledJob_Type) Type() protoreflect.EnumType {
+	return &file_qf_types_proto_enumTypes[9]
+}
+
+func (x ScheduledJob_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledJob_Type.Descriptor instead.
+func (ScheduledJob_Type) EnumDescriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{40, 0}
 }
 
 type GradingCriterion_Grade int32
@@ -368,11 +579,11 @@ func (x GradingCriterion_Grade) String() string {
 }
 
 func (GradingCriterion_Grade) Descriptor() protoreflect.EnumDescriptor {
-	return file_qf_types_proto_enumTypes[6].Descriptor()
+	return file_qf_types_proto_enumTypes[10].Descriptor()
 }
 
 func (GradingCriterion_Grade) Type() protoreflect.EnumType {
-	return &file_qf_types_proto_enumTypes[6]
+	return &file_qf_types_proto_enumTypes[10]
 }
 
 func (x GradingCriterion_Grade) Number() protoreflect.EnumNumber {
@@ -381,7 +592,108 @@ func (x GradingCriterion_Grade) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GradingCriterion_Grade.Descriptor instead.
 func (GradingCriterion_Grade) EnumDescriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{20, 0}
+	return file_qf_types_proto_rawDescGZIP(), []int{46, 0}
+}
+
+type RegradeRequest_Status int32
+
+const (
+	RegradeRequest_PENDING  RegradeRequest_Status = 0 // the request awaits a teacher's decision
+	RegradeRequest_ACCEPTED RegradeRequest_Status = 1 // a teacher is reconsidering the submission
+	RegradeRequest_REJECTED RegradeRequest_Status = 2 // the submission's approval and score are kept
+	RegradeRequest_RESOLVED RegradeRequest_Status = 3 // the submission has been reconsidered
+)
+
+// Enum value maps for RegradeRequest_Status.
+var (
+	RegradeRequest_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "ACCEPTED",
+		2: "REJECTED",
+		3: "RESOLVED",
+	}
+	RegradeRequest_Status_value = map[string]int32{
+		"PENDING":  0,
+		"ACCEPTED": 1,
+		"REJECTED": 2,
+		"RESOLVED": 3,
+	}
+)
+
+func (x RegradeRequest_Status) Enum() *RegradeRequest_Status {
+	p := new(RegradeRequest_Status)
+	*p = x
+	return p
+}
+
+func (x RegradeRequest_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegradeRequest_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_qf_types_proto_enumTypes[11].Descriptor()
+}
+
+func (RegradeRequest_Status) Type() protoreflect.EnumType {
+	return &file_qf_types_proto_enumTypes[11]
+}
+
+func (x RegradeRequest_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegradeRequest_Status.Descriptor instead.
+func (RegradeRequest_Status) EnumDescriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{53, 0}
+}
+
+type RegradeRequest_Action int32
+
+const (
+	RegradeRequest_NONE    RegradeRequest_Action = 0 // the resolution does not change the submission
+	RegradeRequest_REBUILD RegradeRequest_Action = 1 // the submission's tests are run again
+	RegradeRequest_REVIEW  RegradeRequest_Action = 2 // a new review of the submission is created by the resolver
+)
+
+// Enum value maps for RegradeRequest_Action.
+var (
+	RegradeRequest_Action_name = map[int32]string{
+		0: "NONE",
+		1: "REBUILD",
+		2: "REVIEW",
+	}
+	RegradeRequest_Action_value = map[string]int32{
+		"NONE":    0,
+		"REBUILD": 1,
+		"REVIEW":  2,
+	}
+)
+
+func (x RegradeRequest_Action) Enum() *RegradeRequest_Action {
+	p := new(RegradeRequest_Action)
+	*p = x
+	return p
+}
+
+func (x RegradeRequest_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegradeRequest_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_qf_types_proto_enumTypes[12].Descriptor()
+}
+
+func (RegradeRequest_Action) Type() protoreflect.EnumType {
+	return &file_qf_types_proto_enumTypes[12]
+}
+
+func (x RegradeRequest_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegradeRequest_Action.Descriptor instead.
+func (RegradeRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{53, 1}
 }
 
 type User struct {
@@ -389,17 +701,18 @@ type User struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64        `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	IsAdmin      bool          `protobuf:"varint,2,opt,name=IsAdmin,proto3" json:"IsAdmin,omitempty"`
-	Name         string        `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
-	StudentID    string        `protobuf:"bytes,4,opt,name=StudentID,proto3" json:"StudentID,omitempty"`
-	Email        string        `protobuf:"bytes,5,opt,name=Email,proto3" json:"Email,omitempty"`
-	AvatarURL    string        `protobuf:"bytes,6,opt,name=AvatarURL,proto3" json:"AvatarURL,omitempty"`
-	Login        string        `protobuf:"bytes,7,opt,name=Login,proto3" json:"Login,omitempty"`
-	UpdateToken  bool          `protobuf:"varint,8,opt,name=UpdateToken,proto3" json:"UpdateToken,omitempty"`   // Filter; True if user's JWT token needs to be updated.
-	ScmRemoteID  uint64        `protobuf:"varint,9,opt,name=ScmRemoteID,proto3" json:"ScmRemoteID,omitempty"`   // Filter; The user's ID on the remote provider.
-	RefreshToken string        `protobuf:"bytes,10,opt,name=RefreshToken,proto3" json:"RefreshToken,omitempty"` // Filter; The user's refresh token that may be exchanged for an access token.
-	Enrollments  []*Enrollment `protobuf:"bytes,11,rep,name=Enrollments,proto3" json:"Enrollments,omitempty"`
+	ID            uint64        `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	IsAdmin       bool          `protobuf:"varint,2,opt,name=IsAdmin,proto3" json:"IsAdmin,omitempty"`
+	Name          string        `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	StudentID     string        `protobuf:"bytes,4,opt,name=StudentID,proto3" json:"StudentID,omitempty"`
+	Email         string        `protobuf:"bytes,5,opt,name=Email,proto3" json:"Email,omitempty"`
+	AvatarURL     string        `protobuf:"bytes,6,opt,name=AvatarURL,proto3" json:"AvatarURL,omitempty"`
+	Login         string        `protobuf:"bytes,7,opt,name=Login,proto3" json:"Login,omitempty"`
+	UpdateToken   bool          `protobuf:"varint,8,opt,name=UpdateToken,proto3" json:"UpdateToken,omitempty"`   // Filter; True if user's JWT token needs to be updated.
+	ScmRemoteID   uint64        `protobuf:"varint,9,opt,name=ScmRemoteID,proto3" json:"ScmRemoteID,omitempty"`   // Filter; The user's ID on the remote provider.
+	RefreshToken  string        `protobuf:"bytes,10,opt,name=RefreshToken,proto3" json:"RefreshToken,omitempty"` // Filter; The user's refresh token that may be exchanged for an access token.
+	Enrollments   []*Enrollment `protobuf:"bytes,11,rep,name=Enrollments,proto3" json:"Enrollments,omitempty"`
+	EmailVerified bool          `protobuf:"varint,12,opt,name=EmailVerified,proto3" json:"EmailVerified,omitempty"` // True if the user's email is the public email of the user's GitHub account, which GitHub has verified.
 }
 
 func (x *User) Reset() {
@@ -511,6 +824,13 @@ func (x *User) GetEnrollments() []*Enrollment {
 	return nil
 }
 
+func (x *User) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
 type Users struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -692,29 +1012,26 @@ func (x *Groups) GetGroups() []*Group {
 	return nil
 }
 
-type Course struct {
+// GroupInvitation invites a student to join a group created by another student.
+type GroupInvitation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                  uint64                `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseCreatorID     uint64                `protobuf:"varint,2,opt,name=courseCreatorID,proto3" json:"courseCreatorID,omitempty"`
-	Name                string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Code                string                `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty" gorm:"uniqueIndex:course"`
-	Year                uint32                `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty" gorm:"uniqueIndex:course"`
-	Tag                 string                `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
-	ScmOrganizationID   uint64                `protobuf:"varint,8,opt,name=ScmOrganizationID,proto3" json:"ScmOrganizationID,omitempty"`
-	ScmOrganizationName string                `protobuf:"bytes,9,opt,name=ScmOrganizationName,proto3" json:"ScmOrganizationName,omitempty"` // The organization's SCM name, e.g., dat520-2020.
-	SlipDays            uint32                `protobuf:"varint,10,opt,name=slipDays,proto3" json:"slipDays,omitempty"`
-	DockerfileDigest    string                `protobuf:"bytes,11,opt,name=DockerfileDigest,proto3" json:"DockerfileDigest,omitempty"` // Digest of the dockerfile used to build the course's docker image.
-	Enrolled            Enrollment_UserStatus `protobuf:"varint,12,opt,name=enrolled,proto3,enum=qf.Enrollment_UserStatus" json:"enrolled,omitempty" gorm:"-"`
-	Enrollments         []*Enrollment         `protobuf:"bytes,13,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
-	Assignments         []*Assignment         `protobuf:"bytes,14,rep,name=assignments,proto3" json:"assignments,omitempty"`
-	Groups              []*Group              `protobuf:"bytes,15,rep,name=groups,proto3" json:"groups,omitempty"`
+	ID        uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID  uint64                 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	GroupID   uint64                 `protobuf:"varint,3,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"uniqueIndex:group_invitation"`
+	UserID    uint64                 `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:group_invitation"` // the invited student
+	InviterID uint64                 `protobuf:"varint,5,opt,name=inviterID,proto3" json:"inviterID,omitempty"`
+	Status    GroupInvitation_Status `protobuf:"varint,6,opt,name=status,proto3,enum=qf.GroupInvitation_Status" json:"status,omitempty"`
+	Expires   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires,proto3" json:"expires,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	Group     *Group                 `protobuf:"bytes,8,opt,name=group,proto3" json:"group,omitempty"`
+	User      *User                  `protobuf:"bytes,9,opt,name=user,proto3" json:"user,omitempty"`
+	Inviter   *User                  `protobuf:"bytes,10,opt,name=inviter,proto3" json:"inviter,omitempty"`
 }
 
-func (x *Course) Reset() {
-	*x = Course{}
+func (x *GroupInvitation) Reset() {
+	*x = GroupInvitation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_qf_types_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -722,13 +1039,13 @@ func (x *Course) Reset() {
 	}
 }
 
-func (x *Course) String() string {
+func (x *GroupInvitation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Course) ProtoMessage() {}
+func (*GroupInvitation) ProtoMessage() {}
 
-func (x *Course) ProtoReflect() protoreflect.Message {
+func (x *GroupInvitation) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,119 +1057,91 @@ func (x *Course) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Course.ProtoReflect.Descriptor instead.
-func (*Course) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupInvitation.ProtoReflect.Descriptor instead.
+func (*GroupInvitation) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Course) GetID() uint64 {
+func (x *GroupInvitation) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Course) GetCourseCreatorID() uint64 {
+func (x *GroupInvitation) GetCourseID() uint64 {
 	if x != nil {
-		return x.CourseCreatorID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Course) GetName() string {
+func (x *GroupInvitation) GetGroupID() uint64 {
 	if x != nil {
-		return x.Name
+		return x.GroupID
 	}
-	return ""
+	return 0
 }
 
-func (x *Course) GetCode() string {
+func (x *GroupInvitation) GetUserID() uint64 {
 	if x != nil {
-		return x.Code
+		return x.UserID
 	}
-	return ""
+	return 0
 }
 
-func (x *Course) GetYear() uint32 {
+func (x *GroupInvitation) GetInviterID() uint64 {
 	if x != nil {
-		return x.Year
+		return x.InviterID
 	}
 	return 0
 }
 
-func (x *Course) GetTag() string {
+func (x *GroupInvitation) GetStatus() GroupInvitation_Status {
 	if x != nil {
-		return x.Tag
+		return x.Status
 	}
-	return ""
+	return GroupInvitation_PENDING
 }
 
-func (x *Course) GetScmOrganizationID() uint64 {
+func (x *GroupInvitation) GetExpires() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ScmOrganizationID
+		return x.Expires
 	}
-	return 0
+	return nil
 }
 
-func (x *Course) GetScmOrganizationName() string {
+func (x *GroupInvitation) GetGroup() *Group {
 	if x != nil {
-		return x.ScmOrganizationName
+		return x.Group
 	}
-	return ""
+	return nil
 }
 
-func (x *Course) GetSlipDays() uint32 {
+func (x *GroupInvitation) GetUser() *User {
 	if x != nil {
-		return x.SlipDays
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-func (x *Course) GetDockerfileDigest() string {
+func (x *GroupInvitation) GetInviter() *User {
 	if x != nil {
-		return x.DockerfileDigest
+		return x.Inviter
 	}
-	return ""
+	return nil
 }
 
-func (x *Course) GetEnrolled() Enrollment_UserStatus {
-	if x != nil {
-		return x.Enrolled
-	}
-	return Enrollment_NONE
-}
-
-func (x *Course) GetEnrollments() []*Enrollment {
-	if x != nil {
-		return x.Enrollments
-	}
-	return nil
-}
-
-func (x *Course) GetAssignments() []*Assignment {
-	if x != nil {
-		return x.Assignments
-	}
-	return nil
-}
-
-func (x *Course) GetGroups() []*Group {
-	if x != nil {
-		return x.Groups
-	}
-	return nil
-}
-
-type Courses struct {
+type GroupInvitations struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Courses []*Course `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	Invitations []*GroupInvitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
 }
 
-func (x *Courses) Reset() {
-	*x = Courses{}
+func (x *GroupInvitations) Reset() {
+	*x = GroupInvitations{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_qf_types_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -860,13 +1149,13 @@ func (x *Courses) Reset() {
 	}
 }
 
-func (x *Courses) String() string {
+func (x *GroupInvitations) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Courses) ProtoMessage() {}
+func (*GroupInvitations) ProtoMessage() {}
 
-func (x *Courses) ProtoReflect() protoreflect.Message {
+func (x *GroupInvitations) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -878,35 +1167,36 @@ func (x *Courses) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Courses.ProtoReflect.Descriptor instead.
-func (*Courses) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupInvitations.ProtoReflect.Descriptor instead.
+func (*GroupInvitations) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Courses) GetCourses() []*Course {
+func (x *GroupInvitations) GetInvitations() []*GroupInvitation {
 	if x != nil {
-		return x.Courses
+		return x.Invitations
 	}
 	return nil
 }
 
-type Repository struct {
+// GroupMembership records the interval during which a student was a member of a group.
+type GroupMembership struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                uint64          `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	ScmOrganizationID uint64          `protobuf:"varint,2,opt,name=ScmOrganizationID,proto3" json:"ScmOrganizationID,omitempty" gorm:"uniqueIndex:repository"`
-	ScmRepositoryID   uint64          `protobuf:"varint,3,opt,name=ScmRepositoryID,proto3" json:"ScmRepositoryID,omitempty"` // ID of a github repository
-	UserID            uint64          `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:repository"`
-	GroupID           uint64          `protobuf:"varint,5,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"uniqueIndex:repository"`
-	HTMLURL           string          `protobuf:"bytes,6,opt,name=HTMLURL,proto3" json:"HTMLURL,omitempty"`
-	RepoType          Repository_Type `protobuf:"varint,7,opt,name=repoType,proto3,enum=qf.Repository_Type" json:"repoType,omitempty" gorm:"uniqueIndex:repository"`
-	Issues            []*Issue        `protobuf:"bytes,8,rep,name=issues,proto3" json:"issues,omitempty"` // Issues associated with this repository
+	ID        uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID  uint64                 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty"`
+	GroupID   uint64                 `protobuf:"varint,3,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"index"`
+	UserID    uint64                 `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupName string                 `protobuf:"bytes,5,opt,name=groupName,proto3" json:"groupName,omitempty"` // kept after the group is deleted
+	Joined    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=joined,proto3" json:"joined,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	Left      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=left,proto3" json:"left,omitempty" gorm:"serializer:timestamp;type:datetime"` // unset while the student is a member
+	User      *User                  `protobuf:"bytes,8,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *Repository) Reset() {
-	*x = Repository{}
+func (x *GroupMembership) Reset() {
+	*x = GroupMembership{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_qf_types_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,13 +1204,13 @@ func (x *Repository) Reset() {
 	}
 }
 
-func (x *Repository) String() string {
+func (x *GroupMembership) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repository) ProtoMessage() {}
+func (*GroupMembership) ProtoMessage() {}
 
-func (x *Repository) ProtoReflect() protoreflect.Message {
+func (x *GroupMembership) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -932,89 +1222,77 @@ func (x *Repository) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
-func (*Repository) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupMembership.ProtoReflect.Descriptor instead.
+func (*GroupMembership) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Repository) GetID() uint64 {
+func (x *GroupMembership) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Repository) GetScmOrganizationID() uint64 {
+func (x *GroupMembership) GetCourseID() uint64 {
 	if x != nil {
-		return x.ScmOrganizationID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Repository) GetScmRepositoryID() uint64 {
+func (x *GroupMembership) GetGroupID() uint64 {
 	if x != nil {
-		return x.ScmRepositoryID
+		return x.GroupID
 	}
 	return 0
 }
 
-func (x *Repository) GetUserID() uint64 {
+func (x *GroupMembership) GetUserID() uint64 {
 	if x != nil {
 		return x.UserID
 	}
 	return 0
 }
 
-func (x *Repository) GetGroupID() uint64 {
+func (x *GroupMembership) GetGroupName() string {
 	if x != nil {
-		return x.GroupID
+		return x.GroupName
 	}
-	return 0
+	return ""
 }
 
-func (x *Repository) GetHTMLURL() string {
+func (x *GroupMembership) GetJoined() *timestamppb.Timestamp {
 	if x != nil {
-		return x.HTMLURL
+		return x.Joined
 	}
-	return ""
+	return nil
 }
 
-func (x *Repository) GetRepoType() Repository_Type {
+func (x *GroupMembership) GetLeft() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RepoType
+		return x.Left
 	}
-	return Repository_NONE
+	return nil
 }
 
-func (x *Repository) GetIssues() []*Issue {
+func (x *GroupMembership) GetUser() *User {
 	if x != nil {
-		return x.Issues
+		return x.User
 	}
 	return nil
 }
 
-type Enrollment struct {
+type GroupMemberships struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                uint64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseID          uint64                  `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:enrollment"`
-	UserID            uint64                  `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:enrollment"`
-	GroupID           uint64                  `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	User              *User                   `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
-	Course            *Course                 `protobuf:"bytes,6,opt,name=course,proto3" json:"course,omitempty"`
-	Group             *Group                  `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`
-	Status            Enrollment_UserStatus   `protobuf:"varint,8,opt,name=status,proto3,enum=qf.Enrollment_UserStatus" json:"status,omitempty"`
-	State             Enrollment_DisplayState `protobuf:"varint,9,opt,name=state,proto3,enum=qf.Enrollment_DisplayState" json:"state,omitempty"`
-	SlipDaysRemaining uint32                  `protobuf:"varint,10,opt,name=slipDaysRemaining,proto3" json:"slipDaysRemaining,omitempty" gorm:"-"`
-	LastActivityDate  *timestamppb.Timestamp  `protobuf:"bytes,11,opt,name=lastActivityDate,proto3" json:"lastActivityDate,omitempty" gorm:"serializer:timestamp;type:datetime"`
-	TotalApproved     uint64                  `protobuf:"varint,12,opt,name=totalApproved,proto3" json:"totalApproved,omitempty"`
-	UsedSlipDays      []*UsedSlipDays         `protobuf:"bytes,13,rep,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"`
+	Memberships []*GroupMembership `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships,omitempty"`
 }
 
-func (x *Enrollment) Reset() {
-	*x = Enrollment{}
+func (x *GroupMemberships) Reset() {
+	*x = GroupMemberships{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_qf_types_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1022,13 +1300,13 @@ func (x *Enrollment) Reset() {
 	}
 }
 
-func (x *Enrollment) String() string {
+func (x *GroupMemberships) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Enrollment) ProtoMessage() {}
+func (*GroupMemberships) ProtoMessage() {}
 
-func (x *Enrollment) ProtoReflect() protoreflect.Message {
+func (x *GroupMemberships) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1040,115 +1318,32 @@ func (x *Enrollment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
-func (*Enrollment) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupMemberships.ProtoReflect.Descriptor instead.
+func (*GroupMemberships) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Enrollment) GetID() uint64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
-}
-
-func (x *Enrollment) GetCourseID() uint64 {
-	if x != nil {
-		return x.CourseID
-	}
-	return 0
-}
-
-func (x *Enrollment) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
-}
-
-func (x *Enrollment) GetGroupID() uint64 {
-	if x != nil {
-		return x.GroupID
-	}
-	return 0
-}
-
-func (x *Enrollment) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
-}
-
-func (x *Enrollment) GetCourse() *Course {
-	if x != nil {
-		return x.Course
-	}
-	return nil
-}
-
-func (x *Enrollment) GetGroup() *Group {
-	if x != nil {
-		return x.Group
-	}
-	return nil
-}
-
-func (x *Enrollment) GetStatus() Enrollment_UserStatus {
-	if x != nil {
-		return x.Status
-	}
-	return Enrollment_NONE
-}
-
-func (x *Enrollment) GetState() Enrollment_DisplayState {
-	if x != nil {
-		return x.State
-	}
-	return Enrollment_UNSET
-}
-
-func (x *Enrollment) GetSlipDaysRemaining() uint32 {
-	if x != nil {
-		return x.SlipDaysRemaining
-	}
-	return 0
-}
-
-func (x *Enrollment) GetLastActivityDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastActivityDate
-	}
-	return nil
-}
-
-func (x *Enrollment) GetTotalApproved() uint64 {
-	if x != nil {
-		return x.TotalApproved
-	}
-	return 0
-}
-
-func (x *Enrollment) GetUsedSlipDays() []*UsedSlipDays {
+func (x *GroupMemberships) GetMemberships() []*GroupMembership {
 	if x != nil {
-		return x.UsedSlipDays
+		return x.Memberships
 	}
 	return nil
 }
 
-type UsedSlipDays struct {
+// GroupPreference lists the students that a student prefers to be in a group with.
+type GroupPreference struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	EnrollmentID uint64 `protobuf:"varint,2,opt,name=enrollmentID,proto3" json:"enrollmentID,omitempty"`
-	AssignmentID uint64 `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
-	UsedDays     uint32 `protobuf:"varint,4,opt,name=usedDays,proto3" json:"usedDays,omitempty"`
+	ID         uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID   uint64   `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:group_preference"`
+	UserID     uint64   `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:group_preference"`
+	PartnerIDs []uint64 `protobuf:"varint,4,rep,packed,name=partnerIDs,proto3" json:"partnerIDs,omitempty" gorm:"serializer:json"` // user IDs of the preferred partners
 }
 
-func (x *UsedSlipDays) Reset() {
-	*x = UsedSlipDays{}
+func (x *GroupPreference) Reset() {
+	*x = GroupPreference{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_qf_types_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1156,13 +1351,13 @@ func (x *UsedSlipDays) Reset() {
 	}
 }
 
-func (x *UsedSlipDays) String() string {
+func (x *GroupPreference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsedSlipDays) ProtoMessage() {}
+func (*GroupPreference) ProtoMessage() {}
 
-func (x *UsedSlipDays) ProtoReflect() protoreflect.Message {
+func (x *GroupPreference) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1174,49 +1369,53 @@ func (x *UsedSlipDays) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsedSlipDays.ProtoReflect.Descriptor instead.
-func (*UsedSlipDays) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupPreference.ProtoReflect.Descriptor instead.
+func (*GroupPreference) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UsedSlipDays) GetID() uint64 {
+func (x *GroupPreference) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *UsedSlipDays) GetEnrollmentID() uint64 {
+func (x *GroupPreference) GetCourseID() uint64 {
 	if x != nil {
-		return x.EnrollmentID
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *UsedSlipDays) GetAssignmentID() uint64 {
+func (x *GroupPreference) GetUserID() uint64 {
 	if x != nil {
-		return x.AssignmentID
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *UsedSlipDays) GetUsedDays() uint32 {
+func (x *GroupPreference) GetPartnerIDs() []uint64 {
 	if x != nil {
-		return x.UsedDays
+		return x.PartnerIDs
 	}
-	return 0
+	return nil
 }
 
-type Enrollments struct {
+// GroupContributions summarizes how the members of a group contributed to the group's repository.
+type GroupContributions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enrollments []*Enrollment `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	GroupID             uint64                `protobuf:"varint,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Threshold           uint32                `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"` // percent of an equal share of the changed lines below which a member is flagged
+	Members             []*MemberContribution `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	UnattributedCommits uint32                `protobuf:"varint,4,opt,name=unattributedCommits,proto3" json:"unattributedCommits,omitempty"` // commits whose author did not match a group member
 }
 
-func (x *Enrollments) Reset() {
-	*x = Enrollments{}
+func (x *GroupContributions) Reset() {
+	*x = GroupContributions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_qf_types_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1224,13 +1423,13 @@ func (x *Enrollments) Reset() {
 	}
 }
 
-func (x *Enrollments) String() string {
+func (x *GroupContributions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Enrollments) ProtoMessage() {}
+func (*GroupContributions) ProtoMessage() {}
 
-func (x *Enrollments) ProtoReflect() protoreflect.Message {
+func (x *GroupContributions) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1242,40 +1441,58 @@ func (x *Enrollments) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Enrollments.ProtoReflect.Descriptor instead.
-func (*Enrollments) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupContributions.ProtoReflect.Descriptor instead.
+func (*GroupContributions) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Enrollments) GetEnrollments() []*Enrollment {
+func (x *GroupContributions) GetGroupID() uint64 {
 	if x != nil {
-		return x.Enrollments
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *GroupContributions) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GroupContributions) GetMembers() []*MemberContribution {
+	if x != nil {
+		return x.Members
 	}
 	return nil
 }
 
-type Assignment struct {
+func (x *GroupContributions) GetUnattributedCommits() uint32 {
+	if x != nil {
+		return x.UnattributedCommits
+	}
+	return 0
+}
+
+// MemberContribution is a group member's commits and changed lines, in total, per assignment and per day.
+type MemberContribution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseID          uint64                 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"` // foreign key
-	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Deadline          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deadline,proto3" json:"deadline,omitempty" gorm:"serializer:timestamp;type:datetime"`
-	AutoApprove       bool                   `protobuf:"varint,5,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
-	Order             uint32                 `protobuf:"varint,6,opt,name=order,proto3" json:"order,omitempty"`
-	IsGroupLab        bool                   `protobuf:"varint,7,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
-	ScoreLimit        uint32                 `protobuf:"varint,8,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`               // minimal score limit for auto approval
-	Reviewers         uint32                 `protobuf:"varint,9,opt,name=reviewers,proto3" json:"reviewers,omitempty"`                 // number of reviewers that will review submissions for this assignment
-	ContainerTimeout  uint32                 `protobuf:"varint,10,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`  // container timeout for this assignment
-	Submissions       []*Submission          `protobuf:"bytes,11,rep,name=submissions,proto3" json:"submissions,omitempty"`             // submissions produced for this assignment
-	Tasks             []*Task                `protobuf:"bytes,12,rep,name=tasks,proto3" json:"tasks,omitempty"`                         // tasks associated with this assignment
-	GradingBenchmarks []*GradingBenchmark    `protobuf:"bytes,13,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"` // grading benchmarks for this assignment
+	UserID       uint64                    `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	User         *User                     `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Commits      uint32                    `protobuf:"varint,3,opt,name=commits,proto3" json:"commits,omitempty"`
+	LinesAdded   uint32                    `protobuf:"varint,4,opt,name=linesAdded,proto3" json:"linesAdded,omitempty"`
+	LinesRemoved uint32                    `protobuf:"varint,5,opt,name=linesRemoved,proto3" json:"linesRemoved,omitempty"`
+	Share        uint32                    `protobuf:"varint,6,opt,name=share,proto3" json:"share,omitempty"`     // percent of the group's changed lines
+	Flagged      bool                      `protobuf:"varint,7,opt,name=flagged,proto3" json:"flagged,omitempty"` // true if the share is below the threshold
+	Assignments  []*AssignmentContribution `protobuf:"bytes,8,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Activity     []*ContributionActivity   `protobuf:"bytes,9,rep,name=activity,proto3" json:"activity,omitempty"`
 }
 
-func (x *Assignment) Reset() {
-	*x = Assignment{}
+func (x *MemberContribution) Reset() {
+	*x = MemberContribution{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_qf_types_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1283,13 +1500,13 @@ func (x *Assignment) Reset() {
 	}
 }
 
-func (x *Assignment) String() string {
+func (x *MemberContribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assignment) ProtoMessage() {}
+func (*MemberContribution) ProtoMessage() {}
 
-func (x *Assignment) ProtoReflect() protoreflect.Message {
+func (x *MemberContribution) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1301,118 +1518,89 @@ func (x *Assignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
-func (*Assignment) Descriptor() ([]byte, []int) {
+// Deprecated: Use MemberContribution.ProtoReflect.Descriptor instead.
+func (*MemberContribution) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Assignment) GetID() uint64 {
+func (x *MemberContribution) GetUserID() uint64 {
 	if x != nil {
-		return x.ID
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *Assignment) GetCourseID() uint64 {
+func (x *MemberContribution) GetUser() *User {
 	if x != nil {
-		return x.CourseID
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-func (x *Assignment) GetName() string {
+func (x *MemberContribution) GetCommits() uint32 {
 	if x != nil {
-		return x.Name
+		return x.Commits
 	}
-	return ""
+	return 0
 }
 
-func (x *Assignment) GetDeadline() *timestamppb.Timestamp {
+func (x *MemberContribution) GetLinesAdded() uint32 {
 	if x != nil {
-		return x.Deadline
+		return x.LinesAdded
 	}
-	return nil
+	return 0
 }
 
-func (x *Assignment) GetAutoApprove() bool {
+func (x *MemberContribution) GetLinesRemoved() uint32 {
 	if x != nil {
-		return x.AutoApprove
-	}
-	return false
-}
-
-func (x *Assignment) GetOrder() uint32 {
-	if x != nil {
-		return x.Order
-	}
-	return 0
-}
-
-func (x *Assignment) GetIsGroupLab() bool {
-	if x != nil {
-		return x.IsGroupLab
-	}
-	return false
-}
-
-func (x *Assignment) GetScoreLimit() uint32 {
-	if x != nil {
-		return x.ScoreLimit
-	}
-	return 0
-}
-
-func (x *Assignment) GetReviewers() uint32 {
-	if x != nil {
-		return x.Reviewers
+		return x.LinesRemoved
 	}
 	return 0
 }
 
-func (x *Assignment) GetContainerTimeout() uint32 {
+func (x *MemberContribution) GetShare() uint32 {
 	if x != nil {
-		return x.ContainerTimeout
+		return x.Share
 	}
 	return 0
 }
 
-func (x *Assignment) GetSubmissions() []*Submission {
+func (x *MemberContribution) GetFlagged() bool {
 	if x != nil {
-		return x.Submissions
+		return x.Flagged
 	}
-	return nil
+	return false
 }
 
-func (x *Assignment) GetTasks() []*Task {
+func (x *MemberContribution) GetAssignments() []*AssignmentContribution {
 	if x != nil {
-		return x.Tasks
+		return x.Assignments
 	}
 	return nil
 }
 
-func (x *Assignment) GetGradingBenchmarks() []*GradingBenchmark {
+func (x *MemberContribution) GetActivity() []*ContributionActivity {
 	if x != nil {
-		return x.GradingBenchmarks
+		return x.Activity
 	}
 	return nil
 }
 
-type Task struct {
+// AssignmentContribution is a member's commits and changed lines in an assignment's folder.
+type AssignmentContribution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID              uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	AssignmentID    uint64   `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"` // foreign key
-	AssignmentOrder uint32   `protobuf:"varint,3,opt,name=assignmentOrder,proto3" json:"assignmentOrder,omitempty"`
-	Title           string   `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Body            string   `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
-	Name            string   `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Issues          []*Issue `protobuf:"bytes,7,rep,name=issues,proto3" json:"issues,omitempty"` // Issues that use this task as a benchmark
+	AssignmentID uint64 `protobuf:"varint,1,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	Folder       string `protobuf:"bytes,2,opt,name=folder,proto3" json:"folder,omitempty"`
+	Commits      uint32 `protobuf:"varint,3,opt,name=commits,proto3" json:"commits,omitempty"`
+	LinesAdded   uint32 `protobuf:"varint,4,opt,name=linesAdded,proto3" json:"linesAdded,omitempty"`
+	LinesRemoved uint32 `protobuf:"varint,5,opt,name=linesRemoved,proto3" json:"linesRemoved,omitempty"`
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
+func (x *AssignmentContribution) Reset() {
+	*x = AssignmentContribution{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_qf_types_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1420,13 +1608,13 @@ func (x *Task) Reset() {
 	}
 }
 
-func (x *Task) String() string {
+func (x *AssignmentContribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*AssignmentContribution) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
+func (x *AssignmentContribution) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1438,73 +1626,59 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssignmentContribution.ProtoReflect.Descriptor instead.
+func (*AssignmentContribution) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Task) GetID() uint64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
-}
-
-func (x *Task) GetAssignmentID() uint64 {
+func (x *AssignmentContribution) GetAssignmentID() uint64 {
 	if x != nil {
 		return x.AssignmentID
 	}
 	return 0
 }
 
-func (x *Task) GetAssignmentOrder() uint32 {
-	if x != nil {
-		return x.AssignmentOrder
-	}
-	return 0
-}
-
-func (x *Task) GetTitle() string {
+func (x *AssignmentContribution) GetFolder() string {
 	if x != nil {
-		return x.Title
+		return x.Folder
 	}
 	return ""
 }
 
-func (x *Task) GetBody() string {
+func (x *AssignmentContribution) GetCommits() uint32 {
 	if x != nil {
-		return x.Body
+		return x.Commits
 	}
-	return ""
+	return 0
 }
 
-func (x *Task) GetName() string {
+func (x *AssignmentContribution) GetLinesAdded() uint32 {
 	if x != nil {
-		return x.Name
+		return x.LinesAdded
 	}
-	return ""
+	return 0
 }
 
-func (x *Task) GetIssues() []*Issue {
+func (x *AssignmentContribution) GetLinesRemoved() uint32 {
 	if x != nil {
-		return x.Issues
+		return x.LinesRemoved
 	}
-	return nil
+	return 0
 }
 
-type Issue struct {
+// ContributionActivity is a member's commits and changed lines on a given day.
+type ContributionActivity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID             uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	RepositoryID   uint64 `protobuf:"varint,2,opt,name=repositoryID,proto3" json:"repositoryID,omitempty"`     // Represents the internal ID of a repository
-	TaskID         uint64 `protobuf:"varint,3,opt,name=taskID,proto3" json:"taskID,omitempty"`                 // Task that this issue draws its content from
-	ScmIssueNumber uint64 `protobuf:"varint,4,opt,name=ScmIssueNumber,proto3" json:"ScmIssueNumber,omitempty"` // Issue number on scm. Needed for associating db issue with scm issue
+	Date         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // midnight UTC of the day
+	Commits      uint32                 `protobuf:"varint,2,opt,name=commits,proto3" json:"commits,omitempty"`
+	LinesChanged uint32                 `protobuf:"varint,3,opt,name=linesChanged,proto3" json:"linesChanged,omitempty"`
 }
 
-func (x *Issue) Reset() {
-	*x = Issue{}
+func (x *ContributionActivity) Reset() {
+	*x = ContributionActivity{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_qf_types_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1512,13 +1686,13 @@ func (x *Issue) Reset() {
 	}
 }
 
-func (x *Issue) String() string {
+func (x *ContributionActivity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Issue) ProtoMessage() {}
+func (*ContributionActivity) ProtoMessage() {}
 
-func (x *Issue) ProtoReflect() protoreflect.Message {
+func (x *ContributionActivity) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1530,57 +1704,59 @@ func (x *Issue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
-func (*Issue) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContributionActivity.ProtoReflect.Descriptor instead.
+func (*ContributionActivity) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Issue) GetID() uint64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
-}
-
-func (x *Issue) GetRepositoryID() uint64 {
+func (x *ContributionActivity) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RepositoryID
+		return x.Date
 	}
-	return 0
+	return nil
 }
 
-func (x *Issue) GetTaskID() uint64 {
+func (x *ContributionActivity) GetCommits() uint32 {
 	if x != nil {
-		return x.TaskID
+		return x.Commits
 	}
 	return 0
 }
 
-func (x *Issue) GetScmIssueNumber() uint64 {
+func (x *ContributionActivity) GetLinesChanged() uint32 {
 	if x != nil {
-		return x.ScmIssueNumber
+		return x.LinesChanged
 	}
 	return 0
 }
 
-type PullRequest struct {
+type Course struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID              uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	ScmRepositoryID uint64            `protobuf:"varint,2,opt,name=ScmRepositoryID,proto3" json:"ScmRepositoryID,omitempty"` // Represents the scm repository ID
-	TaskID          uint64            `protobuf:"varint,3,opt,name=taskID,proto3" json:"taskID,omitempty"`                   // Foreign key
-	IssueID         uint64            `protobuf:"varint,4,opt,name=issueID,proto3" json:"issueID,omitempty"`                 // Foreign key
-	UserID          uint64            `protobuf:"varint,5,opt,name=userID,proto3" json:"userID,omitempty"`                   // The user who owns this PR
-	ScmCommentID    uint64            `protobuf:"varint,6,opt,name=ScmCommentID,proto3" json:"ScmCommentID,omitempty"`       // Scm ID of the comment used for automatic feedback
-	SourceBranch    string            `protobuf:"bytes,7,opt,name=sourceBranch,proto3" json:"sourceBranch,omitempty"`        // The source branch for this pull request
-	Number          uint64            `protobuf:"varint,8,opt,name=number,proto3" json:"number,omitempty"`                   // Pull request number
-	Stage           PullRequest_Stage `protobuf:"varint,9,opt,name=stage,proto3,enum=qf.PullRequest_Stage" json:"stage,omitempty"`
+	ID                  uint64                `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseCreatorID     uint64                `protobuf:"varint,2,opt,name=courseCreatorID,proto3" json:"courseCreatorID,omitempty"`
+	Name                string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Code                string                `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty" gorm:"uniqueIndex:course"`
+	Year                uint32                `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty" gorm:"uniqueIndex:course"`
+	Tag                 string                `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	ScmOrganizationID   uint64                `protobuf:"varint,8,opt,name=ScmOrganizationID,proto3" json:"ScmOrganizationID,omitempty"`
+	ScmOrganizationName string                `protobuf:"bytes,9,opt,name=ScmOrganizationName,proto3" json:"ScmOrganizationName,omitempty"` // The organization's SCM name, e.g., dat520-2020.
+	SlipDays            uint32                `protobuf:"varint,10,opt,name=slipDays,proto3" json:"slipDays,omitempty"`
+	DockerfileDigest    string                `protobuf:"bytes,11,opt,name=DockerfileDigest,proto3" json:"DockerfileDigest,omitempty"` // Digest of the dockerfile used to build the course's docker image.
+	Enrolled            Enrollment_UserStatus `protobuf:"varint,12,opt,name=enrolled,proto3,enum=qf.Enrollment_UserStatus" json:"enrolled,omitempty" gorm:"-"`
+	LegacyScoreFormat   *bool                 `protobuf:"varint,16,opt,name=legacyScoreFormat,proto3,oneof" json:"legacyScoreFormat,omitempty"` // Accept unsigned score lines containing the raw session secret; used while migrating tests to signed score lines. Left unchanged by updates if unset.
+	MinGroupSize        uint32                `protobuf:"varint,18,opt,name=minGroupSize,proto3" json:"minGroupSize,omitempty"`                 // Smallest group proposed by ProposeGroups; one if zero.
+	MaxGroupSize        uint32                `protobuf:"varint,19,opt,name=maxGroupSize,proto3" json:"maxGroupSize,omitempty"`                 // Largest group proposed by ProposeGroups; required to propose groups.
+	Enrollments         []*Enrollment         `protobuf:"bytes,13,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	Assignments         []*Assignment         `protobuf:"bytes,14,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Groups              []*Group              `protobuf:"bytes,15,rep,name=groups,proto3" json:"groups,omitempty"`
+	EnrollmentPolicy    *EnrollmentPolicy     `protobuf:"bytes,17,opt,name=enrollmentPolicy,proto3" json:"enrollmentPolicy,omitempty"` // not included in responses; UpdateCourse replaces the policy if set, and keeps it otherwise
 }
 
-func (x *PullRequest) Reset() {
-	*x = PullRequest{}
+func (x *Course) Reset() {
+	*x = Course{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_qf_types_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1588,13 +1764,13 @@ func (x *PullRequest) Reset() {
 	}
 }
 
-func (x *PullRequest) String() string {
+func (x *Course) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PullRequest) ProtoMessage() {}
+func (*Course) ProtoMessage() {}
 
-func (x *PullRequest) ProtoReflect() protoreflect.Message {
+func (x *Course) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1606,84 +1782,154 @@ func (x *PullRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
-func (*PullRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Course.ProtoReflect.Descriptor instead.
+func (*Course) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PullRequest) GetID() uint64 {
+func (x *Course) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *PullRequest) GetScmRepositoryID() uint64 {
+func (x *Course) GetCourseCreatorID() uint64 {
 	if x != nil {
-		return x.ScmRepositoryID
+		return x.CourseCreatorID
 	}
 	return 0
 }
 
-func (x *PullRequest) GetTaskID() uint64 {
+func (x *Course) GetName() string {
 	if x != nil {
-		return x.TaskID
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *PullRequest) GetIssueID() uint64 {
+func (x *Course) GetCode() string {
 	if x != nil {
-		return x.IssueID
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Course) GetYear() uint32 {
+	if x != nil {
+		return x.Year
 	}
 	return 0
 }
 
-func (x *PullRequest) GetUserID() uint64 {
+func (x *Course) GetTag() string {
 	if x != nil {
-		return x.UserID
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *Course) GetScmOrganizationID() uint64 {
+	if x != nil {
+		return x.ScmOrganizationID
 	}
 	return 0
 }
 
-func (x *PullRequest) GetScmCommentID() uint64 {
+func (x *Course) GetScmOrganizationName() string {
 	if x != nil {
-		return x.ScmCommentID
+		return x.ScmOrganizationName
+	}
+	return ""
+}
+
+func (x *Course) GetSlipDays() uint32 {
+	if x != nil {
+		return x.SlipDays
 	}
 	return 0
 }
 
-func (x *PullRequest) GetSourceBranch() string {
+func (x *Course) GetDockerfileDigest() string {
 	if x != nil {
-		return x.SourceBranch
+		return x.DockerfileDigest
 	}
 	return ""
 }
 
-func (x *PullRequest) GetNumber() uint64 {
+func (x *Course) GetEnrolled() Enrollment_UserStatus {
 	if x != nil {
-		return x.Number
+		return x.Enrolled
+	}
+	return Enrollment_NONE
+}
+
+func (x *Course) GetLegacyScoreFormat() bool {
+	if x != nil && x.LegacyScoreFormat != nil {
+		return *x.LegacyScoreFormat
+	}
+	return false
+}
+
+func (x *Course) GetMinGroupSize() uint32 {
+	if x != nil {
+		return x.MinGroupSize
 	}
 	return 0
 }
 
-func (x *PullRequest) GetStage() PullRequest_Stage {
+func (x *Course) GetMaxGroupSize() uint32 {
 	if x != nil {
-		return x.Stage
+		return x.MaxGroupSize
 	}
-	return PullRequest_NONE
+	return 0
 }
 
-type Assignments struct {
+func (x *Course) GetEnrollments() []*Enrollment {
+	if x != nil {
+		return x.Enrollments
+	}
+	return nil
+}
+
+func (x *Course) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *Course) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Course) GetEnrollmentPolicy() *EnrollmentPolicy {
+	if x != nil {
+		return x.EnrollmentPolicy
+	}
+	return nil
+}
+
+// EnrollmentPolicy decides who can enroll in a course, and whose enrollments are approved without a teacher.
+type EnrollmentPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Assignments []*Assignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	ID           uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64                 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty" gorm:"uniqueIndex"`                   // foreign key
+	Opens        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=opens,proto3" json:"opens,omitempty" gorm:"serializer:timestamp;type:datetime"`   // enrollment is closed before this time, if set
+	Closes       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=closes,proto3" json:"closes,omitempty" gorm:"serializer:timestamp;type:datetime"` // enrollment is closed after this time, if set
+	JoinCode     string                 `protobuf:"bytes,5,opt,name=joinCode,proto3" json:"joinCode,omitempty"`                                       // code that users must give to enroll, if set
+	EmailDomains []string               `protobuf:"bytes,6,rep,name=emailDomains,proto3" json:"emailDomains,omitempty" gorm:"serializer:json"`        // users with a verified email in one of these domains are enrolled without approval
+	Capacity     uint32                 `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`                                      // maximum number of students and pending enrollments; further enrollments are waitlisted; unlimited if zero
 }
 
-func (x *Assignments) Reset() {
-	*x = Assignments{}
+func (x *EnrollmentPolicy) Reset() {
+	*x = EnrollmentPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_qf_types_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1691,13 +1937,13 @@ func (x *Assignments) Reset() {
 	}
 }
 
-func (x *Assignments) String() string {
+func (x *EnrollmentPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Assignments) ProtoMessage() {}
+func (*EnrollmentPolicy) ProtoMessage() {}
 
-func (x *Assignments) ProtoReflect() protoreflect.Message {
+func (x *EnrollmentPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1709,39 +1955,70 @@ func (x *Assignments) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Assignments.ProtoReflect.Descriptor instead.
-func (*Assignments) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnrollmentPolicy.ProtoReflect.Descriptor instead.
+func (*EnrollmentPolicy) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Assignments) GetAssignments() []*Assignment {
+func (x *EnrollmentPolicy) GetID() uint64 {
 	if x != nil {
-		return x.Assignments
+		return x.ID
 	}
-	return nil
+	return 0
 }
 
-type Submission struct {
+func (x *EnrollmentPolicy) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *EnrollmentPolicy) GetOpens() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Opens
+	}
+	return nil
+}
+
+func (x *EnrollmentPolicy) GetCloses() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Closes
+	}
+	return nil
+}
+
+func (x *EnrollmentPolicy) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+func (x *EnrollmentPolicy) GetEmailDomains() []string {
+	if x != nil {
+		return x.EmailDomains
+	}
+	return nil
+}
+
+func (x *EnrollmentPolicy) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type Courses struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	AssignmentID uint64                 `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
-	UserID       uint64                 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
-	GroupID      uint64                 `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	Score        uint32                 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
-	CommitHash   string                 `protobuf:"bytes,6,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
-	Released     bool                   `protobuf:"varint,7,opt,name=released,proto3" json:"released,omitempty"` // true => feedback is visible to the student or group members
-	Grades       []*Grade               `protobuf:"bytes,8,rep,name=Grades,proto3" json:"Grades,omitempty"`
-	ApprovedDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=approvedDate,proto3" json:"approvedDate,omitempty" gorm:"serializer:timestamp;type:datetime"`
-	Reviews      []*Review              `protobuf:"bytes,10,rep,name=reviews,proto3" json:"reviews,omitempty"`     // reviews produced for this submission
-	BuildInfo    *score.BuildInfo       `protobuf:"bytes,11,opt,name=BuildInfo,proto3" json:"BuildInfo,omitempty"` // build info for tests
-	Scores       []*score.Score         `protobuf:"bytes,12,rep,name=Scores,proto3" json:"Scores,omitempty"`       // list of scores for different tests
+	Courses []*Course `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
 }
 
-func (x *Submission) Reset() {
-	*x = Submission{}
+func (x *Courses) Reset() {
+	*x = Courses{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_qf_types_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1749,13 +2026,13 @@ func (x *Submission) Reset() {
 	}
 }
 
-func (x *Submission) String() string {
+func (x *Courses) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Submission) ProtoMessage() {}
+func (*Courses) ProtoMessage() {}
 
-func (x *Submission) ProtoReflect() protoreflect.Message {
+func (x *Courses) ProtoReflect() protoreflect.Message {
 	mi := &file_qf_types_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1767,120 +2044,159 @@ func (x *Submission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
-func (*Submission) Descriptor() ([]byte, []int) {
+// Deprecated: Use Courses.ProtoReflect.Descriptor instead.
+func (*Courses) Descriptor() ([]byte, []int) {
 	return file_qf_types_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Submission) GetID() uint64 {
+func (x *Courses) GetCourses() []*Course {
 	if x != nil {
-		return x.ID
+		return x.Courses
 	}
-	return 0
+	return nil
 }
 
-func (x *Submission) GetAssignmentID() uint64 {
-	if x != nil {
-		return x.AssignmentID
+// GradingConfig decides how the final grades of a course's students are computed.
+type GradingConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID    uint64                 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty" gorm:"uniqueIndex"` // foreign key
+	Weights     []*AssignmentWeight    `protobuf:"bytes,3,rep,name=weights,proto3" json:"weights,omitempty"`                       // assignments without a weight count with weight 1
+	MinApproved uint32                 `protobuf:"varint,4,opt,name=minApproved,proto3" json:"minApproved,omitempty"`              // minimum number of approved assignments required to pass
+	Components  []*ExternalComponent   `protobuf:"bytes,5,rep,name=components,proto3" json:"components,omitempty"`                 // grade components graded outside QuickFeed, such as a written exam
+	SchemeName  string                 `protobuf:"bytes,6,opt,name=schemeName,proto3" json:"schemeName,omitempty"`
+	GradePoints []uint32               `protobuf:"varint,7,rep,packed,name=gradePoints,proto3" json:"gradePoints,omitempty" gorm:"serializer:json"` // minimum points for each letter grade, in descending order
+	GradeNames  []string               `protobuf:"bytes,8,rep,name=gradeNames,proto3" json:"gradeNames,omitempty" gorm:"serializer:json"`           // letter grades from best to worst; the last grade is also given to students who do not pass
+	Updated     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated,proto3" json:"updated,omitempty" gorm:"serializer:timestamp;type:datetime"`
+}
+
+func (x *GradingConfig) Reset() {
+	*x = GradingConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Submission) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
+func (x *GradingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingConfig) ProtoMessage() {}
+
+func (x *GradingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Submission) GetGroupID() uint64 {
+// Deprecated: Use GradingConfig.ProtoReflect.Descriptor instead.
+func (*GradingConfig) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GradingConfig) GetID() uint64 {
 	if x != nil {
-		return x.GroupID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *Submission) GetScore() uint32 {
+func (x *GradingConfig) GetCourseID() uint64 {
 	if x != nil {
-		return x.Score
+		return x.CourseID
 	}
 	return 0
 }
 
-func (x *Submission) GetCommitHash() string {
+func (x *GradingConfig) GetWeights() []*AssignmentWeight {
 	if x != nil {
-		return x.CommitHash
+		return x.Weights
 	}
-	return ""
+	return nil
 }
 
-func (x *Submission) GetReleased() bool {
+func (x *GradingConfig) GetMinApproved() uint32 {
 	if x != nil {
-		return x.Released
+		return x.MinApproved
 	}
-	return false
+	return 0
 }
 
-func (x *Submission) GetGrades() []*Grade {
+func (x *GradingConfig) GetComponents() []*ExternalComponent {
 	if x != nil {
-		return x.Grades
+		return x.Components
 	}
 	return nil
 }
 
-func (x *Submission) GetApprovedDate() *timestamppb.Timestamp {
+func (x *GradingConfig) GetSchemeName() string {
 	if x != nil {
-		return x.ApprovedDate
+		return x.SchemeName
 	}
-	return nil
+	return ""
 }
 
-func (x *Submission) GetReviews() []*Review {
+func (x *GradingConfig) GetGradePoints() []uint32 {
 	if x != nil {
-		return x.Reviews
+		return x.GradePoints
 	}
 	return nil
 }
 
-func (x *Submission) GetBuildInfo() *score.BuildInfo {
+func (x *GradingConfig) GetGradeNames() []string {
 	if x != nil {
-		return x.BuildInfo
+		return x.GradeNames
 	}
 	return nil
 }
 
-func (x *Submission) GetScores() []*score.Score {
+func (x *GradingConfig) GetUpdated() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Scores
+		return x.Updated
 	}
 	return nil
 }
 
-type Submissions struct {
+type AssignmentWeight struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Submissions []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	ID              uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	GradingConfigID uint64 `protobuf:"varint,2,opt,name=GradingConfigID,proto3" json:"GradingConfigID,omitempty"` // foreign key
+	AssignmentID    uint64 `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"`       // foreign key
+	Weight          uint32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`                   // weight 0 excludes the assignment from the final grade's points
+	Required        bool   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`               // if true, the assignment must be approved to pass
 }
 
-func (x *Submissions) Reset() {
-	*x = Submissions{}
+func (x *AssignmentWeight) Reset() {
+	*x = AssignmentWeight{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qf_types_proto_msgTypes[16]
+		mi := &file_qf_types_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Submissions) String() string {
+func (x *AssignmentWeight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Submissions) ProtoMessage() {}
+func (*AssignmentWeight) ProtoMessage() {}
 
-func (x *Submissions) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_types_proto_msgTypes[16]
+func (x *AssignmentWeight) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1891,45 +2207,74 @@ func (x *Submissions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Submissions.ProtoReflect.Descriptor instead.
-func (*Submissions) Descriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AssignmentWeight.ProtoReflect.Descriptor instead.
+func (*AssignmentWeight) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Submissions) GetSubmissions() []*Submission {
+func (x *AssignmentWeight) GetID() uint64 {
 	if x != nil {
-		return x.Submissions
+		return x.ID
 	}
-	return nil
+	return 0
 }
 
-type Grade struct {
+func (x *AssignmentWeight) GetGradingConfigID() uint64 {
+	if x != nil {
+		return x.GradingConfigID
+	}
+	return 0
+}
+
+func (x *AssignmentWeight) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *AssignmentWeight) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *AssignmentWeight) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type ExternalComponent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubmissionID uint64            `protobuf:"varint,1,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty" gorm:"uniqueIndex:grade"`
-	UserID       uint64            `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty" gorm:"uniqueIndex:grade"`
-	Status       Submission_Status `protobuf:"varint,3,opt,name=Status,proto3,enum=qf.Submission_Status" json:"Status,omitempty"`
+	ID              uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	GradingConfigID uint64 `protobuf:"varint,2,opt,name=GradingConfigID,proto3" json:"GradingConfigID,omitempty"` // foreign key
+	Name            string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                        // e.g., "exam" or "oral"
+	Weight          uint32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func (x *Grade) Reset() {
-	*x = Grade{}
+func (x *ExternalComponent) Reset() {
+	*x = ExternalComponent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qf_types_proto_msgTypes[17]
+		mi := &file_qf_types_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Grade) String() string {
+func (x *ExternalComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Grade) ProtoMessage() {}
+func (*ExternalComponent) ProtoMessage() {}
 
-func (x *Grade) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_types_proto_msgTypes[17]
+func (x *ExternalComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1940,63 +2285,71 @@ func (x *Grade) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Grade.ProtoReflect.Descriptor instead.
-func (*Grade) Descriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ExternalComponent.ProtoReflect.Descriptor instead.
+func (*ExternalComponent) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Grade) GetSubmissionID() uint64 {
+func (x *ExternalComponent) GetID() uint64 {
 	if x != nil {
-		return x.SubmissionID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *Grade) GetUserID() uint64 {
+func (x *ExternalComponent) GetGradingConfigID() uint64 {
 	if x != nil {
-		return x.UserID
+		return x.GradingConfigID
 	}
 	return 0
 }
 
-func (x *Grade) GetStatus() Submission_Status {
+func (x *ExternalComponent) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return Submission_NONE
+	return ""
 }
 
-type GradingBenchmark struct {
+func (x *ExternalComponent) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// FinalGrade is the final grade of a student computed from the course's grading configuration.
+type FinalGrade struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	CourseID     uint64              `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`         // foreign key
-	AssignmentID uint64              `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
-	ReviewID     uint64              `protobuf:"varint,4,opt,name=ReviewID,proto3" json:"ReviewID,omitempty"`         // foreign key
-	Heading      string              `protobuf:"bytes,5,opt,name=heading,proto3" json:"heading,omitempty"`
-	Comment      string              `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
-	Criteria     []*GradingCriterion `protobuf:"bytes,7,rep,name=criteria,proto3" json:"criteria,omitempty" gorm:"foreignKey:BenchmarkID"`
+	EnrollmentID uint64       `protobuf:"varint,1,opt,name=enrollmentID,proto3" json:"enrollmentID,omitempty"`
+	UserID       uint64       `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Points       uint32       `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`     // weighted score between 0 and 100
+	Approved     uint32       `protobuf:"varint,4,opt,name=approved,proto3" json:"approved,omitempty"` // number of approved assignments
+	Passed       bool         `protobuf:"varint,5,opt,name=passed,proto3" json:"passed,omitempty"`     // true if the required assignments and the minimum number of assignments are approved
+	Grade        string       `protobuf:"bytes,6,opt,name=grade,proto3" json:"grade,omitempty"`        // letter grade; the failing grade if not passed
+	Parts        []*GradePart `protobuf:"bytes,7,rep,name=parts,proto3" json:"parts,omitempty"`        // per-assignment and per-component breakdown of the points
 }
 
-func (x *GradingBenchmark) Reset() {
-	*x = GradingBenchmark{}
+func (x *FinalGrade) Reset() {
+	*x = FinalGrade{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qf_types_proto_msgTypes[18]
+		mi := &file_qf_types_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradingBenchmark) String() string {
+func (x *FinalGrade) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradingBenchmark) ProtoMessage() {}
+func (*FinalGrade) ProtoMessage() {}
 
-func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_types_proto_msgTypes[18]
+func (x *FinalGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2007,85 +2360,90 @@ func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradingBenchmark.ProtoReflect.Descriptor instead.
-func (*GradingBenchmark) Descriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use FinalGrade.ProtoReflect.Descriptor instead.
+func (*FinalGrade) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GradingBenchmark) GetID() uint64 {
+func (x *FinalGrade) GetEnrollmentID() uint64 {
 	if x != nil {
-		return x.ID
+		return x.EnrollmentID
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetCourseID() uint64 {
+func (x *FinalGrade) GetUserID() uint64 {
 	if x != nil {
-		return x.CourseID
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetAssignmentID() uint64 {
+func (x *FinalGrade) GetPoints() uint32 {
 	if x != nil {
-		return x.AssignmentID
+		return x.Points
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetReviewID() uint64 {
+func (x *FinalGrade) GetApproved() uint32 {
 	if x != nil {
-		return x.ReviewID
+		return x.Approved
 	}
 	return 0
 }
 
-func (x *GradingBenchmark) GetHeading() string {
+func (x *FinalGrade) GetPassed() bool {
 	if x != nil {
-		return x.Heading
+		return x.Passed
 	}
-	return ""
+	return false
 }
 
-func (x *GradingBenchmark) GetComment() string {
+func (x *FinalGrade) GetGrade() string {
 	if x != nil {
-		return x.Comment
+		return x.Grade
 	}
 	return ""
 }
 
-func (x *GradingBenchmark) GetCriteria() []*GradingCriterion {
+func (x *FinalGrade) GetParts() []*GradePart {
 	if x != nil {
-		return x.Criteria
+		return x.Parts
 	}
 	return nil
 }
 
-type Benchmarks struct {
+type GradePart struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Benchmarks []*GradingBenchmark `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,1,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"` // zero for external components
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Weight       uint32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Score        uint32 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Approved     bool   `protobuf:"varint,5,opt,name=approved,proto3" json:"approved,omitempty"`
+	Required     bool   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
 }
 
-func (x *Benchmarks) Reset() {
-	*x = Benchmarks{}
+func (x *GradePart) Reset() {
+	*x = GradePart{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qf_types_proto_msgTypes[19]
+		mi := &file_qf_types_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Benchmarks) String() string {
+func (x *GradePart) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Benchmarks) ProtoMessage() {}
+func (*GradePart) ProtoMessage() {}
 
-func (x *Benchmarks) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_types_proto_msgTypes[19]
+func (x *GradePart) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2096,49 +2454,79 @@ func (x *Benchmarks) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Benchmarks.ProtoReflect.Descriptor instead.
-func (*Benchmarks) Descriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GradePart.ProtoReflect.Descriptor instead.
+func (*GradePart) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Benchmarks) GetBenchmarks() []*GradingBenchmark {
+func (x *GradePart) GetAssignmentID() uint64 {
 	if x != nil {
-		return x.Benchmarks
+		return x.AssignmentID
 	}
-	return nil
+	return 0
 }
 
-type GradingCriterion struct {
+func (x *GradePart) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GradePart) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *GradePart) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GradePart) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *GradePart) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type FinalGrades struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	BenchmarkID uint64                 `protobuf:"varint,2,opt,name=BenchmarkID,proto3" json:"BenchmarkID,omitempty"` // foreign key
-	CourseID    uint64                 `protobuf:"varint,3,opt,name=CourseID,proto3" json:"CourseID,omitempty"`       // foreign key
-	Points      uint64                 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
-	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Grade       GradingCriterion_Grade `protobuf:"varint,6,opt,name=grade,proto3,enum=qf.GradingCriterion_Grade" json:"grade,omitempty"`
-	Comment     string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	Config *GradingConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"` // the grading configuration used to compute the grades
+	Grades []*FinalGrade  `protobuf:"bytes,2,rep,name=grades,proto3" json:"grades,omitempty"`
 }
 
-func (x *GradingCriterion) Reset() {
-	*x = GradingCriterion{}
+func (x *FinalGrades) Reset() {
+	*x = FinalGrades{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qf_types_proto_msgTypes[20]
+		mi := &file_qf_types_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GradingCriterion) String() string {
+func (x *FinalGrades) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradingCriterion) ProtoMessage() {}
+func (*FinalGrades) ProtoMessage() {}
 
-func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_types_proto_msgTypes[20]
+func (x *FinalGrades) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2149,92 +2537,248 @@ func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradingCriterion.ProtoReflect.Descriptor instead.
-func (*GradingCriterion) Descriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use FinalGrades.ProtoReflect.Descriptor instead.
+func (*FinalGrades) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GradingCriterion) GetID() uint64 {
+func (x *FinalGrades) GetConfig() *GradingConfig {
 	if x != nil {
-		return x.ID
+		return x.Config
 	}
-	return 0
+	return nil
 }
 
-func (x *GradingCriterion) GetBenchmarkID() uint64 {
+func (x *FinalGrades) GetGrades() []*FinalGrade {
 	if x != nil {
-		return x.BenchmarkID
+		return x.Grades
 	}
-	return 0
+	return nil
 }
 
-func (x *GradingCriterion) GetCourseID() uint64 {
+// GradeExport is a CSV or XLSX file with the final grades of a course's students.
+type GradeExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data       []byte            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Mismatches []*RosterMismatch `protobuf:"bytes,2,rep,name=mismatches,proto3" json:"mismatches,omitempty"` // differences between the institution roster and the course's students
+}
+
+func (x *GradeExport) Reset() {
+	*x = GradeExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeExport) ProtoMessage() {}
+
+func (x *GradeExport) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeExport.ProtoReflect.Descriptor instead.
+func (*GradeExport) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GradeExport) GetData() []byte {
 	if x != nil {
-		return x.CourseID
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *GradingCriterion) GetPoints() uint64 {
+func (x *GradeExport) GetMismatches() []*RosterMismatch {
 	if x != nil {
-		return x.Points
+		return x.Mismatches
 	}
-	return 0
+	return nil
 }
 
-func (x *GradingCriterion) GetDescription() string {
+// ExternalGradeImport holds the external grades imported from a CSV file.
+type ExternalGradeImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grades     []*ExternalGrade  `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	Mismatches []*RosterMismatch `protobuf:"bytes,2,rep,name=mismatches,proto3" json:"mismatches,omitempty"` // rows of the CSV file that were not imported
+}
+
+func (x *ExternalGradeImport) Reset() {
+	*x = ExternalGradeImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalGradeImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalGradeImport) ProtoMessage() {}
+
+func (x *ExternalGradeImport) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalGradeImport.ProtoReflect.Descriptor instead.
+func (*ExternalGradeImport) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExternalGradeImport) GetGrades() []*ExternalGrade {
 	if x != nil {
-		return x.Description
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *ExternalGradeImport) GetMismatches() []*RosterMismatch {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
+type RosterMismatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind      RosterMismatch_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=qf.RosterMismatch_Kind" json:"kind,omitempty"`
+	StudentID string              `protobuf:"bytes,2,opt,name=studentID,proto3" json:"studentID,omitempty"`
+	Name      string              `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Row       uint32              `protobuf:"varint,4,opt,name=row,proto3" json:"row,omitempty"`            // row number in the roster, if any
+	Component string              `protobuf:"bytes,5,opt,name=component,proto3" json:"component,omitempty"` // external grade component, if any
+}
+
+func (x *RosterMismatch) Reset() {
+	*x = RosterMismatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RosterMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterMismatch) ProtoMessage() {}
+
+func (x *RosterMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterMismatch.ProtoReflect.Descriptor instead.
+func (*RosterMismatch) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RosterMismatch) GetKind() RosterMismatch_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return RosterMismatch_NOT_IN_QUICKFEED
+}
+
+func (x *RosterMismatch) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
 	}
 	return ""
 }
 
-func (x *GradingCriterion) GetGrade() GradingCriterion_Grade {
+func (x *RosterMismatch) GetName() string {
 	if x != nil {
-		return x.Grade
+		return x.Name
 	}
-	return GradingCriterion_NONE
+	return ""
 }
 
-func (x *GradingCriterion) GetComment() string {
+func (x *RosterMismatch) GetRow() uint32 {
 	if x != nil {
-		return x.Comment
+		return x.Row
+	}
+	return 0
+}
+
+func (x *RosterMismatch) GetComponent() string {
+	if x != nil {
+		return x.Component
 	}
 	return ""
 }
 
-type Review struct {
+type Repository struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	SubmissionID      uint64                 `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
-	ReviewerID        uint64                 `protobuf:"varint,3,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty"`     // UserID of the reviewer
-	Feedback          string                 `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
-	Ready             bool                   `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`
-	Score             uint32                 `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
-	GradingBenchmarks []*GradingBenchmark    `protobuf:"bytes,7,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty" gorm:"foreignKey:ReviewID"`
-	Edited            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=edited,proto3" json:"edited,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	ID                uint64          `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ScmOrganizationID uint64          `protobuf:"varint,2,opt,name=ScmOrganizationID,proto3" json:"ScmOrganizationID,omitempty" gorm:"uniqueIndex:repository"`
+	ScmRepositoryID   uint64          `protobuf:"varint,3,opt,name=ScmRepositoryID,proto3" json:"ScmRepositoryID,omitempty"` // ID of a github repository
+	UserID            uint64          `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:repository"`
+	GroupID           uint64          `protobuf:"varint,5,opt,name=groupID,proto3" json:"groupID,omitempty" gorm:"uniqueIndex:repository"`
+	HTMLURL           string          `protobuf:"bytes,6,opt,name=HTMLURL,proto3" json:"HTMLURL,omitempty"`
+	RepoType          Repository_Type `protobuf:"varint,7,opt,name=repoType,proto3,enum=qf.Repository_Type" json:"repoType,omitempty" gorm:"uniqueIndex:repository"`
+	Issues            []*Issue        `protobuf:"bytes,8,rep,name=issues,proto3" json:"issues,omitempty"` // Issues associated with this repository
 }
 
-func (x *Review) Reset() {
-	*x = Review{}
+func (x *Repository) Reset() {
+	*x = Repository{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_qf_types_proto_msgTypes[21]
+		mi := &file_qf_types_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Review) String() string {
+func (x *Repository) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Review) ProtoMessage() {}
+func (*Repository) ProtoMessage() {}
 
-func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_qf_types_proto_msgTypes[21]
+func (x *Repository) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2245,556 +2789,5212 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Review.ProtoReflect.Descriptor instead.
-func (*Review) Descriptor() ([]byte, []int) {
-	return file_qf_types_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
+func (*Repository) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Review) GetID() uint64 {
+func (x *Repository) GetID() uint64 {
 	if x != nil {
 		return x.ID
 	}
 	return 0
 }
 
-func (x *Review) GetSubmissionID() uint64 {
+func (x *Repository) GetScmOrganizationID() uint64 {
 	if x != nil {
-		return x.SubmissionID
+		return x.ScmOrganizationID
 	}
 	return 0
 }
 
-func (x *Review) GetReviewerID() uint64 {
+func (x *Repository) GetScmRepositoryID() uint64 {
 	if x != nil {
-		return x.ReviewerID
+		return x.ScmRepositoryID
 	}
 	return 0
 }
 
-func (x *Review) GetFeedback() string {
+func (x *Repository) GetUserID() uint64 {
 	if x != nil {
-		return x.Feedback
+		return x.UserID
 	}
-	return ""
+	return 0
 }
 
-func (x *Review) GetReady() bool {
+func (x *Repository) GetGroupID() uint64 {
 	if x != nil {
-		return x.Ready
+		return x.GroupID
 	}
-	return false
+	return 0
 }
 
-func (x *Review) GetScore() uint32 {
+func (x *Repository) GetHTMLURL() string {
 	if x != nil {
-		return x.Score
+		return x.HTMLURL
 	}
-	return 0
+	return ""
 }
 
-func (x *Review) GetGradingBenchmarks() []*GradingBenchmark {
+func (x *Repository) GetRepoType() Repository_Type {
 	if x != nil {
-		return x.GradingBenchmarks
+		return x.RepoType
 	}
-	return nil
+	return Repository_NONE
 }
 
-func (x *Review) GetEdited() *timestamppb.Timestamp {
+func (x *Repository) GetIssues() []*Issue {
 	if x != nil {
-		return x.Edited
+		return x.Issues
 	}
 	return nil
 }
 
-var File_qf_types_proto protoreflect.FileDescriptor
+type Enrollment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_qf_types_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x71, 0x66, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x02, 0x71, 0x66, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x70, 0x61,
-	0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x02, 0x0a,
-	0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
-	0x44, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x76, 0x61, 0x74, 0x61,
-	0x72, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x76, 0x61, 0x74,
-	0x61, 0x72, 0x55, 0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a,
-	0x0b, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e,
-	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x71, 0x66, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xda,
-	0x02, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xb5, 0x03, 0x1b, 0xa2, 0x01, 0x18, 0x67,
-	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x3a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a,
-	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x1f, 0xca, 0xb5, 0x03, 0x1b, 0xa2, 0x01, 0x18, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22,
-	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x71, 0x66, 0x2e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
-	0x22, 0x6d, 0x61, 0x6e, 0x79, 0x32, 0x6d, 0x61, 0x6e, 0x79, 0x3a, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3b, 0x22, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
-	0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x28, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a,
-	0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x22, 0x2b, 0x0a, 0x06, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xcb, 0x04, 0x0a, 0x06, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x34, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x20, 0xca, 0xb5, 0x03, 0x1c, 0xa2, 0x01, 0x19, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x22, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x20, 0xca, 0xb5, 0x03, 0x1c, 0xa2, 0x01, 0x19, 0x67, 0x6f,
-	0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x10, 0x0a,
-	0x03, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12,
-	0x2c, 0x0a, 0x11, 0x53, 0x63, 0x6d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x53, 0x63, 0x6d, 0x4f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x30, 0x0a,
-	0x13, 0x53, 0x63, 0x6d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x53, 0x63, 0x6d, 0x4f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x44,
-	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c,
-	0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x08, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x71, 0x66, 0x2e, 0x45,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72,
-	0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x08, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x12,
-	0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0f, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x2f, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x73, 0x12, 0x24, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x22, 0xf9, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x11, 0x53, 0x63, 0x6d, 0x4f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
-	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x52, 0x11, 0x53, 0x63, 0x6d, 0x4f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x63,
-	0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0f, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x49, 0x44, 0x12, 0x3c, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72,
-	0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x3e, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d,
-	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x12, 0x55, 0x0a, 0x08,
-	0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d,
-	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x71, 0x66, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06,
-	0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08,
-	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f,
-	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54,
-	0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54, 0x53, 0x10, 0x03, 0x12, 0x08,
-	0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55,
-	0x50, 0x10, 0x05, 0x22, 0x95, 0x06, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x49, 0x44, 0x12, 0x40, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72,
-	0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x65,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x49, 0x44, 0x12, 0x3c, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72,
-	0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x65,
-	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x71, 0x66, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x71, 0x66, 0x2e,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1f,
-	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x19, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1b, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79,
-	0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
-	0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d,
-	0x22, 0x52, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69,
-	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x78, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c,
-	0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79,
-	0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x10, 0x6c, 0x61,
-	0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24,
-	0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70,
-	0x44, 0x61, 0x79, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x66, 0x2e,
-	0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x0c, 0x75, 0x73,
-	0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x22, 0x3d, 0x0a, 0x0a, 0x55, 0x73,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
-	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
-	0x0b, 0x0a, 0x07, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
-	0x54, 0x45, 0x41, 0x43, 0x48, 0x45, 0x52, 0x10, 0x03, 0x22, 0x40, 0x0a, 0x0c, 0x44, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53,
-	0x45, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x01,
-	0x12, 0x0b, 0x0a, 0x07, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a,
-	0x08, 0x46, 0x41, 0x56, 0x4f, 0x52, 0x49, 0x54, 0x45, 0x10, 0x03, 0x22, 0x82, 0x01, 0x0a, 0x0c,
-	0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
-	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x64, 0x44, 0x61, 0x79, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x75, 0x73, 0x65, 0x64, 0x44, 0x61, 0x79, 0x73,
-	0x22, 0x3f, 0x0a, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x8e, 0x04, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
-	0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x68, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30,
-	0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22,
-	0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75,
-	0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c,
-	0x61, 0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73,
-	0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x0b,
-	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e,
-	0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x71, 0x66, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x42,
-	0x0a, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47,
-	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52,
-	0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
-	0x28, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62,
-	0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x71, 0x66, 0x2e, 0x49, 0x73, 0x73,
-	0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x7b, 0x0a, 0x05, 0x49, 0x73,
-	0x73, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
-	0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49,
-	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12,
-	0x26, 0x0a, 0x0e, 0x53, 0x63, 0x6d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x53, 0x63, 0x6d, 0x49, 0x73, 0x73, 0x75,
-	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xd6, 0x02, 0x0a, 0x0b, 0x50, 0x75, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x63, 0x6d, 0x52, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0f, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49,
-	0x44, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x73,
-	0x75, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x69, 0x73, 0x73, 0x75,
-	0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x53,
-	0x63, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x53, 0x63, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x71, 0x66, 0x2e,
-	0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x67,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x22, 0x36, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x67,
-	0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44,
-	0x52, 0x41, 0x46, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57,
-	0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03,
-	0x22, 0x3f, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x93, 0x04, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
-	0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x47, 0x72, 0x61, 0x64,
-	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72,
-	0x61, 0x64, 0x65, 0x52, 0x06, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x70, 0x0a, 0x0c, 0x61,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca,
-	0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52,
-	0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a,
-	0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0c, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72,
-	0x65, 0x52, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a,
-	0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52,
-	0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x56,
-	0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x22, 0x3f, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66,
-	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x05, 0x47, 0x72, 0x61,
-	0x64, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xca, 0xb5, 0x03, 0x1b, 0xa2, 0x01,
-	0x18, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x3a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xca, 0xb5, 0x03, 0x1b, 0xa2, 0x01, 0x18,
-	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x3a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x12, 0x2d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x8a, 0x02, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68,
-	0x6d, 0x61, 0x72, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
-	0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44,
-	0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x42, 0x24, 0xca, 0xb5,
-	0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69,
-	0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49,
-	0x44, 0x22, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x42, 0x0a, 0x0a,
-	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x65,
-	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68,
-	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73,
-	0x22, 0x91, 0x02, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74,
-	0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
-	0x72, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
-	0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x71,
-	0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
-	0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x05, 0x47, 0x72, 0x61,
-	0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53,
-	0x45, 0x44, 0x10, 0x02, 0x22, 0xf1, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x65, 0x0a, 0x11, 0x67,
-	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x42, 0x21, 0xca, 0xb5,
-	0x03, 0x1d, 0xa2, 0x01, 0x1a, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69,
-	0x67, 0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x52,
-	0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x12, 0x64, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30,
-	0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22,
-	0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x42, 0x26, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64,
-	0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x71, 0x66, 0xba, 0x02, 0x00,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	ID                uint64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID          uint64                  `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:enrollment"`
+	UserID            uint64                  `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty" gorm:"uniqueIndex:enrollment"`
+	GroupID           uint64                  `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	User              *User                   `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	Course            *Course                 `protobuf:"bytes,6,opt,name=course,proto3" json:"course,omitempty"`
+	Group             *Group                  `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`
+	Status            Enrollment_UserStatus   `protobuf:"varint,8,opt,name=status,proto3,enum=qf.Enrollment_UserStatus" json:"status,omitempty"`
+	State             Enrollment_DisplayState `protobuf:"varint,9,opt,name=state,proto3,enum=qf.Enrollment_DisplayState" json:"state,omitempty"`
+	SlipDaysRemaining uint32                  `protobuf:"varint,10,opt,name=slipDaysRemaining,proto3" json:"slipDaysRemaining,omitempty" gorm:"-"`
+	LastActivityDate  *timestamppb.Timestamp  `protobuf:"bytes,11,opt,name=lastActivityDate,proto3" json:"lastActivityDate,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	TotalApproved     uint64                  `protobuf:"varint,12,opt,name=totalApproved,proto3" json:"totalApproved,omitempty"`
+	UsedSlipDays      []*UsedSlipDays         `protobuf:"bytes,13,rep,name=usedSlipDays,proto3" json:"usedSlipDays,omitempty"`
+	ExternalGrades    []*ExternalGrade        `protobuf:"bytes,14,rep,name=externalGrades,proto3" json:"externalGrades,omitempty"`
+	Waitlisted        bool                    `protobuf:"varint,15,opt,name=waitlisted,proto3" json:"waitlisted,omitempty"`     // the pending enrollment is on the course's waitlist
+	JoinCode          string                  `protobuf:"bytes,16,opt,name=joinCode,proto3" json:"joinCode,omitempty" gorm:"-"` // the course's join code, given when enrolling
 }
 
-var (
-	file_qf_types_proto_rawDescOnce sync.Once
-	file_qf_types_proto_rawDescData = file_qf_types_proto_rawDesc
-)
+func (x *Enrollment) Reset() {
+	*x = Enrollment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_qf_types_proto_rawDescGZIP() []byte {
-	file_qf_types_proto_rawDescOnce.Do(func() {
-		file_qf_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_qf_types_proto_rawDescData)
-	})
-	return file_qf_types_proto_rawDescData
+func (x *Enrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_qf_types_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_qf_types_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
-var file_qf_types_proto_goTypes = []interface{}{
-	(Group_GroupStatus)(0),        // 0: qf.Group.GroupStatus
-	(Repository_Type)(0),          // 1: qf.Repository.Type
-	(Enrollment_UserStatus)(0),    // 2: qf.Enrollment.UserStatus
-	(Enrollment_DisplayState)(0),  // 3: qf.Enrollment.DisplayState
-	(PullRequest_Stage)(0),        // 4: qf.PullRequest.Stage
-	(Submission_Status)(0),        // 5: qf.Submission.Status
-	(GradingCriterion_Grade)(0),   // 6: qf.GradingCriterion.Grade
-	(*User)(nil),                  // 7: qf.User
-	(*Users)(nil),                 // 8: qf.Users
-	(*Group)(nil),                 // 9: qf.Group
-	(*Groups)(nil),                // 10: qf.Groups
-	(*Course)(nil),                // 11: qf.Course
-	(*Courses)(nil),               // 12: qf.Courses
-	(*Repository)(nil),            // 13: qf.Repository
-	(*Enrollment)(nil),            // 14: qf.Enrollment
-	(*UsedSlipDays)(nil),          // 15: qf.UsedSlipDays
-	(*Enrollments)(nil),           // 16: qf.Enrollments
-	(*Assignment)(nil),            // 17: qf.Assignment
-	(*Task)(nil),                  // 18: qf.Task
-	(*Issue)(nil),                 // 19: qf.Issue
-	(*PullRequest)(nil),           // 20: qf.PullRequest
-	(*Assignments)(nil),           // 21: qf.Assignments
-	(*Submission)(nil),            // 22: qf.Submission
-	(*Submissions)(nil),           // 23: qf.Submissions
-	(*Grade)(nil),                 // 24: qf.Grade
-	(*GradingBenchmark)(nil),      // 25: qf.GradingBenchmark
-	(*Benchmarks)(nil),            // 26: qf.Benchmarks
-	(*GradingCriterion)(nil),      // 27: qf.GradingCriterion
-	(*Review)(nil),                // 28: qf.Review
-	(*timestamppb.Timestamp)(nil), // 29: google.protobuf.Timestamp
-	(*score.BuildInfo)(nil),       // 30: score.BuildInfo
-	(*score.Score)(nil),           // 31: score.Score
+func (*Enrollment) ProtoMessage() {}
+
+func (x *Enrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_qf_types_proto_depIdxs = []int32{
-	14, // 0: qf.User.Enrollments:type_name -> qf.Enrollment
-	7,  // 1: qf.Users.users:type_name -> qf.User
-	0,  // 2: qf.Group.status:type_name -> qf.Group.GroupStatus
-	7,  // 3: qf.Group.users:type_name -> qf.User
-	14, // 4: qf.Group.enrollments:type_name -> qf.Enrollment
-	9,  // 5: qf.Groups.groups:type_name -> qf.Group
-	2,  // 6: qf.Course.enrolled:type_name -> qf.Enrollment.UserStatus
-	14, // 7: qf.Course.enrollments:type_name -> qf.Enrollment
-	17, // 8: qf.Course.assignments:type_name -> qf.Assignment
-	9,  // 9: qf.Course.groups:type_name -> qf.Group
-	11, // 10: qf.Courses.courses:type_name -> qf.Course
-	1,  // 11: qf.Repository.repoType:type_name -> qf.Repository.Type
-	19, // 12: qf.Repository.issues:type_name -> qf.Issue
-	7,  // 13: qf.Enrollment.user:type_name -> qf.User
-	11, // 14: qf.Enrollment.course:type_name -> qf.Course
-	9,  // 15: qf.Enrollment.group:type_name -> qf.Group
-	2,  // 16: qf.Enrollment.status:type_name -> qf.Enrollment.UserStatus
-	3,  // 17: qf.Enrollment.state:type_name -> qf.Enrollment.DisplayState
-	29, // 18: qf.Enrollment.lastActivityDate:type_name -> google.protobuf.Timestamp
-	15, // 19: qf.Enrollment.usedSlipDays:type_name -> qf.UsedSlipDays
-	14, // 20: qf.Enrollments.enrollments:type_name -> qf.Enrollment
-	29, // 21: qf.Assignment.deadline:type_name -> google.protobuf.Timestamp
-	22, // 22: qf.Assignment.submissions:type_name -> qf.Submission
-	18, // 23: qf.Assignment.tasks:type_name -> qf.Task
-	25, // 24: qf.Assignment.gradingBenchmarks:type_name -> qf.GradingBenchmark
-	19, // 25: qf.Task.issues:type_name -> qf.Issue
-	4,  // 26: qf.PullRequest.stage:type_name -> qf.PullRequest.Stage
-	17, // 27: qf.Assignments.assignments:type_name -> qf.Assignment
-	24, // 28: qf.Submission.Grades:type_name -> qf.Grade
-	29, // 29: qf.Submission.approvedDate:type_name -> google.protobuf.Timestamp
-	28, // 30: qf.Submission.reviews:type_name -> qf.Review
-	30, // 31: qf.Submission.BuildInfo:type_name -> score.BuildInfo
-	31, // 32: qf.Submission.Scores:type_name -> score.Score
-	22, // 33: qf.Submissions.submissions:type_name -> qf.Submission
-	5,  // 34: qf.Grade.Status:type_name -> qf.Submission.Status
-	27, // 35: qf.GradingBenchmark.criteria:type_name -> qf.GradingCriterion
-	25, // 36: qf.Benchmarks.benchmarks:type_name -> qf.GradingBenchmark
-	6,  // 37: qf.GradingCriterion.grade:type_name -> qf.GradingCriterion.Grade
-	25, // 38: qf.Review.gradingBenchmarks:type_name -> qf.GradingBenchmark
-	29, // 39: qf.Review.edited:type_name -> google.protobuf.Timestamp
-	40, // [40:40] is the sub-list for method output_type
-	40, // [40:40] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+
+// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
+func (*Enrollment) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{26}
 }
 
-func init() { file_qf_types_proto_init() }
-func file_qf_types_proto_init() {
-	if File_qf_types_proto != nil {
-		return
+func (x *Enrollment) GetID() uint64 {
+	if x != nil {
+		return x.ID
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_qf_types_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+	return 0
+}
+
+func (x *Enrollment) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Enrollment) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Enrollment) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *Enrollment) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Enrollment) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *Enrollment) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *Enrollment) GetStatus() Enrollment_UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return Enrollment_NONE
+}
+
+func (x *Enrollment) GetState() Enrollment_DisplayState {
+	if x != nil {
+		return x.State
+	}
+	return Enrollment_UNSET
+}
+
+func (x *Enrollment) GetSlipDaysRemaining() uint32 {
+	if x != nil {
+		return x.SlipDaysRemaining
+	}
+	return 0
+}
+
+func (x *Enrollment) GetLastActivityDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivityDate
+	}
+	return nil
+}
+
+func (x *Enrollment) GetTotalApproved() uint64 {
+	if x != nil {
+		return x.TotalApproved
+	}
+	return 0
+}
+
+func (x *Enrollment) GetUsedSlipDays() []*UsedSlipDays {
+	if x != nil {
+		return x.UsedSlipDays
+	}
+	return nil
+}
+
+func (x *Enrollment) GetExternalGrades() []*ExternalGrade {
+	if x != nil {
+		return x.ExternalGrades
+	}
+	return nil
+}
+
+func (x *Enrollment) GetWaitlisted() bool {
+	if x != nil {
+		return x.Waitlisted
+	}
+	return false
+}
+
+func (x *Enrollment) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+type UsedSlipDays struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	EnrollmentID uint64 `protobuf:"varint,2,opt,name=enrollmentID,proto3" json:"enrollmentID,omitempty"`
+	AssignmentID uint64 `protobuf:"varint,3,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"`
+	UsedDays     uint32 `protobuf:"varint,4,opt,name=usedDays,proto3" json:"usedDays,omitempty"`
+}
+
+func (x *UsedSlipDays) Reset() {
+	*x = UsedSlipDays{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsedSlipDays) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsedSlipDays) ProtoMessage() {}
+
+func (x *UsedSlipDays) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsedSlipDays.ProtoReflect.Descriptor instead.
+func (*UsedSlipDays) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UsedSlipDays) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *UsedSlipDays) GetEnrollmentID() uint64 {
+	if x != nil {
+		return x.EnrollmentID
+	}
+	return 0
+}
+
+func (x *UsedSlipDays) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *UsedSlipDays) GetUsedDays() uint32 {
+	if x != nil {
+		return x.UsedDays
+	}
+	return 0
+}
+
+// ExternalGrade is an enrollment's score for an external grade component, such as a written exam.
+type ExternalGrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	EnrollmentID uint64                 `protobuf:"varint,2,opt,name=enrollmentID,proto3" json:"enrollmentID,omitempty" gorm:"uniqueIndex:external_grade"`
+	Component    string                 `protobuf:"bytes,3,opt,name=component,proto3" json:"component,omitempty" gorm:"uniqueIndex:external_grade"` // name of the grading configuration's external component
+	Score        uint32                 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	Updated      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated,proto3" json:"updated,omitempty" gorm:"serializer:timestamp;type:datetime"`
+}
+
+func (x *ExternalGrade) Reset() {
+	*x = ExternalGrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalGrade) ProtoMessage() {}
+
+func (x *ExternalGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalGrade.ProtoReflect.Descriptor instead.
+func (*ExternalGrade) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExternalGrade) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ExternalGrade) GetEnrollmentID() uint64 {
+	if x != nil {
+		return x.EnrollmentID
+	}
+	return 0
+}
+
+func (x *ExternalGrade) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *ExternalGrade) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ExternalGrade) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+type Enrollments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enrollments []*Enrollment `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+}
+
+func (x *Enrollments) Reset() {
+	*x = Enrollments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Enrollments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollments) ProtoMessage() {}
+
+func (x *Enrollments) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollments.ProtoReflect.Descriptor instead.
+func (*Enrollments) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Enrollments) GetEnrollments() []*Enrollment {
+	if x != nil {
+		return x.Enrollments
+	}
+	return nil
+}
+
+// RosterEntry is a student on a course's institution roster. Users who match a roster entry
+// are enrolled as students without waiting for a teacher to approve their enrollment.
+type RosterEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID  uint64 `protobuf:"varint,2,opt,name=courseID,proto3" json:"courseID,omitempty" gorm:"uniqueIndex:roster_entry"`
+	StudentID string `protobuf:"bytes,3,opt,name=studentID,proto3" json:"studentID,omitempty" gorm:"uniqueIndex:roster_entry"`
+	Email     string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Login     string `protobuf:"bytes,5,opt,name=login,proto3" json:"login,omitempty"` // GitHub login; optional
+}
+
+func (x *RosterEntry) Reset() {
+	*x = RosterEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RosterEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterEntry) ProtoMessage() {}
+
+func (x *RosterEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterEntry.ProtoReflect.Descriptor instead.
+func (*RosterEntry) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RosterEntry) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *RosterEntry) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *RosterEntry) GetStudentID() string {
+	if x != nil {
+		return x.StudentID
+	}
+	return ""
+}
+
+func (x *RosterEntry) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RosterEntry) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+// RosterCoverage reports which of the students on a course's roster have signed up for the course.
+type RosterCoverage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries     uint32         `protobuf:"varint,1,opt,name=entries,proto3" json:"entries,omitempty"`        // number of students on the roster
+	SignedUp    uint32         `protobuf:"varint,2,opt,name=signedUp,proto3" json:"signedUp,omitempty"`      // number of students on the roster with an enrollment
+	NotSignedUp []*RosterEntry `protobuf:"bytes,3,rep,name=notSignedUp,proto3" json:"notSignedUp,omitempty"` // students on the roster without an enrollment
+	Unmatched   []*Enrollment  `protobuf:"bytes,4,rep,name=unmatched,proto3" json:"unmatched,omitempty"`     // pending enrollments that do not match a student on the roster
+}
+
+func (x *RosterCoverage) Reset() {
+	*x = RosterCoverage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RosterCoverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterCoverage) ProtoMessage() {}
+
+func (x *RosterCoverage) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterCoverage.ProtoReflect.Descriptor instead.
+func (*RosterCoverage) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RosterCoverage) GetEntries() uint32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *RosterCoverage) GetSignedUp() uint32 {
+	if x != nil {
+		return x.SignedUp
+	}
+	return 0
+}
+
+func (x *RosterCoverage) GetNotSignedUp() []*RosterEntry {
+	if x != nil {
+		return x.NotSignedUp
+	}
+	return nil
+}
+
+func (x *RosterCoverage) GetUnmatched() []*Enrollment {
+	if x != nil {
+		return x.Unmatched
+	}
+	return nil
+}
+
+type Assignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                uint64                     `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID          uint64                     `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"` // foreign key
+	Name              string                     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Deadline          *timestamppb.Timestamp     `protobuf:"bytes,4,opt,name=deadline,proto3" json:"deadline,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	AutoApprove       bool                       `protobuf:"varint,5,opt,name=autoApprove,proto3" json:"autoApprove,omitempty"`
+	Order             uint32                     `protobuf:"varint,6,opt,name=order,proto3" json:"order,omitempty"`
+	IsGroupLab        bool                       `protobuf:"varint,7,opt,name=isGroupLab,proto3" json:"isGroupLab,omitempty"`
+	ScoreLimit        uint32                     `protobuf:"varint,8,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`                                               // minimal score limit for auto approval
+	Reviewers         uint32                     `protobuf:"varint,9,opt,name=reviewers,proto3" json:"reviewers,omitempty"`                                                 // number of reviewers that will review submissions for this assignment
+	ContainerTimeout  uint32                     `protobuf:"varint,10,opt,name=containerTimeout,proto3" json:"containerTimeout,omitempty"`                                  // container timeout for this assignment
+	Submissions       []*Submission              `protobuf:"bytes,11,rep,name=submissions,proto3" json:"submissions,omitempty"`                                             // submissions produced for this assignment
+	Tasks             []*Task                    `protobuf:"bytes,12,rep,name=tasks,proto3" json:"tasks,omitempty"`                                                         // tasks associated with this assignment
+	GradingBenchmarks []*GradingBenchmark        `protobuf:"bytes,13,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty"`                                 // grading benchmarks for this assignment
+	Quiz              *Quiz                      `protobuf:"bytes,14,opt,name=quiz,proto3" json:"quiz,omitempty"`                                                           // quiz for this assignment, if any
+	ReconcilePolicy   Assignment_ReconcilePolicy `protobuf:"varint,15,opt,name=reconcilePolicy,proto3,enum=qf.Assignment_ReconcilePolicy" json:"reconcilePolicy,omitempty"` // how multiple reviews of a submission are reconciled into a final review
+	Anonymous         bool                       `protobuf:"varint,16,opt,name=anonymous,proto3" json:"anonymous,omitempty"`                                                // if true, reviewers cannot see who made a submission until it is released
+	PeerReviews       uint32                     `protobuf:"varint,17,opt,name=peerReviews,proto3" json:"peerReviews,omitempty"`                                            // number of peer submissions each student reviews in the peer review phase
+}
+
+func (x *Assignment) Reset() {
+	*x = Assignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Assignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignment) ProtoMessage() {}
+
+func (x *Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
+func (*Assignment) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Assignment) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Assignment) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Assignment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Assignment) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *Assignment) GetAutoApprove() bool {
+	if x != nil {
+		return x.AutoApprove
+	}
+	return false
+}
+
+func (x *Assignment) GetOrder() uint32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *Assignment) GetIsGroupLab() bool {
+	if x != nil {
+		return x.IsGroupLab
+	}
+	return false
+}
+
+func (x *Assignment) GetScoreLimit() uint32 {
+	if x != nil {
+		return x.ScoreLimit
+	}
+	return 0
+}
+
+func (x *Assignment) GetReviewers() uint32 {
+	if x != nil {
+		return x.Reviewers
+	}
+	return 0
+}
+
+func (x *Assignment) GetContainerTimeout() uint32 {
+	if x != nil {
+		return x.ContainerTimeout
+	}
+	return 0
+}
+
+func (x *Assignment) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *Assignment) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *Assignment) GetGradingBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.GradingBenchmarks
+	}
+	return nil
+}
+
+func (x *Assignment) GetQuiz() *Quiz {
+	if x != nil {
+		return x.Quiz
+	}
+	return nil
+}
+
+func (x *Assignment) GetReconcilePolicy() Assignment_ReconcilePolicy {
+	if x != nil {
+		return x.ReconcilePolicy
+	}
+	return Assignment_NONE
+}
+
+func (x *Assignment) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *Assignment) GetPeerReviews() uint32 {
+	if x != nil {
+		return x.PeerReviews
+	}
+	return 0
+}
+
+type Task struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID              uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID    uint64   `protobuf:"varint,2,opt,name=assignmentID,proto3" json:"assignmentID,omitempty"` // foreign key
+	AssignmentOrder uint32   `protobuf:"varint,3,opt,name=assignmentOrder,proto3" json:"assignmentOrder,omitempty"`
+	Title           string   `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body            string   `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Name            string   `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Issues          []*Issue `protobuf:"bytes,7,rep,name=issues,proto3" json:"issues,omitempty"` // Issues that use this task as a benchmark
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Task) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Task) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Task) GetAssignmentOrder() uint32 {
+	if x != nil {
+		return x.AssignmentOrder
+	}
+	return 0
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Task) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Task) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type Issue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	RepositoryID   uint64 `protobuf:"varint,2,opt,name=repositoryID,proto3" json:"repositoryID,omitempty"`     // Represents the internal ID of a repository
+	TaskID         uint64 `protobuf:"varint,3,opt,name=taskID,proto3" json:"taskID,omitempty"`                 // Task that this issue draws its content from
+	ScmIssueNumber uint64 `protobuf:"varint,4,opt,name=ScmIssueNumber,proto3" json:"ScmIssueNumber,omitempty"` // Issue number on scm. Needed for associating db issue with scm issue
+}
+
+func (x *Issue) Reset() {
+	*x = Issue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Issue) ProtoMessage() {}
+
+func (x *Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
+func (*Issue) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Issue) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Issue) GetRepositoryID() uint64 {
+	if x != nil {
+		return x.RepositoryID
+	}
+	return 0
+}
+
+func (x *Issue) GetTaskID() uint64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *Issue) GetScmIssueNumber() uint64 {
+	if x != nil {
+		return x.ScmIssueNumber
+	}
+	return 0
+}
+
+type PullRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID              uint64            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ScmRepositoryID uint64            `protobuf:"varint,2,opt,name=ScmRepositoryID,proto3" json:"ScmRepositoryID,omitempty"` // Represents the scm repository ID
+	TaskID          uint64            `protobuf:"varint,3,opt,name=taskID,proto3" json:"taskID,omitempty"`                   // Foreign key
+	IssueID         uint64            `protobuf:"varint,4,opt,name=issueID,proto3" json:"issueID,omitempty"`                 // Foreign key
+	UserID          uint64            `protobuf:"varint,5,opt,name=userID,proto3" json:"userID,omitempty"`                   // The user who owns this PR
+	ScmCommentID    uint64            `protobuf:"varint,6,opt,name=ScmCommentID,proto3" json:"ScmCommentID,omitempty"`       // Scm ID of the comment used for automatic feedback
+	SourceBranch    string            `protobuf:"bytes,7,opt,name=sourceBranch,proto3" json:"sourceBranch,omitempty"`        // The source branch for this pull request
+	Number          uint64            `protobuf:"varint,8,opt,name=number,proto3" json:"number,omitempty"`                   // Pull request number
+	Stage           PullRequest_Stage `protobuf:"varint,9,opt,name=stage,proto3,enum=qf.PullRequest_Stage" json:"stage,omitempty"`
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PullRequest) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PullRequest) GetScmRepositoryID() uint64 {
+	if x != nil {
+		return x.ScmRepositoryID
+	}
+	return 0
+}
+
+func (x *PullRequest) GetTaskID() uint64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *PullRequest) GetIssueID() uint64 {
+	if x != nil {
+		return x.IssueID
+	}
+	return 0
+}
+
+func (x *PullRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *PullRequest) GetScmCommentID() uint64 {
+	if x != nil {
+		return x.ScmCommentID
+	}
+	return 0
+}
+
+func (x *PullRequest) GetSourceBranch() string {
+	if x != nil {
+		return x.SourceBranch
+	}
+	return ""
+}
+
+func (x *PullRequest) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *PullRequest) GetStage() PullRequest_Stage {
+	if x != nil {
+		return x.Stage
+	}
+	return PullRequest_NONE
+}
+
+type Assignments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments []*Assignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+}
+
+func (x *Assignments) Reset() {
+	*x = Assignments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Assignments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignments) ProtoMessage() {}
+
+func (x *Assignments) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignments.ProtoReflect.Descriptor instead.
+func (*Assignments) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Assignments) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type Submission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AssignmentID uint64                 `protobuf:"varint,2,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	UserID       uint64                 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	GroupID      uint64                 `protobuf:"varint,4,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Score        uint32                 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	CommitHash   string                 `protobuf:"bytes,6,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
+	Released     bool                   `protobuf:"varint,7,opt,name=released,proto3" json:"released,omitempty"` // true => feedback is visible to the student or group members
+	Grades       []*Grade               `protobuf:"bytes,8,rep,name=Grades,proto3" json:"Grades,omitempty"`
+	ApprovedDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=approvedDate,proto3" json:"approvedDate,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	Reviews      []*Review              `protobuf:"bytes,10,rep,name=reviews,proto3" json:"reviews,omitempty"`               // reviews produced for this submission
+	BuildInfo    *score.BuildInfo       `protobuf:"bytes,11,opt,name=BuildInfo,proto3" json:"BuildInfo,omitempty"`           // build info for tests
+	Scores       []*score.Score         `protobuf:"bytes,12,rep,name=Scores,proto3" json:"Scores,omitempty"`                 // list of scores for different tests
+	Pseudonym    uint64                 `protobuf:"varint,13,opt,name=pseudonym,proto3" json:"pseudonym,omitempty" gorm:"-"` // replaces user and group IDs of anonymous submissions
+}
+
+func (x *Submission) Reset() {
+	*x = Submission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submission) ProtoMessage() {}
+
+func (x *Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Submission) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Submission) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Submission) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Submission) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *Submission) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Submission) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *Submission) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *Submission) GetGrades() []*Grade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *Submission) GetApprovedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedDate
+	}
+	return nil
+}
+
+func (x *Submission) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *Submission) GetBuildInfo() *score.BuildInfo {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return nil
+}
+
+func (x *Submission) GetScores() []*score.Score {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Submission) GetPseudonym() uint64 {
+	if x != nil {
+		return x.Pseudonym
+	}
+	return 0
+}
+
+type Submissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submissions []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+}
+
+func (x *Submissions) Reset() {
+	*x = Submissions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Submissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submissions) ProtoMessage() {}
+
+func (x *Submissions) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submissions.ProtoReflect.Descriptor instead.
+func (*Submissions) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Submissions) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+type Grade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionID uint64            `protobuf:"varint,1,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty" gorm:"uniqueIndex:grade"`
+	UserID       uint64            `protobuf:"varint,2,opt,name=UserID,proto3" json:"UserID,omitempty" gorm:"uniqueIndex:grade"`
+	Status       Submission_Status `protobuf:"varint,3,opt,name=Status,proto3,enum=qf.Submission_Status" json:"Status,omitempty"`
+}
+
+func (x *Grade) Reset() {
+	*x = Grade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Grade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grade) ProtoMessage() {}
+
+func (x *Grade) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grade.ProtoReflect.Descriptor instead.
+func (*Grade) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Grade) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Grade) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Grade) GetStatus() Submission_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Submission_NONE
+}
+
+// ScheduledJob is a bulk change to an assignment's submissions that the server performs at a scheduled time.
+type ScheduledJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64                 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`                                          // foreign key
+	AssignmentID uint64                 `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty" gorm:"uniqueIndex:scheduled_job"` // foreign key
+	Type         ScheduledJob_Type      `protobuf:"varint,4,opt,name=type,proto3,enum=qf.ScheduledJob_Type" json:"type,omitempty" gorm:"uniqueIndex:scheduled_job"`
+	RunAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=runAt,proto3" json:"runAt,omitempty" gorm:"serializer:timestamp;type:datetime"`         // only used by release jobs
+	GraceHours   uint32                 `protobuf:"varint,6,opt,name=graceHours,proto3" json:"graceHours,omitempty"`                                        // only used by grade jobs; hours after the assignment's deadline
+	ScoreLimit   uint32                 `protobuf:"varint,7,opt,name=scoreLimit,proto3" json:"scoreLimit,omitempty"`                                        // only used by grade jobs
+	Completed    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed,proto3" json:"completed,omitempty" gorm:"serializer:timestamp;type:datetime"` // unset until the job has run
+}
+
+func (x *ScheduledJob) Reset() {
+	*x = ScheduledJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledJob) ProtoMessage() {}
+
+func (x *ScheduledJob) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledJob.ProtoReflect.Descriptor instead.
+func (*ScheduledJob) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ScheduledJob) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ScheduledJob) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ScheduledJob) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *ScheduledJob) GetType() ScheduledJob_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ScheduledJob_RELEASE
+}
+
+func (x *ScheduledJob) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+func (x *ScheduledJob) GetGraceHours() uint32 {
+	if x != nil {
+		return x.GraceHours
+	}
+	return 0
+}
+
+func (x *ScheduledJob) GetScoreLimit() uint32 {
+	if x != nil {
+		return x.ScoreLimit
+	}
+	return 0
+}
+
+func (x *ScheduledJob) GetCompleted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Completed
+	}
+	return nil
+}
+
+type ScheduledJobs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*ScheduledJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *ScheduledJobs) Reset() {
+	*x = ScheduledJobs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledJobs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledJobs) ProtoMessage() {}
+
+func (x *ScheduledJobs) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledJobs.ProtoReflect.Descriptor instead.
+func (*ScheduledJobs) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ScheduledJobs) GetJobs() []*ScheduledJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// AuditEntry records a bulk change to an assignment's submissions.
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID       uint64                 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`             // foreign key
+	AssignmentID   uint64                 `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"`     // foreign key
+	UserID         uint64                 `protobuf:"varint,4,opt,name=UserID,proto3" json:"UserID,omitempty"`                 // the teacher making the change; zero for scheduled changes
+	ScheduledJobID uint64                 `protobuf:"varint,5,opt,name=ScheduledJobID,proto3" json:"ScheduledJobID,omitempty"` // the scheduled job making the change, if any
+	Change         string                 `protobuf:"bytes,6,opt,name=change,proto3" json:"change,omitempty"`                  // description of the change
+	Submissions    uint32                 `protobuf:"varint,7,opt,name=submissions,proto3" json:"submissions,omitempty"`       // number of changed submissions
+	Created        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created,proto3" json:"created,omitempty" gorm:"serializer:timestamp;type:datetime"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AuditEntry) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetScheduledJobID() uint64 {
+	if x != nil {
+		return x.ScheduledJobID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetSubmissions() uint32 {
+	if x != nil {
+		return x.Submissions
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+type AuditEntries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *AuditEntries) Reset() {
+	*x = AuditEntries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntries) ProtoMessage() {}
+
+func (x *AuditEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntries.ProtoReflect.Descriptor instead.
+func (*AuditEntries) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AuditEntries) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GradingBenchmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64              `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64              `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`         // foreign key
+	AssignmentID uint64              `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"` // foreign key
+	ReviewID     uint64              `protobuf:"varint,4,opt,name=ReviewID,proto3" json:"ReviewID,omitempty"`         // foreign key
+	Heading      string              `protobuf:"bytes,5,opt,name=heading,proto3" json:"heading,omitempty"`
+	Comment      string              `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	Criteria     []*GradingCriterion `protobuf:"bytes,7,rep,name=criteria,proto3" json:"criteria,omitempty" gorm:"foreignKey:BenchmarkID"`
+	Weight       uint32              `protobuf:"varint,8,opt,name=weight,proto3" json:"weight,omitempty"` // relative weight of the benchmark in the review score; 0 means unweighted
+}
+
+func (x *GradingBenchmark) Reset() {
+	*x = GradingBenchmark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingBenchmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingBenchmark) ProtoMessage() {}
+
+func (x *GradingBenchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingBenchmark.ProtoReflect.Descriptor instead.
+func (*GradingBenchmark) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GradingBenchmark) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetReviewID() uint64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *GradingBenchmark) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *GradingBenchmark) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GradingBenchmark) GetCriteria() []*GradingCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *GradingBenchmark) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type Benchmarks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Benchmarks []*GradingBenchmark `protobuf:"bytes,1,rep,name=benchmarks,proto3" json:"benchmarks,omitempty"`
+}
+
+func (x *Benchmarks) Reset() {
+	*x = Benchmarks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Benchmarks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Benchmarks) ProtoMessage() {}
+
+func (x *Benchmarks) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Benchmarks.ProtoReflect.Descriptor instead.
+func (*Benchmarks) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Benchmarks) GetBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.Benchmarks
+	}
+	return nil
+}
+
+type GradingCriterion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	BenchmarkID uint64                 `protobuf:"varint,2,opt,name=BenchmarkID,proto3" json:"BenchmarkID,omitempty"` // foreign key
+	CourseID    uint64                 `protobuf:"varint,3,opt,name=CourseID,proto3" json:"CourseID,omitempty"`       // foreign key
+	Points      uint64                 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Grade       GradingCriterion_Grade `protobuf:"varint,6,opt,name=grade,proto3,enum=qf.GradingCriterion_Grade" json:"grade,omitempty"`
+	Comment     string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	Partial     bool                   `protobuf:"varint,8,opt,name=partial,proto3" json:"partial,omitempty"` // if true, the criterion can be awarded between 0 and points
+	Awarded     uint64                 `protobuf:"varint,9,opt,name=awarded,proto3" json:"awarded,omitempty"` // points awarded to a partial credit criterion
+}
+
+func (x *GradingCriterion) Reset() {
+	*x = GradingCriterion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradingCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingCriterion) ProtoMessage() {}
+
+func (x *GradingCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingCriterion.ProtoReflect.Descriptor instead.
+func (*GradingCriterion) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GradingCriterion) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetBenchmarkID() uint64 {
+	if x != nil {
+		return x.BenchmarkID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetPoints() uint64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *GradingCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GradingCriterion) GetGrade() GradingCriterion_Grade {
+	if x != nil {
+		return x.Grade
+	}
+	return GradingCriterion_NONE
+}
+
+func (x *GradingCriterion) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GradingCriterion) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+func (x *GradingCriterion) GetAwarded() uint64 {
+	if x != nil {
+		return x.Awarded
+	}
+	return 0
+}
+
+type Review struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SubmissionID      uint64                 `protobuf:"varint,2,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
+	ReviewerID        uint64                 `protobuf:"varint,3,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty"`     // UserID of the reviewer
+	Feedback          string                 `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Ready             bool                   `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`
+	Score             uint32                 `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	GradingBenchmarks []*GradingBenchmark    `protobuf:"bytes,7,rep,name=gradingBenchmarks,proto3" json:"gradingBenchmarks,omitempty" gorm:"foreignKey:ReviewID"`
+	Edited            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=edited,proto3" json:"edited,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	Final             bool                   `protobuf:"varint,9,opt,name=final,proto3" json:"final,omitempty"` // true if this is the final review reconciled from the submission's other reviews
+	Peer              bool                   `protobuf:"varint,10,opt,name=peer,proto3" json:"peer,omitempty"`  // true if this is a peer review by a student; peer reviews do not affect the submission's score
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Review) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Review) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Review) GetReviewerID() uint64 {
+	if x != nil {
+		return x.ReviewerID
+	}
+	return 0
+}
+
+func (x *Review) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Review) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Review) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Review) GetGradingBenchmarks() []*GradingBenchmark {
+	if x != nil {
+		return x.GradingBenchmarks
+	}
+	return nil
+}
+
+func (x *Review) GetEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Edited
+	}
+	return nil
+}
+
+func (x *Review) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+func (x *Review) GetPeer() bool {
+	if x != nil {
+		return x.Peer
+	}
+	return false
+}
+
+// LineComment is a reviewer's comment on a range of lines in a file of a submission's commit.
+type LineComment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64                 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`         // foreign key
+	SubmissionID uint64                 `protobuf:"varint,3,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
+	AuthorID     uint64                 `protobuf:"varint,4,opt,name=AuthorID,proto3" json:"AuthorID,omitempty"`         // UserID of the comment's author
+	CommitHash   string                 `protobuf:"bytes,5,opt,name=commitHash,proto3" json:"commitHash,omitempty"`      // commit the comment refers to; defaults to the submission's commit
+	Path         string                 `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`                  // path of the commented file, relative to the repository root
+	StartLine    uint32                 `protobuf:"varint,7,opt,name=startLine,proto3" json:"startLine,omitempty"`
+	EndLine      uint32                 `protobuf:"varint,8,opt,name=endLine,proto3" json:"endLine,omitempty"` // last commented line; equal to startLine for a single line
+	Body         string                 `protobuf:"bytes,9,opt,name=body,proto3" json:"body,omitempty"`
+	Edited       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=edited,proto3" json:"edited,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	ScmCommentID int64                  `protobuf:"varint,11,opt,name=ScmCommentID,proto3" json:"ScmCommentID,omitempty"` // ID of the pull request review comment mirroring this comment, if any
+}
+
+func (x *LineComment) Reset() {
+	*x = LineComment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LineComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineComment) ProtoMessage() {}
+
+func (x *LineComment) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineComment.ProtoReflect.Descriptor instead.
+func (*LineComment) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *LineComment) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *LineComment) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *LineComment) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *LineComment) GetAuthorID() uint64 {
+	if x != nil {
+		return x.AuthorID
+	}
+	return 0
+}
+
+func (x *LineComment) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *LineComment) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *LineComment) GetStartLine() uint32 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *LineComment) GetEndLine() uint32 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
+func (x *LineComment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *LineComment) GetEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Edited
+	}
+	return nil
+}
+
+func (x *LineComment) GetScmCommentID() int64 {
+	if x != nil {
+		return x.ScmCommentID
+	}
+	return 0
+}
+
+type LineComments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments []*LineComment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+}
+
+func (x *LineComments) Reset() {
+	*x = LineComments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LineComments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineComments) ProtoMessage() {}
+
+func (x *LineComments) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineComments.ProtoReflect.Descriptor instead.
+func (*LineComments) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LineComments) GetComments() []*LineComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+// FeedbackSnippet is a reusable comment that reviewers can insert into criterion comments and review feedback.
+type FeedbackSnippet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       uint64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID uint64                  `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"` // foreign key
+	Title    string                  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body     string                  `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Tags     []string                `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" gorm:"serializer:json"`
+	Usage    []*FeedbackSnippetUsage `protobuf:"bytes,6,rep,name=usage,proto3" json:"usage,omitempty"` // number of times the snippet has been used in each assignment
+}
+
+func (x *FeedbackSnippet) Reset() {
+	*x = FeedbackSnippet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedbackSnippet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedbackSnippet) ProtoMessage() {}
+
+func (x *FeedbackSnippet) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedbackSnippet.ProtoReflect.Descriptor instead.
+func (*FeedbackSnippet) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *FeedbackSnippet) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *FeedbackSnippet) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *FeedbackSnippet) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FeedbackSnippet) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *FeedbackSnippet) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *FeedbackSnippet) GetUsage() []*FeedbackSnippetUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type FeedbackSnippetUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	FeedbackSnippetID uint64 `protobuf:"varint,2,opt,name=FeedbackSnippetID,proto3" json:"FeedbackSnippetID,omitempty" gorm:"uniqueIndex:snippet_usage"` // foreign key
+	AssignmentID      uint64 `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty" gorm:"uniqueIndex:snippet_usage"`           // foreign key
+	Count             uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *FeedbackSnippetUsage) Reset() {
+	*x = FeedbackSnippetUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedbackSnippetUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedbackSnippetUsage) ProtoMessage() {}
+
+func (x *FeedbackSnippetUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedbackSnippetUsage.ProtoReflect.Descriptor instead.
+func (*FeedbackSnippetUsage) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *FeedbackSnippetUsage) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *FeedbackSnippetUsage) GetFeedbackSnippetID() uint64 {
+	if x != nil {
+		return x.FeedbackSnippetID
+	}
+	return 0
+}
+
+func (x *FeedbackSnippetUsage) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *FeedbackSnippetUsage) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type FeedbackSnippets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snippets []*FeedbackSnippet `protobuf:"bytes,1,rep,name=snippets,proto3" json:"snippets,omitempty"`
+}
+
+func (x *FeedbackSnippets) Reset() {
+	*x = FeedbackSnippets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedbackSnippets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedbackSnippets) ProtoMessage() {}
+
+func (x *FeedbackSnippets) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedbackSnippets.ProtoReflect.Descriptor instead.
+func (*FeedbackSnippets) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *FeedbackSnippets) GetSnippets() []*FeedbackSnippet {
+	if x != nil {
+		return x.Snippets
+	}
+	return nil
+}
+
+// RegradeRequest is a student's request to have the approval or review score of a submission reconsidered.
+type RegradeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64                 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`         // foreign key
+	SubmissionID uint64                 `protobuf:"varint,3,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty"` // foreign key
+	RequesterID  uint64                 `protobuf:"varint,4,opt,name=RequesterID,proto3" json:"RequesterID,omitempty"`   // UserID of the student requesting the regrade
+	Reason       string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status       RegradeRequest_Status  `protobuf:"varint,6,opt,name=status,proto3,enum=qf.RegradeRequest_Status" json:"status,omitempty"`
+	ResolverID   uint64                 `protobuf:"varint,7,opt,name=ResolverID,proto3" json:"ResolverID,omitempty"`                       // UserID of the teacher who last changed the status
+	Resolution   string                 `protobuf:"bytes,8,opt,name=resolution,proto3" json:"resolution,omitempty"`                        // the teacher's explanation of the decision
+	Action       RegradeRequest_Action  `protobuf:"varint,9,opt,name=action,proto3,enum=qf.RegradeRequest_Action" json:"action,omitempty"` // the action taken when the request was resolved
+	Created      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created,proto3" json:"created,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	Updated      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated,proto3" json:"updated,omitempty" gorm:"serializer:timestamp;type:datetime"`
+}
+
+func (x *RegradeRequest) Reset() {
+	*x = RegradeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegradeRequest) ProtoMessage() {}
+
+func (x *RegradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegradeRequest.ProtoReflect.Descriptor instead.
+func (*RegradeRequest) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RegradeRequest) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetRequesterID() uint64 {
+	if x != nil {
+		return x.RequesterID
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RegradeRequest) GetStatus() RegradeRequest_Status {
+	if x != nil {
+		return x.Status
+	}
+	return RegradeRequest_PENDING
+}
+
+func (x *RegradeRequest) GetResolverID() uint64 {
+	if x != nil {
+		return x.ResolverID
+	}
+	return 0
+}
+
+func (x *RegradeRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *RegradeRequest) GetAction() RegradeRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return RegradeRequest_NONE
+}
+
+func (x *RegradeRequest) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *RegradeRequest) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+type RegradeRequests struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*RegradeRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *RegradeRequests) Reset() {
+	*x = RegradeRequests{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegradeRequests) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegradeRequests) ProtoMessage() {}
+
+func (x *RegradeRequests) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegradeRequests.ProtoReflect.Descriptor instead.
+func (*RegradeRequests) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RegradeRequests) GetRequests() []*RegradeRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// CriterionDisagreement holds the points earned for a grading criterion in each of the reviews being reconciled.
+type CriterionDisagreement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Heading     string   `protobuf:"bytes,1,opt,name=heading,proto3" json:"heading,omitempty"` // heading of the criterion's grading benchmark
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Earned      []uint64 `protobuf:"varint,3,rep,packed,name=earned,proto3" json:"earned,omitempty"` // points earned in each review; 1 for a passed criterion without points
+	Spread      uint64   `protobuf:"varint,4,opt,name=spread,proto3" json:"spread,omitempty"`        // difference between the most and the least points earned
+}
+
+func (x *CriterionDisagreement) Reset() {
+	*x = CriterionDisagreement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CriterionDisagreement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionDisagreement) ProtoMessage() {}
+
+func (x *CriterionDisagreement) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionDisagreement.ProtoReflect.Descriptor instead.
+func (*CriterionDisagreement) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CriterionDisagreement) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *CriterionDisagreement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CriterionDisagreement) GetEarned() []uint64 {
+	if x != nil {
+		return x.Earned
+	}
+	return nil
+}
+
+func (x *CriterionDisagreement) GetSpread() uint64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+type Reconciliation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionID uint64                   `protobuf:"varint,1,opt,name=submissionID,proto3" json:"submissionID,omitempty"`
+	Reviews      []*Review                `protobuf:"bytes,2,rep,name=reviews,proto3" json:"reviews,omitempty"`     // ready reviews being reconciled
+	Conflicts    []*CriterionDisagreement `protobuf:"bytes,3,rep,name=conflicts,proto3" json:"conflicts,omitempty"` // criteria the reviews disagree on
+	Final        *Review                  `protobuf:"bytes,4,opt,name=final,proto3" json:"final,omitempty"`         // final review proposed by the assignment's reconcile policy
+}
+
+func (x *Reconciliation) Reset() {
+	*x = Reconciliation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reconciliation) ProtoMessage() {}
+
+func (x *Reconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reconciliation.ProtoReflect.Descriptor instead.
+func (*Reconciliation) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Reconciliation) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *Reconciliation) GetConflicts() []*CriterionDisagreement {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *Reconciliation) GetFinal() *Review {
+	if x != nil {
+		return x.Final
+	}
+	return nil
+}
+
+type ReviewAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`                                              // foreign key
+	AssignmentID uint64 `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"`                                      // foreign key
+	SubmissionID uint64 `protobuf:"varint,4,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty" gorm:"uniqueIndex:review_allocation"` // foreign key
+	ReviewerID   uint64 `protobuf:"varint,5,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty" gorm:"uniqueIndex:review_allocation"`     // UserID of the reviewer
+}
+
+func (x *ReviewAllocation) Reset() {
+	*x = ReviewAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAllocation) ProtoMessage() {}
+
+func (x *ReviewAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAllocation.ProtoReflect.Descriptor instead.
+func (*ReviewAllocation) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReviewAllocation) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ReviewAllocation) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ReviewAllocation) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *ReviewAllocation) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *ReviewAllocation) GetReviewerID() uint64 {
+	if x != nil {
+		return x.ReviewerID
+	}
+	return 0
+}
+
+type ReviewAllocations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allocations []*ReviewAllocation `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+}
+
+func (x *ReviewAllocations) Reset() {
+	*x = ReviewAllocations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewAllocations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAllocations) ProtoMessage() {}
+
+func (x *ReviewAllocations) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAllocations.ProtoReflect.Descriptor instead.
+func (*ReviewAllocations) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReviewAllocations) GetAllocations() []*ReviewAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+// ReviewerLoad records the number of pull request reviews requested from a user.
+// Teacher review loads are counted per course, and have GroupID 0.
+// Student review loads are counted per group.
+type ReviewerLoad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID uint64 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty" gorm:"uniqueIndex:reviewer_load"`
+	GroupID  uint64 `protobuf:"varint,3,opt,name=GroupID,proto3" json:"GroupID,omitempty" gorm:"uniqueIndex:reviewer_load"`
+	UserID   uint64 `protobuf:"varint,4,opt,name=UserID,proto3" json:"UserID,omitempty" gorm:"uniqueIndex:reviewer_load"`
+	Count    uint32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReviewerLoad) Reset() {
+	*x = ReviewerLoad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewerLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerLoad) ProtoMessage() {}
+
+func (x *ReviewerLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerLoad.ProtoReflect.Descriptor instead.
+func (*ReviewerLoad) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReviewerLoad) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ReviewerLoad) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *ReviewerLoad) GetGroupID() uint64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *ReviewerLoad) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ReviewerLoad) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReviewerLoads struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loads []*ReviewerLoad `protobuf:"bytes,1,rep,name=loads,proto3" json:"loads,omitempty"`
+}
+
+func (x *ReviewerLoads) Reset() {
+	*x = ReviewerLoads{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewerLoads) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerLoads) ProtoMessage() {}
+
+func (x *ReviewerLoads) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerLoads.ProtoReflect.Descriptor instead.
+func (*ReviewerLoads) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReviewerLoads) GetLoads() []*ReviewerLoad {
+	if x != nil {
+		return x.Loads
+	}
+	return nil
+}
+
+// PeerReview assigns a student to review another student's (or group's) submission in the peer review phase.
+// The reviewer has read access to the submission's repository while the peer review is open.
+type PeerReview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64  `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`                                        // foreign key
+	AssignmentID uint64  `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty"`                                // foreign key
+	SubmissionID uint64  `protobuf:"varint,4,opt,name=SubmissionID,proto3" json:"SubmissionID,omitempty" gorm:"uniqueIndex:peer_review"` // foreign key
+	ReviewerID   uint64  `protobuf:"varint,5,opt,name=ReviewerID,proto3" json:"ReviewerID,omitempty" gorm:"uniqueIndex:peer_review"`     // UserID of the student reviewer
+	ReviewID     uint64  `protobuf:"varint,6,opt,name=ReviewID,proto3" json:"ReviewID,omitempty"`                                        // the reviewer's peer review, if created
+	Open         bool    `protobuf:"varint,7,opt,name=open,proto3" json:"open,omitempty"`                                                // true while the reviewer can create and update the peer review
+	Quality      uint32  `protobuf:"varint,8,opt,name=quality,proto3" json:"quality,omitempty"`                                          // the teacher's assessment of the peer review's quality; between 0 and 100
+	Review       *Review `protobuf:"bytes,9,opt,name=review,proto3" json:"review,omitempty" gorm:"-"`                                    // the reviewer's peer review, if created
+}
+
+func (x *PeerReview) Reset() {
+	*x = PeerReview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReview) ProtoMessage() {}
+
+func (x *PeerReview) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReview.ProtoReflect.Descriptor instead.
+func (*PeerReview) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PeerReview) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PeerReview) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *PeerReview) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *PeerReview) GetSubmissionID() uint64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+func (x *PeerReview) GetReviewerID() uint64 {
+	if x != nil {
+		return x.ReviewerID
+	}
+	return 0
+}
+
+func (x *PeerReview) GetReviewID() uint64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *PeerReview) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *PeerReview) GetQuality() uint32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+func (x *PeerReview) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+// PeerReviewScore is a student's score for the quality of the student's peer reviews in an assignment.
+type PeerReviewScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64 `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`                                              // foreign key
+	AssignmentID uint64 `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty" gorm:"uniqueIndex:peer_review_score"` // foreign key
+	UserID       uint64 `protobuf:"varint,4,opt,name=UserID,proto3" json:"UserID,omitempty" gorm:"uniqueIndex:peer_review_score"`             // UserID of the student reviewer
+	Score        uint32 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`                                                    // average quality of the student's peer reviews; between 0 and 100
+}
+
+func (x *PeerReviewScore) Reset() {
+	*x = PeerReviewScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerReviewScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReviewScore) ProtoMessage() {}
+
+func (x *PeerReviewScore) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReviewScore.ProtoReflect.Descriptor instead.
+func (*PeerReviewScore) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PeerReviewScore) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PeerReviewScore) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *PeerReviewScore) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *PeerReviewScore) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *PeerReviewScore) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type PeerReviews struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerReviews []*PeerReview     `protobuf:"bytes,1,rep,name=peerReviews,proto3" json:"peerReviews,omitempty"`
+	Scores      map[uint64]uint32 `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // map: reviewer ID -> the reviewer's saved peer review score
+}
+
+func (x *PeerReviews) Reset() {
+	*x = PeerReviews{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerReviews) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerReviews) ProtoMessage() {}
+
+func (x *PeerReviews) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerReviews.ProtoReflect.Descriptor instead.
+func (*PeerReviews) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PeerReviews) GetPeerReviews() []*PeerReview {
+	if x != nil {
+		return x.PeerReviews
+	}
+	return nil
+}
+
+func (x *PeerReviews) GetScores() map[uint64]uint32 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type Quiz struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           uint64          `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CourseID     uint64          `protobuf:"varint,2,opt,name=CourseID,proto3" json:"CourseID,omitempty"`                            // foreign key
+	AssignmentID uint64          `protobuf:"varint,3,opt,name=AssignmentID,proto3" json:"AssignmentID,omitempty" gorm:"uniqueIndex"` // foreign key
+	TimeLimit    uint32          `protobuf:"varint,4,opt,name=timeLimit,proto3" json:"timeLimit,omitempty"`                          // time limit for each attempt in seconds
+	MaxAttempts  uint32          `protobuf:"varint,5,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`                      // number of attempts allowed for each student
+	Questions    []*QuizQuestion `protobuf:"bytes,6,rep,name=questions,proto3" json:"questions,omitempty"`
+}
+
+func (x *Quiz) Reset() {
+	*x = Quiz{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quiz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quiz) ProtoMessage() {}
+
+func (x *Quiz) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quiz.ProtoReflect.Descriptor instead.
+func (*Quiz) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Quiz) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Quiz) GetCourseID() uint64 {
+	if x != nil {
+		return x.CourseID
+	}
+	return 0
+}
+
+func (x *Quiz) GetAssignmentID() uint64 {
+	if x != nil {
+		return x.AssignmentID
+	}
+	return 0
+}
+
+func (x *Quiz) GetTimeLimit() uint32 {
+	if x != nil {
+		return x.TimeLimit
+	}
+	return 0
+}
+
+func (x *Quiz) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Quiz) GetQuestions() []*QuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type QuizQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	QuizID  uint64   `protobuf:"varint,2,opt,name=QuizID,proto3" json:"QuizID,omitempty"` // foreign key
+	Number  uint32   `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	Text    string   `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Choices []string `protobuf:"bytes,5,rep,name=choices,proto3" json:"choices,omitempty" gorm:"serializer:json"` // choices are labeled a, b, c, ...
+	Answers []string `protobuf:"bytes,6,rep,name=answers,proto3" json:"answers,omitempty" gorm:"serializer:json"` // labels of the correct choices; never sent to students
+	Weight  uint32   `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *QuizQuestion) Reset() {
+	*x = QuizQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuizQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizQuestion) ProtoMessage() {}
+
+func (x *QuizQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizQuestion.ProtoReflect.Descriptor instead.
+func (*QuizQuestion) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *QuizQuestion) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetQuizID() uint64 {
+	if x != nil {
+		return x.QuizID
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *QuizQuestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *QuizQuestion) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *QuizQuestion) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *QuizQuestion) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type QuizAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	QuizID    uint64                 `protobuf:"varint,2,opt,name=QuizID,proto3" json:"QuizID,omitempty"` // foreign key
+	UserID    uint64                 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Attempt   uint32                 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"` // attempt number, starting at 1
+	Started   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started,proto3" json:"started,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	Deadline  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	Submitted *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=submitted,proto3" json:"submitted,omitempty" gorm:"serializer:timestamp;type:datetime"`
+	Answers   []*QuizAnswer          `protobuf:"bytes,8,rep,name=answers,proto3" json:"answers,omitempty" gorm:"foreignKey:AttemptID"`
+	Quiz      *Quiz                  `protobuf:"bytes,9,opt,name=quiz,proto3" json:"quiz,omitempty" gorm:"-"` // quiz questions without the correct answers
+}
+
+func (x *QuizAttempt) Reset() {
+	*x = QuizAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuizAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAttempt) ProtoMessage() {}
+
+func (x *QuizAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAttempt.ProtoReflect.Descriptor instead.
+func (*QuizAttempt) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *QuizAttempt) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetQuizID() uint64 {
+	if x != nil {
+		return x.QuizID
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *QuizAttempt) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *QuizAttempt) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *QuizAttempt) GetSubmitted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Submitted
+	}
+	return nil
+}
+
+func (x *QuizAttempt) GetAnswers() []*QuizAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *QuizAttempt) GetQuiz() *Quiz {
+	if x != nil {
+		return x.Quiz
+	}
+	return nil
+}
+
+type QuizAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AttemptID uint64   `protobuf:"varint,2,opt,name=AttemptID,proto3" json:"AttemptID,omitempty"`                     // foreign key
+	Number    uint32   `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`                           // question number
+	Selected  []string `protobuf:"bytes,4,rep,name=selected,proto3" json:"selected,omitempty" gorm:"serializer:json"` // labels of the selected choices
+}
+
+func (x *QuizAnswer) Reset() {
+	*x = QuizAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_qf_types_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuizAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAnswer) ProtoMessage() {}
+
+func (x *QuizAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_qf_types_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAnswer.ProtoReflect.Descriptor instead.
+func (*QuizAnswer) Descriptor() ([]byte, []int) {
+	return file_qf_types_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *QuizAnswer) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *QuizAnswer) GetAttemptID() uint64 {
+	if x != nil {
+		return x.AttemptID
+	}
+	return 0
+}
+
+func (x *QuizAnswer) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *QuizAnswer) GetSelected() []string {
+	if x != nil {
+		return x.Selected
+	}
+	return nil
+}
+
+var File_qf_types_proto protoreflect.FileDescriptor
+
+var file_qf_types_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x71, 0x66, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x71, 0x66, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x6b, 0x69, 0x74, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xec, 0x02, 0x0a,
+	0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x76, 0x61, 0x74, 0x61,
+	0x72, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x55, 0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x27, 0x0a, 0x05, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x22, 0xe7, 0x02, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x33,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xb5,
+	0x03, 0x1b, 0xa2, 0x01, 0x18, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xca, 0xb5, 0x03, 0x1b, 0xa2, 0x01, 0x18, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x44, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x71, 0x66, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01,
+	0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6d, 0x61, 0x6e, 0x79, 0x32, 0x6d, 0x61, 0x6e, 0x79,
+	0x3a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3b, 0x22, 0x52, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x44, 0x10, 0x02, 0x22, 0x2b,
+	0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xa4, 0x04, 0x0a, 0x0f,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x44, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5,
+	0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x12, 0x42, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x66, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x71, 0x66, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x22,
+	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x71, 0x66, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x72, 0x22, 0x3e, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43,
+	0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x43, 0x4c, 0x49,
+	0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x03, 0x22, 0x49, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x66,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x88, 0x03,
+	0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2d, 0x0a,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13,
+	0xca, 0xb5, 0x03, 0x0f, 0xa2, 0x01, 0x0c, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x64, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30,
+	0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22,
+	0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x60, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x52, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x49, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x0b,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x46, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2,
+	0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x3a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x42, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x2a, 0xca, 0xb5, 0x03, 0x26, 0xa2, 0x01, 0x23, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x3d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0xa2, 0x01, 0x16,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72,
+	0x3a, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x71, 0x66, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x75, 0x6e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x13, 0x75, 0x6e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xcc, 0x02, 0x0a, 0x12, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x67, 0x67, 0x65, 0x64,
+	0x12, 0x3c, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x71, 0x66, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x22, 0xb2, 0x01, 0x0a, 0x16, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x41, 0x64,
+	0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x22, 0x9e, 0x06, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xca, 0xb5, 0x03, 0x1c, 0xa2, 0x01, 0x19,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x3a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x34, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x20, 0xca,
+	0xb5, 0x03, 0x1c, 0xa2, 0x01, 0x19, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x22, 0x52,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x2c, 0x0a, 0x11, 0x53, 0x63, 0x6d, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x53, 0x63, 0x6d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x63, 0x6d, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x53, 0x63, 0x6d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44,
+	0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6c, 0x69, 0x70, 0x44,
+	0x61, 0x79, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c,
+	0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x44,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12,
+	0x46, 0x0a, 0x08, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0f, 0xca, 0xb5,
+	0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x08, 0x65,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x11, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x11, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69,
+	0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x40, 0x0a, 0x10, 0x65, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x10, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x22, 0x9e, 0x03, 0x0a, 0x10, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19, 0xca, 0xb5, 0x03, 0x15, 0xa2, 0x01,
+	0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x62, 0x0a,
+	0x05, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01,
+	0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65,
+	0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x05, 0x6f, 0x70, 0x65, 0x6e,
+	0x73, 0x12, 0x64, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca,
+	0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52,
+	0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0xa2,
+	0x01, 0x16, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x3a, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x22, 0x2f, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a,
+	0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x73, 0x22, 0xe7, 0x03, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19, 0xca, 0xb5, 0x03, 0x15, 0xa2, 0x01, 0x12,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x07,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x71, 0x66, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x6d, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x35,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19,
+	0xa2, 0x01, 0x16, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x72, 0x3a, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19,
+	0xa2, 0x01, 0x16, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x72, 0x3a, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xa4, 0x01,
+	0x0a, 0x10, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x47, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x22, 0x79, 0x0a, 0x11, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x47, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0xcf, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x05,
+	0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x71, 0x66,
+	0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x74, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74,
+	0x73, 0x22, 0xa9, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x60, 0x0a,
+	0x0b, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71,
+	0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22,
+	0x55, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x64, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x29, 0x0a,
+	0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x71, 0x66, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71,
+	0x66, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x94, 0x02, 0x0a,
+	0x0e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x2b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x71, 0x66, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x72, 0x6f, 0x77,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x22, 0x73,
+	0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e,
+	0x5f, 0x51, 0x55, 0x49, 0x43, 0x4b, 0x46, 0x45, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x52, 0x4f, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x55,
+	0x44, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x10, 0x03,
+	0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x43, 0x4f, 0x52,
+	0x45, 0x10, 0x04, 0x22, 0xf9, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x52, 0x0a, 0x11, 0x53, 0x63, 0x6d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x24, 0xca,
+	0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x22, 0x52, 0x11, 0x53, 0x63, 0x6d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44,
+	0x12, 0x3c, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3e,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x22, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x48, 0x54, 0x4d, 0x4c, 0x55, 0x52, 0x4c, 0x12, 0x55, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x71, 0x66, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x42,
+	0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x22, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x21, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x71, 0x66, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x73, 0x22, 0x4b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x02, 0x12,
+	0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54, 0x53, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53,
+	0x45, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x05, 0x22,
+	0xac, 0x07, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x40,
+	0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x3c, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x24, 0xca, 0xb5, 0x03, 0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x65, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x71, 0x66, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x31, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x71, 0x66,
+	0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x3d, 0x0a, 0x11, 0x73, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f, 0xca, 0xb5,
+	0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x11, 0x73,
+	0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x12, 0x78, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64,
+	0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x12, 0x34, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x66, 0x2e, 0x55, 0x73, 0x65, 0x64,
+	0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x53, 0x6c,
+	0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x39, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x71, 0x66, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x12, 0x2b, 0x0a, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x2d, 0x22, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x4c,
+	0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x02,
+	0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x41, 0x43, 0x48, 0x45, 0x52, 0x10, 0x03, 0x12, 0x0d, 0x0a,
+	0x09, 0x41, 0x53, 0x53, 0x49, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x10, 0x04, 0x22, 0x40, 0x0a, 0x0c,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05,
+	0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x49, 0x44, 0x44, 0x45,
+	0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x02,
+	0x12, 0x0c, 0x0a, 0x08, 0x46, 0x41, 0x56, 0x4f, 0x52, 0x49, 0x54, 0x45, 0x10, 0x03, 0x22, 0x82,
+	0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x64, 0x53, 0x6c, 0x69, 0x70, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x64, 0x44,
+	0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x75, 0x73, 0x65, 0x64, 0x44,
+	0x61, 0x79, 0x73, 0x22, 0xb3, 0x02, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x28, 0xca, 0xb5, 0x03,
+	0x24, 0xa2, 0x01, 0x21, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x22, 0x52, 0x0c, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x46, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xca, 0xb5, 0x03, 0x24, 0xa2, 0x01, 0x21, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x3a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x66, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30,
+	0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22,
+	0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x3f, 0x0a, 0x0b, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x0b, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x26, 0xca, 0xb5,
+	0x03, 0x22, 0xa2, 0x01, 0x1f, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x44,
+	0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x26, 0xca, 0xb5, 0x03, 0x22, 0xa2, 0x01, 0x1f, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x22, 0xa7, 0x01, 0x0a, 0x0e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x12, 0x31, 0x0a, 0x0b, 0x6e, 0x6f, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x71, 0x66, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x6e, 0x6f, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x70, 0x12, 0x2c, 0x0a, 0x09,
+	0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x22, 0xf5, 0x05, 0x0a, 0x0a, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x68, 0x0a, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72,
+	0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a,
+	0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x69, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x61, 0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x42, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x71,
+	0x75, 0x69, 0x7a, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x51,
+	0x75, 0x69, 0x7a, 0x52, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x12, 0x48, 0x0a, 0x0f, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x71, 0x66, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x22, 0x3d, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a,
+	0x03, 0x4d, 0x41, 0x58, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c,
+	0x10, 0x03, 0x22, 0xc5, 0x01, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x28, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x71, 0x66, 0x2e, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x7b, 0x0a, 0x05, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12,
+	0x26, 0x0a, 0x0e, 0x53, 0x63, 0x6d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x53, 0x63, 0x6d, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xd6, 0x02, 0x0a, 0x0b, 0x50, 0x75, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x63, 0x6d, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x53,
+	0x63, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x53, 0x63, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x71, 0x66, 0x2e,
+	0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x22, 0x36, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44,
+	0x52, 0x41, 0x46, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57,
+	0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03,
+	0x22, 0x3f, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xc2, 0x04, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x06, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x70, 0x0a, 0x0c, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca,
+	0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52,
+	0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a,
+	0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x52, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x09, 0x70, 0x73, 0x65,
+	0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0f, 0xca, 0xb5,
+	0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x09, 0x70,
+	0x73, 0x65, 0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x22, 0x3c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x56, 0x49,
+	0x53, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x22, 0x3f, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x12, 0x43, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xca, 0xb5, 0x03, 0x1b, 0xa2, 0x01, 0x18,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x3a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x37, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xca, 0xb5, 0x03, 0x1b, 0xa2, 0x01, 0x18, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x3a, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x2d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8b,
+	0x04, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x4b, 0x0a, 0x0c, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x27, 0xca, 0xb5, 0x03, 0x23, 0xa2, 0x01, 0x20, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6a, 0x6f, 0x62, 0x22, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x52, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x71, 0x66, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x27, 0xca,
+	0xb5, 0x03, 0x23, 0xa2, 0x01, 0x20, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x5f, 0x6a, 0x6f, 0x62, 0x22, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x62, 0x0a, 0x05,
+	0x72, 0x75, 0x6e, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72,
+	0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a,
+	0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x41, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x63, 0x65, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x63, 0x65, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x6a, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x1e, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x41, 0x44, 0x45, 0x10, 0x01, 0x22, 0x35, 0x0a, 0x0d,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x24, 0x0a,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x66,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a,
+	0x6f, 0x62, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22,
+	0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x66, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2,
+	0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70,
+	0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x22, 0x38, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xa2,
+	0x02, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x42, 0x24, 0xca, 0xb5, 0x03,
+	0x20, 0xa2, 0x01, 0x1d, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x4b, 0x65, 0x79, 0x3a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44,
+	0x22, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x42, 0x0a, 0x0a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x12, 0x34, 0x0a, 0x0a, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x0a, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0xc5, 0x02, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x22,
+	0x9b, 0x03, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x65, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x42, 0x21, 0xca, 0xb5, 0x03, 0x1d, 0xa2, 0x01, 0x1a,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79,
+	0x3a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x52, 0x11, 0x67, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x64, 0x0a,
+	0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2,
+	0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70,
+	0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x06, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x22, 0x83, 0x03,
+	0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x64, 0x0a, 0x06, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61,
+	0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x53, 0x63, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x53, 0x63, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x22, 0x3b, 0x0a, 0x0c, 0x4c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x71, 0x66, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0xca, 0x01, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0xa2, 0x01,
+	0x16, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x72, 0x3a, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x2e, 0x0a,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x71,
+	0x66, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe0, 0x01,
+	0x0a, 0x14, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x55, 0x0a, 0x11, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x27, 0xca, 0xb5, 0x03, 0x23, 0xa2, 0x01, 0x20, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x73, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x11, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x49, 0x44, 0x12, 0x4b, 0x0a,
+	0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x27, 0xca, 0xb5, 0x03, 0x23, 0xa2, 0x01, 0x20, 0x67, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x73, 0x6e,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0c, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x43, 0x0a, 0x10, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x66, 0x2e, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x73, 0x22, 0xfe, 0x04, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x66, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61,
+	0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x66, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca,
+	0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x3f, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52,
+	0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x03, 0x22, 0x2b, 0x0a, 0x06, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x45, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45,
+	0x56, 0x49, 0x45, 0x57, 0x10, 0x02, 0x22, 0x41, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x66,
+	0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x06, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x22,
+	0xb5, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x37, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x71, 0x66, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x44, 0x69,
+	0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x05, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x22, 0x80, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x4f, 0x0a, 0x0c,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x2b, 0xca, 0xb5, 0x03, 0x27, 0xa2, 0x01, 0x24, 0x67, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52,
+	0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x4b, 0x0a,
+	0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2b, 0xca, 0xb5, 0x03, 0x27, 0xa2, 0x01, 0x24, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4b, 0x0a, 0x11, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x36, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x27, 0xca, 0xb5, 0x03, 0x23,
+	0xa2, 0x01, 0x20, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x6c, 0x6f,
+	0x61, 0x64, 0x22, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x41, 0x0a,
+	0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x27,
+	0xca, 0xb5, 0x03, 0x23, 0xa2, 0x01, 0x20, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
+	0x12, 0x3f, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x27, 0xca, 0xb5, 0x03, 0x23, 0xa2, 0x01, 0x20, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x37, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x66, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x22, 0xed, 0x02, 0x0a, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x49, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0xca, 0xb5, 0x03, 0x21, 0xa2, 0x01, 0x1e, 0x67, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x52, 0x0c, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x45, 0x0a, 0x0a, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25,
+	0xca, 0xb5, 0x03, 0x21, 0xa2, 0x01, 0x1e, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x22, 0x52, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6f, 0x70, 0x65,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x06, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x71, 0x66,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08,
+	0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x22, 0xe9, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x44,
+	0x12, 0x4f, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2b, 0xca, 0xb5, 0x03, 0x27, 0xa2, 0x01, 0x24, 0x67,
+	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x3a, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x22, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x43, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2b, 0xca, 0xb5, 0x03, 0x27, 0xa2, 0x01, 0x24, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xaf, 0x01, 0x0a,
+	0x0b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x30, 0x0a, 0x0b,
+	0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x33,
+	0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x71, 0x66, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe1,
+	0x01, 0x0a, 0x04, 0x51, 0x75, 0x69, 0x7a, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x49, 0x44, 0x12, 0x3d, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19, 0xca, 0xb5, 0x03, 0x15, 0xa2,
+	0x01, 0x12, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x52, 0x0c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x66, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x0c, 0x51, 0x75, 0x69, 0x7a, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x51, 0x75, 0x69, 0x7a, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x51, 0x75, 0x69, 0x7a, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0xa2, 0x01,
+	0x16, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x72, 0x3a, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x37, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x1d, 0xca, 0xb5, 0x03, 0x19, 0xa2, 0x01, 0x16, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x6a, 0x73, 0x6f, 0x6e, 0x22,
+	0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xa2, 0x04, 0x0a, 0x0b, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x51, 0x75, 0x69, 0x7a, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x51, 0x75, 0x69, 0x7a, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x66, 0x0a, 0x07, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01,
+	0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65,
+	0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x68, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x30, 0xca, 0xb5, 0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x6a, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x30, 0xca, 0xb5,
+	0x03, 0x2c, 0xa2, 0x01, 0x29, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3b,
+	0x74, 0x79, 0x70, 0x65, 0x3a, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x09,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x07, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x71, 0x66, 0x2e,
+	0x51, 0x75, 0x69, 0x7a, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x42, 0x22, 0xca, 0xb5, 0x03, 0x1e,
+	0xa2, 0x01, 0x1b, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x4b, 0x65, 0x79, 0x3a, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x44, 0x22, 0x52, 0x07,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x71, 0x66, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x42,
+	0x0f, 0xca, 0xb5, 0x03, 0x0b, 0xa2, 0x01, 0x08, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x2d, 0x22,
+	0x52, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x22, 0x8d, 0x01, 0x0a, 0x0a, 0x51, 0x75, 0x69, 0x7a, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xca,
+	0xb5, 0x03, 0x19, 0xa2, 0x01, 0x16, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x3a, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x08, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x26, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x71,
+	0x75, 0x69, 0x63, 0x6b, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x71, 0x66, 0xba, 0x02, 0x00, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_qf_types_proto_rawDescOnce sync.Once
+	file_qf_types_proto_rawDescData = file_qf_types_proto_rawDesc
+)
+
+func file_qf_types_proto_rawDescGZIP() []byte {
+	file_qf_types_proto_rawDescOnce.Do(func() {
+		file_qf_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_qf_types_proto_rawDescData)
+	})
+	return file_qf_types_proto_rawDescData
+}
+
+var file_qf_types_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_qf_types_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
+var file_qf_types_proto_goTypes = []interface{}{
+	(Group_GroupStatus)(0),          // 0: qf.Group.GroupStatus
+	(GroupInvitation_Status)(0),     // 1: qf.GroupInvitation.Status
+	(RosterMismatch_Kind)(0),        // 2: qf.RosterMismatch.Kind
+	(Repository_Type)(0),            // 3: qf.Repository.Type
+	(Enrollment_UserStatus)(0),      // 4: qf.Enrollment.UserStatus
+	(Enrollment_DisplayState)(0),    // 5: qf.Enrollment.DisplayState
+	(Assignment_ReconcilePolicy)(0), // 6: qf.Assignment.ReconcilePolicy
+	(PullRequest_Stage)(0),          // 7: qf.PullRequest.Stage
+	(Submission_Status)(0),          // 8: qf.Submission.Status
+	(ScheduledJob_Type)(0),          // 9: qf.ScheduledJob.Type
+	(GradingCriterion_Grade)(0),     // 10: qf.GradingCriterion.Grade
+	(RegradeRequest_Status)(0),      // 11: qf.RegradeRequest.Status
+	(RegradeRequest_Action)(0),      // 12: qf.RegradeRequest.Action
+	(*User)(nil),                    // 13: qf.User
+	(*Users)(nil),                   // 14: qf.Users
+	(*Group)(nil),                   // 15: qf.Group
+	(*Groups)(nil),                  // 16: qf.Groups
+	(*GroupInvitation)(nil),         // 17: qf.GroupInvitation
+	(*GroupInvitations)(nil),        // 18: qf.GroupInvitations
+	(*GroupMembership)(nil),         // 19: qf.GroupMembership
+	(*GroupMemberships)(nil),        // 20: qf.GroupMemberships
+	(*GroupPreference)(nil),         // 21: qf.GroupPreference
+	(*GroupContributions)(nil),      // 22: qf.GroupContributions
+	(*MemberContribution)(nil),      // 23: qf.MemberContribution
+	(*AssignmentContribution)(nil),  // 24: qf.AssignmentContribution
+	(*ContributionActivity)(nil),    // 25: qf.ContributionActivity
+	(*Course)(nil),                  // 26: qf.Course
+	(*EnrollmentPolicy)(nil),        // 27: qf.EnrollmentPolicy
+	(*Courses)(nil),                 // 28: qf.Courses
+	(*GradingConfig)(nil),           // 29: qf.GradingConfig
+	(*AssignmentWeight)(nil),        // 30: qf.AssignmentWeight
+	(*ExternalComponent)(nil),       // 31: qf.ExternalComponent
+	(*FinalGrade)(nil),              // 32: qf.FinalGrade
+	(*GradePart)(nil),               // 33: qf.GradePart
+	(*FinalGrades)(nil),             // 34: qf.FinalGrades
+	(*GradeExport)(nil),             // 35: qf.GradeExport
+	(*ExternalGradeImport)(nil),     // 36: qf.ExternalGradeImport
+	(*RosterMismatch)(nil),          // 37: qf.RosterMismatch
+	(*Repository)(nil),              // 38: qf.Repository
+	(*Enrollment)(nil),              // 39: qf.Enrollment
+	(*UsedSlipDays)(nil),            // 40: qf.UsedSlipDays
+	(*ExternalGrade)(nil),           // 41: qf.ExternalGrade
+	(*Enrollments)(nil),             // 42: qf.Enrollments
+	(*RosterEntry)(nil),             // 43: qf.RosterEntry
+	(*RosterCoverage)(nil),          // 44: qf.RosterCoverage
+	(*Assignment)(nil),              // 45: qf.Assignment
+	(*Task)(nil),                    // 46: qf.Task
+	(*Issue)(nil),                   // 47: qf.Issue
+	(*PullRequest)(nil),             // 48: qf.PullRequest
+	(*Assignments)(nil),             // 49: qf.Assignments
+	(*Submission)(nil),              // 50: qf.Submission
+	(*Submissions)(nil),             // 51: qf.Submissions
+	(*Grade)(nil),                   // 52: qf.Grade
+	(*ScheduledJob)(nil),            // 53: qf.ScheduledJob
+	(*ScheduledJobs)(nil),           // 54: qf.ScheduledJobs
+	(*AuditEntry)(nil),              // 55: qf.AuditEntry
+	(*AuditEntries)(nil),            // 56: qf.AuditEntries
+	(*GradingBenchmark)(nil),        // 57: qf.GradingBenchmark
+	(*Benchmarks)(nil),              // 58: qf.Benchmarks
+	(*GradingCriterion)(nil),        // 59: qf.GradingCriterion
+	(*Review)(nil),                  // 60: qf.Review
+	(*LineComment)(nil),             // 61: qf.LineComment
+	(*LineComments)(nil),            // 62: qf.LineComments
+	(*FeedbackSnippet)(nil),         // 63: qf.FeedbackSnippet
+	(*FeedbackSnippetUsage)(nil),    // 64: qf.FeedbackSnippetUsage
+	(*FeedbackSnippets)(nil),        // 65: qf.FeedbackSnippets
+	(*RegradeRequest)(nil),          // 66: qf.RegradeRequest
+	(*RegradeRequests)(nil),         // 67: qf.RegradeRequests
+	(*CriterionDisagreement)(nil),   // 68: qf.CriterionDisagreement
+	(*Reconciliation)(nil),          // 69: qf.Reconciliation
+	(*ReviewAllocation)(nil),        // 70: qf.ReviewAllocation
+	(*ReviewAllocations)(nil),       // 71: qf.ReviewAllocations
+	(*ReviewerLoad)(nil),            // 72: qf.ReviewerLoad
+	(*ReviewerLoads)(nil),           // 73: qf.ReviewerLoads
+	(*PeerReview)(nil),              // 74: qf.PeerReview
+	(*PeerReviewScore)(nil),         // 75: qf.PeerReviewScore
+	(*PeerReviews)(nil),             // 76: qf.PeerReviews
+	(*Quiz)(nil),                    // 77: qf.Quiz
+	(*QuizQuestion)(nil),            // 78: qf.QuizQuestion
+	(*QuizAttempt)(nil),             // 79: qf.QuizAttempt
+	(*QuizAnswer)(nil),              // 80: qf.QuizAnswer
+	nil,                             // 81: qf.PeerReviews.ScoresEntry
+	(*timestamppb.Timestamp)(nil),   // 82: google.protobuf.Timestamp
+	(*score.BuildInfo)(nil),         // 83: score.BuildInfo
+	(*score.Score)(nil),             // 84: score.Score
+}
+var file_qf_types_proto_depIdxs = []int32{
+	39,  // 0: qf.User.Enrollments:type_name -> qf.Enrollment
+	13,  // 1: qf.Users.users:type_name -> qf.User
+	0,   // 2: qf.Group.status:type_name -> qf.Group.GroupStatus
+	13,  // 3: qf.Group.users:type_name -> qf.User
+	39,  // 4: qf.Group.enrollments:type_name -> qf.Enrollment
+	15,  // 5: qf.Groups.groups:type_name -> qf.Group
+	1,   // 6: qf.GroupInvitation.status:type_name -> qf.GroupInvitation.Status
+	82,  // 7: qf.GroupInvitation.expires:type_name -> google.protobuf.Timestamp
+	15,  // 8: qf.GroupInvitation.group:type_name -> qf.Group
+	13,  // 9: qf.GroupInvitation.user:type_name -> qf.User
+	13,  // 10: qf.GroupInvitation.inviter:type_name -> qf.User
+	17,  // 11: qf.GroupInvitations.invitations:type_name -> qf.GroupInvitation
+	82,  // 12: qf.GroupMembership.joined:type_name -> google.protobuf.Timestamp
+	82,  // 13: qf.GroupMembership.left:type_name -> google.protobuf.Timestamp
+	13,  // 14: qf.GroupMembership.user:type_name -> qf.User
+	19,  // 15: qf.GroupMemberships.memberships:type_name -> qf.GroupMembership
+	23,  // 16: qf.GroupContributions.members:type_name -> qf.MemberContribution
+	13,  // 17: qf.MemberContribution.user:type_name -> qf.User
+	24,  // 18: qf.MemberContribution.assignments:type_name -> qf.AssignmentContribution
+	25,  // 19: qf.MemberContribution.activity:type_name -> qf.ContributionActivity
+	82,  // 20: qf.ContributionActivity.date:type_name -> google.protobuf.Timestamp
+	4,   // 21: qf.Course.enrolled:type_name -> qf.Enrollment.UserStatus
+	39,  // 22: qf.Course.enrollments:type_name -> qf.Enrollment
+	45,  // 23: qf.Course.assignments:type_name -> qf.Assignment
+	15,  // 24: qf.Course.groups:type_name -> qf.Group
+	27,  // 25: qf.Course.enrollmentPolicy:type_name -> qf.EnrollmentPolicy
+	82,  // 26: qf.EnrollmentPolicy.opens:type_name -> google.protobuf.Timestamp
+	82,  // 27: qf.EnrollmentPolicy.closes:type_name -> google.protobuf.Timestamp
+	26,  // 28: qf.Courses.courses:type_name -> qf.Course
+	30,  // 29: qf.GradingConfig.weights:type_name -> qf.AssignmentWeight
+	31,  // 30: qf.GradingConfig.components:type_name -> qf.ExternalComponent
+	82,  // 31: qf.GradingConfig.updated:type_name -> google.protobuf.Timestamp
+	33,  // 32: qf.FinalGrade.parts:type_name -> qf.GradePart
+	29,  // 33: qf.FinalGrades.config:type_name -> qf.GradingConfig
+	32,  // 34: qf.FinalGrades.grades:type_name -> qf.FinalGrade
+	37,  // 35: qf.GradeExport.mismatches:type_name -> qf.RosterMismatch
+	41,  // 36: qf.ExternalGradeImport.grades:type_name -> qf.ExternalGrade
+	37,  // 37: qf.ExternalGradeImport.mismatches:type_name -> qf.RosterMismatch
+	2,   // 38: qf.RosterMismatch.kind:type_name -> qf.RosterMismatch.Kind
+	3,   // 39: qf.Repository.repoType:type_name -> qf.Repository.Type
+	47,  // 40: qf.Repository.issues:type_name -> qf.Issue
+	13,  // 41: qf.Enrollment.user:type_name -> qf.User
+	26,  // 42: qf.Enrollment.course:type_name -> qf.Course
+	15,  // 43: qf.Enrollment.group:type_name -> qf.Group
+	4,   // 44: qf.Enrollment.status:type_name -> qf.Enrollment.UserStatus
+	5,   // 45: qf.Enrollment.state:type_name -> qf.Enrollment.DisplayState
+	82,  // 46: qf.Enrollment.lastActivityDate:type_name -> google.protobuf.Timestamp
+	40,  // 47: qf.Enrollment.usedSlipDays:type_name -> qf.UsedSlipDays
+	41,  // 48: qf.Enrollment.externalGrades:type_name -> qf.ExternalGrade
+	82,  // 49: qf.ExternalGrade.updated:type_name -> google.protobuf.Timestamp
+	39,  // 50: qf.Enrollments.enrollments:type_name -> qf.Enrollment
+	43,  // 51: qf.RosterCoverage.notSignedUp:type_name -> qf.RosterEntry
+	39,  // 52: qf.RosterCoverage.unmatched:type_name -> qf.Enrollment
+	82,  // 53: qf.Assignment.deadline:type_name -> google.protobuf.Timestamp
+	50,  // 54: qf.Assignment.submissions:type_name -> qf.Submission
+	46,  // 55: qf.Assignment.tasks:type_name -> qf.Task
+	57,  // 56: qf.Assignment.gradingBenchmarks:type_name -> qf.GradingBenchmark
+	77,  // 57: qf.Assignment.quiz:type_name -> qf.Quiz
+	6,   // 58: qf.Assignment.reconcilePolicy:type_name -> qf.Assignment.ReconcilePolicy
+	47,  // 59: qf.Task.issues:type_name -> qf.Issue
+	7,   // 60: qf.PullRequest.stage:type_name -> qf.PullRequest.Stage
+	45,  // 61: qf.Assignments.assignments:type_name -> qf.Assignment
+	52,  // 62: qf.Submission.Grades:type_name -> qf.Grade
+	82,  // 63: qf.Submission.approvedDate:type_name -> google.protobuf.Timestamp
+	60,  // 64: qf.Submission.reviews:type_name -> qf.Review
+	83,  // 65: qf.Submission.BuildInfo:type_name -> score.BuildInfo
+	84,  // 66: qf.Submission.Scores:type_name -> score.Score
+	50,  // 67: qf.Submissions.submissions:type_name -> qf.Submission
+	8,   // 68: qf.Grade.Status:type_name -> qf.Submission.Status
+	9,   // 69: qf.ScheduledJob.type:type_name -> qf.ScheduledJob.Type
+	82,  // 70: qf.ScheduledJob.runAt:type_name -> google.protobuf.Timestamp
+	82,  // 71: qf.ScheduledJob.completed:type_name -> google.protobuf.Timestamp
+	53,  // 72: qf.ScheduledJobs.jobs:type_name -> qf.ScheduledJob
+	82,  // 73: qf.AuditEntry.created:type_name -> google.protobuf.Timestamp
+	55,  // 74: qf.AuditEntries.entries:type_name -> qf.AuditEntry
+	59,  // 75: qf.GradingBenchmark.criteria:type_name -> qf.GradingCriterion
+	57,  // 76: qf.Benchmarks.benchmarks:type_name -> qf.GradingBenchmark
+	10,  // 77: qf.GradingCriterion.grade:type_name -> qf.GradingCriterion.Grade
+	57,  // 78: qf.Review.gradingBenchmarks:type_name -> qf.GradingBenchmark
+	82,  // 79: qf.Review.edited:type_name -> google.protobuf.Timestamp
+	82,  // 80: qf.LineComment.edited:type_name -> google.protobuf.Timestamp
+	61,  // 81: qf.LineComments.comments:type_name -> qf.LineComment
+	64,  // 82: qf.FeedbackSnippet.usage:type_name -> qf.FeedbackSnippetUsage
+	63,  // 83: qf.FeedbackSnippets.snippets:type_name -> qf.FeedbackSnippet
+	11,  // 84: qf.RegradeRequest.status:type_name -> qf.RegradeRequest.Status
+	12,  // 85: qf.RegradeRequest.action:type_name -> qf.RegradeRequest.Action
+	82,  // 86: qf.RegradeRequest.created:type_name -> google.protobuf.Timestamp
+	82,  // 87: qf.RegradeRequest.updated:type_name -> google.protobuf.Timestamp
+	66,  // 88: qf.RegradeRequests.requests:type_name -> qf.RegradeRequest
+	60,  // 89: qf.Reconciliation.reviews:type_name -> qf.Review
+	68,  // 90: qf.Reconciliation.conflicts:type_name -> qf.CriterionDisagreement
+	60,  // 91: qf.Reconciliation.final:type_name -> qf.Review
+	70,  // 92: qf.ReviewAllocations.allocations:type_name -> qf.ReviewAllocation
+	72,  // 93: qf.ReviewerLoads.loads:type_name -> qf.ReviewerLoad
+	60,  // 94: qf.PeerReview.review:type_name -> qf.Review
+	74,  // 95: qf.PeerReviews.peerReviews:type_name -> qf.PeerReview
+	81,  // 96: qf.PeerReviews.scores:type_name -> qf.PeerReviews.ScoresEntry
+	78,  // 97: qf.Quiz.questions:type_name -> qf.QuizQuestion
+	82,  // 98: qf.QuizAttempt.started:type_name -> google.protobuf.Timestamp
+	82,  // 99: qf.QuizAttempt.deadline:type_name -> google.protobuf.Timestamp
+	82,  // 100: qf.QuizAttempt.submitted:type_name -> google.protobuf.Timestamp
+	80,  // 101: qf.QuizAttempt.answers:type_name -> qf.QuizAnswer
+	77,  // 102: qf.QuizAttempt.quiz:type_name -> qf.Quiz
+	103, // [103:103] is the sub-list for method output_type
+	103, // [103:103] is the sub-list for method input_type
+	103, // [103:103] is the sub-list for extension type_name
+	103, // [103:103] is the sub-list for extension extendee
+	0,   // [0:103] is the sub-list for field type_name
+}
+
+func init() { file_qf_types_proto_init() }
+func file_qf_types_proto_init() {
+	if File_qf_types_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_qf_types_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Users); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Groups); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupInvitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupInvitations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMembership); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMemberships); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupPreference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupContributions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberContribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentContribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContributionActivity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Course); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollmentPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Courses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradingConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignmentWeight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalComponent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalGrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradePart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalGrades); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalGradeImport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RosterMismatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Repository); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enrollment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2805,8 +8005,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Users); i {
+		file_qf_types_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsedSlipDays); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2817,8 +8017,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Group); i {
+		file_qf_types_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalGrade); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2829,8 +8029,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Groups); i {
+		file_qf_types_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enrollments); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2841,8 +8041,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Course); i {
+		file_qf_types_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RosterEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2853,8 +8053,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Courses); i {
+		file_qf_types_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RosterCoverage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2865,8 +8065,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Repository); i {
+		file_qf_types_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2877,8 +8077,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Enrollment); i {
+		file_qf_types_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Task); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2889,8 +8089,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsedSlipDays); i {
+		file_qf_types_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Issue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2901,8 +8101,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Enrollments); i {
+		file_qf_types_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2913,8 +8113,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignment); i {
+		file_qf_types_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignments); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2925,8 +8125,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Task); i {
+		file_qf_types_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2937,8 +8137,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Issue); i {
+		file_qf_types_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Submissions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2949,8 +8149,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullRequest); i {
+		file_qf_types_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Grade); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2961,8 +8161,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assignments); i {
+		file_qf_types_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2973,8 +8173,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submission); i {
+		file_qf_types_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledJobs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2985,8 +8185,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Submissions); i {
+		file_qf_types_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2997,8 +8197,8 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Grade); i {
+		file_qf_types_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3009,7 +8209,7 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_qf_types_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GradingBenchmark); i {
 			case 0:
 				return &v.state
@@ -3021,7 +8221,7 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_qf_types_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Benchmarks); i {
 			case 0:
 				return &v.state
@@ -3033,7 +8233,7 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_qf_types_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GradingCriterion); i {
 			case 0:
 				return &v.state
@@ -3045,7 +8245,7 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
-		file_qf_types_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_qf_types_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Review); i {
 			case 0:
 				return &v.state
@@ -3057,14 +8257,255 @@ func file_qf_types_proto_init() {
 				return nil
 			}
 		}
+		file_qf_types_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LineComment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LineComments); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedbackSnippet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedbackSnippetUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedbackSnippets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegradeRequests); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriterionDisagreement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reconciliation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewAllocations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewerLoad); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewerLoads); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerReview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerReviewScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerReviews); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quiz); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuizQuestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuizAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_qf_types_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuizAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_qf_types_proto_msgTypes[13].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_qf_types_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   22,
+			NumEnums:      13,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,5 +1,10 @@
 package qf
 
+import (
+	"slices"
+	"strings"
+)
+
 // IsValid on void message always returns true.
 func (*Void) IsValid() bool {
 	return true
@@ -16,7 +21,23 @@ func (c *Course) IsValid() bool {
 		c.GetCode() != "" &&
 		c.GetScmOrganizationID() != 0 &&
 		c.GetYear() != 0 &&
-		c.GetTag() != ""
+		c.GetTag() != "" &&
+		(c.GetMaxGroupSize() == 0 || c.GetMinGroupSize() <= c.GetMaxGroupSize()) &&
+		(c.GetEnrollmentPolicy() == nil || c.GetEnrollmentPolicy().IsValid())
+}
+
+// IsValid ensures that an enrollment policy closes after it opens, and that its email domains are domain names.
+func (p *EnrollmentPolicy) IsValid() bool {
+	if p.GetOpens() != nil && p.GetCloses() != nil && !p.GetCloses().AsTime().After(p.GetOpens().AsTime()) {
+		return false
+	}
+	for _, domain := range p.GetEmailDomains() {
+		domain = strings.TrimPrefix(domain, "@")
+		if domain == "" || strings.ContainsAny(domain, "@ ") {
+			return false
+		}
+	}
+	return true
 }
 
 // IsValid checks required fields of a user request
@@ -26,7 +47,7 @@ func (u *User) IsValid() bool {
 
 // IsValid checks required fields of an enrollment request.
 func (req *Enrollment) IsValid() bool {
-	return req.GetStatus() <= Enrollment_TEACHER &&
+	return req.GetStatus() <= Enrollment_ASSISTANT &&
 		req.GetUserID() > 0 && req.GetCourseID() > 0
 }
 
@@ -118,9 +139,10 @@ func (bm *GradingBenchmark) IsValid() bool {
 }
 
 // IsValid ensures that a criterion always belongs to a grading benchmark
-// and is not empty.
+// and is not empty, and that a partial credit criterion is not awarded more than its points.
 func (c *GradingCriterion) IsValid() bool {
-	return c.BenchmarkID > 0 && c.Description != ""
+	return c.BenchmarkID > 0 && c.Description != "" &&
+		(!c.Partial || c.Points > 0) && c.Awarded <= c.Points
 }
 
 func (m *Enrollments) IsValid() bool {
@@ -134,3 +156,155 @@ func (m *Enrollments) IsValid() bool {
 	}
 	return m.HasCourseID()
 }
+
+// IsValid ensures that both course and submission IDs are set.
+func (req *ReconcileRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetSubmissionID() > 0
+}
+
+// IsValid ensures that both course and assignment IDs are set.
+func (req *ReviewAllocationRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that both course and assignment IDs are set.
+func (req *PeerReviewRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that both course and peer review IDs are set, and that the quality is at most 100.
+func (r *PeerReview) IsValid() bool {
+	return r.GetCourseID() > 0 && r.GetID() > 0 && r.GetQuality() <= 100
+}
+
+// IsValid ensures that both course and submission IDs are set.
+func (req *LineCommentRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetSubmissionID() > 0
+}
+
+// IsValid ensures that a line comment belongs to a submission, refers to a file
+// and a valid line range, and is not empty.
+func (c *LineComment) IsValid() bool {
+	return c.GetCourseID() > 0 && c.GetSubmissionID() > 0 && c.GetPath() != "" &&
+		c.GetStartLine() > 0 && c.GetEndLine() >= c.GetStartLine() && c.GetBody() != ""
+}
+
+// IsValid ensures that course ID is set.
+func (req *FeedbackSnippetRequest) IsValid() bool {
+	return req.GetCourseID() > 0
+}
+
+// IsValid ensures that a feedback snippet belongs to a course and is not empty.
+func (s *FeedbackSnippet) IsValid() bool {
+	return s.GetCourseID() > 0 && s.GetBody() != ""
+}
+
+// IsValid ensures that course, snippet and assignment IDs are set.
+func (req *FeedbackSnippetUsageRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetSnippetID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that a grading configuration belongs to a course, has a valid letter
+// grading scheme, and that its external components have distinct names.
+func (c *GradingConfig) IsValid() bool {
+	if c.GetCourseID() == 0 || !c.IsValidScheme() {
+		return false
+	}
+	names := make(map[string]bool)
+	for _, component := range c.GetComponents() {
+		if component.GetName() == "" || names[component.GetName()] {
+			return false
+		}
+		names[component.GetName()] = true
+	}
+	return true
+}
+
+// IsValid ensures that course ID is set and that the export has at most one column per header.
+func (req *GradeExportRequest) IsValid() bool {
+	if req.GetCourseID() == 0 {
+		return false
+	}
+	headers := make(map[string]bool)
+	for _, column := range req.GetColumns() {
+		if column.GetHeader() == "" || headers[column.GetHeader()] {
+			return false
+		}
+		headers[column.GetHeader()] = true
+	}
+	return true
+}
+
+// IsValid ensures that course ID is set and that the request has a CSV file.
+func (req *ExternalGradeImportRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && len(req.GetData()) > 0
+}
+
+// IsValid ensures that course ID is set and that the request has a CSV file.
+func (req *RosterImportRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && len(req.GetData()) > 0
+}
+
+// IsValid ensures that the request has a course ID and a known strategy.
+func (req *GroupFormationRequest) IsValid() bool {
+	_, ok := GroupFormationRequest_Strategy_name[int32(req.GetStrategy())]
+	return req.GetCourseID() > 0 && ok
+}
+
+// IsValid ensures that the request has groups, and that all groups are valid and belong to the request's course.
+func (req *CreateGroupsRequest) IsValid() bool {
+	if req.GetCourseID() == 0 || len(req.GetGroups()) == 0 {
+		return false
+	}
+	for _, group := range req.GetGroups() {
+		if !group.IsValid() || group.GetCourseID() != req.GetCourseID() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValid ensures that the request has course and group IDs, and a threshold of at most 100 percent.
+func (req *ContributionRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetGroupID() > 0 && req.GetThreshold() <= 100
+}
+
+// IsValid ensures that a response to a group invitation identifies the invitation and accepts or declines it.
+func (i *GroupInvitation) IsValid() bool {
+	return i.GetID() > 0 && i.GetCourseID() > 0 && i.GetUserID() > 0 &&
+		(i.GetStatus() == GroupInvitation_ACCEPTED || i.GetStatus() == GroupInvitation_DECLINED)
+}
+
+// IsValid ensures that the preference has course and user IDs, and that the user does not prefer themselves.
+func (p *GroupPreference) IsValid() bool {
+	return p.GetCourseID() > 0 && p.GetUserID() > 0 && !slices.Contains(p.GetPartnerIDs(), p.GetUserID())
+}
+
+// IsValid ensures that a scheduled job belongs to an assignment in a course,
+// that a release job has a time to run, and that the score limit is at most 100.
+func (j *ScheduledJob) IsValid() bool {
+	if j.GetCourseID() == 0 || j.GetAssignmentID() == 0 || j.GetScoreLimit() > 100 {
+		return false
+	}
+	return j.GetType() != ScheduledJob_RELEASE || j.GetRunAt() != nil
+}
+
+// IsValid ensures that course ID is set.
+func (req *RegradeRequestQuery) IsValid() bool {
+	return req.GetCourseID() > 0
+}
+
+// IsValid ensures that a regrade request belongs to a submission in a course.
+func (r *RegradeRequest) IsValid() bool {
+	return r.GetCourseID() > 0 && r.GetSubmissionID() > 0
+}
+
+// IsValid ensures that both course and assignment IDs are set.
+func (req *QuizRequest) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAssignmentID() > 0
+}
+
+// IsValid ensures that both course and attempt IDs are set.
+func (req *QuizSubmission) IsValid() bool {
+	return req.GetCourseID() > 0 && req.GetAttemptID() > 0
+}
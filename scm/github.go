@@ -217,8 +217,30 @@ func (s *GithubSCM) UpdateEnrollment(ctx context.Context, opt *UpdateEnrollmentO
 		}
 		// Teacher's private (student) repo should already exist
 		return nil, nil
+
+	case qf.Enrollment_ASSISTANT:
+		m = M("failed to enroll %s as teaching assistant in %s", opt.User, org.ScmOrganizationName)
+		// Demote user to organization member, in case the user was a teacher
+		if err := s.updatePermission(ctx, opt.User, org.ScmOrganizationName, member); err != nil {
+			return nil, E(op, m, err)
+		}
+		repos, err := s.GetRepositories(ctx, org.ScmOrganizationName)
+		if err != nil {
+			return nil, E(op, m, err)
+		}
+		for _, repo := range repos {
+			if repo.Repo == qf.StudentRepoName(opt.User) {
+				// keep the assistant's push access to their own (student) repo
+				continue
+			}
+			if err := s.addUser(ctx, org.ScmOrganizationName, repo.Repo, opt.User, pullAccess); err != nil {
+				return nil, E(op, m, err)
+			}
+		}
+		// Assistant's private (student) repo should already exist
+		return nil, nil
 	}
-	// Only student and teacher enrollments are allowed (NONE and PENDING are not relevant here)
+	// Only student, teacher and assistant enrollments are allowed (NONE and PENDING are not relevant here)
 	return nil, E(op, m, fmt.Errorf("invalid enrollment status: %s", opt.Status))
 }
 
@@ -331,6 +353,32 @@ func (s *GithubSCM) DeleteGroup(ctx context.Context, opt *RepositoryOptions) err
 	return nil
 }
 
+// GrantReadAccess adds the user as a collaborator with read access to the repository.
+func (s *GithubSCM) GrantReadAccess(ctx context.Context, opt *CollaboratorOptions) error {
+	const op Op = "GrantReadAccess"
+	m := M("failed to grant read access to %s", opt.Repository)
+	if !opt.valid() {
+		return E(op, m, fmt.Errorf("missing fields: %+v", *opt))
+	}
+	if err := s.addUser(ctx, opt.Organization, opt.Repository, opt.User, pullAccess); err != nil {
+		return E(op, m, err)
+	}
+	return nil
+}
+
+// RevokeAccess removes the user as a collaborator on the repository.
+func (s *GithubSCM) RevokeAccess(ctx context.Context, opt *CollaboratorOptions) error {
+	const op Op = "RevokeAccess"
+	m := M("failed to revoke access to %s", opt.Repository)
+	if !opt.valid() {
+		return E(op, m, fmt.Errorf("missing fields: %+v", *opt))
+	}
+	if _, err := s.client.Repositories.RemoveCollaborator(ctx, opt.Organization, opt.Repository, opt.User); err != nil {
+		return E(op, m, fmt.Errorf("failed to remove %s: %w", opt.User, err))
+	}
+	return nil
+}
+
 // getRepository fetches a repository by ID or name.
 func (s *GithubSCM) getRepository(ctx context.Context, opt *RepositoryOptions) (*Repository, error) {
 	const op Op = "getRepository"
@@ -125,6 +125,31 @@ func (s *GithubSCM) RequestReviewers(ctx context.Context, opt *RequestReviewersO
 	return nil
 }
 
+// CreateReviewComment implements the SCM interface
+func (s *GithubSCM) CreateReviewComment(ctx context.Context, opt *ReviewCommentOptions) (int64, error) {
+	const op Op = "CreateReviewComment"
+	m := M("failed to create review comment on pull request #%d on %s/%s", opt.Number, opt.Organization, opt.Repository)
+	if !opt.valid() {
+		return 0, E(op, m, fmt.Errorf("missing fields: %+v", opt))
+	}
+	comment := &github.PullRequestComment{
+		Body:     &opt.Body,
+		CommitID: &opt.CommitID,
+		Path:     &opt.Path,
+		Line:     &opt.Line,
+		Side:     github.String("RIGHT"),
+	}
+	if opt.StartLine < opt.Line {
+		comment.StartLine = &opt.StartLine
+		comment.StartSide = github.String("RIGHT")
+	}
+	created, _, err := s.client.PullRequests.CreateComment(ctx, opt.Organization, opt.Repository, opt.Number, comment)
+	if err != nil {
+		return 0, E(op, m, fmt.Errorf("%s: %w", m, err))
+	}
+	return created.GetID(), nil
+}
+
 func toIssue(issue *github.Issue) *Issue {
 	return &Issue{
 		ID:         issue.GetID(),
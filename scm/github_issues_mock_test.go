@@ -687,3 +687,69 @@ func TestMockRequestReviewers(t *testing.T) {
 		})
 	}
 }
+
+func TestMockCreateReviewComment(t *testing.T) {
+	opt := func(repo string, number, startLine, line int) *ReviewCommentOptions {
+		return &ReviewCommentOptions{
+			Organization: "foo",
+			Repository:   repo,
+			Number:       number,
+			CommitID:     "abc123",
+			Path:         "lab1/main.go",
+			StartLine:    startLine,
+			Line:         line,
+			Body:         "Consider a table-driven test here.",
+		}
+	}
+	tests := []struct {
+		name          string
+		opt           *ReviewCommentOptions
+		wantErr       bool
+		wantStartLine *int
+	}{
+		{name: "IncompleteRequest", opt: &ReviewCommentOptions{}, wantErr: true},
+		{name: "IncompleteRequest", opt: &ReviewCommentOptions{Organization: "foo", Repository: "meling-labs", Number: 1}, wantErr: true},
+		{name: "IncompleteRequest/InvalidLines", opt: opt("meling-labs", 1, 12, 10), wantErr: true},
+
+		{name: "CompleteRequest/RepoNotFound", opt: opt("lamport-labs", 1, 10, 10), wantErr: true},
+		{name: "CompleteRequest/WrongNumber", opt: opt("meling-labs", 543, 10, 10), wantErr: true},
+
+		{name: "CompleteRequest/SingleLine", opt: opt("meling-labs", 1, 10, 10), wantErr: false},
+		{name: "CompleteRequest/MultiLine", opt: opt("josie-labs", 2, 10, 12), wantErr: false, wantStartLine: github.Int(10)},
+	}
+
+	s := NewMockedGithubSCMClient(qtest.Logger(t), WithOrgs(ghOrgFoo, ghOrgBar), WithRepos(repos...), WithReviewers(reviewers))
+	for _, tt := range tests {
+		name := qtest.Name(tt.name, []string{"Repository", "Number", "StartLine", "Line"}, tt.opt.Repository, tt.opt.Number, tt.opt.StartLine, tt.opt.Line)
+		t.Run(name, func(t *testing.T) {
+			id, err := s.CreateReviewComment(context.Background(), tt.opt)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateReviewComment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			comments := s.reviewComments[tt.opt.Organization][tt.opt.Repository][tt.opt.Number]
+			if len(comments) == 0 {
+				t.Fatal("CreateReviewComment() comment not found")
+			}
+			got := comments[len(comments)-1]
+			want := github.PullRequestComment{
+				ID:        github.Int64(id),
+				Body:      github.String(tt.opt.Body),
+				CommitID:  github.String(tt.opt.CommitID),
+				Path:      github.String(tt.opt.Path),
+				Line:      github.Int(tt.opt.Line),
+				Side:      github.String("RIGHT"),
+				StartLine: tt.wantStartLine,
+			}
+			if tt.wantStartLine != nil {
+				want.StartSide = github.String("RIGHT")
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("CreateReviewComment() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
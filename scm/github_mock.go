@@ -570,6 +570,32 @@ func NewMockedGithubSCMClient(logger *zap.SugaredLogger, opts ...MockOption) *Mo
 			mustWrite(w, pr)
 		}),
 	)
+	postReposPullsCommentsByOwnerByRepoByPullNumberHandler := WithRequestMatchHandler(
+		postReposPullsCommentsByOwnerByRepoByPullNumber,
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			owner := r.PathValue("owner")
+			repo := r.PathValue("repo")
+			pullNumber := mustParse[int](r.PathValue("pull_number"))
+			comment := mustRead[github.PullRequestComment](r.Body)
+			logger.Debug(replaceArgs(postReposPullsCommentsByOwnerByRepoByPullNumber, owner, repo, pullNumber), " comment=", comment)
+
+			if _, exists := s.reviewers[owner][repo][pullNumber]; !exists {
+				w.WriteHeader(http.StatusNotFound) // pull request not found
+				return
+			}
+			s.commentID++
+			comment.ID = github.Int64(s.commentID)
+			if s.reviewComments[owner] == nil {
+				s.reviewComments[owner] = make(map[string]map[int][]github.PullRequestComment)
+			}
+			if s.reviewComments[owner][repo] == nil {
+				s.reviewComments[owner][repo] = make(map[int][]github.PullRequestComment)
+			}
+			s.reviewComments[owner][repo][pullNumber] = append(s.reviewComments[owner][repo][pullNumber], comment)
+			w.WriteHeader(http.StatusCreated)
+			mustWrite(w, comment)
+		}),
+	)
 	// Mock query handler for fetching the issue ID based on issue number
 	queryHandler := func(w http.ResponseWriter, vars map[string]any) {
 		owner := vars["repositoryOwner"].(string)
@@ -676,6 +702,7 @@ func NewMockedGithubSCMClient(logger *zap.SugaredLogger, opts ...MockOption) *Mo
 		postReposIssuesCommentsByOwnerByRepoByIssueNumberHandler,
 		patchReposIssuesCommentsByOwnerByRepoByCommentIDHandler,
 		postReposPullsRequestedReviewersByOwnerByRepoByPullNumberHandler,
+		postReposPullsCommentsByOwnerByRepoByPullNumberHandler,
 		graphQLHandler,
 	)
 	s.GithubSCM = &GithubSCM{
@@ -18,6 +18,8 @@ type mockOptions struct {
 	issues    map[string]map[string][]github.Issue                  // map: owner -> repo -> issues
 	comments  map[string]map[string]map[int64][]github.IssueComment // map: owner -> repo -> issue ID -> comments
 	reviewers map[string]map[string]map[int]github.ReviewersRequest // map: owner -> repo -> pull requests ID -> reviewers
+	// map: owner -> repo -> pull requests ID -> review comments
+	reviewComments map[string]map[string]map[int][]github.PullRequestComment
 }
 
 // DumpState returns a string representation of the mock state.
@@ -62,6 +64,15 @@ func (s mockOptions) DumpState() string {
 			}
 		}
 	}
+	for owner, repos := range s.reviewComments {
+		for repo, prs := range repos {
+			for prID, comments := range prs {
+				for i, comment := range comments {
+					fmt.Fprintf(b, "ReviewComment[%s][%s][%d][%d]: %v\n", owner, repo, prID, i, comment)
+				}
+			}
+		}
+	}
 	return b.String()
 }
 
@@ -111,6 +122,8 @@ func newMockOptions() *mockOptions {
 		issues:    map[string]map[string][]github.Issue{},
 		comments:  map[string]map[string]map[int64][]github.IssueComment{},
 		reviewers: map[string]map[string]map[int]github.ReviewersRequest{},
+
+		reviewComments: map[string]map[string]map[int][]github.PullRequestComment{},
 	}
 }
 
@@ -182,8 +195,8 @@ func WithMockOrgs(members ...string) MockOption {
 }
 
 // WithMockCourses sets up mock data based on qtest.MockCourses with complete
-// course organizations and four repositories.
-func WithMockCourses() MockOption {
+// course organizations and four repositories, and a repository for each of the given students, if any.
+func WithMockCourses(students ...string) MockOption {
 	return func(opts *mockOptions) {
 		for _, course := range qtest.MockCourses {
 			ghOrg := toOrg(course)
@@ -191,6 +204,9 @@ func WithMockCourses() MockOption {
 			for _, repo := range []string{"info", "assignments", "tests", qf.StudentRepoName("meling")} {
 				opts.repos = append(opts.repos, toRepo(&ghOrg, repo))
 			}
+			for _, student := range students {
+				opts.repos = append(opts.repos, toRepo(&ghOrg, qf.StudentRepoName(student)))
+			}
 		}
 	}
 }
@@ -14,8 +14,8 @@ const (
 	getRepositoriesByID                                       = "GET /repositories/{repository_id}"                                  // getRepository, deleteRepository
 	getReposContentsByOwnerByRepoByPath                       = "GET /repos/{owner}/{repo}/contents/{path...}"                       // RepositoryIsEmpty
 	getReposCollaboratorsByOwnerByRepo                        = "GET /repos/{owner}/{repo}/collaborators"                            // UpdateGroupMembers
-	putReposCollaboratorsByOwnerByRepoByUsername              = "PUT /repos/{owner}/{repo}/collaborators/{username}"                 // CreateCourse, UpdateEnrollment, CreateGroup, UpdateGroupMembers, createStudentRepo, grantPullAccessToCourseRepos
-	deleteReposCollaboratorsByOwnerByRepoByUsername           = "DELETE /repos/{owner}/{repo}/collaborators/{username}"              // UpdateGroupMembers
+	putReposCollaboratorsByOwnerByRepoByUsername              = "PUT /repos/{owner}/{repo}/collaborators/{username}"                 // CreateCourse, UpdateEnrollment, CreateGroup, UpdateGroupMembers, GrantReadAccess, createStudentRepo, grantPullAccessToCourseRepos
+	deleteReposCollaboratorsByOwnerByRepoByUsername           = "DELETE /repos/{owner}/{repo}/collaborators/{username}"              // UpdateGroupMembers, RevokeAccess
 	postReposIssuesByOwnerByRepo                              = "POST /repos/{owner}/{repo}/issues"                                  // CreateIssue
 	patchReposIssuesByOwnerByRepoByIssueNumber                = "PATCH /repos/{owner}/{repo}/issues/{issue_number}"                  // UpdateIssue
 	getReposIssuesByOwnerByRepoByIssueNumber                  = "GET /repos/{owner}/{repo}/issues/{issue_number}"                    // GetIssue
@@ -23,4 +23,5 @@ const (
 	postReposIssuesCommentsByOwnerByRepoByIssueNumber         = "POST /repos/{owner}/{repo}/issues/{issue_number}/comments"          // CreateIssueComment
 	patchReposIssuesCommentsByOwnerByRepoByCommentID          = "PATCH /repos/{owner}/{repo}/issues/comments/{comment_id}"           // UpdateIssueComment
 	postReposPullsRequestedReviewersByOwnerByRepoByPullNumber = "POST /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers" // RequestReviewers
+	postReposPullsCommentsByOwnerByRepoByPullNumber           = "POST /repos/{owner}/{repo}/pulls/{pull_number}/comments"            // CreateReviewComment
 )
@@ -356,6 +356,7 @@ func TestMockUpdateEnrollment(t *testing.T) {
 		{name: "CompleteRequest/Student", opt: &UpdateEnrollmentOptions{Organization: "foo", User: "meling", Status: qf.Enrollment_STUDENT}, wantRepo: wantFooRepo, wantErr: false}, // allowed; returns already created repo (skip creation)
 		{name: "CompleteRequest/Teacher", opt: &UpdateEnrollmentOptions{Organization: "foo", User: "meling", Status: qf.Enrollment_TEACHER}, wantRepo: nil, wantErr: false},         // does not return a repo since repo is not created
 		{name: "CompleteRequest/Student", opt: &UpdateEnrollmentOptions{Organization: "bar", User: "meling", Status: qf.Enrollment_STUDENT}, wantRepo: wantBarRepo, wantErr: false}, // allowed; returns newly created repo (actual creation)
+		{name: "CompleteRequest/Assistant", opt: &UpdateEnrollmentOptions{Organization: "foo", User: "meling", Status: qf.Enrollment_ASSISTANT}, wantRepo: nil, wantErr: false},     // does not return a repo since repo is not created
 	}
 	s := NewMockedGithubSCMClient(qtest.Logger(t), WithOrgs(ghOrgFoo, ghOrgBar), WithRepos(repos...), WithMembers(members...), WithGroups(g))
 	for _, tt := range tests {
@@ -606,3 +607,48 @@ func TestMockDeleteGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestMockGrantRevokeAccess(t *testing.T) {
+	pull := map[string]bool{"pull": true}
+	leslieReader := github.User{Login: github.String("leslie"), Permissions: pull}
+	tests := []struct {
+		name      string
+		opt       *CollaboratorOptions
+		revoke    bool
+		wantUsers []github.User
+		wantErr   bool
+	}{
+		{name: "IncompleteRequest", opt: &CollaboratorOptions{}, wantErr: true},
+		{name: "IncompleteRequest", opt: &CollaboratorOptions{Organization: "foo", Repository: "meling-labs"}, wantErr: true},
+		{name: "IncompleteRequest", opt: &CollaboratorOptions{Organization: "foo", User: "leslie"}, wantErr: true},
+		{name: "IncompleteRequest", opt: &CollaboratorOptions{Organization: "foo", Repository: "meling-labs"}, revoke: true, wantErr: true},
+
+		{name: "CompleteRequest/NotFound", opt: &CollaboratorOptions{Organization: "foo", Repository: "leslie-labs", User: "meling"}, wantErr: true},
+		{name: "CompleteRequest/NotFound", opt: &CollaboratorOptions{Organization: "bar", Repository: "meling-labs", User: "leslie"}, revoke: true, wantErr: true},
+
+		{name: "CompleteRequest/Grant", opt: &CollaboratorOptions{Organization: "foo", Repository: "meling-labs", User: "leslie"}, wantUsers: []github.User{leslieReader}},
+		{name: "CompleteRequest/GrantAgain", opt: &CollaboratorOptions{Organization: "foo", Repository: "meling-labs", User: "leslie"}, wantUsers: []github.User{leslieReader}},
+		{name: "CompleteRequest/Revoke", opt: &CollaboratorOptions{Organization: "foo", Repository: "meling-labs", User: "leslie"}, revoke: true, wantUsers: []github.User{}},
+	}
+	s := NewMockedGithubSCMClient(qtest.Logger(t), WithOrgs(ghOrgFoo, ghOrgBar), WithRepos(repos...), WithGroups(groups))
+	for _, tt := range tests {
+		name := qtest.Name(tt.name, []string{"Organization", "Repository", "User", "Revoke"}, tt.opt.Organization, tt.opt.Repository, tt.opt.User, tt.revoke)
+		t.Run(name, func(t *testing.T) {
+			var err error
+			if tt.revoke {
+				err = s.RevokeAccess(context.Background(), tt.opt)
+			} else {
+				err = s.GrantReadAccess(context.Background(), tt.opt)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrantReadAccess/RevokeAccess() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantUsers == nil {
+				return
+			}
+			if diff := cmp.Diff(tt.wantUsers, s.groups[tt.opt.Organization][tt.opt.Repository]); diff != "" {
+				t.Errorf("GrantReadAccess/RevokeAccess() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -32,6 +32,10 @@ type SCM interface {
 	UpdateGroupMembers(context.Context, *GroupOptions) error
 	// DeleteGroup deletes group's repository.
 	DeleteGroup(context.Context, *RepositoryOptions) error
+	// GrantReadAccess adds the user as a collaborator with read access to the repository.
+	GrantReadAccess(context.Context, *CollaboratorOptions) error
+	// RevokeAccess removes the user as a collaborator on the repository.
+	RevokeAccess(context.Context, *CollaboratorOptions) error
 
 	// Clone clones the given repository and returns the path to the cloned repository.
 	// The returned path is the provided destination directory joined with the
@@ -60,6 +64,8 @@ type SCM interface {
 	UpdateIssueComment(context.Context, *IssueCommentOptions) error
 	// RequestReviewers requests reviewers for a pull request.
 	RequestReviewers(context.Context, *RequestReviewersOptions) error
+	// CreateReviewComment creates a review comment on a range of lines in a pull request.
+	CreateReviewComment(context.Context, *ReviewCommentOptions) (int64, error)
 }
 
 // NewSCMClient returns a new provider client implementing the SCM interface.
@@ -80,6 +80,17 @@ func (opt GroupOptions) valid() bool {
 	return opt.GroupName != "" && opt.Organization != ""
 }
 
+// CollaboratorOptions is used when granting or revoking a user's access to a repository.
+type CollaboratorOptions struct {
+	Organization string
+	Repository   string
+	User         string // User is the collaborator's GitHub username
+}
+
+func (opt CollaboratorOptions) valid() bool {
+	return opt.Organization != "" && opt.Repository != "" && opt.User != ""
+}
+
 // IssueOptions contains information for creating or updating an Issue.
 type IssueOptions struct {
 	Organization string
@@ -121,3 +132,20 @@ type RequestReviewersOptions struct {
 func (opt RequestReviewersOptions) valid() bool {
 	return opt.Organization != "" && opt.Repository != "" && opt.Number > 0 && len(opt.Reviewers) != 0
 }
+
+// ReviewCommentOptions contains information for creating a review comment on a pull request.
+type ReviewCommentOptions struct {
+	Organization string
+	Repository   string
+	Number       int
+	CommitID     string // CommitID is the SHA of the commit being commented on
+	Path         string // Path is the path of the commented file, relative to the repository root
+	StartLine    int    // StartLine is the first commented line; set to Line for a single line comment
+	Line         int
+	Body         string
+}
+
+func (opt ReviewCommentOptions) valid() bool {
+	return opt.Organization != "" && opt.Repository != "" && opt.Number > 0 && opt.CommitID != "" &&
+		opt.Path != "" && opt.StartLine > 0 && opt.Line >= opt.StartLine && opt.Body != ""
+}
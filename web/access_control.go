@@ -27,10 +27,10 @@ func (s *QuickFeedService) hasCourseAccess(userID, courseID uint64, check func(*
 	return check(enrollment)
 }
 
-// isTeacher returns true if the given user is teacher for the given course.
-func (s *QuickFeedService) isTeacher(userID, courseID uint64) bool {
+// isStaff returns true if the given user is teacher or teaching assistant for the given course.
+func (s *QuickFeedService) isStaff(userID, courseID uint64) bool {
 	return s.hasCourseAccess(userID, courseID, func(e *qf.Enrollment) bool {
-		return e.Status == qf.Enrollment_TEACHER
+		return e.IsStaff()
 	})
 }
 
@@ -0,0 +1,51 @@
+package web
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+// setPseudonyms sets the pseudonym of every unreleased submission to an anonymous assignment.
+// The pseudonyms are used by the validation interceptor to hide who made the submissions.
+func (s *QuickFeedService) setPseudonyms(assignments []*qf.Assignment, submissions ...*qf.Submission) {
+	anonymous := make(map[uint64]bool)
+	for _, assignment := range assignments {
+		anonymous[assignment.GetID()] = assignment.GetAnonymous()
+	}
+	for _, submission := range submissions {
+		if anonymous[submission.GetAssignmentID()] && !submission.GetReleased() {
+			submission.Pseudonym = s.pseudonym(submission)
+		}
+	}
+}
+
+// removeAnonymous removes unreleased submissions to anonymous assignments from the submissions.
+// Otherwise, reviewers could learn who made a submission listed under its pseudonym
+// by matching submission IDs with the submissions of a specific user or group.
+func (s *QuickFeedService) removeAnonymous(courseID uint64, submissions *qf.Submissions) error {
+	assignments, err := s.db.GetAssignmentsByCourse(courseID)
+	if err != nil {
+		return err
+	}
+	s.setPseudonyms(assignments, submissions.GetSubmissions()...)
+	submissions.Submissions = slices.DeleteFunc(submissions.GetSubmissions(), func(submission *qf.Submission) bool {
+		return submission.GetPseudonym() > 0
+	})
+	return nil
+}
+
+// pseudonym returns a pseudonymous ID for the user or group that made the submission.
+// The pseudonym is the same for all submissions by the same user or group to the same assignment,
+// and does not change while the service is running. The highest bit is set to avoid
+// collisions with user, group and enrollment IDs.
+func (s *QuickFeedService) pseudonym(submission *qf.Submission) uint64 {
+	mac := hmac.New(sha256.New, s.pseudonymKey)
+	for _, id := range []uint64{submission.GetAssignmentID(), submission.GetUserID(), submission.GetGroupID()} {
+		mac.Write(binary.BigEndian.AppendUint64(nil, id))
+	}
+	return binary.BigEndian.Uint64(mac.Sum(nil)) | 1<<63
+}
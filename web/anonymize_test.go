@@ -0,0 +1,111 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+)
+
+func TestAnonymousSubmissions(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	student := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, student, course)
+
+	lab := &qf.Assignment{CourseID: course.ID, Name: "exam", Order: 1, Reviewers: 1, Anonymous: true}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &qf.Submission{AssignmentID: lab.ID, UserID: student.ID, CommitHash: "abc", BuildInfo: &score.BuildInfo{BuildLog: "cloned " + student.Login + "-labs"}}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	group := &qf.Group{CourseID: course.ID, Name: "group1", Users: []*qf.User{student}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+	groupSubmission := &qf.Submission{AssignmentID: lab.ID, GroupID: group.ID}
+	if err := db.CreateSubmission(groupSubmission); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	cookie := Cookie(t, tm, admin)
+	submissionRequest := &qf.SubmissionRequest{CourseID: course.ID, FetchMode: &qf.SubmissionRequest_SubmissionID{SubmissionID: submission.ID}}
+	courseRequest := &qf.SubmissionRequest{CourseID: course.ID, FetchMode: &qf.SubmissionRequest_Type{Type: qf.SubmissionRequest_ALL}}
+	groupRequest := &qf.SubmissionRequest{CourseID: course.ID, FetchMode: &qf.SubmissionRequest_GroupID{GroupID: group.ID}}
+
+	gotSubmission, err := client.GetSubmission(ctx, qtest.RequestWithCookie(submissionRequest, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pseudonym := gotSubmission.Msg.GetPseudonym()
+	if pseudonym == 0 || gotSubmission.Msg.GetUserID() != pseudonym || gotSubmission.Msg.GetCommitHash() != "" || gotSubmission.Msg.GetBuildInfo().GetBuildLog() != "" {
+		t.Errorf("GetSubmission() = (user=%d, pseudonym=%d, commit=%q, log=%q), want anonymous submission",
+			gotSubmission.Msg.GetUserID(), pseudonym, gotSubmission.Msg.GetCommitHash(), gotSubmission.Msg.GetBuildInfo().GetBuildLog())
+	}
+	for _, grade := range gotSubmission.Msg.GetGrades() {
+		if grade.GetUserID() != 0 {
+			t.Errorf("GetSubmission() revealed user %d in grade", grade.GetUserID())
+		}
+	}
+
+	courseSubmissions, err := client.GetSubmissionsByCourse(ctx, qtest.RequestWithCookie(courseRequest, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	anonymous := courseSubmissions.Msg.GetSubmissions()[pseudonym].GetSubmissions()
+	if len(anonymous) != 1 || anonymous[0].GetID() != submission.ID || anonymous[0].GetUserID() != pseudonym {
+		t.Errorf("GetSubmissionsByCourse() anonymous submissions = %v, want submission %d with pseudonym", anonymous, submission.ID)
+	}
+	for key, submissions := range courseSubmissions.Msg.GetSubmissions() {
+		for _, s := range submissions.GetSubmissions() {
+			if key != pseudonym && s.GetID() == submission.ID {
+				t.Errorf("GetSubmissionsByCourse() returned anonymous submission under key %d", key)
+			}
+		}
+	}
+
+	// the submissions of a specific group would reveal who made the anonymous submission
+	groupSubmissions, err := client.GetSubmissions(ctx, qtest.RequestWithCookie(groupRequest, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groupSubmissions.Msg.GetSubmissions()) != 0 {
+		t.Errorf("GetSubmissions() = %v, want no submissions", groupSubmissions.Msg.GetSubmissions())
+	}
+
+	// identities are revealed after release
+	for _, s := range []*qf.Submission{submission, groupSubmission} {
+		s.Released = true
+		if err := db.UpdateSubmission(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	gotSubmission, err = client.GetSubmission(ctx, qtest.RequestWithCookie(submissionRequest, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotSubmission.Msg.GetPseudonym() != 0 || gotSubmission.Msg.GetUserID() != student.ID {
+		t.Errorf("GetSubmission() after release = (user=%d, pseudonym=%d), want (user=%d, pseudonym=0)", gotSubmission.Msg.GetUserID(), gotSubmission.Msg.GetPseudonym(), student.ID)
+	}
+
+	groupSubmissions, err = client.GetSubmissions(ctx, qtest.RequestWithCookie(groupRequest, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groupSubmissions.Msg.GetSubmissions()) != 1 || groupSubmissions.Msg.GetSubmissions()[0].GetID() != groupSubmission.ID {
+		t.Errorf("GetSubmissions() after release = %v, want submission %d", groupSubmissions.Msg.GetSubmissions(), groupSubmission.ID)
+	}
+}
@@ -37,10 +37,34 @@ func (s *QuickFeedService) createReview(review *qf.Review) (*qf.Review, error) {
 	if err != nil {
 		return nil, err
 	}
-	if len(submission.Reviews) >= int(assignment.Reviewers) {
+	allocations, err := s.db.GetReviewAllocations(&qf.ReviewAllocation{SubmissionID: submission.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(allocations) > 0 && !isAllocated(allocations, review.ReviewerID) {
+		return nil, fmt.Errorf("failed to create a new review for submission %d to assignment %s: reviewer %d not allocated",
+			submission.ID, assignment.Name, review.ReviewerID)
+	}
+	reviews := 0
+	for _, r := range submission.Reviews {
+		// reviews by reviewers that are no longer allocated to the submission do not count
+		if !r.GetFinal() && !r.GetPeer() && (len(allocations) == 0 || isAllocated(allocations, r.GetReviewerID())) {
+			reviews++
+		}
+	}
+	if reviews >= int(assignment.Reviewers) {
 		return nil, fmt.Errorf("failed to create a new review for submission %d to assignment %s: all %d reviews already created",
 			submission.ID, assignment.Name, assignment.Reviewers)
 	}
+	return s.addReview(submission, review)
+}
+
+// addReview creates the review of the submission with the assignment's grading benchmarks,
+// without checking the assignment's number of reviewers.
+func (s *QuickFeedService) addReview(submission *qf.Submission, review *qf.Review) (*qf.Review, error) {
+	review.SubmissionID = submission.GetID()
+	review.Final = false
+	review.Peer = false
 	review.Edited = timestamppb.Now()
 	review.ComputeScore()
 
@@ -63,7 +87,9 @@ func (s *QuickFeedService) createReview(review *qf.Review) (*qf.Review, error) {
 	return review, nil
 }
 
-func (s *QuickFeedService) updateReview(review *qf.Review) (*qf.Review, error) {
+// updateReview updates the given review and, if the review decides the submission's score,
+// the submission's score. Only the course creator can update the final review.
+func (s *QuickFeedService) updateReview(reviewerID uint64, review *qf.Review) (*qf.Review, error) {
 	if review.ID == 0 {
 		return nil, fmt.Errorf("cannot update review with empty ID")
 	}
@@ -71,23 +97,33 @@ func (s *QuickFeedService) updateReview(review *qf.Review) (*qf.Review, error) {
 	if err != nil {
 		return nil, err
 	}
+	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: submission.AssignmentID})
+	if err != nil {
+		return nil, err
+	}
+	storedReview, err := s.db.GetReview(&qf.Review{ID: review.ID})
+	if err != nil {
+		return nil, err
+	}
+	review.Final = storedReview.GetFinal()
+	review.Peer = storedReview.GetPeer()
+	if review.GetFinal() && !s.isCourseCreator(assignment.GetCourseID(), reviewerID) {
+		return nil, ErrFinalReviewUpdate
+	}
 
 	review.Edited = timestamppb.Now()
 	review.ComputeScore()
 
-	if err := s.db.UpdateReview(review); err != nil {
+	if err := s.saveReview(review); err != nil {
 		return nil, err
 	}
-
-	for _, bm := range review.GradingBenchmarks {
-		if err := s.db.UpdateBenchmark(bm); err != nil {
-			return nil, err
-		}
-		for _, c := range bm.Criteria {
-			if err := s.db.UpdateCriterion(c); err != nil {
-				return nil, err
-			}
-		}
+	// Peer reviews never decide the submission's score.
+	if review.GetPeer() {
+		return review, nil
+	}
+	// With a reconcile policy, only the final review decides the submission's score.
+	if assignment.GetReconcilePolicy() != qf.Assignment_NONE && !review.GetFinal() {
+		return review, nil
 	}
 	// Update the submission's score if the review score has changed.
 	if submission.Score != review.Score {
@@ -99,6 +135,24 @@ func (s *QuickFeedService) updateReview(review *qf.Review) (*qf.Review, error) {
 	return review, nil
 }
 
+// saveReview updates the review and its grading benchmarks and criteria.
+func (s *QuickFeedService) saveReview(review *qf.Review) error {
+	if err := s.db.UpdateReview(review); err != nil {
+		return err
+	}
+	for _, bm := range review.GradingBenchmarks {
+		if err := s.db.UpdateBenchmark(bm); err != nil {
+			return err
+		}
+		for _, c := range bm.Criteria {
+			if err := s.db.UpdateCriterion(c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (s *QuickFeedService) getAssignmentWithCourse(query *qf.Assignment, withCourseInfo bool) (*qf.Assignment, *qf.Course, error) {
 	assignment, err := s.db.GetAssignment(query)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/quickfeed/quickfeed/database"
@@ -160,6 +161,10 @@ func fetchUser(logger *zap.SugaredLogger, db database.Database, token *oauth2.To
 	case err == nil:
 		logger.Debugf("Found user: %v in database", user)
 		user.RefreshToken = token.RefreshToken
+		if externalUser.Email != "" && strings.EqualFold(user.GetEmail(), externalUser.Email) {
+			// GitHub only allows verified emails as the public email
+			user.EmailVerified = true
+		}
 		if err = db.UpdateUser(user); err != nil {
 			return nil, fmt.Errorf("failed to update access token for user %q: %w", externalUser.Login, err)
 		}
@@ -168,12 +173,13 @@ func fetchUser(logger *zap.SugaredLogger, db database.Database, token *oauth2.To
 	case err == gorm.ErrRecordNotFound:
 		logger.Debugf("User %q not found in database; creating new user", externalUser.Login)
 		user = &qf.User{
-			Name:         externalUser.Name,
-			Email:        externalUser.Email,
-			AvatarURL:    externalUser.AvatarURL,
-			Login:        externalUser.Login,
-			ScmRemoteID:  externalUser.ID,
-			RefreshToken: token.RefreshToken,
+			Name:          externalUser.Name,
+			Email:         externalUser.Email,
+			EmailVerified: externalUser.Email != "",
+			AvatarURL:     externalUser.AvatarURL,
+			Login:         externalUser.Login,
+			ScmRemoteID:   externalUser.ID,
+			RefreshToken:  token.RefreshToken,
 		}
 		if err = db.CreateUser(user); err != nil {
 			return nil, fmt.Errorf("failed to create remote identity for user %q: %w", externalUser.Login, err)
@@ -26,20 +26,40 @@ func (s *QuickFeedService) updateEnrollment(ctx context.Context, sc scm.SCM, cur
 	if err != nil {
 		return err
 	}
-	// log changes to teacher status
-	if enrollment.IsTeacher() || request.IsTeacher() {
+	// log changes to teacher and teaching assistant status
+	if enrollment.IsStaff() || request.IsStaff() {
 		s.logger.Debugf("User %s attempting to change enrollment status of user %d from %s to %s", curUser, enrollment.UserID, enrollment.Status, request.Status)
 	}
 
 	switch {
 	case (enrollment.IsPending() || enrollment.IsStudent()) && request.IsNone(): // pending or student -> none
-		return s.rejectEnrollment(ctx, sc, enrollment)
+		if err := s.rejectEnrollment(ctx, sc, enrollment); err != nil {
+			return err
+		}
+		return s.promoteWaitlisted(ctx, sc, enrollment.GetCourseID())
 	case enrollment.IsPending() && request.IsStudent(): // pending -> student
+		if enrollment.GetWaitlisted() {
+			return ErrEnrollmentWaitlisted
+		}
 		return s.enrollStudent(ctx, sc, enrollment)
 	case enrollment.IsStudent() && request.IsTeacher(): // student -> teacher
-		return s.enrollTeacher(ctx, sc, enrollment)
+		if err := s.enrollTeacher(ctx, sc, enrollment); err != nil {
+			return err
+		}
+		return s.promoteWaitlisted(ctx, sc, enrollment.GetCourseID())
 	case enrollment.IsTeacher() && request.IsStudent(): // teacher -> student
 		return s.revokeTeacherStatus(ctx, sc, enrollment)
+	case enrollment.IsStudent() && request.IsAssistant(): // student -> assistant
+		if err := s.enrollAssistant(ctx, sc, enrollment); err != nil {
+			return err
+		}
+		return s.promoteWaitlisted(ctx, sc, enrollment.GetCourseID())
+	case enrollment.IsTeacher() && request.IsAssistant(): // teacher -> assistant
+		return s.enrollAssistant(ctx, sc, enrollment)
+	case enrollment.IsAssistant() && request.IsTeacher(): // assistant -> teacher
+		return s.enrollTeacher(ctx, sc, enrollment)
+	case enrollment.IsAssistant() && request.IsStudent(): // assistant -> student
+		return s.revokeAssistantStatus(ctx, sc, enrollment)
 	}
 	return fmt.Errorf("unknown enrollment status change from %s to %s", enrollment.GetStatus(), request.GetStatus())
 }
@@ -117,6 +137,7 @@ func (s *QuickFeedService) enrollStudent(ctx context.Context, sc scm.SCM, query
 	if err := s.db.CreateRepository(&userRepo); err != nil {
 		return fmt.Errorf("failed to create %s repository for %q: %w", course.Code, user.Login, err)
 	}
+	s.grantAssistantAccess(ctx, sc, course, scmRepo.Repo)
 
 	if err := s.acceptRepositoryInvites(ctx, sc, user, course.GetScmOrganizationName()); err != nil {
 		// log error, but continue with enrollment; we can manually accept invitations later
@@ -157,6 +178,81 @@ func (s *QuickFeedService) revokeTeacherStatus(ctx context.Context, sc scm.SCM,
 	return s.db.UpdateEnrollment(query)
 }
 
+// enrollAssistant makes the given user a teaching assistant of the given course.
+// Unlike teachers, assistants are not organization owners; they get read access to the course's repositories.
+func (s *QuickFeedService) enrollAssistant(ctx context.Context, sc scm.SCM, query *qf.Enrollment) error {
+	// course and user are both preloaded, no need to query the database
+	course, user := query.GetCourse(), query.GetUser()
+	query.Status = qf.Enrollment_ASSISTANT
+	if _, err := sc.UpdateEnrollment(ctx, &scm.UpdateEnrollmentOptions{
+		Organization: course.GetScmOrganizationName(),
+		User:         user.GetLogin(),
+		Status:       qf.Enrollment_ASSISTANT,
+	}); err != nil {
+		return fmt.Errorf("failed to update %s repository membership for teaching assistant %q: %w", course.Code, user.Login, err)
+	}
+	if err := s.acceptRepositoryInvites(ctx, sc, user, course.GetScmOrganizationName()); err != nil {
+		// log error, but continue with enrollment; we can manually accept invitations later
+		s.logger.Errorf("Failed to accept %s repository invites for %q: %v", course.Code, user.Login, err)
+	}
+	return s.db.UpdateEnrollment(query)
+}
+
+// revokeAssistantStatus demotes the given teaching assistant to student, and revokes the assistant's
+// read access to the course's repositories, except the repositories that students have access to.
+func (s *QuickFeedService) revokeAssistantStatus(ctx context.Context, sc scm.SCM, query *qf.Enrollment) error {
+	// course and user are both preloaded, no need to query the database
+	course, user := query.GetCourse(), query.GetUser()
+	keep := map[string]bool{
+		qf.InfoRepo:                         true,
+		qf.AssignmentsRepo:                  true,
+		qf.StudentRepoName(user.GetLogin()): true,
+	}
+	if query.GetGroupID() > 0 {
+		if groupRepo, err := s.getRepo(course, query.GetGroupID(), qf.Repository_GROUP); err == nil {
+			keep[groupRepo.Name()] = true
+		}
+	}
+	repos, err := sc.GetRepositories(ctx, course.GetScmOrganizationName())
+	if err != nil {
+		// log error, but continue to update enrollment; we can manually revoke access later
+		s.logger.Errorf("Failed to get %s repositories to revoke teaching assistant access for %q: %v", course.Code, user.Login, err)
+	}
+	for _, repo := range repos {
+		if keep[repo.Repo] {
+			continue
+		}
+		if err := sc.RevokeAccess(ctx, &scm.CollaboratorOptions{
+			Organization: course.GetScmOrganizationName(),
+			Repository:   repo.Repo,
+			User:         user.GetLogin(),
+		}); err != nil {
+			s.logger.Errorf("Failed to revoke %s teaching assistant access to %q for %q: %v", course.Code, repo.Repo, user.Login, err)
+		}
+	}
+	query.Status = qf.Enrollment_STUDENT
+	return s.db.UpdateEnrollment(query)
+}
+
+// grantAssistantAccess gives the course's teaching assistants read access to the given repository,
+// which was created after the assistants were enrolled.
+func (s *QuickFeedService) grantAssistantAccess(ctx context.Context, sc scm.SCM, course *qf.Course, repo string) {
+	assistants, err := s.db.GetEnrollmentsByCourse(course.GetID(), qf.Enrollment_ASSISTANT)
+	if err != nil {
+		s.logger.Errorf("Failed to get %s teaching assistants: %v", course.Code, err)
+		return
+	}
+	for _, assistant := range assistants {
+		if err := sc.GrantReadAccess(ctx, &scm.CollaboratorOptions{
+			Organization: course.GetScmOrganizationName(),
+			Repository:   repo,
+			User:         assistant.GetUser().GetLogin(),
+		}); err != nil {
+			s.logger.Errorf("Failed to grant %s teaching assistant %q access to %q: %v", course.Code, assistant.GetUser().GetLogin(), repo, err)
+		}
+	}
+}
+
 // getSubmissions returns all the latests submissions for a user of the given course.
 func (s *QuickFeedService) getSubmissions(request *qf.SubmissionRequest) (*qf.Submissions, error) {
 	// only one of user ID and group ID will be set; enforced by IsValid on qf.SubmissionRequest
@@ -182,6 +278,7 @@ func (s *QuickFeedService) getAllCourseSubmissions(request *qf.SubmissionRequest
 	if err != nil {
 		return nil, err
 	}
+	s.setPseudonyms(course.GetAssignments(), submissions...)
 
 	var submissionsMap map[uint64]*qf.Submissions
 	switch request.GetType() {
@@ -243,8 +340,9 @@ func makeAllResults(course *qf.Course, submissions []*qf.Submission) map[uint64]
 	for _, enrollment := range course.Enrollments {
 		submissionsMap[enrollment.ID] = &qf.Submissions{
 			Submissions: choose(submissions, om, func(submission *qf.Submission) bool {
-				// include individual and group submissions for this enrollment
-				return submission.ByUser(enrollment.UserID) || submission.ByGroup(enrollment.GroupID)
+				// include individual submissions and the group submissions credited to this enrollment's student,
+				// also for groups that the student has left since the submission was delivered
+				return submission.ByUser(enrollment.UserID) || submission.ByGroupMember(enrollment.UserID)
 			}),
 		}
 	}
@@ -283,15 +381,31 @@ func (s *QuickFeedService) updateSubmission(submissionID uint64, grades []*qf.Gr
 }
 
 // updateSubmissions updates status and release state of multiple submissions for the
-// given course and assignment ID for all submissions with score equal or above the provided score
-func (s *QuickFeedService) updateSubmissions(request *qf.UpdateSubmissionsRequest) error {
+// given course and assignment ID for all submissions with score equal or above the provided score.
+// The change is recorded in the course's audit log.
+func (s *QuickFeedService) updateSubmissions(userID uint64, request *qf.UpdateSubmissionsRequest) error {
 	query := &qf.Submission{
 		AssignmentID: request.AssignmentID,
 		Score:        request.ScoreLimit,
 		Released:     request.Release,
 	}
 
-	return s.db.UpdateSubmissions(query, true)
+	updated, err := s.db.UpdateSubmissions(query, true)
+	if err != nil {
+		return err
+	}
+	change := "approved"
+	if request.Release {
+		change = "released and approved"
+	}
+	s.audit(&qf.AuditEntry{
+		CourseID:     request.GetCourseID(),
+		AssignmentID: request.GetAssignmentID(),
+		UserID:       userID,
+		Change:       fmt.Sprintf("%s submissions with score >= %d", change, request.GetScoreLimit()),
+		Submissions:  uint32(updated),
+	})
+	return nil
 }
 
 // updateCourse updates an existing course.
@@ -307,7 +421,24 @@ func (s *QuickFeedService) updateCourse(ctx context.Context, sc scm.SCM, request
 		return err
 	}
 	request.ScmOrganizationName = org.GetScmOrganizationName()
-	return s.db.UpdateCourse(request)
+	// the policy is stored separately, such that the course update does not replace it
+	policy := request.GetEnrollmentPolicy()
+	request.EnrollmentPolicy = nil
+	if err := s.db.UpdateCourse(request); err != nil {
+		return err
+	}
+	if policy == nil {
+		return nil
+	}
+	policy.CourseID = request.GetID()
+	if err := s.db.UpdateEnrollmentPolicy(policy); err != nil {
+		return fmt.Errorf("failed to update enrollment policy for course %d: %w", request.GetID(), err)
+	}
+	// a larger capacity may have freed seats for waitlisted enrollments
+	if err := s.promoteWaitlisted(ctx, sc, request.GetID()); err != nil {
+		s.logger.Errorf("Failed to promote waitlisted enrollments in course %d: %v", request.GetID(), err)
+	}
+	return nil
 }
 
 // returns all enrollments for the course ID with last activity date and number of approved assignments
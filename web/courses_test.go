@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"connectrpc.com/connect"
 	"github.com/google/go-cmp/cmp"
 	"github.com/quickfeed/quickfeed/internal/qtest"
 	"github.com/quickfeed/quickfeed/qf"
@@ -537,6 +538,82 @@ func TestPromoteDemoteRejectTeacher(t *testing.T) {
 	}
 }
 
+func TestPromoteDemoteAssistant(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockCourses())
+
+	teacher := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "teacher", Login: "teacher"})
+	student := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "student", Login: "student"})
+	assistant := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "assistant", Login: "assistant"})
+
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, teacher, course)
+
+	ctx := context.Background()
+	update := func(cookie string, userID uint64, status qf.Enrollment_UserStatus) error {
+		request := &qf.Enrollments{Enrollments: []*qf.Enrollment{{UserID: userID, CourseID: course.ID, Status: status}}}
+		_, err := client.UpdateEnrollments(ctx, qtest.RequestWithCookie(request, cookie))
+		return err
+	}
+	checkStatus := func(userID uint64, want qf.Enrollment_UserStatus) {
+		t.Helper()
+		enrol, err := db.GetEnrollmentByCourseAndUser(course.ID, userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enrol.Status != want {
+			t.Errorf("expected status %s, got %s", want, enrol.Status)
+		}
+	}
+	teacherCookie := Cookie(t, tm, teacher)
+	assistantCookie := Cookie(t, tm, assistant)
+
+	// student and assistant enroll in the course and are accepted as students, must succeed
+	for _, user := range []*qf.User{student, assistant} {
+		enrollment := &qf.Enrollment{UserID: user.ID, CourseID: course.ID}
+		if _, err := client.CreateEnrollment(ctx, qtest.RequestWithCookie(enrollment, Cookie(t, tm, user))); err != nil {
+			t.Fatal(err)
+		}
+		if err := update(teacherCookie, user.ID, qf.Enrollment_STUDENT); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// teacher promotes student to teaching assistant, must succeed
+	if err := update(teacherCookie, assistant.ID, qf.Enrollment_ASSISTANT); err != nil {
+		t.Error(err)
+	}
+	checkStatus(assistant.ID, qf.Enrollment_ASSISTANT)
+
+	// teaching assistant attempts to promote self to teacher, must fail
+	if err := update(assistantCookie, assistant.ID, qf.Enrollment_TEACHER); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("expected error %v, got %v", connect.CodePermissionDenied, err)
+	}
+	// teaching assistant attempts to promote another student, must fail
+	if err := update(assistantCookie, student.ID, qf.Enrollment_ASSISTANT); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("expected error %v, got %v", connect.CodePermissionDenied, err)
+	}
+	checkStatus(student.ID, qf.Enrollment_STUDENT)
+
+	// teacher promotes teaching assistant to teacher and back again, must succeed
+	if err := update(teacherCookie, assistant.ID, qf.Enrollment_TEACHER); err != nil {
+		t.Error(err)
+	}
+	checkStatus(assistant.ID, qf.Enrollment_TEACHER)
+	if err := update(teacherCookie, assistant.ID, qf.Enrollment_ASSISTANT); err != nil {
+		t.Error(err)
+	}
+	checkStatus(assistant.ID, qf.Enrollment_ASSISTANT)
+
+	// teacher demotes teaching assistant to student, must succeed
+	if err := update(teacherCookie, assistant.ID, qf.Enrollment_STUDENT); err != nil {
+		t.Error(err)
+	}
+	checkStatus(assistant.ID, qf.Enrollment_STUDENT)
+}
+
 func TestUpdateCourseVisibility(t *testing.T) {
 	db, cleanup := qtest.TestDB(t)
 	defer cleanup()
@@ -0,0 +1,163 @@
+package web
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrEnrollmentClosed     = connect.NewError(connect.CodeFailedPrecondition, errors.New("enrollment in this course is closed"))
+	ErrInvalidJoinCode      = connect.NewError(connect.CodePermissionDenied, errors.New("invalid join code"))
+	ErrEnrollmentWaitlisted = connect.NewError(connect.CodeFailedPrecondition, errors.New("enrollment is on the waitlist; increase the course capacity to approve it"))
+)
+
+// getEnrollmentPolicy returns the enrollment policy of the given course.
+// If the course has no policy, an empty policy that allows anyone to enroll is returned.
+func (s *QuickFeedService) getEnrollmentPolicy(courseID uint64) (*qf.EnrollmentPolicy, error) {
+	policy, err := s.db.GetEnrollmentPolicy(courseID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &qf.EnrollmentPolicy{CourseID: courseID}, nil
+		}
+		return nil, fmt.Errorf("failed to get enrollment policy for course %d: %w", courseID, err)
+	}
+	return policy, nil
+}
+
+// createEnrollment creates a pending enrollment for the user in the course, if the course's
+// enrollment policy is open and the request has the course's join code. The enrollment is
+// waitlisted if the course is full, and approved if the roster or the policy allows it.
+func (s *QuickFeedService) createEnrollment(ctx context.Context, request *qf.Enrollment) error {
+	policy, err := s.getEnrollmentPolicy(request.GetCourseID())
+	if err != nil {
+		return err
+	}
+	if !policy.IsOpen(time.Now()) {
+		return ErrEnrollmentClosed
+	}
+	if !policy.IsJoinCode(request.GetJoinCode()) {
+		return ErrInvalidJoinCode
+	}
+	seats, _, err := s.getSeats(request.GetCourseID())
+	if err != nil {
+		return err
+	}
+	enrollment := &qf.Enrollment{
+		UserID:     request.GetUserID(),
+		CourseID:   request.GetCourseID(),
+		Status:     qf.Enrollment_PENDING,
+		Waitlisted: policy.IsFull(seats),
+	}
+	if err := s.db.CreateEnrollment(enrollment); err != nil {
+		return err
+	}
+	if enrollment.GetWaitlisted() {
+		return nil
+	}
+	if err := s.approveEnrollment(ctx, enrollment.GetCourseID(), enrollment.GetUserID()); err != nil {
+		// the enrollment remains pending, such that a teacher can approve it manually
+		s.logger.Errorf("Failed to approve enrollment of user %d in course %d: %v", enrollment.GetUserID(), enrollment.GetCourseID(), err)
+	}
+	return nil
+}
+
+// getSeats returns the number of students and pending enrollments that are not waitlisted,
+// and the course's waitlisted enrollments in the order they were created.
+func (s *QuickFeedService) getSeats(courseID uint64) (int, []*qf.Enrollment, error) {
+	enrollments, err := s.db.GetEnrollmentsByCourse(courseID, qf.Enrollment_PENDING, qf.Enrollment_STUDENT)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get enrollments for course %d: %w", courseID, err)
+	}
+	seats := 0
+	var waitlist []*qf.Enrollment
+	for _, enrollment := range enrollments {
+		if enrollment.GetWaitlisted() {
+			waitlist = append(waitlist, enrollment)
+		} else {
+			seats++
+		}
+	}
+	slices.SortFunc(waitlist, func(a, b *qf.Enrollment) int { return cmp.Compare(a.GetID(), b.GetID()) })
+	return seats, waitlist, nil
+}
+
+// promoteWaitlisted moves waitlisted enrollments off the course's waitlist, in the order they were
+// created, until the course is full again. Promoted enrollments are approved if the roster or
+// the policy allows it, and otherwise remain pending.
+func (s *QuickFeedService) promoteWaitlisted(ctx context.Context, sc scm.SCM, courseID uint64) error {
+	policy, err := s.getEnrollmentPolicy(courseID)
+	if err != nil {
+		return err
+	}
+	seats, waitlist, err := s.getSeats(courseID)
+	if err != nil {
+		return err
+	}
+	for _, enrollment := range waitlist {
+		if policy.IsFull(seats) {
+			break
+		}
+		enrollment.Waitlisted = false
+		if err := s.db.UpdateEnrollment(enrollment); err != nil {
+			return fmt.Errorf("failed to promote %q from the waitlist of course %d: %w", enrollment.GetUser().GetLogin(), courseID, err)
+		}
+		seats++
+		approve, err := s.isPreapproved(policy, enrollment)
+		if err != nil {
+			return err
+		}
+		if !approve {
+			continue
+		}
+		if err := s.enrollStudent(ctx, sc, enrollment); err != nil {
+			s.logger.Errorf("Failed to enroll promoted student %q in course %d: %v", enrollment.GetUser().GetLogin(), courseID, err)
+		}
+	}
+	return nil
+}
+
+// approveEnrollment enrolls the user as a student in the course if the user's pending enrollment
+// is not waitlisted, and the user is on the course's roster or has an email approved by the course's policy.
+func (s *QuickFeedService) approveEnrollment(ctx context.Context, courseID, userID uint64) error {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get enrollment for user %d in course %d: %w", userID, courseID, err)
+	}
+	if !enrollment.IsPending() || enrollment.GetWaitlisted() {
+		return nil
+	}
+	policy, err := s.getEnrollmentPolicy(courseID)
+	if err != nil {
+		return err
+	}
+	approve, err := s.isPreapproved(policy, enrollment)
+	if err != nil || !approve {
+		return err
+	}
+	sc, err := s.getSCMForCourse(ctx, courseID)
+	if err != nil {
+		return fmt.Errorf("failed to get SCM client for course %d: %w", courseID, err)
+	}
+	return s.enrollStudent(ctx, sc, enrollment)
+}
+
+// isPreapproved returns true if the enrollment's user has an email approved by the policy, or is on the course's roster.
+func (s *QuickFeedService) isPreapproved(policy *qf.EnrollmentPolicy, enrollment *qf.Enrollment) (bool, error) {
+	if policy.ApprovesEmail(enrollment.GetUser()) {
+		return true, nil
+	}
+	entries, err := s.db.GetRoster(enrollment.GetCourseID())
+	if err != nil {
+		return false, fmt.Errorf("failed to get roster for course %d: %w", enrollment.GetCourseID(), err)
+	}
+	return onRoster(entries, enrollment.GetUser()), nil
+}
@@ -0,0 +1,120 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/database"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEnrollmentPolicy(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "admin", Login: "admin"})
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockCourses())
+
+	ctx := context.Background()
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	cookie := Cookie(t, tm, admin)
+
+	alice := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Alice", Login: "alice", Email: "alice@UiS.no", EmailVerified: true})
+	bob := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Bob", Login: "bob", Email: "bob@uis.no"})
+	carol := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Carol", Login: "carol", Email: "carol@example.com", EmailVerified: true})
+	dave := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Dave", Login: "dave", Email: "dave@example.com"})
+	eve := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Eve", Login: "eve", Email: "eve@uis.no", EmailVerified: true})
+
+	enroll := func(user *qf.User, joinCode string) error {
+		t.Helper()
+		_, err := client.CreateEnrollment(ctx, qtest.RequestWithCookie(&qf.Enrollment{CourseID: course.ID, UserID: user.ID, JoinCode: joinCode}, Cookie(t, tm, user)))
+		return err
+	}
+	updatePolicy := func(policy *qf.EnrollmentPolicy) {
+		t.Helper()
+		// clone the course, such that the policy is not stored with the shared mock course
+		update := proto.Clone(course).(*qf.Course)
+		update.EnrollmentPolicy = policy
+		if _, err := client.UpdateCourse(ctx, qtest.RequestWithCookie(update, cookie)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	updatePolicy(&qf.EnrollmentPolicy{JoinCode: "secret", EmailDomains: []string{"@uis.no"}, Capacity: 2})
+	if _, err := client.GetEnrollmentPolicy(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, Cookie(t, tm, alice))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("GetEnrollmentPolicy() by student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	policy, err := client.GetEnrollmentPolicy(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policy.Msg.GetJoinCode() != "secret" || policy.Msg.GetCapacity() != 2 {
+		t.Errorf("GetEnrollmentPolicy() = %v, want join code %q and capacity %d", policy.Msg, "secret", 2)
+	}
+
+	if err := enroll(alice, "wrong"); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("CreateEnrollment() with wrong join code: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	// alice has a verified email in the policy's domain, while bob's email is not verified
+	for _, user := range []*qf.User{alice, bob} {
+		if err := enroll(user, " secret "); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkEnrollmentStatus(t, db, course.ID, alice.ID, qf.Enrollment_STUDENT)
+	checkEnrollmentStatus(t, db, course.ID, bob.ID, qf.Enrollment_PENDING)
+	checkWaitlisted(t, db, course.ID, bob.ID, false)
+
+	// the course is full, so carol and dave are waitlisted
+	for _, user := range []*qf.User{carol, dave} {
+		if err := enroll(user, "secret"); err != nil {
+			t.Fatal(err)
+		}
+		checkWaitlisted(t, db, course.ID, user.ID, true)
+	}
+	approve := &qf.Enrollments{Enrollments: []*qf.Enrollment{{CourseID: course.ID, UserID: carol.ID, Status: qf.Enrollment_STUDENT}}}
+	if _, err := client.UpdateEnrollments(ctx, qtest.RequestWithCookie(approve, cookie)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("UpdateEnrollments() approving waitlisted enrollment: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	// rejecting bob promotes carol, who was waitlisted first
+	reject := &qf.Enrollments{Enrollments: []*qf.Enrollment{{CourseID: course.ID, UserID: bob.ID, Status: qf.Enrollment_NONE}}}
+	if _, err := client.UpdateEnrollments(ctx, qtest.RequestWithCookie(reject, cookie)); err != nil {
+		t.Fatal(err)
+	}
+	checkEnrollmentStatus(t, db, course.ID, carol.ID, qf.Enrollment_PENDING)
+	checkWaitlisted(t, db, course.ID, carol.ID, false)
+	checkWaitlisted(t, db, course.ID, dave.ID, true)
+	if _, err := client.UpdateEnrollments(ctx, qtest.RequestWithCookie(approve, cookie)); err != nil {
+		t.Fatal(err)
+	}
+	checkEnrollmentStatus(t, db, course.ID, carol.ID, qf.Enrollment_STUDENT)
+
+	// increasing the capacity promotes dave
+	updatePolicy(&qf.EnrollmentPolicy{JoinCode: "secret", Capacity: 3})
+	checkWaitlisted(t, db, course.ID, dave.ID, false)
+
+	updatePolicy(&qf.EnrollmentPolicy{Closes: timestamppb.New(time.Now().Add(-time.Hour))})
+	if err := enroll(eve, ""); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("CreateEnrollment() after enrollment closed: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+}
+
+func checkWaitlisted(t *testing.T, db database.Database, courseID, userID uint64, want bool) {
+	t.Helper()
+	enrollment, err := db.GetEnrollmentByCourseAndUser(courseID, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetWaitlisted() != want {
+		t.Errorf("enrollment of user %d waitlisted = %t, want %t", userID, enrollment.GetWaitlisted(), want)
+	}
+}
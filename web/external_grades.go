@@ -0,0 +1,85 @@
+package web
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// importExternalGrades imports the scores of the course's students for the external components
+// of the course's grading configuration. Each row of the CSV file has a student ID, a component
+// name and a score between 0 and 100; a header row is skipped. Students are matched by student ID
+// only, and component names are matched regardless of case. Rows that cannot be imported are
+// reported as mismatches, while the other rows replace the students' existing scores.
+func (s *QuickFeedService) importExternalGrades(request *qf.ExternalGradeImportRequest) (*qf.ExternalGradeImport, error) {
+	config, err := s.getGradingConfig(request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	enrollments, err := s.db.GetEnrollmentsByCourse(request.GetCourseID(), qf.Enrollment_STUDENT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enrollments for course %d: %w", request.GetCourseID(), err)
+	}
+	studentsByID := make(map[string][]*qf.Enrollment)
+	for _, enrollment := range enrollments {
+		studentID := enrollment.GetUser().GetStudentID()
+		studentsByID[studentID] = append(studentsByID[studentID], enrollment)
+	}
+	components := make(map[string]string)
+	for _, component := range config.GetComponents() {
+		components[strings.ToLower(component.GetName())] = component.GetName()
+	}
+
+	rows, err := readCSV(request.GetData())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to read CSV file: %w", err))
+	}
+
+	imported := &qf.ExternalGradeImport{}
+	type studentComponent struct{ studentID, component string }
+	seen := make(map[studentComponent]bool)
+	for i, row := range rows {
+		studentID, component, value := csvField(row, 0), csvField(row, 1), csvField(row, 2)
+		// scores may use a decimal comma
+		score, scoreErr := strconv.ParseFloat(strings.Replace(value, ",", ".", 1), 64)
+		if (studentID == "" && component == "" && value == "") || (i == 0 && scoreErr != nil) {
+			// skip empty rows and the header row
+			continue
+		}
+		name, known := components[strings.ToLower(component)]
+		students := studentsByID[studentID]
+		mismatch := &qf.RosterMismatch{StudentID: studentID, Component: component, Row: uint32(i + 1)}
+		if len(students) == 1 {
+			mismatch.Name = students[0].Name()
+		}
+		switch {
+		case !known:
+			mismatch.Kind = qf.RosterMismatch_UNKNOWN_COMPONENT
+		case scoreErr != nil || math.IsNaN(score) || score < 0 || score > 100:
+			mismatch.Kind = qf.RosterMismatch_INVALID_SCORE
+		case len(students) == 0:
+			mismatch.Kind = qf.RosterMismatch_NOT_IN_QUICKFEED
+		case len(students) > 1 || seen[studentComponent{studentID, name}]:
+			mismatch.Kind = qf.RosterMismatch_DUPLICATE_STUDENT_ID
+		default:
+			seen[studentComponent{studentID, name}] = true
+			imported.Grades = append(imported.Grades, &qf.ExternalGrade{
+				EnrollmentID: students[0].GetID(),
+				Component:    name,
+				Score:        uint32(math.Round(score)),
+				Updated:      timestamppb.Now(),
+			})
+			continue
+		}
+		imported.Mismatches = append(imported.Mismatches, mismatch)
+	}
+	if err := s.db.UpdateExternalGrades(imported.GetGrades()); err != nil {
+		return nil, fmt.Errorf("failed to update external grades for course %d: %w", request.GetCourseID(), err)
+	}
+	return imported, nil
+}
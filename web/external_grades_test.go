@@ -0,0 +1,112 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestImportExternalGrades(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	alice := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Alice Doe", Login: "alice", StudentID: "1001"})
+	bob := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Bob Doe", Login: "bob", StudentID: "1002"})
+	bobby := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Bobby Doe", Login: "bobby", StudentID: "1002"})
+	for _, student := range []*qf.User{alice, bob, bobby} {
+		qtest.EnrollStudent(t, db, student, course)
+	}
+	lab := &qf.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &qf.Submission{AssignmentID: lab.ID, UserID: alice.ID, Score: 80, Grades: []*qf.Grade{{UserID: alice.ID, Status: qf.Submission_APPROVED}}}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	config := &qf.GradingConfig{CourseID: course.ID, Components: []*qf.ExternalComponent{{Name: "Exam", Weight: 3}}}
+	if err := db.UpdateGradingConfig(config); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	cookie := Cookie(t, tm, admin)
+
+	csv := "Student ID,Component,Score\n1001,exam,\"71,5\"\n1003,Exam,50\n1002,Exam,60\n1001,Oral,80\n1001,Exam,101\n\n1001,Exam,40\n"
+	request := &qf.ExternalGradeImportRequest{CourseID: course.ID, Data: []byte(csv)}
+	if _, err := client.ImportExternalGrades(ctx, qtest.RequestWithCookie(request, Cookie(t, tm, alice))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("ImportExternalGrades() by student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	imported, err := client.ImportExternalGrades(ctx, qtest.RequestWithCookie(request, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	aliceEnrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, alice.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantGrades := []*qf.ExternalGrade{{EnrollmentID: aliceEnrollment.ID, Component: "Exam", Score: 72}}
+	if diff := cmp.Diff(wantGrades, imported.Msg.GetGrades(), protocmp.Transform(), protocmp.IgnoreFields(&qf.ExternalGrade{}, "ID", "updated")); diff != "" {
+		t.Errorf("ImportExternalGrades() mismatch (-want +got):\n%s", diff)
+	}
+	wantMismatches := []*qf.RosterMismatch{
+		{Kind: qf.RosterMismatch_NOT_IN_QUICKFEED, StudentID: "1003", Component: "Exam", Row: 3},
+		{Kind: qf.RosterMismatch_DUPLICATE_STUDENT_ID, StudentID: "1002", Component: "Exam", Row: 4},
+		{Kind: qf.RosterMismatch_UNKNOWN_COMPONENT, StudentID: "1001", Name: "Alice Doe", Component: "Oral", Row: 5},
+		{Kind: qf.RosterMismatch_INVALID_SCORE, StudentID: "1001", Name: "Alice Doe", Component: "Exam", Row: 6},
+		{Kind: qf.RosterMismatch_DUPLICATE_STUDENT_ID, StudentID: "1001", Name: "Alice Doe", Component: "Exam", Row: 8},
+	}
+	if diff := cmp.Diff(wantMismatches, imported.Msg.GetMismatches(), protocmp.Transform()); diff != "" {
+		t.Errorf("ImportExternalGrades() mismatches (-want +got):\n%s", diff)
+	}
+
+	// importing again replaces the existing score
+	request = &qf.ExternalGradeImportRequest{CourseID: course.ID, Data: []byte("1001,Exam,90\n")}
+	if _, err := client.ImportExternalGrades(ctx, qtest.RequestWithCookie(request, cookie)); err != nil {
+		t.Fatal(err)
+	}
+	enrollmentRequest := &qf.EnrollmentRequest{
+		FetchMode: &qf.EnrollmentRequest_CourseID{CourseID: course.ID},
+		Statuses:  []qf.Enrollment_UserStatus{qf.Enrollment_STUDENT},
+	}
+	enrollments, err := client.GetEnrollments(ctx, qtest.RequestWithCookie(enrollmentRequest, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, enrollment := range enrollments.Msg.GetEnrollments() {
+		var want []*qf.ExternalGrade
+		if enrollment.GetUserID() == alice.ID {
+			want = []*qf.ExternalGrade{{EnrollmentID: enrollment.GetID(), Component: "Exam", Score: 90}}
+		}
+		if diff := cmp.Diff(want, enrollment.GetExternalGrades(), protocmp.Transform(), protocmp.IgnoreFields(&qf.ExternalGrade{}, "ID", "updated")); diff != "" {
+			t.Errorf("GetEnrollments() external grades for %s mismatch (-want +got):\n%s", enrollment.Name(), diff)
+		}
+		for _, grade := range enrollment.GetExternalGrades() {
+			if grade.GetUpdated() == nil {
+				t.Errorf("external grade %v has no update time", grade)
+			}
+		}
+	}
+
+	finalGrades, err := client.ComputeFinalGrades(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, grade := range finalGrades.Msg.GetGrades() {
+		if grade.GetUserID() == alice.ID && grade.GetPoints() != 88 {
+			t.Errorf("ComputeFinalGrades() points for %s = %d, want %d", alice.Name, grade.GetPoints(), 88)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package web
+
+import (
+	"fmt"
+
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+// getFeedbackSnippets returns the course's feedback snippets with the requested tag.
+// If the request has an assignment ID, the snippets are ordered by their number of uses in the assignment.
+func (s *QuickFeedService) getFeedbackSnippets(request *qf.FeedbackSnippetRequest) (*qf.FeedbackSnippets, error) {
+	snippets, err := s.db.GetFeedbackSnippets(&qf.FeedbackSnippet{CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback snippets for course %d: %w", request.GetCourseID(), err)
+	}
+	feedbackSnippets := &qf.FeedbackSnippets{Snippets: snippets}
+	feedbackSnippets.FilterByTag(request.GetTag())
+	if request.GetAssignmentID() > 0 {
+		feedbackSnippets.SortByUsage(request.GetAssignmentID())
+	}
+	return feedbackSnippets, nil
+}
+
+// createFeedbackSnippet adds a new feedback snippet to the course; the snippet has no usage.
+func (s *QuickFeedService) createFeedbackSnippet(snippet *qf.FeedbackSnippet) (*qf.FeedbackSnippet, error) {
+	snippet.ID = 0
+	snippet.Usage = nil
+	if err := s.db.CreateFeedbackSnippet(snippet); err != nil {
+		return nil, fmt.Errorf("failed to create feedback snippet: %w", err)
+	}
+	return snippet, nil
+}
+
+// updateFeedbackSnippet updates the title, body and tags of the given feedback snippet.
+func (s *QuickFeedService) updateFeedbackSnippet(snippet *qf.FeedbackSnippet) (*qf.FeedbackSnippet, error) {
+	if _, err := s.getFeedbackSnippet(snippet.GetCourseID(), snippet.GetID()); err != nil {
+		return nil, err
+	}
+	if err := s.db.UpdateFeedbackSnippet(snippet); err != nil {
+		return nil, fmt.Errorf("failed to update feedback snippet %d: %w", snippet.GetID(), err)
+	}
+	return s.getFeedbackSnippet(snippet.GetCourseID(), snippet.GetID())
+}
+
+// deleteFeedbackSnippet removes the given feedback snippet and its usage.
+func (s *QuickFeedService) deleteFeedbackSnippet(snippet *qf.FeedbackSnippet) error {
+	stored, err := s.getFeedbackSnippet(snippet.GetCourseID(), snippet.GetID())
+	if err != nil {
+		return err
+	}
+	if err := s.db.DeleteFeedbackSnippet(stored); err != nil {
+		return fmt.Errorf("failed to delete feedback snippet %d: %w", stored.GetID(), err)
+	}
+	return nil
+}
+
+// useFeedbackSnippet records that the snippet was inserted into a review of the requested assignment,
+// and returns the snippet with its updated usage.
+func (s *QuickFeedService) useFeedbackSnippet(request *qf.FeedbackSnippetUsageRequest) (*qf.FeedbackSnippet, error) {
+	if _, err := s.getFeedbackSnippet(request.GetCourseID(), request.GetSnippetID()); err != nil {
+		return nil, err
+	}
+	if _, err := s.db.GetAssignment(&qf.Assignment{ID: request.GetAssignmentID(), CourseID: request.GetCourseID()}); err != nil {
+		return nil, fmt.Errorf("failed to get assignment %d: %w", request.GetAssignmentID(), err)
+	}
+	if err := s.db.IncrementFeedbackSnippetUsage(request.GetSnippetID(), request.GetAssignmentID()); err != nil {
+		return nil, fmt.Errorf("failed to update usage of feedback snippet %d: %w", request.GetSnippetID(), err)
+	}
+	return s.getFeedbackSnippet(request.GetCourseID(), request.GetSnippetID())
+}
+
+// getFeedbackSnippet returns the course's feedback snippet with the given ID.
+func (s *QuickFeedService) getFeedbackSnippet(courseID, snippetID uint64) (*qf.FeedbackSnippet, error) {
+	snippets, err := s.db.GetFeedbackSnippets(&qf.FeedbackSnippet{ID: snippetID, CourseID: courseID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback snippet %d: %w", snippetID, err)
+	}
+	if snippetID == 0 || len(snippets) != 1 {
+		return nil, fmt.Errorf("feedback snippet %d not found in course %d", snippetID, courseID)
+	}
+	return snippets[0], nil
+}
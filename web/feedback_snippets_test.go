@@ -0,0 +1,106 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestFeedbackSnippets(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	student := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, student, course)
+
+	lab := &qf.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	cookie := Cookie(t, tm, admin)
+
+	errorHandling := &qf.FeedbackSnippet{CourseID: course.ID, Title: "Errors", Body: "Missing error handling.", Tags: []string{"errors"}}
+	if _, err := client.CreateFeedbackSnippet(ctx, qtest.RequestWithCookie(errorHandling, Cookie(t, tm, student))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("CreateFeedbackSnippet() by student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	createdErrorHandling, err := client.CreateFeedbackSnippet(ctx, qtest.RequestWithCookie(errorHandling, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	goroutineLeak := &qf.FeedbackSnippet{CourseID: course.ID, Title: "Leak", Body: "Goroutine leak.", Tags: []string{"concurrency"}}
+	createdGoroutineLeak, err := client.CreateFeedbackSnippet(ctx, qtest.RequestWithCookie(goroutineLeak, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snippets, err := client.GetFeedbackSnippets(ctx, qtest.RequestWithCookie(&qf.FeedbackSnippetRequest{CourseID: course.ID, Tag: "Concurrency"}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*qf.FeedbackSnippet{createdGoroutineLeak.Msg}, snippets.Msg.GetSnippets(), protocmp.Transform()); diff != "" {
+		t.Errorf("GetFeedbackSnippets(tag) mismatch (-want +got):\n%s", diff)
+	}
+
+	useRequest := &qf.FeedbackSnippetUsageRequest{CourseID: course.ID, SnippetID: createdGoroutineLeak.Msg.GetID(), AssignmentID: lab.ID}
+	for range 2 {
+		if _, err := client.UseFeedbackSnippet(ctx, qtest.RequestWithCookie(useRequest, cookie)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	used, err := client.UseFeedbackSnippet(ctx, qtest.RequestWithCookie(useRequest, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := used.Msg.UsageCount(lab.ID); got != 3 {
+		t.Errorf("UseFeedbackSnippet() usage count = %d, want 3", got)
+	}
+	unknownAssignment := &qf.FeedbackSnippetUsageRequest{CourseID: course.ID, SnippetID: createdGoroutineLeak.Msg.GetID(), AssignmentID: 123}
+	if _, err := client.UseFeedbackSnippet(ctx, qtest.RequestWithCookie(unknownAssignment, cookie)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("UseFeedbackSnippet() for unknown assignment: got %v, want %v", err, connect.CodeInvalidArgument)
+	}
+
+	// the most used snippets in the assignment come first
+	snippets, err = client.GetFeedbackSnippets(ctx, qtest.RequestWithCookie(&qf.FeedbackSnippetRequest{CourseID: course.ID, AssignmentID: lab.ID}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*qf.FeedbackSnippet{used.Msg, createdErrorHandling.Msg}, snippets.Msg.GetSnippets(), protocmp.Transform()); diff != "" {
+		t.Errorf("GetFeedbackSnippets(assignment) mismatch (-want +got):\n%s", diff)
+	}
+
+	// updating a snippet keeps its usage
+	update := &qf.FeedbackSnippet{ID: used.Msg.GetID(), CourseID: course.ID, Title: "Leak", Body: "Goroutine leak; use a context to stop the goroutine.", Tags: []string{"concurrency", "context"}}
+	updated, err := client.UpdateFeedbackSnippet(ctx, qtest.RequestWithCookie(update, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	update.Usage = used.Msg.GetUsage()
+	if diff := cmp.Diff(update, updated.Msg, protocmp.Transform()); diff != "" {
+		t.Errorf("UpdateFeedbackSnippet() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := client.DeleteFeedbackSnippet(ctx, qtest.RequestWithCookie(updated.Msg, cookie)); err != nil {
+		t.Fatal(err)
+	}
+	snippets, err = client.GetFeedbackSnippets(ctx, qtest.RequestWithCookie(&qf.FeedbackSnippetRequest{CourseID: course.ID}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*qf.FeedbackSnippet{createdErrorHandling.Msg}, snippets.Msg.GetSnippets(), protocmp.Transform()); diff != "" {
+		t.Errorf("GetFeedbackSnippets() after delete mismatch (-want +got):\n%s", diff)
+	}
+}
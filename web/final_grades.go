@@ -0,0 +1,84 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// getGradingConfig returns the course's grading configuration.
+// Courses without a grading configuration get an empty configuration,
+// where each assignment counts with weight 1 and no assignments are required.
+func (s *QuickFeedService) getGradingConfig(courseID uint64) (*qf.GradingConfig, error) {
+	config, err := s.db.GetGradingConfig(courseID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &qf.GradingConfig{CourseID: courseID}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grading configuration for course %d: %w", courseID, err)
+	}
+	return config, nil
+}
+
+// updateGradingConfig replaces the course's grading configuration,
+// ensuring that the weighted assignments belong to the course.
+func (s *QuickFeedService) updateGradingConfig(config *qf.GradingConfig) (*qf.GradingConfig, error) {
+	weighted := make(map[uint64]bool)
+	for _, weight := range config.GetWeights() {
+		if weighted[weight.GetAssignmentID()] {
+			return nil, fmt.Errorf("assignment %d has more than one weight", weight.GetAssignmentID())
+		}
+		weighted[weight.GetAssignmentID()] = true
+		if _, err := s.db.GetAssignment(&qf.Assignment{ID: weight.GetAssignmentID(), CourseID: config.GetCourseID()}); err != nil {
+			return nil, fmt.Errorf("failed to get assignment %d in course %d: %w", weight.GetAssignmentID(), config.GetCourseID(), err)
+		}
+	}
+	config.Updated = timestamppb.Now()
+	if err := s.db.UpdateGradingConfig(config); err != nil {
+		return nil, fmt.Errorf("failed to update grading configuration for course %d: %w", config.GetCourseID(), err)
+	}
+	return config, nil
+}
+
+// computeFinalGrades computes the final grade of each student in the course from the course's
+// grading configuration. The grades are ordered by enrollment ID, and returned together with the
+// configuration used, such that the grades can be reproduced from the same submissions.
+func (s *QuickFeedService) computeFinalGrades(courseID uint64) (*qf.FinalGrades, error) {
+	config, err := s.getGradingConfig(courseID)
+	if err != nil {
+		return nil, err
+	}
+	return s.computeFinalGradesWith(config)
+}
+
+// computeFinalGradesWith computes the final grade of each student in the configuration's course
+// from the given grading configuration.
+func (s *QuickFeedService) computeFinalGradesWith(config *qf.GradingConfig) (*qf.FinalGrades, error) {
+	courseID := config.GetCourseID()
+	course, err := s.db.GetCourse(courseID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course %d: %w", courseID, err)
+	}
+	submissions, err := s.db.GetCourseSubmissions(courseID, qf.SubmissionRequest_ALL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get submissions for course %d: %w", courseID, err)
+	}
+	results := makeAllResults(course, submissions)
+
+	finalGrades := &qf.FinalGrades{Config: config}
+	for _, enrollment := range course.GetEnrollments() {
+		if !enrollment.IsStudent() {
+			continue
+		}
+		grade := config.FinalGrade(enrollment, course.GetAssignments(), results[enrollment.GetID()].GetSubmissions(), enrollment.ExternalScores())
+		finalGrades.Grades = append(finalGrades.Grades, grade)
+	}
+	sort.Slice(finalGrades.Grades, func(i, j int) bool {
+		return finalGrades.Grades[i].GetEnrollmentID() < finalGrades.Grades[j].GetEnrollmentID()
+	})
+	return finalGrades, nil
+}
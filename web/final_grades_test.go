@@ -0,0 +1,107 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestComputeFinalGrades(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	student := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, student, course)
+	otherStudent := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, otherStudent, course)
+
+	lab1 := &qf.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	lab2 := &qf.Assignment{CourseID: course.ID, Name: "lab2", Order: 2}
+	for _, lab := range []*qf.Assignment{lab1, lab2} {
+		if err := db.CreateAssignment(lab); err != nil {
+			t.Fatal(err)
+		}
+	}
+	submissions := []*qf.Submission{
+		{AssignmentID: lab1.ID, UserID: student.ID, Score: 80, Grades: []*qf.Grade{{UserID: student.ID, Status: qf.Submission_APPROVED}}},
+		{AssignmentID: lab2.ID, UserID: student.ID, Score: 95, Grades: []*qf.Grade{{UserID: student.ID, Status: qf.Submission_APPROVED}}},
+		{AssignmentID: lab1.ID, UserID: otherStudent.ID, Score: 100, Grades: []*qf.Grade{{UserID: otherStudent.ID, Status: qf.Submission_APPROVED}}},
+	}
+	for _, submission := range submissions {
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+	cookie := Cookie(t, tm, admin)
+
+	config := &qf.GradingConfig{
+		CourseID:    course.ID,
+		Weights:     []*qf.AssignmentWeight{{AssignmentID: lab2.ID, Weight: 3, Required: true}},
+		MinApproved: 1,
+		SchemeName:  "Letter grades",
+		GradePoints: []uint32{90, 60, 0},
+		GradeNames:  []string{"A", "C", "F"},
+	}
+	if _, err := client.UpdateGradingConfig(ctx, qtest.RequestWithCookie(config, Cookie(t, tm, student))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("UpdateGradingConfig() by student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	invalid := &qf.GradingConfig{CourseID: course.ID, GradePoints: []uint32{60}, GradeNames: []string{"Pass", "Fail"}}
+	if _, err := client.UpdateGradingConfig(ctx, qtest.RequestWithCookie(invalid, cookie)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("UpdateGradingConfig() with invalid scheme: got %v, want %v", err, connect.CodeInvalidArgument)
+	}
+	updated, err := client.UpdateGradingConfig(ctx, qtest.RequestWithCookie(config, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotConfig, err := client.GetGradingConfig(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(updated.Msg, gotConfig.Msg, protocmp.Transform()); diff != "" {
+		t.Errorf("GetGradingConfig() mismatch (-want +got):\n%s", diff)
+	}
+
+	finalGrades, err := client.ComputeFinalGrades(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, student.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherEnrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, otherStudent.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantGrades := []*qf.FinalGrade{
+		{EnrollmentID: enrollment.ID, UserID: student.ID, Points: 91, Approved: 2, Passed: true, Grade: "A", Parts: []*qf.GradePart{
+			{AssignmentID: lab1.ID, Name: "lab1", Weight: 1, Score: 80, Approved: true},
+			{AssignmentID: lab2.ID, Name: "lab2", Weight: 3, Score: 95, Approved: true, Required: true},
+		}},
+		// the required lab2 is not approved
+		{EnrollmentID: otherEnrollment.ID, UserID: otherStudent.ID, Points: 25, Approved: 1, Passed: false, Grade: "F", Parts: []*qf.GradePart{
+			{AssignmentID: lab1.ID, Name: "lab1", Weight: 1, Score: 100, Approved: true},
+			{AssignmentID: lab2.ID, Name: "lab2", Weight: 3, Required: true},
+		}},
+	}
+	if diff := cmp.Diff(wantGrades, finalGrades.Msg.GetGrades(), protocmp.Transform()); diff != "" {
+		t.Errorf("ComputeFinalGrades() mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(updated.Msg, finalGrades.Msg.GetConfig(), protocmp.Transform()); diff != "" {
+		t.Errorf("ComputeFinalGrades() config mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,401 @@
+package web
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/360EntSecGroup-Skylar/excelize"
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+const (
+	defaultPassValue = "Passed"
+	defaultFailValue = "Failed"
+	defaultSheetName = "Sheet1"
+)
+
+// ErrNoPassRequirement is returned when exporting pass or fail values without any requirements to pass.
+var ErrNoPassRequirement = connect.NewError(connect.CodeFailedPrecondition,
+	errors.New("cannot export pass or fail values: the grading configuration has no minimum number of approved assignments or required assignments"))
+
+// defaultExportColumns are the columns of a grade export that does not specify its columns.
+var defaultExportColumns = []*qf.ExportColumn{
+	{Field: qf.ExportColumn_NAME, Header: "Name"},
+	{Field: qf.ExportColumn_STUDENT_ID, Header: "Student ID"},
+	{Field: qf.ExportColumn_APPROVED, Header: "Approved"},
+	{Field: qf.ExportColumn_PASSED, Header: "Passed"},
+	{Field: qf.ExportColumn_POINTS, Header: "Points"},
+	{Field: qf.ExportColumn_GRADE, Header: "Grade"},
+}
+
+// isResultField returns true if the field is computed by QuickFeed, and thus filled in on a roster.
+func isResultField(field qf.ExportColumn_Field) bool {
+	switch field {
+	case qf.ExportColumn_APPROVED, qf.ExportColumn_PASSED, qf.ExportColumn_POINTS, qf.ExportColumn_GRADE:
+		return true
+	}
+	return false
+}
+
+// exportStudent is a course student with the student's final grade.
+type exportStudent struct {
+	user  *qf.User
+	grade *qf.FinalGrade
+}
+
+// gradeExporter fills in a sheet with the final grades of a course's students.
+type gradeExporter struct {
+	columns    []*qf.ExportColumn
+	passValue  string
+	failValue  string
+	students   []*exportStudent
+	mismatches []*qf.RosterMismatch
+}
+
+// exportGrades returns the final grades of the course's students as a CSV or XLSX file.
+// If the request has an institution roster, the roster's result columns are filled in
+// for the students in the roster, and the differences between the roster and the
+// course's students are reported. Students are matched by student ID only.
+// The request's minimum number of approved assignments, if set, replaces the grading configuration's.
+// Pass or fail values are only exported if there are requirements to pass, since every student
+// would pass otherwise.
+func (s *QuickFeedService) exportGrades(request *qf.GradeExportRequest) (*qf.GradeExport, error) {
+	config, err := s.getGradingConfig(request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	if request.GetMinApproved() > 0 {
+		config.MinApproved = request.GetMinApproved()
+	}
+	enrollments, err := s.db.GetEnrollmentsByCourse(request.GetCourseID(), qf.Enrollment_STUDENT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enrollments for course %d: %w", request.GetCourseID(), err)
+	}
+	users := make(map[uint64]*qf.User)
+	for _, enrollment := range enrollments {
+		users[enrollment.GetID()] = enrollment.GetUser()
+	}
+	e := &gradeExporter{
+		columns:   request.GetColumns(),
+		passValue: cmp.Or(request.GetPassValue(), defaultPassValue),
+		failValue: cmp.Or(request.GetFailValue(), defaultFailValue),
+	}
+	if len(e.columns) == 0 {
+		e.columns = defaultExportColumns
+	}
+	if !config.HasPassRequirement() && slices.ContainsFunc(e.columns, func(column *qf.ExportColumn) bool {
+		return column.GetField() == qf.ExportColumn_PASSED
+	}) {
+		return nil, ErrNoPassRequirement
+	}
+	finalGrades, err := s.computeFinalGradesWith(config)
+	if err != nil {
+		return nil, err
+	}
+	for _, grade := range finalGrades.GetGrades() {
+		e.students = append(e.students, &exportStudent{user: users[grade.GetEnrollmentID()], grade: grade})
+	}
+	slices.SortStableFunc(e.students, func(a, b *exportStudent) int {
+		return strings.Compare(a.user.GetName(), b.user.GetName())
+	})
+
+	var sheet *gradeSheet
+	if len(request.GetRoster()) == 0 {
+		sheet = e.list()
+	} else {
+		sheet, err = readRoster(request.GetFormat(), request.GetRoster())
+		if err != nil {
+			return nil, err
+		}
+		if err := e.fillIn(sheet); err != nil {
+			return nil, err
+		}
+	}
+	data, err := sheet.write(request.GetFormat())
+	if err != nil {
+		return nil, fmt.Errorf("failed to write grade export for course %d: %w", request.GetCourseID(), err)
+	}
+	return &qf.GradeExport{Data: data, Mismatches: e.mismatches}, nil
+}
+
+// list returns a sheet with a header row and a row for each of the course's students,
+// and reports students that share a student ID.
+func (e *gradeExporter) list() *gradeSheet {
+	sheet := &gradeSheet{}
+	for i, column := range e.columns {
+		sheet.set(0, i, column.GetHeader())
+	}
+	for row, student := range e.students {
+		for i, column := range e.columns {
+			sheet.set(row+1, i, e.value(column.GetField(), student))
+		}
+	}
+	e.reportDuplicates(e.studentsByID())
+	return sheet
+}
+
+// fillIn fills in the result columns of the roster for the students in the roster.
+// Result columns that are missing from the roster are appended to the roster.
+// Students in the roster that are not enrolled in the course get the fail value.
+func (e *gradeExporter) fillIn(sheet *gradeSheet) error {
+	var header []string
+	if len(sheet.rows) > 0 {
+		header = sheet.rows[0]
+	}
+	width := 0
+	for _, row := range sheet.rows {
+		width = max(width, len(row))
+	}
+	columnIndex := func(header []string, name string) int {
+		return slices.IndexFunc(header, func(h string) bool {
+			return strings.EqualFold(strings.TrimSpace(h), strings.TrimSpace(name))
+		})
+	}
+	studentIDColumn := -1
+	var nameColumns []int
+	resultColumns := make(map[int]qf.ExportColumn_Field)
+	for _, column := range e.columns {
+		index := columnIndex(header, column.GetHeader())
+		switch {
+		case isResultField(column.GetField()):
+			if index < 0 {
+				index = width
+				width++
+				sheet.set(0, index, column.GetHeader())
+				header = sheet.rows[0]
+			}
+			resultColumns[index] = column.GetField()
+		case index < 0:
+			// identity columns are only used to match students
+		case column.GetField() == qf.ExportColumn_STUDENT_ID:
+			studentIDColumn = index
+		case column.GetField() == qf.ExportColumn_NAME:
+			nameColumns = append(nameColumns, index)
+		}
+	}
+	if studentIDColumn < 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("roster has no student ID column"))
+	}
+
+	studentsByID := e.studentsByID()
+	e.reportDuplicates(studentsByID)
+	rosterRows := make(map[string]int)
+	for row := 1; row < len(sheet.rows); row++ {
+		studentID := strings.TrimSpace(sheet.cell(row, studentIDColumn))
+		if studentID == "" {
+			continue
+		}
+		var names []string
+		for _, column := range nameColumns {
+			if name := strings.TrimSpace(sheet.cell(row, column)); name != "" {
+				names = append(names, name)
+			}
+		}
+		if _, ok := rosterRows[studentID]; ok {
+			e.report(qf.RosterMismatch_DUPLICATE_STUDENT_ID, studentID, strings.Join(names, " "), row)
+			continue
+		}
+		rosterRows[studentID] = row
+
+		students := studentsByID[studentID]
+		switch len(students) {
+		case 0:
+			e.report(qf.RosterMismatch_NOT_IN_QUICKFEED, studentID, strings.Join(names, " "), row)
+			for column, field := range resultColumns {
+				if field == qf.ExportColumn_PASSED {
+					sheet.set(row, column, e.failValue)
+				}
+			}
+		case 1:
+			for column, field := range resultColumns {
+				sheet.set(row, column, e.value(field, students[0]))
+			}
+		default:
+			// the duplicates are already reported; leave it to the teacher to decide
+		}
+	}
+	for _, student := range e.students {
+		if _, ok := rosterRows[student.user.GetStudentID()]; !ok {
+			e.report(qf.RosterMismatch_NOT_IN_ROSTER, student.user.GetStudentID(), student.user.GetName(), -1)
+		}
+	}
+	for column := range resultColumns {
+		sheet.filled = append(sheet.filled, column)
+	}
+	return nil
+}
+
+// studentsByID returns the course's students keyed by student ID.
+func (e *gradeExporter) studentsByID() map[string][]*exportStudent {
+	studentsByID := make(map[string][]*exportStudent)
+	for _, student := range e.students {
+		studentID := student.user.GetStudentID()
+		studentsByID[studentID] = append(studentsByID[studentID], student)
+	}
+	return studentsByID
+}
+
+// reportDuplicates reports the course's students that share a student ID.
+func (e *gradeExporter) reportDuplicates(studentsByID map[string][]*exportStudent) {
+	for _, student := range e.students {
+		studentID := student.user.GetStudentID()
+		if studentID != "" && len(studentsByID[studentID]) > 1 {
+			e.report(qf.RosterMismatch_DUPLICATE_STUDENT_ID, studentID, student.user.GetName(), -1)
+		}
+	}
+}
+
+// report records a mismatch; row is the zero-based roster row, or negative if not in the roster.
+func (e *gradeExporter) report(kind qf.RosterMismatch_Kind, studentID, name string, row int) {
+	e.mismatches = append(e.mismatches, &qf.RosterMismatch{
+		Kind:      kind,
+		StudentID: studentID,
+		Name:      name,
+		Row:       uint32(row + 1),
+	})
+}
+
+// value returns the student's value for the given field.
+func (e *gradeExporter) value(field qf.ExportColumn_Field, student *exportStudent) string {
+	switch field {
+	case qf.ExportColumn_NAME:
+		return student.user.GetName()
+	case qf.ExportColumn_STUDENT_ID:
+		return student.user.GetStudentID()
+	case qf.ExportColumn_EMAIL:
+		return student.user.GetEmail()
+	case qf.ExportColumn_LOGIN:
+		return student.user.GetLogin()
+	case qf.ExportColumn_APPROVED:
+		return strconv.Itoa(int(student.grade.GetApproved()))
+	case qf.ExportColumn_PASSED:
+		if student.grade.GetPassed() {
+			return e.passValue
+		}
+		return e.failValue
+	case qf.ExportColumn_POINTS:
+		return strconv.Itoa(int(student.grade.GetPoints()))
+	case qf.ExportColumn_GRADE:
+		return student.grade.GetGrade()
+	}
+	return ""
+}
+
+// gradeSheet holds the rows of a grade export. If the export fills in an uploaded
+// XLSX roster, the roster's workbook is kept such that only the filled in columns
+// are changed, preserving the roster's formatting and other sheets.
+type gradeSheet struct {
+	rows     [][]string
+	filled   []int          // columns filled in on the workbook
+	workbook *excelize.File // the uploaded XLSX roster, if any
+	name     string         // name of the roster's sheet
+}
+
+// readRoster returns the rows of a CSV roster, or of the active sheet of an XLSX roster.
+func readRoster(format qf.GradeExportRequest_Format, data []byte) (*gradeSheet, error) {
+	if format == qf.GradeExportRequest_XLSX {
+		workbook, err := excelize.OpenReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to read XLSX roster: %w", err))
+		}
+		name := workbook.GetSheetName(workbook.GetActiveSheetIndex())
+		return &gradeSheet{rows: workbook.GetRows(name), workbook: workbook, name: name}, nil
+	}
+	rows, err := readCSV(data)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to read CSV roster: %w", err))
+	}
+	return &gradeSheet{rows: rows}, nil
+}
+
+// readCSV returns the rows of a CSV file, allowing a different number of fields on each row.
+// Empty lines are kept as empty rows, such that row i starts on line i+1 of the file.
+func readCSV(data []byte) ([][]string, error) {
+	// spreadsheet applications may prefix exported CSV files with a byte order mark
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+	var rows [][]string
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		for len(rows) < line-1 {
+			rows = append(rows, nil)
+		}
+		rows = append(rows, record)
+	}
+}
+
+// csvField returns the given field of a CSV row without surrounding spaces,
+// or the empty string if the row has fewer fields.
+func csvField(row []string, column int) string {
+	if column < len(row) {
+		return strings.TrimSpace(row[column])
+	}
+	return ""
+}
+
+// cell returns the value of the given cell, or the empty string if the cell is outside the sheet.
+func (g *gradeSheet) cell(row, column int) string {
+	if row >= len(g.rows) || column >= len(g.rows[row]) {
+		return ""
+	}
+	return g.rows[row][column]
+}
+
+// set sets the value of the given cell, extending the sheet as needed.
+func (g *gradeSheet) set(row, column int, value string) {
+	for len(g.rows) <= row {
+		g.rows = append(g.rows, nil)
+	}
+	for len(g.rows[row]) <= column {
+		g.rows[row] = append(g.rows[row], "")
+	}
+	g.rows[row][column] = value
+}
+
+// write returns the sheet as a CSV or XLSX file.
+func (g *gradeSheet) write(format qf.GradeExportRequest_Format) ([]byte, error) {
+	if format == qf.GradeExportRequest_XLSX {
+		return g.writeXLSX()
+	}
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.WriteAll(g.rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (g *gradeSheet) writeXLSX() ([]byte, error) {
+	workbook, name := g.workbook, g.name
+	if workbook == nil {
+		workbook, name = excelize.NewFile(), defaultSheetName
+	}
+	for row, values := range g.rows {
+		for column, value := range values {
+			if g.workbook != nil && !slices.Contains(g.filled, column) {
+				continue
+			}
+			workbook.SetCellStr(name, excelize.ToAlphaString(column)+strconv.Itoa(row+1), value)
+		}
+	}
+	buf, err := workbook.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,176 @@
+package web_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/360EntSecGroup-Skylar/excelize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestExportGrades(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	alice := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Alice Doe", Login: "alice", StudentID: "1001"})
+	bob := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Bob Doe", Login: "bob", StudentID: "1002"})
+	carol := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Carol Doe", Login: "carol", StudentID: "1003"})
+	for _, student := range []*qf.User{alice, bob, carol} {
+		qtest.EnrollStudent(t, db, student, course)
+	}
+
+	lab := &qf.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &qf.Submission{AssignmentID: lab.ID, UserID: alice.ID, Score: 90, Grades: []*qf.Grade{{UserID: alice.ID, Status: qf.Submission_APPROVED}}}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	cookie := Cookie(t, tm, admin)
+
+	// without requirements to pass, every student would pass
+	if _, err := client.ExportGrades(ctx, qtest.RequestWithCookie(&qf.GradeExportRequest{CourseID: course.ID}, cookie)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("ExportGrades() without requirements to pass: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+	limitExport, err := client.ExportGrades(ctx, qtest.RequestWithCookie(&qf.GradeExportRequest{
+		CourseID:    course.ID,
+		Columns:     []*qf.ExportColumn{{Field: qf.ExportColumn_NAME, Header: "Name"}, {Field: qf.ExportColumn_PASSED, Header: "Passed"}},
+		MinApproved: 1,
+	}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantLimitRows := [][]string{
+		{"Name", "Passed"},
+		{"Alice Doe", "Passed"},
+		{"Bob Doe", "Failed"},
+		{"Carol Doe", "Failed"},
+	}
+	if diff := cmp.Diff(wantLimitRows, readCSV(t, limitExport.Msg.GetData())); diff != "" {
+		t.Errorf("ExportGrades() with pass limit mismatch (-want +got):\n%s", diff)
+	}
+
+	config := &qf.GradingConfig{CourseID: course.ID, MinApproved: 1, GradePoints: []uint32{60, 0}, GradeNames: []string{"P", "F"}}
+	if err := db.UpdateGradingConfig(config); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.ExportGrades(ctx, qtest.RequestWithCookie(&qf.GradeExportRequest{CourseID: course.ID}, Cookie(t, tm, alice))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("ExportGrades() by student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+
+	export, err := client.ExportGrades(ctx, qtest.RequestWithCookie(&qf.GradeExportRequest{CourseID: course.ID}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRows := [][]string{
+		{"Name", "Student ID", "Approved", "Passed", "Points", "Grade"},
+		{"Alice Doe", "1001", "1", "Passed", "90", "P"},
+		{"Bob Doe", "1002", "0", "Failed", "0", "F"},
+		{"Carol Doe", "1003", "0", "Failed", "0", "F"},
+	}
+	if diff := cmp.Diff(wantRows, readCSV(t, export.Msg.GetData())); diff != "" {
+		t.Errorf("ExportGrades() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Norwegian institution roster with separate name columns and no approval column
+	roster := "Fornavn,Etternavn,Studentnr.\nAlice,Doe,1001\nBob,Doe,1002\nDave,Doe,1004\nBobby,Doe,1002\n"
+	request := &qf.GradeExportRequest{
+		CourseID: course.ID,
+		Columns: []*qf.ExportColumn{
+			{Field: qf.ExportColumn_NAME, Header: "Fornavn"},
+			{Field: qf.ExportColumn_NAME, Header: "Etternavn"},
+			{Field: qf.ExportColumn_STUDENT_ID, Header: "studentnr."},
+			{Field: qf.ExportColumn_PASSED, Header: "Godkjenning"},
+		},
+		Roster:    []byte(roster),
+		PassValue: "Godkjent",
+		FailValue: "Ikke godkjent",
+	}
+	export, err = client.ExportGrades(ctx, qtest.RequestWithCookie(request, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRows = [][]string{
+		{"Fornavn", "Etternavn", "Studentnr.", "Godkjenning"},
+		{"Alice", "Doe", "1001", "Godkjent"},
+		{"Bob", "Doe", "1002", "Ikke godkjent"},
+		{"Dave", "Doe", "1004", "Ikke godkjent"},
+		{"Bobby", "Doe", "1002"},
+	}
+	if diff := cmp.Diff(wantRows, readCSV(t, export.Msg.GetData())); diff != "" {
+		t.Errorf("ExportGrades() mismatch (-want +got):\n%s", diff)
+	}
+	wantMismatches := []*qf.RosterMismatch{
+		{Kind: qf.RosterMismatch_NOT_IN_QUICKFEED, StudentID: "1004", Name: "Dave Doe", Row: 4},
+		{Kind: qf.RosterMismatch_DUPLICATE_STUDENT_ID, StudentID: "1002", Name: "Bobby Doe", Row: 5},
+		{Kind: qf.RosterMismatch_NOT_IN_ROSTER, StudentID: "1003", Name: "Carol Doe"},
+	}
+	if diff := cmp.Diff(wantMismatches, export.Msg.GetMismatches(), protocmp.Transform()); diff != "" {
+		t.Errorf("ExportGrades() mismatches (-want +got):\n%s", diff)
+	}
+
+	// the roster's existing approval column is filled in, leaving the other sheets intact
+	workbook := excelize.NewFile()
+	workbook.NewSheet("Info")
+	workbook.SetCellStr("Info", "A1", "Exam registrations")
+	for cell, value := range map[string]string{"A1": "Student ID", "B1": "Approved", "A2": "1003", "A3": "1001"} {
+		workbook.SetCellStr("Sheet1", cell, value)
+	}
+	buf, err := workbook.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	request = &qf.GradeExportRequest{CourseID: course.ID, Format: qf.GradeExportRequest_XLSX, Roster: buf.Bytes()}
+	export, err = client.ExportGrades(ctx, qtest.RequestWithCookie(request, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	filled, err := excelize.OpenReader(bytes.NewReader(export.Msg.GetData()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRows = [][]string{
+		{"Student ID", "Approved", "Passed", "Points", "Grade"},
+		{"1003", "0", "Failed", "0", "F"},
+		{"1001", "1", "Passed", "90", "P"},
+	}
+	if diff := cmp.Diff(wantRows, filled.GetRows("Sheet1")); diff != "" {
+		t.Errorf("ExportGrades() mismatch (-want +got):\n%s", diff)
+	}
+	if got := filled.GetCellValue("Info", "A1"); got != "Exam registrations" {
+		t.Errorf("ExportGrades() changed other sheet: got %q, want %q", got, "Exam registrations")
+	}
+
+	request = &qf.GradeExportRequest{CourseID: course.ID, Roster: []byte("Name,Approved\nAlice Doe,\n")}
+	if _, err := client.ExportGrades(ctx, qtest.RequestWithCookie(request, cookie)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("ExportGrades() without student ID column: got %v, want %v", err, connect.CodeInvalidArgument)
+	}
+}
+
+func readCSV(t *testing.T, data []byte) [][]string {
+	t.Helper()
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
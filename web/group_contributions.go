@@ -0,0 +1,214 @@
+package web
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultContributionThreshold is the percent of an equal share of a group's changed lines
+	// below which a member is flagged, if the request does not specify a threshold.
+	defaultContributionThreshold = 50
+	// noreplyDomain is the domain of the private commit emails that GitHub creates for its users.
+	noreplyDomain = "@users.noreply.github.com"
+)
+
+// getGroupContributions clones or updates the group's repository, and returns the contributions
+// of the group's current and former members to the repository.
+func (s *QuickFeedService) getGroupContributions(ctx context.Context, sc scm.SCM, request *qf.ContributionRequest) (*qf.GroupContributions, error) {
+	course, group, err := s.getCourseGroup(&qf.GroupRequest{CourseID: request.GetCourseID(), GroupID: request.GetGroupID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group %d: %w", request.GetGroupID(), err)
+	}
+	if group.GetCourseID() != course.GetID() {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("group %d not found in course %d", group.GetID(), course.GetID()))
+	}
+	repo, err := s.getRepo(course, group.GetID(), qf.Repository_GROUP)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("repository for group %q not found: %w", group.GetName(), err))
+	}
+	members, err := s.groupContributors(group)
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := s.db.GetAssignmentsByCourse(course.GetID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assignments in course %d: %w", course.GetID(), err)
+	}
+	repoDir, err := sc.Clone(ctx, &scm.CloneOptions{
+		Organization: course.GetScmOrganizationName(),
+		Repository:   repo.Name(),
+		DestDir:      course.CloneDir(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone repository %q: %w", repo.Name(), err)
+	}
+	threshold := request.GetThreshold()
+	if threshold == 0 {
+		threshold = defaultContributionThreshold
+	}
+	contributions, err := analyzeContributions(repoDir, members, assignments, threshold)
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze repository %q: %w", repo.Name(), err)
+	}
+	contributions.GroupID = group.GetID()
+	return contributions, nil
+}
+
+// groupContributors returns the group's current members, followed by its former members.
+func (s *QuickFeedService) groupContributors(group *qf.Group) ([]*qf.User, error) {
+	memberships, err := s.db.GetGroupMemberships(&qf.GroupMembership{GroupID: group.GetID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get membership history of group %q: %w", group.GetName(), err)
+	}
+	members := slices.Clone(group.GetUsers())
+	for _, membership := range memberships {
+		if membership.GetUser() == nil || slices.ContainsFunc(members, func(u *qf.User) bool { return u.GetID() == membership.GetUserID() }) {
+			continue
+		}
+		members = append(members, membership.GetUser())
+	}
+	return members, nil
+}
+
+// analyzeContributions attributes the non-merge commits reachable from the repository's HEAD
+// to the given members, and counts each member's commits and changed lines, in total, per
+// assignment folder and per day. A member is flagged if their share of the changed lines is
+// below the threshold percent of an equal share.
+func analyzeContributions(repoDir string, members []*qf.User, assignments []*qf.Assignment, threshold uint32) (*qf.GroupContributions, error) {
+	contributions := &qf.GroupContributions{Threshold: threshold}
+	index := make(map[uint64]*qf.MemberContribution)
+	for _, member := range members {
+		contribution := &qf.MemberContribution{UserID: member.GetID(), User: member}
+		contributions.Members = append(contributions.Members, contribution)
+		index[member.GetID()] = contribution
+	}
+	folders := make(map[string]*qf.Assignment)
+	for _, assignment := range assignments {
+		folders[assignment.GetName()] = assignment
+	}
+
+	r, err := git.PlainOpen(repoDir)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := r.Log(&git.LogOptions{})
+	if err != nil {
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			// the repository has no commits
+			return contributions, nil
+		}
+		return nil, err
+	}
+	err = commits.ForEach(func(commit *object.Commit) error {
+		if commit.NumParents() > 1 {
+			// merge commits only combine the work of other commits
+			return nil
+		}
+		author := commitAuthor(members, commit.Author)
+		if author == nil {
+			contributions.UnattributedCommits++
+			return nil
+		}
+		stats, err := commit.Stats()
+		if err != nil {
+			return fmt.Errorf("failed to compute changes in commit %s: %w", commit.Hash, err)
+		}
+		addCommit(index[author.GetID()], commit.Author.When, stats, folders)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var total uint64
+	for _, member := range contributions.GetMembers() {
+		total += uint64(member.GetLinesAdded() + member.GetLinesRemoved())
+	}
+	for _, member := range contributions.GetMembers() {
+		if total > 0 {
+			changed := uint64(member.GetLinesAdded() + member.GetLinesRemoved())
+			member.Share = uint32(changed * 100 / total)
+			// the share is below the threshold percent of an equal share, 100/len(members) percent
+			member.Flagged = changed*uint64(len(members))*100 < total*uint64(threshold)
+		}
+		slices.SortFunc(member.Assignments, func(a, b *qf.AssignmentContribution) int { return cmp.Compare(a.GetFolder(), b.GetFolder()) })
+		slices.SortFunc(member.Activity, func(a, b *qf.ContributionActivity) int {
+			return a.GetDate().AsTime().Compare(b.GetDate().AsTime())
+		})
+	}
+	return contributions, nil
+}
+
+// addCommit adds a commit with the given time and file changes to the member's contribution.
+// The changes to files in an assignment's folder are also added to the member's contribution to the assignment.
+func addCommit(member *qf.MemberContribution, when time.Time, stats object.FileStats, folders map[string]*qf.Assignment) {
+	member.Commits++
+	var changed uint32
+	touched := make(map[string]bool)
+	for _, file := range stats {
+		added, removed := uint32(file.Addition), uint32(file.Deletion)
+		member.LinesAdded += added
+		member.LinesRemoved += removed
+		changed += added + removed
+
+		folder, _, _ := strings.Cut(file.Name, "/")
+		assignment, ok := folders[folder]
+		if !ok {
+			continue
+		}
+		i := slices.IndexFunc(member.GetAssignments(), func(a *qf.AssignmentContribution) bool { return a.GetFolder() == folder })
+		if i < 0 {
+			member.Assignments = append(member.Assignments, &qf.AssignmentContribution{AssignmentID: assignment.GetID(), Folder: folder})
+			i = len(member.Assignments) - 1
+		}
+		if !touched[folder] {
+			member.Assignments[i].Commits++
+			touched[folder] = true
+		}
+		member.Assignments[i].LinesAdded += added
+		member.Assignments[i].LinesRemoved += removed
+	}
+
+	day := when.UTC().Truncate(24 * time.Hour)
+	i := slices.IndexFunc(member.GetActivity(), func(a *qf.ContributionActivity) bool { return a.GetDate().AsTime().Equal(day) })
+	if i < 0 {
+		member.Activity = append(member.Activity, &qf.ContributionActivity{Date: timestamppb.New(day)})
+		i = len(member.Activity) - 1
+	}
+	member.Activity[i].Commits++
+	member.Activity[i].LinesChanged += changed
+}
+
+// commitAuthor returns the member that authored a commit with the given signature, or nil if none did.
+// The signature matches a member's email, a GitHub private commit email with the member's login,
+// or an author name that is the member's login.
+func commitAuthor(members []*qf.User, author object.Signature) *qf.User {
+	login := ""
+	if local, found := strings.CutSuffix(strings.ToLower(author.Email), noreplyDomain); found {
+		// private commit emails are either ID+login@users.noreply.github.com or login@users.noreply.github.com
+		login = local[strings.Index(local, "+")+1:]
+	}
+	for _, member := range members {
+		switch {
+		case member.GetEmail() != "" && strings.EqualFold(member.GetEmail(), author.Email):
+			return member
+		case member.GetLogin() != "" && (strings.EqualFold(member.GetLogin(), login) || strings.EqualFold(member.GetLogin(), author.Name)):
+			return member
+		}
+	}
+	return nil
+}
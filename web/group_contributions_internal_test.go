@@ -0,0 +1,88 @@
+package web
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAnalyzeContributions(t *testing.T) {
+	dir := t.TempDir()
+	r, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	day1 := time.Date(2024, 9, 2, 10, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	commit := func(file string, lines int, author object.Signature) {
+		t.Helper()
+		path := filepath.Join(dir, file)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(strings.Repeat("line\n", lines)), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Add(file); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Commit("update "+file, &git.CommitOptions{Author: &author}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	alice := &qf.User{ID: 1, Login: "alice", Email: "alice@uis.no"}
+	bob := &qf.User{ID: 2, Login: "bob", Email: "bob@uis.no"}
+	carol := &qf.User{ID: 3, Login: "carol", Email: "carol@uis.no"}
+	lab1 := &qf.Assignment{ID: 10, Name: "lab1"}
+	lab2 := &qf.Assignment{ID: 20, Name: "lab2"}
+
+	// alice commits with her email, bob with his GitHub private email, and carol under her login
+	commit("lab1/main.go", 40, object.Signature{Name: "Alice", Email: "Alice@UiS.no", When: day1})
+	commit("lab1/main.go", 50, object.Signature{Name: "Alice", Email: "alice@uis.no", When: day1.Add(time.Hour)})
+	commit("lab2/main.go", 30, object.Signature{Name: "Bob", Email: "123+bob@users.noreply.github.com", When: day2})
+	commit("README.md", 10, object.Signature{Name: "carol", Email: "carol@laptop.local", When: day2})
+	commit("lab2/main.go", 35, object.Signature{Name: "Mallory", Email: "mallory@example.com", When: day2})
+
+	got, err := analyzeContributions(dir, []*qf.User{alice, bob, carol}, []*qf.Assignment{lab1, lab2}, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &qf.GroupContributions{
+		Threshold:           50,
+		UnattributedCommits: 1,
+		Members: []*qf.MemberContribution{
+			{
+				UserID: alice.ID, User: alice, Commits: 2, LinesAdded: 50, LinesRemoved: 0, Share: 55,
+				Assignments: []*qf.AssignmentContribution{{AssignmentID: lab1.ID, Folder: "lab1", Commits: 2, LinesAdded: 50}},
+				Activity:    []*qf.ContributionActivity{{Date: timestamppb.New(day1.Truncate(24 * time.Hour)), Commits: 2, LinesChanged: 50}},
+			},
+			{
+				UserID: bob.ID, User: bob, Commits: 1, LinesAdded: 30, Share: 33,
+				Assignments: []*qf.AssignmentContribution{{AssignmentID: lab2.ID, Folder: "lab2", Commits: 1, LinesAdded: 30}},
+				Activity:    []*qf.ContributionActivity{{Date: timestamppb.New(day2.Truncate(24 * time.Hour)), Commits: 1, LinesChanged: 30}},
+			},
+			{
+				// carol changed 10 of 90 lines, which is below half of an equal share
+				UserID: carol.ID, User: carol, Commits: 1, LinesAdded: 10, Share: 11, Flagged: true,
+				Activity: []*qf.ContributionActivity{{Date: timestamppb.New(day2.Truncate(24 * time.Hour)), Commits: 1, LinesChanged: 10}},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("analyzeContributions() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,47 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+)
+
+func TestGetGroupContributions(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	alice := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Alice", Login: "alice"})
+	bob := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Bob", Login: "bob"})
+	for _, user := range []*qf.User{alice, bob} {
+		qtest.EnrollStudent(t, db, user, course)
+	}
+	group := &qf.Group{Name: "pending", CourseID: course.ID, Users: []*qf.User{alice, bob}}
+	if err := db.CreateGroup(group); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	request := &qf.ContributionRequest{CourseID: course.ID, GroupID: group.ID}
+	if _, err := client.GetGroupContributions(ctx, qtest.RequestWithCookie(request, Cookie(t, tm, alice))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("GetGroupContributions() by student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	cookie := Cookie(t, tm, admin)
+	invalid := &qf.ContributionRequest{CourseID: course.ID, GroupID: group.ID, Threshold: 101}
+	if _, err := client.GetGroupContributions(ctx, qtest.RequestWithCookie(invalid, cookie)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("GetGroupContributions() with threshold above 100: got %v, want %v", err, connect.CodeInvalidArgument)
+	}
+	// the group is not approved, and has no repository to analyze
+	if _, err := client.GetGroupContributions(ctx, qtest.RequestWithCookie(request, cookie)); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("GetGroupContributions() for group without repository: got %v, want %v", err, connect.CodeNotFound)
+	}
+}
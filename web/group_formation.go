@@ -0,0 +1,324 @@
+package web
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+)
+
+// proposeGroups proposes groups for the course's students who are not in a group, using the requested strategy.
+// The group sizes are within the course's group size bounds, and differ by at most one student.
+func (s *QuickFeedService) proposeGroups(request *qf.GroupFormationRequest) (*qf.Groups, error) {
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course %d: %w", request.GetCourseID(), err)
+	}
+	enrollments, err := s.db.GetEnrollmentsByCourse(course.GetID(), qf.Enrollment_STUDENT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get students in course %d: %w", course.GetID(), err)
+	}
+	var students []*qf.Enrollment
+	for _, enrollment := range enrollments {
+		if enrollment.GetGroupID() == 0 {
+			students = append(students, enrollment)
+		}
+	}
+	sizes, err := groupSizes(len(students), course.GetMinGroupSize(), course.GetMaxGroupSize())
+	if err != nil {
+		return nil, err
+	}
+	// random order breaks ties in the other strategies
+	rand.Shuffle(len(students), func(i, j int) { students[i], students[j] = students[j], students[i] })
+
+	var members [][]*qf.Enrollment
+	switch request.GetStrategy() {
+	case qf.GroupFormationRequest_RANDOM:
+		members = dealGroups(students, sizes)
+	case qf.GroupFormationRequest_BALANCED:
+		grades, err := s.computeFinalGrades(course.GetID())
+		if err != nil {
+			return nil, err
+		}
+		points := make(map[uint64]uint32)
+		for _, grade := range grades.GetGrades() {
+			points[grade.GetEnrollmentID()] = grade.GetPoints()
+		}
+		members = balancedGroups(students, sizes, points)
+	case qf.GroupFormationRequest_PREFERENCES:
+		preferences, err := s.db.GetGroupPreferences(&qf.GroupPreference{CourseID: course.GetID()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get group preferences in course %d: %w", course.GetID(), err)
+		}
+		members = preferredGroups(students, sizes, preferences)
+	}
+
+	names, err := s.groupNames(course.GetID(), len(members))
+	if err != nil {
+		return nil, err
+	}
+	proposal := &qf.Groups{}
+	for i, group := range members {
+		users := make([]*qf.User, len(group))
+		for j, enrollment := range group {
+			users[j] = enrollment.GetUser()
+		}
+		slices.SortFunc(users, func(a, b *qf.User) int { return cmp.Compare(a.GetName(), b.GetName()) })
+		proposal.Groups = append(proposal.Groups, &qf.Group{Name: names[i], CourseID: course.GetID(), Users: users})
+	}
+	return proposal, nil
+}
+
+// groupSizes returns the sizes of the groups that the given number of students are divided into:
+// as few groups as possible of at most maxSize students, with sizes that differ by at most one.
+func groupSizes(students int, minSize, maxSize uint32) ([]int, error) {
+	if maxSize == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the course has no maximum group size"))
+	}
+	minSize = max(minSize, 1)
+	if students == 0 {
+		return nil, nil
+	}
+	groups := (students + int(maxSize) - 1) / int(maxSize)
+	if students/groups < int(minSize) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("cannot divide %d students into groups of %d to %d students", students, minSize, maxSize))
+	}
+	sizes := make([]int, groups)
+	for i := range sizes {
+		sizes[i] = students / groups
+		if i < students%groups {
+			sizes[i]++
+		}
+	}
+	return sizes, nil
+}
+
+// dealGroups divides the students into groups of the given sizes, in the students' order.
+func dealGroups(students []*qf.Enrollment, sizes []int) [][]*qf.Enrollment {
+	groups := make([][]*qf.Enrollment, len(sizes))
+	for i, size := range sizes {
+		groups[i], students = students[:size], students[size:]
+	}
+	return groups
+}
+
+// balancedGroups divides the students into groups of the given sizes, such that each group
+// has a similar mix of students with high and low points. Students are picked in order of
+// decreasing points, alternating the direction in which the groups pick each round.
+func balancedGroups(students []*qf.Enrollment, sizes []int, points map[uint64]uint32) [][]*qf.Enrollment {
+	ranked := slices.Clone(students)
+	slices.SortStableFunc(ranked, func(a, b *qf.Enrollment) int { return cmp.Compare(points[b.GetID()], points[a.GetID()]) })
+
+	groups := make([][]*qf.Enrollment, len(sizes))
+	order := make([]int, len(sizes))
+	for i := range order {
+		order[i] = i
+	}
+	for len(ranked) > 0 {
+		for _, i := range order {
+			if len(ranked) == 0 {
+				break
+			}
+			if len(groups[i]) < sizes[i] {
+				groups[i] = append(groups[i], ranked[0])
+				ranked = ranked[1:]
+			}
+		}
+		slices.Reverse(order)
+	}
+	return groups
+}
+
+// preferredGroups divides the students into groups of the given sizes, keeping students who
+// prefer each other together, as long as they fit in a group. The remaining students fill the
+// groups in the students' order.
+func preferredGroups(students []*qf.Enrollment, sizes []int, preferences []*qf.GroupPreference) [][]*qf.Enrollment {
+	index := make(map[uint64]int)
+	for i, enrollment := range students {
+		index[enrollment.GetUserID()] = i
+	}
+	prefers := make(map[[2]uint64]bool)
+	for _, preference := range preferences {
+		for _, partnerID := range preference.GetPartnerIDs() {
+			prefers[[2]uint64{preference.GetUserID(), partnerID}] = true
+		}
+	}
+
+	// join students who prefer each other into clusters that fit in the largest group
+	cluster := make([]int, len(students))
+	members := make([][]int, len(students))
+	for i := range students {
+		cluster[i] = i
+		members[i] = []int{i}
+	}
+	for _, preference := range preferences {
+		i, ok := index[preference.GetUserID()]
+		if !ok {
+			continue
+		}
+		for _, partnerID := range preference.GetPartnerIDs() {
+			j, ok := index[partnerID]
+			if !ok || !prefers[[2]uint64{partnerID, preference.GetUserID()}] {
+				continue
+			}
+			a, b := cluster[i], cluster[j]
+			if a == b || len(members[a])+len(members[b]) > slices.Max(sizes) {
+				continue
+			}
+			for _, k := range members[b] {
+				cluster[k] = a
+			}
+			members[a], members[b] = append(members[a], members[b]...), nil
+		}
+	}
+	var clusters [][]int
+	for _, m := range members {
+		if len(m) > 0 {
+			clusters = append(clusters, m)
+		}
+	}
+	// place the largest clusters first, each in the group with the most free seats
+	slices.SortStableFunc(clusters, func(a, b []int) int { return cmp.Compare(len(b), len(a)) })
+	groups := make([][]*qf.Enrollment, len(sizes))
+	free := slices.Clone(sizes)
+	var rest []*qf.Enrollment
+	for _, c := range clusters {
+		g := 0
+		for i := range free {
+			if free[i] > free[g] {
+				g = i
+			}
+		}
+		if len(c) > free[g] {
+			// the cluster does not fit in any group
+			for _, k := range c {
+				rest = append(rest, students[k])
+			}
+			continue
+		}
+		for _, k := range c {
+			groups[g] = append(groups[g], students[k])
+		}
+		free[g] -= len(c)
+	}
+	for g := range groups {
+		groups[g], rest = append(groups[g], rest[:free[g]]...), rest[free[g]:]
+	}
+	return groups
+}
+
+// groupNames returns the given number of group names that are not used by the course's groups.
+func (s *QuickFeedService) groupNames(courseID uint64, count int) ([]string, error) {
+	groups, err := s.db.GetGroupsByCourse(courseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get groups in course %d: %w", courseID, err)
+	}
+	used := make(map[string]bool)
+	for _, group := range groups {
+		used[strings.ToLower(group.GetName())] = true
+	}
+	var names []string
+	for i := 1; len(names) < count; i++ {
+		name := fmt.Sprintf("group-%d", i)
+		if !used[name] {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// createGroups creates the given groups, approves them, and creates their repositories.
+// All groups are checked before any group is created, such that an invalid group,
+// for instance with a name that is already in use, does not leave a partial set of groups.
+func (s *QuickFeedService) createGroups(ctx context.Context, sc scm.SCM, request *qf.CreateGroupsRequest) (*qf.Groups, error) {
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get course %d: %w", request.GetCourseID(), err)
+	}
+	names := make(map[string]bool)
+	members := make(map[uint64]bool)
+	for _, group := range request.GetGroups() {
+		name := strings.ToLower(group.GetName())
+		if names[name] {
+			return nil, ErrGroupNameDuplicate
+		}
+		names[name] = true
+		if err := s.checkGroupName(course.GetID(), group.GetName()); err != nil {
+			return nil, err
+		}
+		group.ID = 0
+		if _, err := s.getGroupUsers(group); err != nil {
+			return nil, err
+		}
+		for _, user := range group.GetUsers() {
+			if members[user.GetID()] {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user %d is in more than one group", user.GetID()))
+			}
+			members[user.GetID()] = true
+		}
+	}
+
+	created := &qf.Groups{}
+	for _, request := range request.GetGroups() {
+		group, err := s.createGroup(request, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create group %q: %w", request.GetName(), err)
+		}
+		repo, err := createRepo(ctx, sc, course, group)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create repository for group %q: %w", group.GetName(), err)
+		}
+		if err := s.db.CreateRepository(repo); err != nil {
+			return nil, fmt.Errorf("failed to create repository for group %q in database: %w", group.GetName(), err)
+		}
+		s.grantAssistantAccess(ctx, sc, course, repo.Name())
+		group.Status = qf.Group_APPROVED
+		if err := s.db.UpdateGroupStatus(group); err != nil {
+			return nil, fmt.Errorf("failed to approve group %q: %w", group.GetName(), err)
+		}
+		created.Groups = append(created.Groups, group)
+	}
+	return created, nil
+}
+
+// getGroupPreference returns the student's group preference in the course,
+// or an empty preference if the student has no preference.
+func (s *QuickFeedService) getGroupPreference(courseID, userID uint64) (*qf.GroupPreference, error) {
+	preferences, err := s.db.GetGroupPreferences(&qf.GroupPreference{CourseID: courseID, UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group preference of user %d in course %d: %w", userID, courseID, err)
+	}
+	if len(preferences) == 0 {
+		return &qf.GroupPreference{CourseID: courseID, UserID: userID}, nil
+	}
+	return preferences[0], nil
+}
+
+// updateGroupPreference replaces the student's group preference, ensuring that the preferred
+// partners are students in the course, and that they fit in a group with the student.
+func (s *QuickFeedService) updateGroupPreference(preference *qf.GroupPreference) error {
+	course, err := s.db.GetCourse(preference.GetCourseID(), false)
+	if err != nil {
+		return fmt.Errorf("failed to get course %d: %w", preference.GetCourseID(), err)
+	}
+	slices.Sort(preference.PartnerIDs)
+	preference.PartnerIDs = slices.Compact(preference.PartnerIDs)
+	if course.GetMaxGroupSize() > 0 && len(preference.GetPartnerIDs()) >= int(course.GetMaxGroupSize()) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("groups have at most %d students", course.GetMaxGroupSize()))
+	}
+	for _, partnerID := range preference.GetPartnerIDs() {
+		enrollment, err := s.db.GetEnrollmentByCourseAndUser(course.GetID(), partnerID)
+		if err != nil || !enrollment.IsStudent() {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user %d is not a student in the course", partnerID))
+		}
+	}
+	return s.db.UpdateGroupPreference(preference)
+}
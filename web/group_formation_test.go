@@ -0,0 +1,171 @@
+package web_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestProposeAndCreateGroups(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	lab := &qf.Assignment{CourseID: course.ID, Name: "lab1", Order: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	var students []*qf.User
+	for i := range 7 {
+		student := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: fmt.Sprintf("student%d", i), Login: fmt.Sprintf("student%d", i)})
+		qtest.EnrollStudent(t, db, student, course)
+		students = append(students, student)
+		score := uint32(0)
+		if i < 3 {
+			score = 100
+		}
+		if err := db.CreateSubmission(&qf.Submission{AssignmentID: lab.ID, UserID: student.ID, Score: score}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+	cookie := Cookie(t, tm, admin)
+	propose := func(strategy qf.GroupFormationRequest_Strategy) []*qf.Group {
+		t.Helper()
+		proposal, err := client.ProposeGroups(ctx, qtest.RequestWithCookie(&qf.GroupFormationRequest{CourseID: course.ID, Strategy: strategy}, cookie))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return proposal.Msg.GetGroups()
+	}
+
+	if _, err := client.ProposeGroups(ctx, qtest.RequestWithCookie(&qf.GroupFormationRequest{CourseID: course.ID}, cookie)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("ProposeGroups() without group size: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+	// clone the course, such that the group sizes are not stored with the shared mock course
+	sized := proto.Clone(course).(*qf.Course)
+	sized.MinGroupSize, sized.MaxGroupSize = 2, 3
+	if err := db.UpdateCourse(sized); err != nil {
+		t.Fatal(err)
+	}
+
+	groups := propose(qf.GroupFormationRequest_RANDOM)
+	if diff := cmp.Diff([]int{3, 2, 2}, groupSizes(groups)); diff != "" {
+		t.Errorf("ProposeGroups(RANDOM) group sizes mismatch (-want +got):\n%s", diff)
+	}
+	seen := make(map[uint64]bool)
+	for _, group := range groups {
+		for _, user := range group.GetUsers() {
+			if seen[user.GetID()] {
+				t.Errorf("ProposeGroups(RANDOM) proposed %s in more than one group", user.GetName())
+			}
+			seen[user.GetID()] = true
+		}
+	}
+	if len(seen) != len(students) {
+		t.Errorf("ProposeGroups(RANDOM) grouped %d students, want %d", len(seen), len(students))
+	}
+
+	// the three students with full score are spread across the three groups
+	for _, group := range propose(qf.GroupFormationRequest_BALANCED) {
+		top := 0
+		for _, user := range group.GetUsers() {
+			if user.GetID() <= students[2].GetID() {
+				top++
+			}
+		}
+		if top != 1 {
+			t.Errorf("ProposeGroups(BALANCED) group %s has %d students with full score, want 1", group.GetName(), top)
+		}
+	}
+
+	// student0 and student4 prefer each other, while student5 prefers student6 alone
+	preferences := []*qf.GroupPreference{
+		{CourseID: course.ID, UserID: students[0].ID, PartnerIDs: []uint64{students[4].ID}},
+		{CourseID: course.ID, UserID: students[4].ID, PartnerIDs: []uint64{students[0].ID}},
+		{CourseID: course.ID, UserID: students[5].ID, PartnerIDs: []uint64{students[6].ID}},
+	}
+	for _, preference := range preferences {
+		user := students[0]
+		for _, student := range students {
+			if student.ID == preference.UserID {
+				user = student
+			}
+		}
+		if _, err := client.UpdateGroupPreference(ctx, qtest.RequestWithCookie(preference, Cookie(t, tm, user))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	preference := &qf.GroupPreference{CourseID: course.ID, UserID: students[1].ID, PartnerIDs: []uint64{students[2].ID}}
+	if _, err := client.UpdateGroupPreference(ctx, qtest.RequestWithCookie(preference, Cookie(t, tm, students[2]))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("UpdateGroupPreference() for another student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	preference.PartnerIDs = []uint64{students[2].ID, students[3].ID, students[4].ID}
+	if _, err := client.UpdateGroupPreference(ctx, qtest.RequestWithCookie(preference, Cookie(t, tm, students[1]))); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("UpdateGroupPreference() with too many partners: got %v, want %v", err, connect.CodeInvalidArgument)
+	}
+	gotPreference, err := client.GetGroupPreference(ctx, qtest.RequestWithCookie(&qf.GroupRequest{CourseID: course.ID, UserID: students[0].ID}, Cookie(t, tm, students[0])))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(preferences[0], gotPreference.Msg, protocmp.Transform(), protocmp.IgnoreFields(&qf.GroupPreference{}, "ID")); diff != "" {
+		t.Errorf("GetGroupPreference() mismatch (-want +got):\n%s", diff)
+	}
+	for range 10 {
+		for _, group := range propose(qf.GroupFormationRequest_PREFERENCES) {
+			if group.Contains(students[0]) != group.Contains(students[4]) {
+				t.Fatalf("ProposeGroups(PREFERENCES) separated %s and %s: %v", students[0].Name, students[4].Name, group.GetUsers())
+			}
+		}
+	}
+
+	groups = propose(qf.GroupFormationRequest_RANDOM)
+	if _, err := client.CreateGroups(ctx, qtest.RequestWithCookie(&qf.CreateGroupsRequest{CourseID: course.ID, Groups: groups}, Cookie(t, tm, students[0]))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("CreateGroups() by student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	duplicate := []*qf.Group{groups[0], {Name: groups[0].GetName(), CourseID: course.ID, Users: groups[1].GetUsers()}}
+	if _, err := client.CreateGroups(ctx, qtest.RequestWithCookie(&qf.CreateGroupsRequest{CourseID: course.ID, Groups: duplicate}, cookie)); connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Errorf("CreateGroups() with duplicate names: got %v, want %v", err, connect.CodeAlreadyExists)
+	}
+	created, err := client.CreateGroups(ctx, qtest.RequestWithCookie(&qf.CreateGroupsRequest{CourseID: course.ID, Groups: groups}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created.Msg.GetGroups()) != len(groups) {
+		t.Errorf("CreateGroups() created %d groups, want %d", len(created.Msg.GetGroups()), len(groups))
+	}
+	for _, group := range created.Msg.GetGroups() {
+		if group.GetStatus() != qf.Group_APPROVED {
+			t.Errorf("CreateGroups() group %s status = %v, want %v", group.GetName(), group.GetStatus(), qf.Group_APPROVED)
+		}
+		repos, err := db.GetRepositories(&qf.Repository{GroupID: group.GetID(), RepoType: qf.Repository_GROUP})
+		if err != nil || len(repos) != 1 {
+			t.Errorf("CreateGroups() group %s has repositories %v (error %v), want one repository", group.GetName(), repos, err)
+		}
+	}
+	if groups := propose(qf.GroupFormationRequest_RANDOM); len(groups) != 0 {
+		t.Errorf("ProposeGroups() after all students are grouped = %v, want no groups", groups)
+	}
+}
+
+func groupSizes(groups []*qf.Group) []int {
+	var sizes []int
+	for _, group := range groups {
+		sizes = append(sizes, len(group.GetUsers()))
+	}
+	return sizes
+}
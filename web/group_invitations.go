@@ -0,0 +1,198 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// groupInvitationTTL is how long an invited student has to respond to a group invitation.
+const groupInvitationTTL = 7 * 24 * time.Hour
+
+var (
+	ErrGroupTooSmall          = connect.NewError(connect.CodeInvalidArgument, errors.New("group has too few members"))
+	ErrGroupTooLarge          = connect.NewError(connect.CodeInvalidArgument, errors.New("group has too many members"))
+	ErrGroupAwaitingMembers   = connect.NewError(connect.CodeFailedPrecondition, errors.New("group is waiting for invited members to respond"))
+	ErrGroupInvitationClosed  = connect.NewError(connect.CodeFailedPrecondition, errors.New("group invitation has already been answered"))
+	ErrGroupInvitationExpired = connect.NewError(connect.CodeFailedPrecondition, errors.New("group invitation has expired"))
+)
+
+// checkGroupSize returns an error if the given number of members is outside the course's group size bounds.
+// A bound of zero means that the course has no such bound.
+func checkGroupSize(course *qf.Course, members int) error {
+	if course.GetMaxGroupSize() > 0 && members > int(course.GetMaxGroupSize()) {
+		return ErrGroupTooLarge
+	}
+	if members < int(course.GetMinGroupSize()) {
+		return ErrGroupTooSmall
+	}
+	return nil
+}
+
+// inviteGroupMembers invites the given users to join the group on behalf of the inviter.
+func (s *QuickFeedService) inviteGroupMembers(group *qf.Group, inviterID uint64, users []*qf.User) error {
+	expires := timestamppb.New(time.Now().Add(groupInvitationTTL))
+	var invitations []*qf.GroupInvitation
+	for _, user := range users {
+		invitations = append(invitations, &qf.GroupInvitation{
+			CourseID:  group.GetCourseID(),
+			GroupID:   group.GetID(),
+			UserID:    user.GetID(),
+			InviterID: inviterID,
+			Expires:   expires,
+		})
+	}
+	if err := s.db.CreateGroupInvitations(invitations); err != nil {
+		return fmt.Errorf("failed to invite members to group %q: %w", group.GetName(), err)
+	}
+	for _, invitation := range invitations {
+		s.notifyGroupInvitation(invitation)
+	}
+	return nil
+}
+
+// getGroupInvitations returns the student's invitations to groups in the course,
+// and the invitations to the student's group, if any.
+func (s *QuickFeedService) getGroupInvitations(courseID, userID uint64) (*qf.GroupInvitations, error) {
+	invitations, err := s.db.GetGroupInvitations(&qf.GroupInvitation{CourseID: courseID, UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group invitations of user %d in course %d: %w", userID, courseID, err)
+	}
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(courseID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enrollment of user %d in course %d: %w", userID, courseID, err)
+	}
+	if enrollment.GetGroupID() > 0 {
+		groupInvitations, err := s.db.GetGroupInvitations(&qf.GroupInvitation{CourseID: courseID, GroupID: enrollment.GetGroupID()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get invitations to group %d: %w", enrollment.GetGroupID(), err)
+		}
+		for _, invitation := range groupInvitations {
+			if invitation.GetUserID() != userID {
+				invitations = append(invitations, invitation)
+			}
+		}
+	}
+	now := time.Now()
+	for _, invitation := range invitations {
+		if invitation.IsExpired(now) {
+			// the scheduler has not yet marked the invitation as expired
+			invitation.Status = qf.GroupInvitation_EXPIRED
+		}
+	}
+	return &qf.GroupInvitations{Invitations: invitations}, nil
+}
+
+// respondToGroupInvitation accepts or declines the student's pending invitation to a group.
+// An accepting student joins the group if the student is not in another group and the group
+// is not full. When all invited students have accepted, the group awaits teacher approval.
+func (s *QuickFeedService) respondToGroupInvitation(response *qf.GroupInvitation) error {
+	invitations, err := s.db.GetGroupInvitations(&qf.GroupInvitation{ID: response.GetID(), CourseID: response.GetCourseID(), UserID: response.GetUserID()})
+	if err != nil {
+		return fmt.Errorf("failed to get group invitation %d: %w", response.GetID(), err)
+	}
+	if len(invitations) != 1 {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("group invitation %d not found", response.GetID()))
+	}
+	invitation := invitations[0]
+	if invitation.GetStatus() != qf.GroupInvitation_PENDING {
+		return ErrGroupInvitationClosed
+	}
+	if invitation.IsExpired(time.Now()) {
+		if err := s.closeGroupInvitation(invitation, qf.GroupInvitation_EXPIRED); err != nil {
+			return err
+		}
+		return ErrGroupInvitationExpired
+	}
+	if response.GetStatus() == qf.GroupInvitation_ACCEPTED {
+		if err := s.joinGroup(invitation); err != nil {
+			return err
+		}
+	}
+	return s.closeGroupInvitation(invitation, response.GetStatus())
+}
+
+// joinGroup adds the invited student to the invitation's group.
+func (s *QuickFeedService) joinGroup(invitation *qf.GroupInvitation) error {
+	enrollment, err := s.db.GetEnrollmentByCourseAndUser(invitation.GetCourseID(), invitation.GetUserID())
+	if err != nil {
+		return fmt.Errorf("failed to get enrollment of user %d in course %d: %w", invitation.GetUserID(), invitation.GetCourseID(), err)
+	}
+	if enrollment.GetGroupID() > 0 {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("user already enrolled in another group"))
+	}
+	course, group, err := s.getCourseGroup(&qf.GroupRequest{CourseID: invitation.GetCourseID(), GroupID: invitation.GetGroupID()})
+	if err != nil {
+		return fmt.Errorf("failed to get group %d: %w", invitation.GetGroupID(), err)
+	}
+	if course.GetMaxGroupSize() > 0 && len(group.GetUsers()) >= int(course.GetMaxGroupSize()) {
+		return ErrGroupTooLarge
+	}
+	group.Users = append(group.Users, enrollment.GetUser())
+	if err := s.db.UpdateGroup(group); err != nil {
+		return fmt.Errorf("failed to add user %d to group %q: %w", invitation.GetUserID(), group.GetName(), err)
+	}
+	return nil
+}
+
+// closeGroupInvitation sets the invitation's status, and notifies the invited student and the group's members.
+// A group that was waiting for invited members awaits teacher approval when all invitations have been accepted.
+// If an invitation was declined or has expired, the group keeps waiting, such that it is not approved without
+// the consent of its members; a teacher can then update or delete the group.
+func (s *QuickFeedService) closeGroupInvitation(invitation *qf.GroupInvitation, status qf.GroupInvitation_Status) error {
+	invitation.Status = status
+	if err := s.db.UpdateGroupInvitation(invitation); err != nil {
+		return fmt.Errorf("failed to update group invitation %d: %w", invitation.GetID(), err)
+	}
+	invitations, err := s.db.GetGroupInvitations(&qf.GroupInvitation{GroupID: invitation.GetGroupID()})
+	if err != nil {
+		return fmt.Errorf("failed to get invitations to group %d: %w", invitation.GetGroupID(), err)
+	}
+	accepted := !slices.ContainsFunc(invitations, func(i *qf.GroupInvitation) bool {
+		return i.GetStatus() != qf.GroupInvitation_ACCEPTED
+	})
+	if accepted && invitation.GetGroup().GetStatus() == qf.Group_INVITED {
+		invitation.Group.Status = qf.Group_PENDING
+		if err := s.db.UpdateGroupStatus(invitation.GetGroup()); err != nil {
+			return fmt.Errorf("failed to update status of group %d: %w", invitation.GetGroupID(), err)
+		}
+	}
+	s.notifyGroupInvitation(invitation)
+	return nil
+}
+
+// notifyGroupInvitation sends the invitation to the invited student and the members of the invitation's group.
+func (s *QuickFeedService) notifyGroupInvitation(invitation *qf.GroupInvitation) {
+	ids := invitation.UserIDs()
+	if group, err := s.db.GetGroup(invitation.GetGroupID()); err == nil {
+		ids = append(ids, group.UserIDs()...)
+	}
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+	msg := proto.Clone(invitation).(*qf.GroupInvitation)
+	msg.RemoveRemoteID()
+	s.streams.GroupInvitation.SendTo(msg, ids...)
+}
+
+// expireGroupInvitations marks the pending group invitations that have expired at the given time as expired.
+func (s *QuickFeedService) expireGroupInvitations(now time.Time) {
+	invitations, err := s.db.GetGroupInvitations(&qf.GroupInvitation{}, qf.GroupInvitation_PENDING)
+	if err != nil {
+		s.logger.Errorf("Failed to get pending group invitations: %v", err)
+		return
+	}
+	for _, invitation := range invitations {
+		if !invitation.IsExpired(now) {
+			continue
+		}
+		if err := s.closeGroupInvitation(invitation, qf.GroupInvitation_EXPIRED); err != nil {
+			s.logger.Errorf("Failed to expire group invitation %d: %v", invitation.GetID(), err)
+		}
+	}
+}
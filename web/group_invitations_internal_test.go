@@ -0,0 +1,53 @@
+package web
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+)
+
+func TestExpireGroupInvitations(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	s := NewQuickFeedService(qtest.Logger(t).Desugar(), db, scm.MockManager(t, scm.WithMockOrgs()), BaseHookOptions{}, &ci.Local{})
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	alice := qtest.CreateFakeUser(t, db)
+	bob := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, alice, course)
+	qtest.EnrollStudent(t, db, bob, course)
+
+	group, err := s.createGroup(&qf.Group{Name: "expiring", CourseID: course.ID, Users: []*qf.User{alice, bob}}, alice.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkInvitation := func(want qf.GroupInvitation_Status, wantGroup qf.Group_GroupStatus) *qf.GroupInvitation {
+		t.Helper()
+		invitations, err := db.GetGroupInvitations(&qf.GroupInvitation{GroupID: group.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(invitations) != 1 || invitations[0].GetStatus() != want || invitations[0].GetGroup().GetStatus() != wantGroup {
+			t.Fatalf("GetGroupInvitations() = %v, want one %v invitation to a %v group", invitations, want, wantGroup)
+		}
+		return invitations[0]
+	}
+
+	s.expireGroupInvitations(time.Now())
+	checkInvitation(qf.GroupInvitation_PENDING, qf.Group_INVITED)
+
+	// the invitation expires, and the group keeps waiting, since bob never agreed to join
+	s.expireGroupInvitations(time.Now().Add(groupInvitationTTL))
+	invitation := checkInvitation(qf.GroupInvitation_EXPIRED, qf.Group_INVITED)
+	invitation.Status = qf.GroupInvitation_ACCEPTED
+	if err := s.respondToGroupInvitation(invitation); !errors.Is(err, ErrGroupInvitationClosed) {
+		t.Errorf("respondToGroupInvitation() after expiry: got %v, want %v", err, ErrGroupInvitationClosed)
+	}
+}
@@ -0,0 +1,165 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/qf/qfconnect"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"github.com/quickfeed/quickfeed/web/auth"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGroupInvitations(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	// clone the course, such that the group sizes are not stored with the shared mock course
+	sized := proto.Clone(course).(*qf.Course)
+	sized.MinGroupSize, sized.MaxGroupSize = 2, 3
+	if err := db.UpdateCourse(sized); err != nil {
+		t.Fatal(err)
+	}
+	alice := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Alice", Login: "alice"})
+	bob := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Bob", Login: "bob"})
+	carol := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Carol", Login: "carol"})
+	dave := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Dave", Login: "dave"})
+	for _, user := range []*qf.User{alice, bob, carol, dave} {
+		qtest.EnrollStudent(t, db, user, course)
+	}
+
+	ctx := context.Background()
+	createGroup := func(users ...*qf.User) (*qf.Group, error) {
+		t.Helper()
+		group := &qf.Group{Name: "invited" + users[0].Login, CourseID: course.ID, Users: users}
+		resp, err := client.CreateGroup(ctx, qtest.RequestWithCookie(group, Cookie(t, tm, users[0])))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+	respond := func(user *qf.User, invitation *qf.GroupInvitation, status qf.GroupInvitation_Status) error {
+		t.Helper()
+		response := &qf.GroupInvitation{ID: invitation.GetID(), CourseID: course.ID, UserID: invitation.GetUserID(), Status: status}
+		_, err := client.RespondToGroupInvitation(ctx, qtest.RequestWithCookie(response, Cookie(t, tm, user)))
+		return err
+	}
+
+	if _, err := createGroup(alice, bob, carol, dave); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("CreateGroup() with too many members: got %v, want %v", err, connect.CodeInvalidArgument)
+	}
+	if _, err := createGroup(alice); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("CreateGroup() with too few members: got %v, want %v", err, connect.CodeInvalidArgument)
+	}
+	group, err := createGroup(alice, bob, carol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// only alice is a member until bob and carol accept
+	if group.GetStatus() != qf.Group_INVITED || len(group.GetUsers()) != 1 || !group.Contains(alice) {
+		t.Errorf("CreateGroup() = %v, want invited group with only %s", group, alice.Name)
+	}
+	if _, err := client.UpdateGroup(ctx, qtest.RequestWithCookie(group, Cookie(t, tm, admin))); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("UpdateGroup() before members responded: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	bobInvitation := groupInvitation(t, client, tm, course.ID, bob)
+	carolInvitation := groupInvitation(t, client, tm, course.ID, carol)
+	if bobInvitation.GetInviterID() != alice.ID || bobInvitation.GetGroupID() != group.GetID() {
+		t.Errorf("GetGroupInvitations() = %v, want invitation from %s to group %d", bobInvitation, alice.Name, group.GetID())
+	}
+	if err := respond(carol, bobInvitation, qf.GroupInvitation_ACCEPTED); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("RespondToGroupInvitation() for another student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	if err := respond(bob, bobInvitation, qf.GroupInvitation_ACCEPTED); err != nil {
+		t.Fatal(err)
+	}
+	if err := respond(bob, bobInvitation, qf.GroupInvitation_DECLINED); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("RespondToGroupInvitation() after accepting: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+	checkGroup(t, client, tm, admin, group, qf.Group_INVITED, alice, bob)
+
+	// alice sees the invitations to her group
+	invitations, err := client.GetGroupInvitations(ctx, qtest.RequestWithCookie(&qf.GroupRequest{CourseID: course.ID, UserID: alice.ID}, Cookie(t, tm, alice)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var statuses []qf.GroupInvitation_Status
+	for _, invitation := range invitations.Msg.GetInvitations() {
+		statuses = append(statuses, invitation.GetStatus())
+	}
+	if diff := cmp.Diff([]qf.GroupInvitation_Status{qf.GroupInvitation_ACCEPTED, qf.GroupInvitation_PENDING}, statuses); diff != "" {
+		t.Errorf("GetGroupInvitations() statuses mismatch (-want +got):\n%s", diff)
+	}
+
+	// when carol declines, all invited members have responded, but the group keeps waiting,
+	// since not all invited members agreed to join; the teacher can still update the group
+	if err := respond(carol, carolInvitation, qf.GroupInvitation_DECLINED); err != nil {
+		t.Fatal(err)
+	}
+	checkGroup(t, client, tm, admin, group, qf.Group_INVITED, alice, bob)
+	if _, err := client.UpdateGroup(ctx, qtest.RequestWithCookie(&qf.Group{ID: group.GetID(), CourseID: course.ID, Users: []*qf.User{alice}}, Cookie(t, tm, admin))); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("UpdateGroup() with too few members: got %v, want %v", err, connect.CodeInvalidArgument)
+	}
+
+	// when all invited members accept, the group awaits approval
+	otherGroup, err := createGroup(carol, dave)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGroup(t, client, tm, admin, otherGroup, qf.Group_INVITED, carol)
+	acceptGroupInvitations(t, client, tm, course.ID, dave)
+	checkGroup(t, client, tm, admin, otherGroup, qf.Group_PENDING, carol, dave)
+}
+
+// groupInvitation returns the user's only group invitation in the course.
+func groupInvitation(t *testing.T, client qfconnect.QuickFeedServiceClient, tm *auth.TokenManager, courseID uint64, user *qf.User) *qf.GroupInvitation {
+	t.Helper()
+	invitations, err := client.GetGroupInvitations(context.Background(), qtest.RequestWithCookie(&qf.GroupRequest{CourseID: courseID, UserID: user.ID}, Cookie(t, tm, user)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invitations.Msg.GetInvitations()) != 1 {
+		t.Fatalf("GetGroupInvitations() for %s = %v, want one invitation", user.Name, invitations.Msg.GetInvitations())
+	}
+	return invitations.Msg.GetInvitations()[0]
+}
+
+// acceptGroupInvitations accepts the users' only group invitations in the course.
+func acceptGroupInvitations(t *testing.T, client qfconnect.QuickFeedServiceClient, tm *auth.TokenManager, courseID uint64, users ...*qf.User) {
+	t.Helper()
+	for _, user := range users {
+		invitation := groupInvitation(t, client, tm, courseID, user)
+		invitation.Status = qf.GroupInvitation_ACCEPTED
+		if _, err := client.RespondToGroupInvitation(context.Background(), qtest.RequestWithCookie(invitation, Cookie(t, tm, user))); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// checkGroup checks that the group has the given status and members.
+func checkGroup(t *testing.T, client qfconnect.QuickFeedServiceClient, tm *auth.TokenManager, teacher *qf.User, group *qf.Group, status qf.Group_GroupStatus, members ...*qf.User) {
+	t.Helper()
+	got, err := client.GetGroup(context.Background(), qtest.RequestWithCookie(&qf.GroupRequest{CourseID: group.GetCourseID(), GroupID: group.GetID()}, Cookie(t, tm, teacher)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Msg.GetStatus() != status || len(got.Msg.GetUsers()) != len(members) {
+		t.Errorf("GetGroup() = %v, want status %v with %d members", got.Msg, status, len(members))
+	}
+	for _, member := range members {
+		if !got.Msg.Contains(member) {
+			t.Errorf("GetGroup() members = %v, want %s as member", got.Msg.GetUsers(), member.Name)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+)
+
+func TestGroupMembershipHistory(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	alice := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Alice", Login: "alice"})
+	bob := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Bob", Login: "bob"})
+	carol := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Carol", Login: "carol"})
+	for _, user := range []*qf.User{alice, bob, carol} {
+		qtest.EnrollStudent(t, db, user, course)
+	}
+	lab := &qf.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, IsGroupLab: true}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	cookie := Cookie(t, tm, admin)
+	created, err := client.CreateGroup(ctx, qtest.RequestWithCookie(&qf.Group{Name: "history", CourseID: course.ID, Users: []*qf.User{alice, bob}}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	group := created.Msg
+	if _, err := client.UpdateGroup(ctx, qtest.RequestWithCookie(group, cookie)); err != nil {
+		t.Fatal(err)
+	}
+	// alice and bob deliver the lab together, and the teacher approves it
+	submission := &qf.Submission{AssignmentID: lab.ID, GroupID: group.ID, Score: 80}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	approve := &qf.UpdateSubmissionRequest{SubmissionID: submission.ID, CourseID: course.ID, Grades: []*qf.Grade{
+		{UserID: alice.ID, Status: qf.Submission_APPROVED},
+		{UserID: bob.ID, Status: qf.Submission_APPROVED},
+	}}
+	if _, err := client.UpdateSubmission(ctx, qtest.RequestWithCookie(approve, cookie)); err != nil {
+		t.Fatal(err)
+	}
+	// alice leaves the group, and carol joins after the lab was delivered
+	group.Users = []*qf.User{bob, carol}
+	if _, err := client.UpdateGroup(ctx, qtest.RequestWithCookie(group, cookie)); err != nil {
+		t.Fatal(err)
+	}
+
+	finalGrades, err := client.ComputeFinalGrades(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotApproved := make(map[uint64]uint32)
+	for _, grade := range finalGrades.Msg.GetGrades() {
+		gotApproved[grade.GetUserID()] = grade.GetApproved()
+	}
+	wantApproved := map[uint64]uint32{alice.ID: 1, bob.ID: 1, carol.ID: 0}
+	if diff := cmp.Diff(wantApproved, gotApproved); diff != "" {
+		t.Errorf("ComputeFinalGrades() approved labs mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := client.GetGroupMemberships(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, Cookie(t, tm, bob))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("GetGroupMemberships() by student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	memberships, err := client.GetGroupMemberships(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotMember := make(map[uint64]bool)
+	for _, membership := range memberships.Msg.GetMemberships() {
+		gotMember[membership.GetUserID()] = membership.GetLeft() == nil
+	}
+	wantMember := map[uint64]bool{alice.ID: false, bob.ID: true, carol.ID: true}
+	if diff := cmp.Diff(wantMember, gotMember); diff != "" {
+		t.Errorf("GetGroupMemberships() current members mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -70,19 +70,41 @@ func (s *QuickFeedService) deleteGroup(ctx context.Context, sc scm.SCM, request
 // This function is typically called by a student when creating
 // a group, which will later be (optionally) edited and approved
 // by a teacher of the course using the updateGroup function below.
-func (s *QuickFeedService) createGroup(request *qf.Group) (*qf.Group, error) {
+// If the creator is a member of the group, the other members are invited
+// to join the group, and the group cannot be approved until they have responded.
+func (s *QuickFeedService) createGroup(request *qf.Group, creatorID uint64) (*qf.Group, error) {
 	if err := s.checkGroupName(request.GetCourseID(), request.GetName()); err != nil {
 		return nil, err
 	}
 	// get users of group, check consistency of group request
-	if _, err := s.getGroupUsers(request); err != nil {
+	users, err := s.getGroupUsers(request)
+	if err != nil {
 		s.logger.Errorf("CreateGroup: failed to retrieve users for group %s: %v", request.GetName(), err)
 		return nil, err
 	}
+	course, err := s.db.GetCourse(request.GetCourseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkGroupSize(course, len(users)); err != nil {
+		return nil, err
+	}
+	var invitees []*qf.User
+	if request.Contains(&qf.User{ID: creatorID}) {
+		invitees = request.GetUsersExcept(creatorID)
+	}
+	if len(invitees) > 0 {
+		// only the creator is a member until the invited users accept
+		request.Users = []*qf.User{{ID: creatorID}}
+		request.Status = qf.Group_INVITED
+	}
 	// create new group and update groupID in enrollment table
 	if err := s.db.CreateGroup(request); err != nil {
 		return nil, err
 	}
+	if err := s.inviteGroupMembers(request, creatorID, invitees); err != nil {
+		return nil, err
+	}
 	return s.db.GetGroup(request.ID)
 }
 
@@ -99,11 +121,25 @@ func (s *QuickFeedService) updateGroup(ctx context.Context, sc scm.SCM, request
 		return err
 	}
 
+	if group.GetStatus() == qf.Group_INVITED {
+		// a group whose invitations were declined or have expired can be updated by the teacher
+		pending, err := s.db.GetGroupInvitations(&qf.GroupInvitation{GroupID: group.GetID()}, qf.GroupInvitation_PENDING)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			return ErrGroupAwaitingMembers
+		}
+	}
+
 	// get users of group, check consistency of group request
 	users, err := s.getGroupUsers(request)
 	if err != nil {
 		return err
 	}
+	if err := checkGroupSize(course, len(users)); err != nil {
+		return err
+	}
 
 	// allow changing the name of the group only if the group
 	// is not already approved and the new name is valid
@@ -126,6 +162,7 @@ func (s *QuickFeedService) updateGroup(ctx context.Context, sc scm.SCM, request
 			return err
 		}
 		s.logger.Debugf("Created group repo in database: %+v", repo)
+		s.grantAssistantAccess(ctx, sc, course, repo.Name())
 	}
 
 	// if there are changes in group membership, update group repository
@@ -220,6 +257,15 @@ func (s *QuickFeedService) checkGroupName(courseID uint64, groupName string) err
 	return nil
 }
 
+// getGroupMemberships returns the membership history of the course's groups, including deleted groups.
+func (s *QuickFeedService) getGroupMemberships(courseID uint64) (*qf.GroupMemberships, error) {
+	memberships, err := s.db.GetGroupMemberships(&qf.GroupMembership{CourseID: courseID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group memberships in course %d: %w", courseID, err)
+	}
+	return &qf.GroupMemberships{Memberships: memberships}, nil
+}
+
 // getCourseGroup returns the course and group specified in the GroupRequest.
 func (s *QuickFeedService) getCourseGroup(request *qf.GroupRequest) (*qf.Course, *qf.Group, error) {
 	group, err := s.db.GetGroup(request.GetGroupID())
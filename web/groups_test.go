@@ -207,6 +207,9 @@ func TestStudentCreateNewGroupTeacherUpdateGroup(t *testing.T) {
 		t.Errorf("CreateGroup() mismatch (-wantGroup +gotGroup):\n%s", diff)
 	}
 
+	// user2 must accept the invitation before the group can be approved
+	acceptGroupInvitations(t, client, tm, course.ID, user2)
+
 	// ******************* Teacher UpdateGroup *******************
 
 	// set teacher in cookie
@@ -557,7 +560,11 @@ func TestDeleteApprovedGroup(t *testing.T) {
 		t.Error(err)
 	}
 
+	// user2 must accept the invitation before the group can be approved
+	acceptGroupInvitations(t, client, tm, course.ID, user2)
+
 	// first approve the group
+	createdGroup.Msg.Users = []*qf.User{user1, user2}
 	createdGroup.Msg.Status = qf.Group_APPROVED
 	// current user must be teacher for the course
 	if _, err = client.UpdateGroup(ctx, qtest.RequestWithCookie(createdGroup.Msg, Cookie(t, tm, admin))); err != nil {
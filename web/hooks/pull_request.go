@@ -137,8 +137,8 @@ func (wh GitHubWebHook) handlePullRequestReview(payload *github.PullRequestRevie
 	}
 
 	// If we reach here the pull request already has an approved state. However, only if the
-	// review is from a course teacher, do we mark the pull request as approved for QuickFeed.
-	if reviewer.IsTeacher() {
+	// review is from a course teacher or teaching assistant, do we mark the pull request as approved for QuickFeed.
+	if reviewer.IsStaff() {
 		pullRequest.SetApproved()
 		if err := wh.db.UpdatePullRequest(pullRequest); err != nil {
 			wh.logger.Errorf("Failed to update pull request in database: %v", err)
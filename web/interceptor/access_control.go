@@ -26,12 +26,25 @@ const (
 	group
 	// student role implies that the user is enrolled in the course with any role.
 	student
+	// peer role implies that the user is a course student assigned to peer review the given submission.
+	peer
+	// assistant: user enrolled in the course with teaching assistant status.
+	assistant
 	// teacher: user enrolled in the course with teacher status.
 	teacher
 	// admin is the user with admin privileges.
 	admin
 )
 
+// ownRequestOnly lists the methods that a student can only call on their own behalf.
+var ownRequestOnly = map[string]bool{
+	"CreateRegradeRequest":     true,
+	"GetGroupPreference":       true,
+	"UpdateGroupPreference":    true,
+	"GetGroupInvitations":      true,
+	"RespondToGroupInvitation": true,
+}
+
 // If there are several roles that can call a method, a role with the least privilege must come first.
 var accessRolesFor = map[string]roles{
 	"GetUser":                {none},
@@ -41,34 +54,83 @@ var accessRolesFor = map[string]roles{
 	"CreateEnrollment":       {user},
 	"UpdateCourseVisibility": {user},
 	"UpdateUser":             {user, admin},
-	"GetEnrollments":         {user, student, teacher, admin},
-	"GetSubmissions":         {student, group, teacher},
-	"GetSubmission":          {teacher},
+	"GetEnrollments":         {user, student, assistant, teacher, admin},
+	"GetSubmissions":         {student, group, assistant, teacher},
+	"GetSubmission":          {assistant, teacher},
 	"CreateGroup":            {group, teacher},
-	"GetGroup":               {group, teacher},
-	"GetAssignments":         {student, teacher},
-	"GetRepositories":        {student, teacher},
+	"GetGroup":               {group, assistant, teacher},
+	"GetAssignments":         {student, assistant, teacher},
+	"GetRepositories":        {student, assistant, teacher},
 	"UpdateGroup":            {teacher},
 	"DeleteGroup":            {teacher},
-	"GetGroupsByCourse":      {teacher},
+	"GetGroupsByCourse":      {assistant, teacher},
 	"UpdateCourse":           {teacher},
 	"UpdateEnrollments":      {teacher},
 	"UpdateAssignments":      {teacher},
-	"UpdateSubmission":       {teacher},
-	"UpdateSubmissions":      {teacher},
-	"RebuildSubmissions":     {teacher},
+	"UpdateSubmission":       {assistant, teacher},
+	"UpdateSubmissions":      {assistant, teacher},
+	"RebuildSubmissions":     {assistant, teacher},
 	"CreateBenchmark":        {teacher},
 	"UpdateBenchmark":        {teacher},
 	"DeleteBenchmark":        {teacher},
 	"CreateCriterion":        {teacher},
 	"UpdateCriterion":        {teacher},
 	"DeleteCriterion":        {teacher},
-	"CreateReview":           {teacher},
-	"UpdateReview":           {teacher},
-	"IsEmptyRepo":            {teacher},
-	"GetSubmissionsByCourse": {teacher},
+	"CreateReview":           {assistant, teacher},
+	"UpdateReview":           {assistant, teacher},
+	"IsEmptyRepo":            {assistant, teacher},
+	"GetSubmissionsByCourse": {assistant, teacher},
 	"GetUsers":               {admin},
 	"GetOrganization":        {admin},
+
+	"RegradeRequestStream":     {none},
+	"GroupInvitationStream":    {none},
+	"GetGroupPreference":       {student},
+	"UpdateGroupPreference":    {student},
+	"GetGroupInvitations":      {student},
+	"RespondToGroupInvitation": {student},
+	"StartQuiz":                {student, teacher},
+	"SubmitQuiz":               {student, teacher},
+	"ProposeGroups":            {teacher},
+	"CreateGroups":             {teacher},
+	"GetGroupMemberships":      {teacher},
+	"GetGroupContributions":    {assistant, teacher},
+	"GetGradingConfig":         {teacher},
+	"UpdateGradingConfig":      {teacher},
+	"ComputeFinalGrades":       {teacher},
+	"ExportGrades":             {teacher},
+	"ImportExternalGrades":     {teacher},
+	"ImportRoster":             {teacher},
+	"GetRosterCoverage":        {teacher},
+	"GetEnrollmentPolicy":      {teacher},
+	"GetScheduledJobs":         {teacher},
+	"ScheduleJob":              {teacher},
+	"CancelScheduledJob":       {teacher},
+	"GetAuditEntries":          {teacher},
+	"GetLineComments":          {student, assistant, teacher},
+	"CreateLineComment":        {assistant, teacher},
+	"UpdateLineComment":        {assistant, teacher},
+	"DeleteLineComment":        {assistant, teacher},
+	"GetFeedbackSnippets":      {assistant, teacher},
+	"CreateFeedbackSnippet":    {teacher},
+	"UpdateFeedbackSnippet":    {teacher},
+	"DeleteFeedbackSnippet":    {teacher},
+	"UseFeedbackSnippet":       {assistant, teacher},
+	"GetRegradeRequests":       {student, assistant, teacher},
+	"CreateRegradeRequest":     {student},
+	"UpdateRegradeRequest":     {assistant, teacher},
+	"GetReconciliation":        {assistant, teacher},
+	"ReconcileReviews":         {assistant, teacher},
+	"AllocateReviewers":        {teacher},
+	"ReassignReviews":          {teacher},
+	"GetReviewQueue":           {assistant, teacher},
+	"GetReviewerLoads":         {teacher},
+	"StartPeerReview":          {teacher},
+	"EndPeerReview":            {teacher},
+	"GradePeerReview":          {teacher},
+	"GetPeerReviews":           {student, assistant, teacher},
+	"CreatePeerReview":         {peer},
+	"UpdatePeerReview":         {peer},
 }
 
 type AccessControlInterceptor struct {
@@ -136,9 +198,19 @@ func (a *AccessControlInterceptor) WrapUnary(next connect.UnaryFunc) connect.Una
 								method, claims.UserID, req.IDFor("user")))
 					}
 				}
+				// Students can only request a regrade, state group preferences or respond to group invitations on their own behalf.
+				if ownRequestOnly[method] && !claims.SameUser(req) {
+					return nil, connect.NewError(connect.CodePermissionDenied,
+						fmt.Errorf("access denied for %s: ID mismatch in claims (%d) and request (%d)",
+							method, claims.UserID, req.IDFor("user")))
+				}
 				if claims.HasCourseStatus(req, qf.Enrollment_STUDENT) {
 					return next(ctx, request)
 				}
+			case peer:
+				if claims.HasCourseStatus(req, qf.Enrollment_STUDENT) && isPeerReviewer(a.tokenManager.Database(), req, claims.UserID) {
+					return next(ctx, request)
+				}
 			case group:
 				// Request for CreateGroup will not have ID yet, need to check
 				// if the user is in the group (unless teacher).
@@ -158,14 +230,18 @@ func (a *AccessControlInterceptor) WrapUnary(next connect.UnaryFunc) connect.Una
 						return next(ctx, request)
 					}
 				}
-			case teacher:
+			case assistant, teacher:
 				if method == "RebuildSubmissions" || method == "UpdateSubmission" {
 					if !isValidSubmission(a.tokenManager.Database(), req) {
 						return nil, connect.NewError(connect.CodePermissionDenied,
 							fmt.Errorf("access denied for %s: %v", method, "invalid submission"))
 					}
 				}
-				if claims.HasCourseStatus(req, qf.Enrollment_TEACHER) {
+				status := qf.Enrollment_TEACHER
+				if role == assistant {
+					status = qf.Enrollment_ASSISTANT
+				}
+				if claims.HasCourseStatus(req, status) {
 					return next(ctx, request)
 				}
 			case admin:
@@ -58,6 +58,55 @@ func TestAccessControlMethodsChecker(t *testing.T) {
 		"GetOrganization":        true,
 		"GetSubmission":          true,
 		"SubmissionStream":       true,
+
+		"ProposeGroups":            true,
+		"CreateGroups":             true,
+		"GetGroupMemberships":      true,
+		"GetGroupContributions":    true,
+		"GetGroupPreference":       true,
+		"UpdateGroupPreference":    true,
+		"GetGroupInvitations":      true,
+		"RespondToGroupInvitation": true,
+		"ImportRoster":             true,
+		"GetRosterCoverage":        true,
+		"GetEnrollmentPolicy":      true,
+		"GetReconciliation":        true,
+		"ReconcileReviews":         true,
+		"AllocateReviewers":        true,
+		"ReassignReviews":          true,
+		"GetReviewQueue":           true,
+		"GetReviewerLoads":         true,
+		"StartPeerReview":          true,
+		"EndPeerReview":            true,
+		"GetPeerReviews":           true,
+		"GradePeerReview":          true,
+		"CreatePeerReview":         true,
+		"UpdatePeerReview":         true,
+		"GetLineComments":          true,
+		"CreateLineComment":        true,
+		"UpdateLineComment":        true,
+		"DeleteLineComment":        true,
+		"GetFeedbackSnippets":      true,
+		"CreateFeedbackSnippet":    true,
+		"UpdateFeedbackSnippet":    true,
+		"DeleteFeedbackSnippet":    true,
+		"UseFeedbackSnippet":       true,
+		"GetRegradeRequests":       true,
+		"CreateRegradeRequest":     true,
+		"UpdateRegradeRequest":     true,
+		"RegradeRequestStream":     true,
+		"GroupInvitationStream":    true,
+		"GetScheduledJobs":         true,
+		"ScheduleJob":              true,
+		"CancelScheduledJob":       true,
+		"GetAuditEntries":          true,
+		"GetGradingConfig":         true,
+		"UpdateGradingConfig":      true,
+		"ComputeFinalGrades":       true,
+		"ExportGrades":             true,
+		"ImportExternalGrades":     true,
+		"StartQuiz":                true,
+		"SubmitQuiz":               true,
 	}
 	if err := checkAccessControlMethods(serviceMethods); err != nil {
 		t.Error(err)
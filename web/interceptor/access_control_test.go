@@ -42,6 +42,7 @@ func TestAccessControl(t *testing.T) {
 	groupStudent := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "group student", Login: "group student"})
 	student := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "student", Login: "student"})
 	user := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "user", Login: "user"})
+	assistant := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "assistant", Login: "assistant"})
 	admin := qtest.CreateFakeUser(t, db)
 	admin.IsAdmin = true
 	if err := db.UpdateUser(admin); err != nil {
@@ -58,6 +59,15 @@ func TestAccessControl(t *testing.T) {
 	qtest.CreateCourse(t, db, courseAdmin, course)
 	qtest.EnrollStudent(t, db, groupStudent, course)
 	qtest.EnrollStudent(t, db, student, course)
+	qtest.EnrollStudent(t, db, assistant, course)
+	assistantEnrollment, err := db.GetEnrollmentByCourseAndUser(course.ID, assistant.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assistantEnrollment.Status = qf.Enrollment_ASSISTANT
+	if err := db.UpdateEnrollment(assistantEnrollment); err != nil {
+		t.Fatal(err)
+	}
 	group := &qf.Group{
 		CourseID: course.ID,
 		Name:     "Test",
@@ -81,6 +91,16 @@ func TestAccessControl(t *testing.T) {
 	}); err != nil {
 		t.Fatal(err)
 	}
+	// student is assigned to peer review the group student's submission
+	if err := db.CreatePeerReviews([]*qf.PeerReview{{
+		CourseID:     course.ID,
+		AssignmentID: assignment.ID,
+		SubmissionID: 1,
+		ReviewerID:   student.ID,
+		Open:         true,
+	}}); err != nil {
+		t.Fatal(err)
+	}
 
 	f := func(t *testing.T, id uint64) string {
 		cookie, err := tm.NewAuthCookie(id)
@@ -93,6 +113,7 @@ func TestAccessControl(t *testing.T) {
 	groupStudentCookie := f(t, groupStudent.ID)
 	studentCookie := f(t, student.ID)
 	userCookie := f(t, user.ID)
+	assistantCookie := f(t, assistant.ID)
 	adminCookie := f(t, admin.ID)
 
 	freeAccessTest := map[string]accessTest{
@@ -168,6 +189,49 @@ func TestAccessControl(t *testing.T) {
 			checkAccess(t, "GetEnrollments", err, tt.wantCode, tt.wantAccess)
 			_, err = client.GetRepositories(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: tt.courseID}, tt.cookie))
 			checkAccess(t, "GetRepositories", err, tt.wantCode, tt.wantAccess)
+			_, err = client.StartQuiz(ctx, qtest.RequestWithCookie(&qf.QuizRequest{CourseID: tt.courseID, AssignmentID: assignment.ID}, tt.cookie))
+			checkAccess(t, "StartQuiz", err, tt.wantCode, tt.wantAccess)
+			_, err = client.SubmitQuiz(ctx, qtest.RequestWithCookie(&qf.QuizSubmission{CourseID: tt.courseID, AttemptID: 1}, tt.cookie))
+			checkAccess(t, "SubmitQuiz", err, tt.wantCode, tt.wantAccess)
+			_, err = client.GetPeerReviews(ctx, qtest.RequestWithCookie(&qf.PeerReviewRequest{CourseID: tt.courseID, AssignmentID: assignment.ID}, tt.cookie))
+			checkAccess(t, "GetPeerReviews", err, tt.wantCode, tt.wantAccess)
+		})
+	}
+
+	peerAccessTests := map[string]struct {
+		cookie       string
+		courseID     uint64
+		submissionID uint64
+		wantAccess   bool
+		wantCode     connect.Code
+	}{
+		"peer reviewer":                        {cookie: studentCookie, courseID: course.ID, submissionID: 1, wantAccess: true, wantCode: connect.CodePermissionDenied},
+		"peer reviewer, other submission":      {cookie: studentCookie, courseID: course.ID, submissionID: 2, wantAccess: false, wantCode: connect.CodePermissionDenied},
+		"peer reviewer, other course":          {cookie: studentCookie, courseID: 123, submissionID: 1, wantAccess: false, wantCode: connect.CodePermissionDenied},
+		"student, not a peer reviewer":         {cookie: groupStudentCookie, courseID: course.ID, submissionID: 1, wantAccess: false, wantCode: connect.CodePermissionDenied},
+		"course teacher, not a peer reviewer":  {cookie: courseAdminCookie, courseID: course.ID, submissionID: 1, wantAccess: false, wantCode: connect.CodePermissionDenied},
+		"user, not enrolled in the course":     {cookie: userCookie, courseID: course.ID, submissionID: 1, wantAccess: false, wantCode: connect.CodePermissionDenied},
+		"admin, not enrolled in the course":    {cookie: adminCookie, courseID: course.ID, submissionID: 1, wantAccess: false, wantCode: connect.CodePermissionDenied},
+		"peer reviewer, missing submission ID": {cookie: studentCookie, courseID: course.ID, wantAccess: false, wantCode: connect.CodePermissionDenied},
+	}
+	for name, tt := range peerAccessTests {
+		t.Run("PeerAccess/"+name, func(t *testing.T) {
+			_, err := client.CreatePeerReview(ctx, qtest.RequestWithCookie(&qf.ReviewRequest{
+				CourseID: tt.courseID,
+				Review: &qf.Review{
+					SubmissionID: tt.submissionID,
+					ReviewerID:   1,
+				},
+			}, tt.cookie))
+			checkAccess(t, "CreatePeerReview", err, tt.wantCode, tt.wantAccess)
+			_, err = client.UpdatePeerReview(ctx, qtest.RequestWithCookie(&qf.ReviewRequest{
+				CourseID: tt.courseID,
+				Review: &qf.Review{
+					SubmissionID: tt.submissionID,
+					ReviewerID:   1,
+				},
+			}, tt.cookie))
+			checkAccess(t, "UpdatePeerReview", err, tt.wantCode, tt.wantAccess)
 		})
 	}
 
@@ -256,11 +320,105 @@ func TestAccessControl(t *testing.T) {
 				},
 			}, tt.cookie))
 			checkAccess(t, "UpdateReview", err, tt.wantCode, tt.wantAccess)
+			_, err = client.GetReconciliation(ctx, qtest.RequestWithCookie(&qf.ReconcileRequest{CourseID: tt.courseID, SubmissionID: 1}, tt.cookie))
+			checkAccess(t, "GetReconciliation", err, tt.wantCode, tt.wantAccess)
+			_, err = client.ReconcileReviews(ctx, qtest.RequestWithCookie(&qf.ReconcileRequest{CourseID: tt.courseID, SubmissionID: 1}, tt.cookie))
+			checkAccess(t, "ReconcileReviews", err, tt.wantCode, tt.wantAccess)
+			_, err = client.AllocateReviewers(ctx, qtest.RequestWithCookie(&qf.ReviewAllocationRequest{CourseID: tt.courseID, AssignmentID: 1}, tt.cookie))
+			checkAccess(t, "AllocateReviewers", err, tt.wantCode, tt.wantAccess)
+			_, err = client.ReassignReviews(ctx, qtest.RequestWithCookie(&qf.ReviewAllocationRequest{CourseID: tt.courseID, AssignmentID: 1, ReviewerID: 1}, tt.cookie))
+			checkAccess(t, "ReassignReviews", err, tt.wantCode, tt.wantAccess)
+			_, err = client.GetReviewQueue(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: tt.courseID}, tt.cookie))
+			checkAccess(t, "GetReviewQueue", err, tt.wantCode, tt.wantAccess)
+			_, err = client.GetReviewerLoads(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: tt.courseID}, tt.cookie))
+			checkAccess(t, "GetReviewerLoads", err, tt.wantCode, tt.wantAccess)
+			_, err = client.StartPeerReview(ctx, qtest.RequestWithCookie(&qf.PeerReviewRequest{CourseID: tt.courseID, AssignmentID: 1}, tt.cookie))
+			checkAccess(t, "StartPeerReview", err, tt.wantCode, tt.wantAccess)
+			_, err = client.EndPeerReview(ctx, qtest.RequestWithCookie(&qf.PeerReviewRequest{CourseID: tt.courseID, AssignmentID: 1}, tt.cookie))
+			checkAccess(t, "EndPeerReview", err, tt.wantCode, tt.wantAccess)
+			_, err = client.GradePeerReview(ctx, qtest.RequestWithCookie(&qf.PeerReview{CourseID: tt.courseID, ID: 1}, tt.cookie))
+			checkAccess(t, "GradePeerReview", err, tt.wantCode, tt.wantAccess)
 			_, err = client.IsEmptyRepo(ctx, qtest.RequestWithCookie(&qf.RepositoryRequest{CourseID: tt.courseID}, tt.cookie))
 			checkAccess(t, "IsEmptyRepo", err, tt.wantCode, tt.wantAccess)
 		})
 	}
 
+	assistantAccessTests := map[string]accessTest{
+		"course teacher":                   {cookie: courseAdminCookie, courseID: course.ID, groupID: group.ID, wantAccess: true, wantCode: connect.CodePermissionDenied},
+		"teaching assistant":               {cookie: assistantCookie, courseID: course.ID, groupID: group.ID, wantAccess: true, wantCode: connect.CodePermissionDenied},
+		"teaching assistant, other course": {cookie: assistantCookie, courseID: 123, groupID: group.ID, wantAccess: false, wantCode: connect.CodePermissionDenied},
+		"student":                          {cookie: studentCookie, courseID: course.ID, groupID: group.ID, wantAccess: false, wantCode: connect.CodePermissionDenied},
+	}
+	for name, tt := range assistantAccessTests {
+		t.Run("AssistantAccess/"+name, func(t *testing.T) {
+			_, err := client.GetGroup(ctx, qtest.RequestWithCookie(&qf.GroupRequest{
+				CourseID: tt.courseID,
+				GroupID:  tt.groupID,
+			}, tt.cookie))
+			checkAccess(t, "GetGroup", err, tt.wantCode, tt.wantAccess)
+			_, err = client.GetGroupsByCourse(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: tt.courseID}, tt.cookie))
+			checkAccess(t, "GetGroupsByCourse", err, tt.wantCode, tt.wantAccess)
+			_, err = client.GetSubmissionsByCourse(ctx, qtest.RequestWithCookie(&qf.SubmissionRequest{
+				CourseID: tt.courseID,
+				FetchMode: &qf.SubmissionRequest_Type{
+					Type: qf.SubmissionRequest_ALL,
+				},
+			}, tt.cookie))
+			checkAccess(t, "GetSubmissionsByCourse", err, tt.wantCode, tt.wantAccess)
+			_, err = client.UpdateSubmission(ctx, qtest.RequestWithCookie(&qf.UpdateSubmissionRequest{SubmissionID: 1, CourseID: tt.courseID}, tt.cookie))
+			checkAccess(t, "UpdateSubmission", err, tt.wantCode, tt.wantAccess)
+			_, err = client.UpdateSubmissions(ctx, qtest.RequestWithCookie(&qf.UpdateSubmissionsRequest{AssignmentID: 1, CourseID: tt.courseID}, tt.cookie))
+			checkAccess(t, "UpdateSubmissions", err, tt.wantCode, tt.wantAccess)
+			_, err = client.RebuildSubmissions(ctx, qtest.RequestWithCookie(&qf.RebuildRequest{
+				AssignmentID: 1,
+				CourseID:     tt.courseID,
+			}, tt.cookie))
+			checkAccess(t, "RebuildSubmissions", err, tt.wantCode, tt.wantAccess)
+			_, err = client.CreateReview(ctx, qtest.RequestWithCookie(&qf.ReviewRequest{
+				CourseID: tt.courseID,
+				Review: &qf.Review{
+					SubmissionID: 1,
+					ReviewerID:   1,
+				},
+			}, tt.cookie))
+			checkAccess(t, "CreateReview", err, tt.wantCode, tt.wantAccess)
+			_, err = client.GetReviewQueue(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: tt.courseID}, tt.cookie))
+			checkAccess(t, "GetReviewQueue", err, tt.wantCode, tt.wantAccess)
+			_, err = client.GetReconciliation(ctx, qtest.RequestWithCookie(&qf.ReconcileRequest{CourseID: tt.courseID, SubmissionID: 1}, tt.cookie))
+			checkAccess(t, "GetReconciliation", err, tt.wantCode, tt.wantAccess)
+		})
+	}
+
+	// Teaching assistants cannot change course settings, enrollments or the grading setup.
+	assistantDeniedTests := map[string]accessTest{
+		"teaching assistant": {cookie: assistantCookie, courseID: course.ID, groupID: group.ID, wantAccess: false, wantCode: connect.CodePermissionDenied},
+	}
+	for name, tt := range assistantDeniedTests {
+		t.Run("AssistantDenied/"+name, func(t *testing.T) {
+			_, err := client.UpdateCourse(ctx, qtest.RequestWithCookie(course, tt.cookie))
+			checkAccess(t, "UpdateCourse", err, tt.wantCode, tt.wantAccess)
+			_, err = client.UpdateEnrollments(ctx, qtest.RequestWithCookie(&qf.Enrollments{
+				Enrollments: []*qf.Enrollment{{ID: 1, CourseID: tt.courseID}},
+			}, tt.cookie))
+			checkAccess(t, "UpdateEnrollments", err, tt.wantCode, tt.wantAccess)
+			_, err = client.UpdateAssignments(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: tt.courseID}, tt.cookie))
+			checkAccess(t, "UpdateAssignments", err, tt.wantCode, tt.wantAccess)
+			_, err = client.DeleteGroup(ctx, qtest.RequestWithCookie(&qf.GroupRequest{
+				GroupID:  tt.groupID,
+				CourseID: tt.courseID,
+			}, tt.cookie))
+			checkAccess(t, "DeleteGroup", err, tt.wantCode, tt.wantAccess)
+			_, err = client.CreateBenchmark(ctx, qtest.RequestWithCookie(&qf.GradingBenchmark{CourseID: tt.courseID, AssignmentID: 1}, tt.cookie))
+			checkAccess(t, "CreateBenchmark", err, tt.wantCode, tt.wantAccess)
+			_, err = client.CreateCriterion(ctx, qtest.RequestWithCookie(&qf.GradingCriterion{CourseID: tt.courseID, BenchmarkID: 1}, tt.cookie))
+			checkAccess(t, "CreateCriterion", err, tt.wantCode, tt.wantAccess)
+			_, err = client.AllocateReviewers(ctx, qtest.RequestWithCookie(&qf.ReviewAllocationRequest{CourseID: tt.courseID, AssignmentID: 1}, tt.cookie))
+			checkAccess(t, "AllocateReviewers", err, tt.wantCode, tt.wantAccess)
+			_, err = client.StartPeerReview(ctx, qtest.RequestWithCookie(&qf.PeerReviewRequest{CourseID: tt.courseID, AssignmentID: 1}, tt.cookie))
+			checkAccess(t, "StartPeerReview", err, tt.wantCode, tt.wantAccess)
+		})
+	}
+
 	courseAdminTests := map[string]accessTest{
 		"admin, not enrolled": {cookie: adminCookie, courseID: course.ID, wantAccess: false, wantCode: connect.CodePermissionDenied},
 	}
@@ -22,6 +22,12 @@ type idCleaner interface {
 	RemoveRemoteID()
 }
 
+// anonymizer should be implemented by response types that may hold submissions
+// whose submitter must be hidden from reviewers.
+type anonymizer interface {
+	Anonymize()
+}
+
 type ValidationInterceptor struct {
 	logger *zap.SugaredLogger
 }
@@ -46,7 +52,8 @@ func (*ValidationInterceptor) WrapStreamingClient(next connect.StreamingClientFu
 // that implements the validator interface.
 // Invalid requests are rejected without logging and before it reaches any
 // user-level code and returns an illegal argument to the client.
-// Further, the response values are cleaned of any remote IDs.
+// Further, the response values are cleaned of any remote IDs,
+// and the identities of anonymous submissions are replaced by pseudonyms.
 // In addition, the interceptor also implements a cancellation mechanism.
 func (v *ValidationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return connect.UnaryFunc(func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
@@ -86,5 +93,8 @@ func clean(resp interface{}) {
 		if v, ok := resp.(idCleaner); ok {
 			v.RemoveRemoteID()
 		}
+		if v, ok := resp.(anonymizer); ok {
+			v.Anonymize()
+		}
 	}
 }
@@ -29,3 +29,21 @@ func isValidSubmission(db database.Database, req requestID) bool {
 	}
 	return true
 }
+
+// isPeerReviewer returns true if the user is assigned to peer review the requested submission,
+// and the peer review is open.
+func isPeerReviewer(db database.Database, req requestID, userID uint64) bool {
+	submissionID := req.IDFor("submission")
+	if submissionID == 0 {
+		return false
+	}
+	peerReviews, err := db.GetPeerReviews(&qf.PeerReview{
+		CourseID:     req.IDFor("course"),
+		SubmissionID: submissionID,
+		ReviewerID:   userID,
+	})
+	if err != nil || len(peerReviews) != 1 {
+		return false
+	}
+	return peerReviews[0].GetOpen()
+}
@@ -17,12 +17,14 @@ func TestImplementsValidation(t *testing.T) {
 		cleaner   bool
 		validator bool
 		found     bool
+
+		anonymizer bool
 	}{
 		"qf.Void":                     {cleaner: F, validator: T},
 		"qf.User":                     {cleaner: T, validator: T},
 		"qf.Users":                    {cleaner: T, validator: F},
-		"qf.Submission":               {cleaner: F, validator: F},
-		"qf.Submissions":              {cleaner: F, validator: F},
+		"qf.Submission":               {cleaner: F, validator: F, anonymizer: T},
+		"qf.Submissions":              {cleaner: F, validator: F, anonymizer: T},
 		"qf.Grade":                    {cleaner: F, validator: F},
 		"qf.Enrollment":               {cleaner: T, validator: T},
 		"qf.Enrollments":              {cleaner: T, validator: T},
@@ -46,7 +48,7 @@ func TestImplementsValidation(t *testing.T) {
 		"qf.Task":                     {cleaner: F, validator: F},
 		"qf.GradingCriterion":         {cleaner: F, validator: T},
 		"qf.Repositories":             {cleaner: F, validator: F},
-		"qf.CourseSubmissions":        {cleaner: F, validator: F},
+		"qf.CourseSubmissions":        {cleaner: F, validator: F, anonymizer: T},
 		"qf.Organization":             {cleaner: F, validator: T},
 		"qf.SubmissionRequest":        {cleaner: F, validator: T},
 		"qf.ReviewRequest":            {cleaner: F, validator: T},
@@ -55,6 +57,69 @@ func TestImplementsValidation(t *testing.T) {
 		"qf.EnrollmentRequest":        {cleaner: F, validator: T},
 		"score.Score":                 {cleaner: F, validator: F},
 		"score.BuildInfo":             {cleaner: F, validator: F},
+
+		"qf.RosterEntry":                 {cleaner: F, validator: F},
+		"qf.RosterCoverage":              {cleaner: T, validator: F},
+		"qf.RosterImportRequest":         {cleaner: F, validator: T},
+		"qf.EnrollmentPolicy":            {cleaner: F, validator: T},
+		"qf.GroupPreference":             {cleaner: F, validator: T},
+		"qf.GroupInvitation":             {cleaner: T, validator: T},
+		"qf.GroupInvitations":            {cleaner: T, validator: F},
+		"qf.GroupMembership":             {cleaner: F, validator: F},
+		"qf.GroupMemberships":            {cleaner: T, validator: F},
+		"qf.GroupFormationRequest":       {cleaner: F, validator: T},
+		"qf.CreateGroupsRequest":         {cleaner: F, validator: T},
+		"qf.ContributionRequest":         {cleaner: F, validator: T},
+		"qf.GroupContributions":          {cleaner: T, validator: F},
+		"qf.MemberContribution":          {cleaner: F, validator: F},
+		"qf.AssignmentContribution":      {cleaner: F, validator: F},
+		"qf.ContributionActivity":        {cleaner: F, validator: F},
+		"qf.CriterionDisagreement":       {cleaner: F, validator: F},
+		"qf.Reconciliation":              {cleaner: F, validator: F},
+		"qf.ReconcileRequest":            {cleaner: F, validator: T},
+		"qf.ReviewAllocation":            {cleaner: F, validator: F},
+		"qf.ReviewAllocations":           {cleaner: F, validator: F},
+		"qf.ReviewAllocationRequest":     {cleaner: F, validator: T},
+		"qf.ReviewerLoad":                {cleaner: F, validator: F},
+		"qf.ReviewerLoads":               {cleaner: F, validator: F},
+		"qf.PeerReview":                  {cleaner: F, validator: T},
+		"qf.PeerReviewScore":             {cleaner: F, validator: F},
+		"qf.PeerReviews":                 {cleaner: F, validator: F},
+		"qf.PeerReviewRequest":           {cleaner: F, validator: T},
+		"qf.LineComment":                 {cleaner: F, validator: T},
+		"qf.LineComments":                {cleaner: F, validator: F},
+		"qf.LineCommentRequest":          {cleaner: F, validator: T},
+		"qf.FeedbackSnippet":             {cleaner: F, validator: T},
+		"qf.FeedbackSnippetUsage":        {cleaner: F, validator: F},
+		"qf.FeedbackSnippets":            {cleaner: F, validator: F},
+		"qf.FeedbackSnippetRequest":      {cleaner: F, validator: T},
+		"qf.FeedbackSnippetUsageRequest": {cleaner: F, validator: T},
+		"qf.RegradeRequest":              {cleaner: F, validator: T},
+		"qf.RegradeRequests":             {cleaner: F, validator: F},
+		"qf.RegradeRequestQuery":         {cleaner: F, validator: T},
+		"qf.ScheduledJob":                {cleaner: F, validator: T},
+		"qf.ScheduledJobs":               {cleaner: F, validator: F},
+		"qf.AuditEntry":                  {cleaner: F, validator: F},
+		"qf.AuditEntries":                {cleaner: F, validator: F},
+		"qf.GradingConfig":               {cleaner: F, validator: T},
+		"qf.AssignmentWeight":            {cleaner: F, validator: F},
+		"qf.ExternalComponent":           {cleaner: F, validator: F},
+		"qf.FinalGrade":                  {cleaner: F, validator: F},
+		"qf.GradePart":                   {cleaner: F, validator: F},
+		"qf.FinalGrades":                 {cleaner: F, validator: F},
+		"qf.GradeExportRequest":          {cleaner: F, validator: T},
+		"qf.ExportColumn":                {cleaner: F, validator: F},
+		"qf.GradeExport":                 {cleaner: F, validator: F},
+		"qf.RosterMismatch":              {cleaner: F, validator: F},
+		"qf.ExternalGrade":               {cleaner: F, validator: F},
+		"qf.ExternalGradeImportRequest":  {cleaner: F, validator: T},
+		"qf.ExternalGradeImport":         {cleaner: F, validator: F},
+		"qf.Quiz":                        {cleaner: F, validator: F},
+		"qf.QuizQuestion":                {cleaner: F, validator: F},
+		"qf.QuizAttempt":                 {cleaner: F, validator: F},
+		"qf.QuizAnswer":                  {cleaner: F, validator: F},
+		"qf.QuizRequest":                 {cleaner: F, validator: T},
+		"qf.QuizSubmission":              {cleaner: F, validator: T},
 	}
 
 	protoregistry.GlobalTypes.RangeMessages(func(desc protoreflect.MessageType) bool {
@@ -81,6 +146,12 @@ func TestImplementsValidation(t *testing.T) {
 			t.Errorf("Message %s implements idCleaner, but should not", name)
 		}
 
+		if _, ok = msg.(anonymizer); test.anonymizer && !ok {
+			t.Errorf("Message %s does not implement anonymizer", name)
+		} else if !test.anonymizer && ok {
+			t.Errorf("Message %s implements anonymizer, but should not", name)
+		}
+
 		if _, ok = msg.(validator); test.validator && !ok {
 			t.Errorf("Message %s does not implement validator", name)
 		} else if !test.validator && ok {
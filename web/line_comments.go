@@ -0,0 +1,190 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+var ErrLineCommentAccess = errors.New("line comments are only available for your own submissions")
+
+// getLineComments returns the line comments on the requested submission.
+// Teachers and teaching assistants get all line comments; students only get the line comments on their own
+// submissions, and only after the submission has been released.
+func (s *QuickFeedService) getLineComments(userID uint64, request *qf.LineCommentRequest) (*qf.LineComments, error) {
+	submission, err := s.getCourseSubmission(request.GetCourseID(), request.GetSubmissionID())
+	if err != nil {
+		return nil, err
+	}
+	if !s.isStaff(userID, request.GetCourseID()) {
+		if !submission.BelongsTo(userID) {
+			return nil, ErrLineCommentAccess
+		}
+		if !submission.GetReleased() {
+			return &qf.LineComments{}, nil
+		}
+	}
+	comments, err := s.db.GetLineComments(&qf.LineComment{SubmissionID: submission.GetID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get line comments for submission %d: %w", submission.GetID(), err)
+	}
+	return &qf.LineComments{Comments: comments}, nil
+}
+
+// createLineComment creates the author's line comment on the given submission.
+// If the comment's commit hash is not set, the comment refers to the submission's commit.
+// The comment is mirrored as a review comment on the pull request for the submission's task, if any.
+func (s *QuickFeedService) createLineComment(ctx context.Context, authorID uint64, comment *qf.LineComment) (*qf.LineComment, error) {
+	submission, err := s.getCourseSubmission(comment.GetCourseID(), comment.GetSubmissionID())
+	if err != nil {
+		return nil, err
+	}
+	comment.ID = 0
+	comment.AuthorID = authorID
+	comment.ScmCommentID = 0
+	comment.Edited = timestamppb.Now()
+	if comment.GetCommitHash() == "" {
+		comment.CommitHash = submission.GetCommitHash()
+	}
+	if err := s.db.CreateLineComment(comment); err != nil {
+		return nil, fmt.Errorf("failed to create line comment: %w", err)
+	}
+	if err := s.mirrorLineComment(ctx, submission, comment); err != nil {
+		// The line comment is still available in QuickFeed; only the pull request review comment is missing.
+		s.logger.Errorf("Failed to mirror line comment %d on submission %d: %v", comment.GetID(), submission.GetID(), err)
+	}
+	return comment, nil
+}
+
+// updateLineComment updates the body and line range of the given line comment.
+// The commit, file, author and mirrored review comment of the stored comment are kept.
+func (s *QuickFeedService) updateLineComment(comment *qf.LineComment) (*qf.LineComment, error) {
+	stored, err := s.getLineComment(comment)
+	if err != nil {
+		return nil, err
+	}
+	stored.StartLine = comment.GetStartLine()
+	stored.EndLine = comment.GetEndLine()
+	stored.Body = comment.GetBody()
+	stored.Edited = timestamppb.Now()
+	if err := s.db.UpdateLineComment(stored); err != nil {
+		return nil, fmt.Errorf("failed to update line comment %d: %w", stored.GetID(), err)
+	}
+	return stored, nil
+}
+
+// deleteLineComment removes the given line comment.
+func (s *QuickFeedService) deleteLineComment(comment *qf.LineComment) error {
+	stored, err := s.getLineComment(comment)
+	if err != nil {
+		return err
+	}
+	if err := s.db.DeleteLineComment(stored); err != nil {
+		return fmt.Errorf("failed to delete line comment %d: %w", stored.GetID(), err)
+	}
+	return nil
+}
+
+// getLineComment returns the stored line comment with the given comment's ID,
+// ensuring that it belongs to the given comment's course and submission.
+func (s *QuickFeedService) getLineComment(comment *qf.LineComment) (*qf.LineComment, error) {
+	comments, err := s.db.GetLineComments(&qf.LineComment{
+		ID:           comment.GetID(),
+		CourseID:     comment.GetCourseID(),
+		SubmissionID: comment.GetSubmissionID(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get line comment %d: %w", comment.GetID(), err)
+	}
+	if comment.GetID() == 0 || len(comments) != 1 {
+		return nil, fmt.Errorf("line comment %d not found for submission %d", comment.GetID(), comment.GetSubmissionID())
+	}
+	return comments[0], nil
+}
+
+// getCourseSubmission returns the given submission, ensuring that it belongs to the given course.
+func (s *QuickFeedService) getCourseSubmission(courseID, submissionID uint64) (*qf.Submission, error) {
+	submission, err := s.db.GetSubmission(&qf.Submission{ID: submissionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get submission %d: %w", submissionID, err)
+	}
+	if _, err := s.db.GetAssignment(&qf.Assignment{ID: submission.GetAssignmentID(), CourseID: courseID}); err != nil {
+		return nil, fmt.Errorf("failed to get assignment %d for submission %d in course %d: %w", submission.GetAssignmentID(), submissionID, courseID, err)
+	}
+	return submission, nil
+}
+
+// mirrorLineComment creates a review comment mirroring the line comment on the pull request
+// for one of the assignment's tasks in the submission's group repository.
+// Submissions without a pull request are ignored.
+func (s *QuickFeedService) mirrorLineComment(ctx context.Context, submission *qf.Submission, comment *qf.LineComment) error {
+	if submission.GetGroupID() == 0 {
+		return nil // only group repositories have pull requests
+	}
+	pullRequest, err := s.taskPullRequest(submission)
+	if err != nil || pullRequest == nil {
+		return err
+	}
+	course, err := s.db.GetCourse(comment.GetCourseID(), false)
+	if err != nil {
+		return fmt.Errorf("failed to get course %d: %w", comment.GetCourseID(), err)
+	}
+	repository, err := s.submissionRepository(submission.GetID())
+	if err != nil {
+		return err
+	}
+	sc, err := s.getSCM(ctx, course.GetScmOrganizationName())
+	if err != nil {
+		return err
+	}
+	scmCommentID, err := sc.CreateReviewComment(ctx, &scm.ReviewCommentOptions{
+		Organization: course.GetScmOrganizationName(),
+		Repository:   repository,
+		Number:       int(pullRequest.GetNumber()),
+		CommitID:     comment.GetCommitHash(),
+		Path:         comment.GetPath(),
+		StartLine:    int(comment.GetStartLine()),
+		Line:         int(comment.GetEndLine()),
+		Body:         comment.GetBody(),
+	})
+	if err != nil {
+		return err
+	}
+	comment.ScmCommentID = scmCommentID
+	return s.db.UpdateLineComment(comment)
+}
+
+// taskPullRequest returns the pull request for one of the submission's assignment tasks
+// in the submission's group repository, or nil if there is no such pull request.
+func (s *QuickFeedService) taskPullRequest(submission *qf.Submission) (*qf.PullRequest, error) {
+	repos, err := s.db.GetRepositories(&qf.Repository{GroupID: submission.GetGroupID(), RepoType: qf.Repository_GROUP})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository for group %d: %w", submission.GetGroupID(), err)
+	}
+	if len(repos) != 1 {
+		return nil, nil
+	}
+	tasks, err := s.db.GetTasks(&qf.Task{AssignmentID: submission.GetAssignmentID()})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks for assignment %d: %w", submission.GetAssignmentID(), err)
+	}
+	for _, task := range tasks {
+		pullRequest, err := s.db.GetPullRequest(&qf.PullRequest{ScmRepositoryID: repos[0].GetScmRepositoryID(), TaskID: task.GetID()})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get pull request for task %d: %w", task.GetID(), err)
+		}
+		return pullRequest, nil
+	}
+	return nil, nil
+}
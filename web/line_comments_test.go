@@ -0,0 +1,119 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestLineComments(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	student := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, student, course)
+	otherStudent := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, otherStudent, course)
+
+	lab := &qf.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &qf.Submission{AssignmentID: lab.ID, UserID: student.ID, CommitHash: "abc123"}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	teacherCookie := Cookie(t, tm, admin)
+	studentCookie := Cookie(t, tm, student)
+
+	comment := &qf.LineComment{
+		CourseID:     course.ID,
+		SubmissionID: submission.ID,
+		Path:         "lab1/main.go",
+		StartLine:    40,
+		EndLine:      42,
+		Body:         "This loop never terminates.",
+	}
+	if _, err := client.CreateLineComment(ctx, qtest.RequestWithCookie(comment, studentCookie)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("CreateLineComment() by student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	invalid := &qf.LineComment{CourseID: course.ID, SubmissionID: submission.ID, Path: "lab1/main.go", StartLine: 42, EndLine: 40, Body: "Invalid range"}
+	if _, err := client.CreateLineComment(ctx, qtest.RequestWithCookie(invalid, teacherCookie)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("CreateLineComment() with invalid line range: got %v, want %v", err, connect.CodeInvalidArgument)
+	}
+	created, err := client.CreateLineComment(ctx, qtest.RequestWithCookie(comment, teacherCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Msg.GetAuthorID() != admin.ID || created.Msg.GetCommitHash() != submission.CommitHash || created.Msg.GetEdited() == nil {
+		t.Errorf("CreateLineComment() = %v, want comment by %d on commit %s", created.Msg, admin.ID, submission.CommitHash)
+	}
+
+	request := &qf.LineCommentRequest{CourseID: course.ID, SubmissionID: submission.ID}
+	teacherComments, err := client.GetLineComments(ctx, qtest.RequestWithCookie(request, teacherCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*qf.LineComment{created.Msg}, teacherComments.Msg.GetComments(), protocmp.Transform()); diff != "" {
+		t.Errorf("GetLineComments() by teacher mismatch (-want +got):\n%s", diff)
+	}
+
+	// students cannot see line comments before the submission is released
+	studentComments, err := client.GetLineComments(ctx, qtest.RequestWithCookie(request, studentCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(studentComments.Msg.GetComments()) != 0 {
+		t.Errorf("GetLineComments() before release = %v, want no comments", studentComments.Msg.GetComments())
+	}
+
+	created.Msg.Body = "This loop never terminates; check the exit condition."
+	created.Msg.StartLine = 41
+	updated, err := client.UpdateLineComment(ctx, qtest.RequestWithCookie(created.Msg, teacherCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Msg.GetBody() != created.Msg.GetBody() || updated.Msg.GetStartLine() != 41 || updated.Msg.GetPath() != comment.GetPath() {
+		t.Errorf("UpdateLineComment() = %v, want updated body and start line", updated.Msg)
+	}
+
+	submission.Released = true
+	if err := db.UpdateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	studentComments, err = client.GetLineComments(ctx, qtest.RequestWithCookie(request, studentCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*qf.LineComment{updated.Msg}, studentComments.Msg.GetComments(), protocmp.Transform()); diff != "" {
+		t.Errorf("GetLineComments() after release mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := client.GetLineComments(ctx, qtest.RequestWithCookie(request, Cookie(t, tm, otherStudent))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("GetLineComments() by other student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+
+	if _, err := client.DeleteLineComment(ctx, qtest.RequestWithCookie(updated.Msg, teacherCookie)); err != nil {
+		t.Fatal(err)
+	}
+	teacherComments, err = client.GetLineComments(ctx, qtest.RequestWithCookie(request, teacherCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(teacherComments.Msg.GetComments()) != 0 {
+		t.Errorf("GetLineComments() after delete = %v, want no comments", teacherComments.Msg.GetComments())
+	}
+}
@@ -0,0 +1,333 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/quickfeed/quickfeed/assignments"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrPeerReviewBeforeDeadline = errors.New("peer review cannot start before the assignment's deadline")
+	ErrPeerReviewStarted        = errors.New("peer review has already started for the assignment")
+	ErrPeerReviewNotOpen        = errors.New("peer review is not open")
+)
+
+// startPeerReview assigns each student the assignment's number of peer submissions to review,
+// and grants each reviewer read access to the repositories of the submissions they review.
+func (s *QuickFeedService) startPeerReview(ctx context.Context, request *qf.PeerReviewRequest) (*qf.PeerReviews, error) {
+	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: request.GetAssignmentID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assignment %d: %w", request.GetAssignmentID(), err)
+	}
+	if assignment.SinceDeadline(time.Now()) < 0 {
+		return nil, ErrPeerReviewBeforeDeadline
+	}
+	existing, err := s.db.GetPeerReviews(&qf.PeerReview{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get peer reviews for assignment %s: %w", assignment.GetName(), err)
+	}
+	if len(existing) > 0 {
+		return nil, ErrPeerReviewStarted
+	}
+	submissions, err := s.getAssignmentSubmissions(assignment)
+	if err != nil {
+		return nil, err
+	}
+	peerReviews, err := assignments.AssignPeerReviews(assignment, submissions, rand.New(rand.NewSource(time.Now().UnixNano())))
+	if err != nil {
+		return nil, err
+	}
+	// Access is granted before the peer reviews are saved, so that a failed start can be retried.
+	if err := s.updatePeerReviewAccess(ctx, request.GetCourseID(), peerReviews, nil); err != nil {
+		return nil, err
+	}
+	if err := s.db.CreatePeerReviews(peerReviews); err != nil {
+		return nil, fmt.Errorf("failed to save peer reviews for assignment %s: %w", assignment.GetName(), err)
+	}
+	if err := s.db.UpdatePeerReviewScores(assignments.PeerReviewScores(peerReviews)); err != nil {
+		return nil, fmt.Errorf("failed to save peer review scores for assignment %s: %w", assignment.GetName(), err)
+	}
+	return s.getPeerReviews(request, 0)
+}
+
+// endPeerReview closes the assignment's open peer reviews, and revokes the reviewers' read access
+// to the reviewed repositories, unless they still need access for another open peer review.
+func (s *QuickFeedService) endPeerReview(ctx context.Context, request *qf.PeerReviewRequest) (*qf.PeerReviews, error) {
+	peerReviews, err := s.db.GetPeerReviews(&qf.PeerReview{CourseID: request.GetCourseID(), Open: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get peer reviews for course %d: %w", request.GetCourseID(), err)
+	}
+	var closed, stillOpen []*qf.PeerReview
+	for _, peerReview := range peerReviews {
+		if peerReview.GetAssignmentID() == request.GetAssignmentID() {
+			closed = append(closed, peerReview)
+		} else {
+			stillOpen = append(stillOpen, peerReview)
+		}
+	}
+	if err := s.updatePeerReviewAccess(ctx, request.GetCourseID(), stillOpen, closed); err != nil {
+		return nil, err
+	}
+	for _, peerReview := range closed {
+		peerReview.Open = false
+		if err := s.db.UpdatePeerReview(peerReview); err != nil {
+			return nil, fmt.Errorf("failed to close peer review %d: %w", peerReview.GetID(), err)
+		}
+	}
+	return s.getPeerReviews(request, 0)
+}
+
+// getPeerReviews returns the assignment's peer reviews, with the reviews created by the reviewers
+// and the reviewers' peer review scores.
+// If reviewerID is non-zero, only the peer reviews and score of the given reviewer are returned.
+func (s *QuickFeedService) getPeerReviews(request *qf.PeerReviewRequest, reviewerID uint64) (*qf.PeerReviews, error) {
+	peerReviews, err := s.db.GetPeerReviews(&qf.PeerReview{
+		CourseID:     request.GetCourseID(),
+		AssignmentID: request.GetAssignmentID(),
+		ReviewerID:   reviewerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get peer reviews for assignment %d: %w", request.GetAssignmentID(), err)
+	}
+	for _, peerReview := range peerReviews {
+		if peerReview.GetReviewID() == 0 {
+			continue
+		}
+		if peerReview.Review, err = s.db.GetReview(&qf.Review{ID: peerReview.GetReviewID()}); err != nil {
+			return nil, fmt.Errorf("failed to get review %d: %w", peerReview.GetReviewID(), err)
+		}
+	}
+	peerReviewScores, err := s.db.GetPeerReviewScores(&qf.PeerReviewScore{
+		CourseID:     request.GetCourseID(),
+		AssignmentID: request.GetAssignmentID(),
+		UserID:       reviewerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get peer review scores for assignment %d: %w", request.GetAssignmentID(), err)
+	}
+	scores := make(map[uint64]uint32)
+	for _, score := range peerReviewScores {
+		scores[score.GetUserID()] = score.GetScore()
+	}
+	return &qf.PeerReviews{PeerReviews: peerReviews, Scores: scores}, nil
+}
+
+// updatePeerReviewScore saves the reviewer's peer review score for the given peer review's assignment.
+func (s *QuickFeedService) updatePeerReviewScore(peerReview *qf.PeerReview) error {
+	peerReviews, err := s.db.GetPeerReviews(&qf.PeerReview{
+		CourseID:     peerReview.GetCourseID(),
+		AssignmentID: peerReview.GetAssignmentID(),
+		ReviewerID:   peerReview.GetReviewerID(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get peer reviews by reviewer %d: %w", peerReview.GetReviewerID(), err)
+	}
+	if err := s.db.UpdatePeerReviewScores(assignments.PeerReviewScores(peerReviews)); err != nil {
+		return fmt.Errorf("failed to save peer review score of reviewer %d: %w", peerReview.GetReviewerID(), err)
+	}
+	return nil
+}
+
+// gradePeerReview sets the teacher's assessment of the given peer review's quality,
+// and updates the reviewer's peer review score.
+func (s *QuickFeedService) gradePeerReview(request *qf.PeerReview) (*qf.PeerReview, error) {
+	peerReviews, err := s.db.GetPeerReviews(&qf.PeerReview{ID: request.GetID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get peer review %d: %w", request.GetID(), err)
+	}
+	if len(peerReviews) != 1 {
+		return nil, fmt.Errorf("peer review %d not found in course %d", request.GetID(), request.GetCourseID())
+	}
+	peerReview := peerReviews[0]
+	peerReview.Quality = request.GetQuality()
+	if err := s.db.UpdatePeerReview(peerReview); err != nil {
+		return nil, fmt.Errorf("failed to update peer review %d: %w", peerReview.GetID(), err)
+	}
+	if err := s.updatePeerReviewScore(peerReview); err != nil {
+		return nil, err
+	}
+	return peerReview, nil
+}
+
+// createPeerReview creates the reviewer's peer review of the given review's submission.
+func (s *QuickFeedService) createPeerReview(reviewerID uint64, review *qf.Review) (*qf.Review, error) {
+	peerReview, err := s.getOpenPeerReview(review.GetSubmissionID(), reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	if peerReview.GetReviewID() > 0 {
+		return nil, fmt.Errorf("reviewer %d has already created a peer review of submission %d", reviewerID, review.GetSubmissionID())
+	}
+	benchmarks, err := s.db.GetBenchmarks(&qf.Assignment{ID: peerReview.GetAssignmentID()})
+	if err != nil {
+		return nil, err
+	}
+	review.ReviewerID = reviewerID
+	review.Ready = false
+	review.Final = false
+	review.Peer = true
+	review.Edited = timestamppb.Now()
+	review.GradingBenchmarks = benchmarks
+	for _, bm := range review.GradingBenchmarks {
+		bm.ID = 0
+		for _, c := range bm.Criteria {
+			c.ID = 0
+		}
+	}
+	review.ComputeScore()
+	if err := s.db.CreateReview(review); err != nil {
+		return nil, err
+	}
+	peerReview.ReviewID = review.GetID()
+	if err := s.db.UpdatePeerReview(peerReview); err != nil {
+		return nil, fmt.Errorf("failed to update peer review %d: %w", peerReview.GetID(), err)
+	}
+	// the peer review's quality counts toward the reviewer's score once the peer review is created
+	if err := s.updatePeerReviewScore(peerReview); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// updatePeerReview updates the reviewer's peer review. The review's grading benchmarks and
+// criteria must belong to the stored peer review.
+func (s *QuickFeedService) updatePeerReview(reviewerID uint64, review *qf.Review) (*qf.Review, error) {
+	storedReview, err := s.db.GetReview(&qf.Review{ID: review.GetID()})
+	if err != nil {
+		return nil, err
+	}
+	if !storedReview.GetPeer() || storedReview.GetReviewerID() != reviewerID || storedReview.GetSubmissionID() != review.GetSubmissionID() {
+		return nil, fmt.Errorf("review %d is not a peer review of submission %d by reviewer %d", review.GetID(), review.GetSubmissionID(), reviewerID)
+	}
+	if _, err := s.getOpenPeerReview(review.GetSubmissionID(), reviewerID); err != nil {
+		return nil, err
+	}
+	if !sameGradingCriteria(storedReview, review) {
+		return nil, fmt.Errorf("review %d has grading criteria that do not belong to the peer review", review.GetID())
+	}
+	review.Final = false
+	review.Peer = true
+	review.Edited = timestamppb.Now()
+	review.ComputeScore()
+	if err := s.saveReview(review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// getOpenPeerReview returns the reviewer's open peer review of the given submission.
+func (s *QuickFeedService) getOpenPeerReview(submissionID, reviewerID uint64) (*qf.PeerReview, error) {
+	peerReviews, err := s.db.GetPeerReviews(&qf.PeerReview{SubmissionID: submissionID, ReviewerID: reviewerID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get peer review of submission %d by reviewer %d: %w", submissionID, reviewerID, err)
+	}
+	if len(peerReviews) != 1 || !peerReviews[0].GetOpen() {
+		return nil, fmt.Errorf("submission %d by reviewer %d: %w", submissionID, reviewerID, ErrPeerReviewNotOpen)
+	}
+	return peerReviews[0], nil
+}
+
+// updatePeerReviewAccess grants the reviewers of the open peer reviews read access to the reviewed repositories,
+// and revokes the reviewers' access for the closed peer reviews, unless an open peer review still needs it.
+func (s *QuickFeedService) updatePeerReviewAccess(ctx context.Context, courseID uint64, open, closed []*qf.PeerReview) error {
+	course, err := s.db.GetCourse(courseID, false)
+	if err != nil {
+		return fmt.Errorf("failed to get course %d: %w", courseID, err)
+	}
+	sc, err := s.getSCM(ctx, course.GetScmOrganizationName())
+	if err != nil {
+		return err
+	}
+	access := func(peerReview *qf.PeerReview) (*scm.CollaboratorOptions, error) {
+		repository, err := s.submissionRepository(peerReview.GetSubmissionID())
+		if err != nil {
+			return nil, err
+		}
+		reviewer, err := s.db.GetUser(peerReview.GetReviewerID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get reviewer %d: %w", peerReview.GetReviewerID(), err)
+		}
+		return &scm.CollaboratorOptions{
+			Organization: course.GetScmOrganizationName(),
+			Repository:   repository,
+			User:         reviewer.GetLogin(),
+		}, nil
+	}
+	needed := make(map[scm.CollaboratorOptions]bool)
+	for _, peerReview := range open {
+		opt, err := access(peerReview)
+		if err != nil {
+			return err
+		}
+		needed[*opt] = true
+	}
+	for opt := range needed {
+		if err := sc.GrantReadAccess(ctx, &opt); err != nil {
+			return err
+		}
+	}
+	for _, peerReview := range closed {
+		opt, err := access(peerReview)
+		if err != nil {
+			return err
+		}
+		if needed[*opt] {
+			continue
+		}
+		if err := sc.RevokeAccess(ctx, opt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// submissionRepository returns the name of the repository holding the given submission.
+func (s *QuickFeedService) submissionRepository(submissionID uint64) (string, error) {
+	submission, err := s.db.GetSubmission(&qf.Submission{ID: submissionID})
+	if err != nil {
+		return "", fmt.Errorf("failed to get submission %d: %w", submissionID, err)
+	}
+	if submission.GetGroupID() > 0 {
+		group, err := s.db.GetGroup(submission.GetGroupID())
+		if err != nil {
+			return "", fmt.Errorf("failed to get group %d: %w", submission.GetGroupID(), err)
+		}
+		return group.GetName(), nil
+	}
+	user, err := s.db.GetUser(submission.GetUserID())
+	if err != nil {
+		return "", fmt.Errorf("failed to get user %d: %w", submission.GetUserID(), err)
+	}
+	return qf.StudentRepoName(user.GetLogin()), nil
+}
+
+// sameGradingCriteria returns true if all grading benchmarks and criteria of the updated review
+// belong to the stored review.
+func sameGradingCriteria(stored, updated *qf.Review) bool {
+	benchmarks := make(map[uint64]bool)
+	criteria := make(map[uint64]uint64) // [criterionID] -> benchmarkID
+	for _, bm := range stored.GetGradingBenchmarks() {
+		benchmarks[bm.GetID()] = true
+		for _, c := range bm.GetCriteria() {
+			criteria[c.GetID()] = bm.GetID()
+		}
+	}
+	for _, bm := range updated.GetGradingBenchmarks() {
+		if !benchmarks[bm.GetID()] || bm.GetReviewID() != stored.GetID() {
+			return false
+		}
+		for _, c := range bm.GetCriteria() {
+			if benchmarkID, ok := criteria[c.GetID()]; !ok || benchmarkID != bm.GetID() || c.GetBenchmarkID() != bm.GetID() {
+				return false
+			}
+		}
+	}
+	return true
+}
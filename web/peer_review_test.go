@@ -0,0 +1,177 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPeerReview(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	logins := []string{"alice", "bob", "carol"}
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockCourses(logins...))
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+
+	benchmarks := func() []*qf.GradingBenchmark {
+		return []*qf.GradingBenchmark{{
+			CourseID: course.ID,
+			Heading:  "Code",
+			Criteria: []*qf.GradingCriterion{{CourseID: course.ID, Description: "Compiles"}},
+		}}
+	}
+	openLab := &qf.Assignment{
+		CourseID:          course.ID,
+		Name:              "lab1",
+		Order:             1,
+		Reviewers:         1,
+		PeerReviews:       1,
+		Deadline:          timestamppb.New(time.Now().Add(time.Hour)),
+		GradingBenchmarks: benchmarks(),
+	}
+	lab := &qf.Assignment{
+		CourseID:          course.ID,
+		Name:              "lab2",
+		Order:             2,
+		Reviewers:         1,
+		PeerReviews:       1,
+		Deadline:          timestamppb.New(time.Now().Add(-time.Hour)),
+		GradingBenchmarks: benchmarks(),
+	}
+	for _, assignment := range []*qf.Assignment{openLab, lab} {
+		if err := db.CreateAssignment(assignment); err != nil {
+			t.Fatal(err)
+		}
+	}
+	students := make(map[uint64]*qf.User)    // [userID] -> student
+	submissions := make(map[uint64]*qf.User) // [submissionID] -> submitter
+	for _, login := range logins {
+		student := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: login, Login: login})
+		qtest.EnrollStudent(t, db, student, course)
+		submission := &qf.Submission{AssignmentID: lab.ID, UserID: student.ID}
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+		students[student.ID] = student
+		submissions[submission.ID] = student
+	}
+
+	ctx := context.Background()
+	adminCookie := Cookie(t, tm, admin)
+	if _, err := client.StartPeerReview(ctx, qtest.RequestWithCookie(&qf.PeerReviewRequest{CourseID: course.ID, AssignmentID: openLab.ID}, adminCookie)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("StartPeerReview() before deadline: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	request := &qf.PeerReviewRequest{CourseID: course.ID, AssignmentID: lab.ID}
+	started, err := client.StartPeerReview(ctx, qtest.RequestWithCookie(request, adminCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(started.Msg.GetPeerReviews()) != len(logins) {
+		t.Fatalf("StartPeerReview() got %d peer reviews, want %d", len(started.Msg.GetPeerReviews()), len(logins))
+	}
+	if _, err := client.StartPeerReview(ctx, qtest.RequestWithCookie(request, adminCookie)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("StartPeerReview() again: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	// each student reviews another student's submission
+	peerReviews := make(map[uint64]*qf.PeerReview) // [reviewerID] -> peer review
+	for _, student := range students {
+		got, err := client.GetPeerReviews(ctx, qtest.RequestWithCookie(request, Cookie(t, tm, student)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.Msg.GetPeerReviews()) != 1 {
+			t.Fatalf("GetPeerReviews() for %s got %d peer reviews, want 1", student.GetLogin(), len(got.Msg.GetPeerReviews()))
+		}
+		peerReview := got.Msg.GetPeerReviews()[0]
+		if peerReview.GetReviewerID() != student.GetID() || submissions[peerReview.GetSubmissionID()] == student {
+			t.Errorf("GetPeerReviews() for %s = %v, want peer review of another student's submission", student.GetLogin(), peerReview)
+		}
+		peerReviews[student.GetID()] = peerReview
+	}
+
+	reviewer := students[started.Msg.GetPeerReviews()[0].GetReviewerID()]
+	peerReview := peerReviews[reviewer.GetID()]
+	reviewRequest := &qf.ReviewRequest{
+		CourseID: course.ID,
+		Review:   &qf.Review{SubmissionID: peerReview.GetSubmissionID(), ReviewerID: reviewer.GetID()},
+	}
+	// only the assigned peer reviewer may review the submission
+	submitter := submissions[peerReview.GetSubmissionID()]
+	if _, err := client.CreatePeerReview(ctx, qtest.RequestWithCookie(reviewRequest, Cookie(t, tm, submitter))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("CreatePeerReview() by submitter: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	reviewerCookie := Cookie(t, tm, reviewer)
+	review, err := client.CreatePeerReview(ctx, qtest.RequestWithCookie(reviewRequest, reviewerCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !review.Msg.GetPeer() || len(review.Msg.GetGradingBenchmarks()) != 1 {
+		t.Fatalf("CreatePeerReview() = %v, want peer review with grading benchmarks", review.Msg)
+	}
+	review.Msg.GetGradingBenchmarks()[0].GetCriteria()[0].Grade = qf.GradingCriterion_PASSED
+	review.Msg.Ready = true
+	updated, err := client.UpdatePeerReview(ctx, qtest.RequestWithCookie(&qf.ReviewRequest{CourseID: course.ID, Review: review.Msg}, reviewerCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Msg.GetScore() != 100 {
+		t.Errorf("UpdatePeerReview() score = %d, want 100", updated.Msg.GetScore())
+	}
+	// peer reviews do not affect the submission's score
+	submission, err := db.GetSubmission(&qf.Submission{ID: peerReview.GetSubmissionID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if submission.GetScore() != 0 {
+		t.Errorf("submission score = %d, want 0", submission.GetScore())
+	}
+
+	// the reviewer's peer review score is saved, and replaced when the peer review is graded again
+	for _, quality := range []uint32{40, 80} {
+		if _, err := client.GradePeerReview(ctx, qtest.RequestWithCookie(&qf.PeerReview{ID: peerReview.GetID(), CourseID: course.ID, Quality: quality}, adminCookie)); err != nil {
+			t.Fatal(err)
+		}
+		scores, err := db.GetPeerReviewScores(&qf.PeerReviewScore{AssignmentID: lab.ID, UserID: reviewer.GetID()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(scores) != 1 || scores[0].GetScore() != quality {
+			t.Errorf("GetPeerReviewScores() = %v, want one score of %d", scores, quality)
+		}
+	}
+	ended, err := client.EndPeerReview(ctx, qtest.RequestWithCookie(request, adminCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pr := range ended.Msg.GetPeerReviews() {
+		if pr.GetOpen() {
+			t.Errorf("EndPeerReview() peer review %d is still open", pr.GetID())
+		}
+		wantScore := uint32(0)
+		if pr.GetReviewerID() == reviewer.GetID() {
+			wantScore = 80
+			if pr.GetReview().GetID() != review.Msg.GetID() {
+				t.Errorf("EndPeerReview() peer review %d has review %v, want review %d", pr.GetID(), pr.GetReview(), review.Msg.GetID())
+			}
+		}
+		if got := ended.Msg.GetScores()[pr.GetReviewerID()]; got != wantScore {
+			t.Errorf("EndPeerReview() score for reviewer %d = %d, want %d", pr.GetReviewerID(), got, wantScore)
+		}
+	}
+	if _, err := client.UpdatePeerReview(ctx, qtest.RequestWithCookie(&qf.ReviewRequest{CourseID: course.ID, Review: review.Msg}, reviewerCookie)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("UpdatePeerReview() after end: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/quickfeed/quickfeed/assignments"
 	"github.com/quickfeed/quickfeed/ci"
 	"github.com/quickfeed/quickfeed/database"
+	"github.com/quickfeed/quickfeed/internal/rand"
 	"github.com/quickfeed/quickfeed/qf"
 	"github.com/quickfeed/quickfeed/qf/qfconnect"
 	"github.com/quickfeed/quickfeed/scm"
@@ -27,6 +28,8 @@ type QuickFeedService struct {
 	runner ci.Runner
 	qfconnect.UnimplementedQuickFeedServiceHandler
 	streams *stream.StreamServices
+	// pseudonymKey is used to compute pseudonyms for submissions to anonymous assignments.
+	pseudonymKey []byte
 }
 
 // NewQuickFeedService returns a QuickFeedService object.
@@ -38,6 +41,8 @@ func NewQuickFeedService(logger *zap.Logger, db database.Database, mgr *scm.Mana
 		bh:      bh,
 		runner:  runner,
 		streams: stream.NewStreamServices(),
+
+		pseudonymKey: []byte(rand.String()),
 	}
 }
 
@@ -140,14 +145,12 @@ func (s *QuickFeedService) UpdateCourseVisibility(ctx context.Context, in *conne
 }
 
 // CreateEnrollment enrolls a new student for the course specified in the request.
-func (s *QuickFeedService) CreateEnrollment(_ context.Context, in *connect.Request[qf.Enrollment]) (*connect.Response[qf.Void], error) {
-	enrollment := &qf.Enrollment{
-		UserID:   in.Msg.GetUserID(),
-		CourseID: in.Msg.GetCourseID(),
-		Status:   qf.Enrollment_PENDING,
-	}
-	if err := s.db.CreateEnrollment(enrollment); err != nil {
+func (s *QuickFeedService) CreateEnrollment(ctx context.Context, in *connect.Request[qf.Enrollment]) (*connect.Response[qf.Void], error) {
+	if err := s.createEnrollment(ctx, in.Msg); err != nil {
 		s.logger.Errorf("CreateEnrollment failed: %v", err)
+		if connect.CodeOf(err) != connect.CodeUnknown {
+			return nil, err
+		}
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to create enrollment"))
 	}
 	return &connect.Response[qf.Void]{}, nil
@@ -177,6 +180,9 @@ func (s *QuickFeedService) UpdateEnrollments(ctx context.Context, in *connect.Re
 				s.logger.Error(ctxErr)
 				return nil, ctxErr
 			}
+			if connect.CodeOf(err) != connect.CodeUnknown {
+				return nil, err
+			}
 			if ok, parsedErr := parseSCMError(err); ok {
 				return nil, parsedErr
 			}
@@ -186,6 +192,45 @@ func (s *QuickFeedService) UpdateEnrollments(ctx context.Context, in *connect.Re
 	return &connect.Response[qf.Void]{}, nil
 }
 
+// ImportRoster replaces the course's institution roster and approves the pending enrollments that match the roster.
+func (s *QuickFeedService) ImportRoster(ctx context.Context, in *connect.Request[qf.RosterImportRequest]) (*connect.Response[qf.RosterCoverage], error) {
+	scmClient, err := s.getSCMForCourse(ctx, in.Msg.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("ImportRoster failed: could not create scm client for course %d: %v", in.Msg.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	coverage, err := s.importRoster(ctx, scmClient, in.Msg)
+	if err != nil {
+		s.logger.Errorf("ImportRoster failed for course %d: %v", in.Msg.GetCourseID(), err)
+		if connect.CodeOf(err) != connect.CodeUnknown {
+			// err was already a status error; return it to client.
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to import roster"))
+	}
+	return connect.NewResponse(coverage), nil
+}
+
+// GetRosterCoverage reports which students on the course's roster have not signed up for the course.
+func (s *QuickFeedService) GetRosterCoverage(_ context.Context, in *connect.Request[qf.CourseRequest]) (*connect.Response[qf.RosterCoverage], error) {
+	coverage, err := s.getRosterCoverage(in.Msg.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetRosterCoverage failed for course %d: %v", in.Msg.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get roster coverage"))
+	}
+	return connect.NewResponse(coverage), nil
+}
+
+// GetEnrollmentPolicy returns the course's enrollment policy.
+func (s *QuickFeedService) GetEnrollmentPolicy(_ context.Context, in *connect.Request[qf.CourseRequest]) (*connect.Response[qf.EnrollmentPolicy], error) {
+	policy, err := s.getEnrollmentPolicy(in.Msg.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetEnrollmentPolicy failed for course %d: %v", in.Msg.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get enrollment policy"))
+	}
+	return connect.NewResponse(policy), nil
+}
+
 // GetEnrollments returns all enrollments for the given course ID or user ID and enrollment status.
 func (s *QuickFeedService) GetEnrollments(_ context.Context, in *connect.Request[qf.EnrollmentRequest]) (*connect.Response[qf.Enrollments], error) {
 	var enrollments []*qf.Enrollment
@@ -245,8 +290,9 @@ func (s *QuickFeedService) GetGroupsByCourse(_ context.Context, in *connect.Requ
 
 // CreateGroup creates a new group in the database.
 // Access policy: Any User enrolled in course and specified as member of the group or a course teacher.
-func (s *QuickFeedService) CreateGroup(_ context.Context, in *connect.Request[qf.Group]) (*connect.Response[qf.Group], error) {
-	group, err := s.createGroup(in.Msg)
+// A student creating a group invites the other members, who must accept before the group can be approved.
+func (s *QuickFeedService) CreateGroup(ctx context.Context, in *connect.Request[qf.Group]) (*connect.Response[qf.Group], error) {
+	group, err := s.createGroup(in.Msg, userID(ctx))
 	if err != nil {
 		s.logger.Errorf("CreateGroup failed: %v", err)
 		if connect.CodeOf(err) != connect.CodeUnknown {
@@ -276,6 +322,10 @@ func (s *QuickFeedService) UpdateGroup(ctx context.Context, in *connect.Request[
 		if ok, parsedErr := parseSCMError(err); ok {
 			return nil, parsedErr
 		}
+		if connect.CodeOf(err) != connect.CodeUnknown {
+			// err was already a status error; return it to client.
+			return nil, err
+		}
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to update group"))
 	}
 	group, err := s.db.GetGroup(in.Msg.ID)
@@ -306,6 +356,120 @@ func (s *QuickFeedService) DeleteGroup(ctx context.Context, in *connect.Request[
 	return &connect.Response[qf.Void]{}, nil
 }
 
+// ProposeGroups proposes groups for the course's students who are not in a group.
+func (s *QuickFeedService) ProposeGroups(_ context.Context, in *connect.Request[qf.GroupFormationRequest]) (*connect.Response[qf.Groups], error) {
+	proposal, err := s.proposeGroups(in.Msg)
+	if err != nil {
+		s.logger.Errorf("ProposeGroups failed for course %d: %v", in.Msg.GetCourseID(), err)
+		if connect.CodeOf(err) != connect.CodeUnknown {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to propose groups"))
+	}
+	return connect.NewResponse(proposal), nil
+}
+
+// CreateGroups creates and approves the given groups, and creates their repositories.
+func (s *QuickFeedService) CreateGroups(ctx context.Context, in *connect.Request[qf.CreateGroupsRequest]) (*connect.Response[qf.Groups], error) {
+	scmClient, err := s.getSCMForCourse(ctx, in.Msg.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("CreateGroups failed: could not create scm client for course %d: %v", in.Msg.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	groups, err := s.createGroups(ctx, scmClient, in.Msg)
+	if err != nil {
+		s.logger.Errorf("CreateGroups failed: %v", err)
+		if ctxErr := ctxErr(ctx); ctxErr != nil {
+			s.logger.Error(ctxErr)
+			return nil, ctxErr
+		}
+		if connect.CodeOf(err) != connect.CodeUnknown {
+			return nil, err
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to create groups"))
+	}
+	return connect.NewResponse(groups), nil
+}
+
+// GetGroupMemberships returns the membership history of the course's groups.
+func (s *QuickFeedService) GetGroupMemberships(_ context.Context, in *connect.Request[qf.CourseRequest]) (*connect.Response[qf.GroupMemberships], error) {
+	memberships, err := s.getGroupMemberships(in.Msg.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetGroupMemberships failed: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get group membership history"))
+	}
+	return connect.NewResponse(memberships), nil
+}
+
+// GetGroupContributions analyzes the commits in a group's repository and returns each member's contributions.
+func (s *QuickFeedService) GetGroupContributions(ctx context.Context, in *connect.Request[qf.ContributionRequest]) (*connect.Response[qf.GroupContributions], error) {
+	scmClient, err := s.getSCMForCourse(ctx, in.Msg.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetGroupContributions failed: could not create scm client for course %d: %v", in.Msg.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	contributions, err := s.getGroupContributions(ctx, scmClient, in.Msg)
+	if err != nil {
+		s.logger.Errorf("GetGroupContributions failed for group %d: %v", in.Msg.GetGroupID(), err)
+		if ctxErr := ctxErr(ctx); ctxErr != nil {
+			s.logger.Error(ctxErr)
+			return nil, ctxErr
+		}
+		if connect.CodeOf(err) != connect.CodeUnknown {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to analyze group contributions"))
+	}
+	return connect.NewResponse(contributions), nil
+}
+
+// GetGroupPreference returns the student's preferred group partners.
+func (s *QuickFeedService) GetGroupPreference(_ context.Context, in *connect.Request[qf.GroupRequest]) (*connect.Response[qf.GroupPreference], error) {
+	preference, err := s.getGroupPreference(in.Msg.GetCourseID(), in.Msg.GetUserID())
+	if err != nil {
+		s.logger.Errorf("GetGroupPreference failed: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get group preference"))
+	}
+	return connect.NewResponse(preference), nil
+}
+
+// UpdateGroupPreference replaces the student's preferred group partners.
+func (s *QuickFeedService) UpdateGroupPreference(_ context.Context, in *connect.Request[qf.GroupPreference]) (*connect.Response[qf.Void], error) {
+	if err := s.updateGroupPreference(in.Msg); err != nil {
+		s.logger.Errorf("UpdateGroupPreference failed: %v", err)
+		if connect.CodeOf(err) != connect.CodeUnknown {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to update group preference"))
+	}
+	return &connect.Response[qf.Void]{}, nil
+}
+
+// GetGroupInvitations returns the student's invitations to groups, and the invitations to the student's group.
+func (s *QuickFeedService) GetGroupInvitations(_ context.Context, in *connect.Request[qf.GroupRequest]) (*connect.Response[qf.GroupInvitations], error) {
+	invitations, err := s.getGroupInvitations(in.Msg.GetCourseID(), in.Msg.GetUserID())
+	if err != nil {
+		s.logger.Errorf("GetGroupInvitations failed: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get group invitations"))
+	}
+	return connect.NewResponse(invitations), nil
+}
+
+// RespondToGroupInvitation accepts or declines the student's invitation to a group.
+func (s *QuickFeedService) RespondToGroupInvitation(_ context.Context, in *connect.Request[qf.GroupInvitation]) (*connect.Response[qf.Void], error) {
+	if err := s.respondToGroupInvitation(in.Msg); err != nil {
+		s.logger.Errorf("RespondToGroupInvitation failed: %v", err)
+		if connect.CodeOf(err) != connect.CodeUnknown {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to respond to group invitation"))
+	}
+	return &connect.Response[qf.Void]{}, nil
+}
+
 // GetSubmission returns a fully populated submission matching the given submission ID if it exists for the given course ID.
 // Used in the frontend to fetch a full submission for a given submission ID and course ID.
 func (s *QuickFeedService) GetSubmission(_ context.Context, in *connect.Request[qf.SubmissionRequest]) (*connect.Response[qf.Submission], error) {
@@ -314,6 +478,12 @@ func (s *QuickFeedService) GetSubmission(_ context.Context, in *connect.Request[
 		s.logger.Errorf("GetSubmission failed: %v", err)
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get submission"))
 	}
+	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: submission.GetAssignmentID()})
+	if err != nil {
+		s.logger.Errorf("GetSubmission failed: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get submission"))
+	}
+	s.setPseudonyms([]*qf.Assignment{assignment}, submission)
 	return connect.NewResponse(submission), nil
 }
 
@@ -326,9 +496,12 @@ func (s *QuickFeedService) GetSubmissions(ctx context.Context, in *connect.Reque
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("no submissions found"))
 	}
 	id := userID(ctx)
-	// If the user is not a teacher, remove score and reviews from submissions that are not released.
-	if !s.isTeacher(id, in.Msg.CourseID) {
+	// If the user is not a teacher or teaching assistant, remove score and reviews from submissions that are not released.
+	if !s.isStaff(id, in.Msg.CourseID) {
 		submissions.Clean(id)
+	} else if err := s.removeAnonymous(in.Msg.GetCourseID(), submissions); err != nil {
+		s.logger.Errorf("GetSubmissions failed: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("no submissions found"))
 	}
 	return connect.NewResponse(submissions), nil
 }
@@ -445,19 +618,308 @@ func (s *QuickFeedService) CreateReview(_ context.Context, in *connect.Request[q
 }
 
 // UpdateReview updates a submission review.
-func (s *QuickFeedService) UpdateReview(_ context.Context, in *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error) {
-	review, err := s.updateReview(in.Msg.Review)
+func (s *QuickFeedService) UpdateReview(ctx context.Context, in *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error) {
+	review, err := s.updateReview(userID(ctx), in.Msg.Review)
 	if err != nil {
 		s.logger.Errorf("UpdateReview failed for review %+v: %v", in, err)
+		if errors.Is(err, ErrFinalReviewUpdate) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to update review"))
 	}
 	return connect.NewResponse(review), nil
 }
 
+// GetLineComments returns the line comments on the given submission.
+// Students only get the line comments on their own submissions after the submission is released.
+func (s *QuickFeedService) GetLineComments(ctx context.Context, in *connect.Request[qf.LineCommentRequest]) (*connect.Response[qf.LineComments], error) {
+	comments, err := s.getLineComments(userID(ctx), in.Msg)
+	if err != nil {
+		s.logger.Errorf("GetLineComments failed for submission %d: %v", in.Msg.GetSubmissionID(), err)
+		if errors.Is(err, ErrLineCommentAccess) {
+			return nil, connect.NewError(connect.CodePermissionDenied, ErrLineCommentAccess)
+		}
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get line comments"))
+	}
+	return connect.NewResponse(comments), nil
+}
+
+// CreateLineComment adds a new line comment on a submission.
+func (s *QuickFeedService) CreateLineComment(ctx context.Context, in *connect.Request[qf.LineComment]) (*connect.Response[qf.LineComment], error) {
+	comment, err := s.createLineComment(ctx, userID(ctx), in.Msg)
+	if err != nil {
+		s.logger.Errorf("CreateLineComment failed for %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to create line comment"))
+	}
+	return connect.NewResponse(comment), nil
+}
+
+// UpdateLineComment updates the body and line range of a line comment.
+func (s *QuickFeedService) UpdateLineComment(_ context.Context, in *connect.Request[qf.LineComment]) (*connect.Response[qf.LineComment], error) {
+	comment, err := s.updateLineComment(in.Msg)
+	if err != nil {
+		s.logger.Errorf("UpdateLineComment failed for %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to update line comment"))
+	}
+	return connect.NewResponse(comment), nil
+}
+
+// DeleteLineComment removes a line comment.
+func (s *QuickFeedService) DeleteLineComment(_ context.Context, in *connect.Request[qf.LineComment]) (*connect.Response[qf.Void], error) {
+	if err := s.deleteLineComment(in.Msg); err != nil {
+		s.logger.Errorf("DeleteLineComment failed for %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to delete line comment"))
+	}
+	return &connect.Response[qf.Void]{}, nil
+}
+
+// GetFeedbackSnippets returns the course's feedback snippets, optionally filtered by tag.
+func (s *QuickFeedService) GetFeedbackSnippets(_ context.Context, in *connect.Request[qf.FeedbackSnippetRequest]) (*connect.Response[qf.FeedbackSnippets], error) {
+	snippets, err := s.getFeedbackSnippets(in.Msg)
+	if err != nil {
+		s.logger.Errorf("GetFeedbackSnippets failed for request %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get feedback snippets"))
+	}
+	return connect.NewResponse(snippets), nil
+}
+
+// CreateFeedbackSnippet adds a new feedback snippet to the course's snippet library.
+func (s *QuickFeedService) CreateFeedbackSnippet(_ context.Context, in *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.FeedbackSnippet], error) {
+	snippet, err := s.createFeedbackSnippet(in.Msg)
+	if err != nil {
+		s.logger.Errorf("CreateFeedbackSnippet failed for %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to create feedback snippet"))
+	}
+	return connect.NewResponse(snippet), nil
+}
+
+// UpdateFeedbackSnippet updates the title, body and tags of a feedback snippet.
+func (s *QuickFeedService) UpdateFeedbackSnippet(_ context.Context, in *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.FeedbackSnippet], error) {
+	snippet, err := s.updateFeedbackSnippet(in.Msg)
+	if err != nil {
+		s.logger.Errorf("UpdateFeedbackSnippet failed for %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to update feedback snippet"))
+	}
+	return connect.NewResponse(snippet), nil
+}
+
+// DeleteFeedbackSnippet removes a feedback snippet from the course's snippet library.
+func (s *QuickFeedService) DeleteFeedbackSnippet(_ context.Context, in *connect.Request[qf.FeedbackSnippet]) (*connect.Response[qf.Void], error) {
+	if err := s.deleteFeedbackSnippet(in.Msg); err != nil {
+		s.logger.Errorf("DeleteFeedbackSnippet failed for %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to delete feedback snippet"))
+	}
+	return &connect.Response[qf.Void]{}, nil
+}
+
+// UseFeedbackSnippet records that a feedback snippet was inserted into a review of the given assignment.
+func (s *QuickFeedService) UseFeedbackSnippet(_ context.Context, in *connect.Request[qf.FeedbackSnippetUsageRequest]) (*connect.Response[qf.FeedbackSnippet], error) {
+	snippet, err := s.useFeedbackSnippet(in.Msg)
+	if err != nil {
+		s.logger.Errorf("UseFeedbackSnippet failed for request %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to use feedback snippet"))
+	}
+	return connect.NewResponse(snippet), nil
+}
+
+// GetRegradeRequests returns the course's regrade requests; students only get their own requests.
+func (s *QuickFeedService) GetRegradeRequests(ctx context.Context, in *connect.Request[qf.RegradeRequestQuery]) (*connect.Response[qf.RegradeRequests], error) {
+	requests, err := s.getRegradeRequests(userID(ctx), in.Msg)
+	if err != nil {
+		s.logger.Errorf("GetRegradeRequests failed for course %d: %v", in.Msg.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get regrade requests"))
+	}
+	return connect.NewResponse(requests), nil
+}
+
+// CreateRegradeRequest creates a regrade request for one of the current user's submissions.
+func (s *QuickFeedService) CreateRegradeRequest(_ context.Context, in *connect.Request[qf.RegradeRequest]) (*connect.Response[qf.RegradeRequest], error) {
+	request, err := s.createRegradeRequest(in.Msg)
+	if err != nil {
+		s.logger.Errorf("CreateRegradeRequest failed for %+v: %v", in.Msg, err)
+		switch {
+		case errors.Is(err, ErrRegradeRequestAccess):
+			return nil, connect.NewError(connect.CodePermissionDenied, ErrRegradeRequestAccess)
+		case errors.Is(err, ErrRegradeRequestOpen):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrRegradeRequestOpen)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to create regrade request"))
+	}
+	return connect.NewResponse(request), nil
+}
+
+// UpdateRegradeRequest accepts, rejects or resolves a regrade request.
+func (s *QuickFeedService) UpdateRegradeRequest(ctx context.Context, in *connect.Request[qf.RegradeRequest]) (*connect.Response[qf.RegradeRequest], error) {
+	request, err := s.updateRegradeRequest(userID(ctx), in.Msg)
+	if err != nil {
+		s.logger.Errorf("UpdateRegradeRequest failed for %+v: %v", in.Msg, err)
+		switch {
+		case errors.Is(err, ErrRegradeRequestStatus):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrRegradeRequestStatus)
+		case errors.Is(err, ErrRegradeRequestAction):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrRegradeRequestAction)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to update regrade request"))
+	}
+	return connect.NewResponse(request), nil
+}
+
+// GetReconciliation returns the disagreements between the submission's ready reviews,
+// and the final review proposed by the assignment's reconcile policy.
+func (s *QuickFeedService) GetReconciliation(_ context.Context, in *connect.Request[qf.ReconcileRequest]) (*connect.Response[qf.Reconciliation], error) {
+	_, reconciliation, err := s.getReconciliation(in.Msg)
+	if err != nil {
+		s.logger.Errorf("GetReconciliation failed for submission %d: %v", in.Msg.GetSubmissionID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get reconciliation"))
+	}
+	return connect.NewResponse(reconciliation), nil
+}
+
+// ReconcileReviews creates the final review for the submission, whose score becomes the submission's score.
+func (s *QuickFeedService) ReconcileReviews(ctx context.Context, in *connect.Request[qf.ReconcileRequest]) (*connect.Response[qf.Review], error) {
+	review, err := s.reconcileReviews(userID(ctx), in.Msg)
+	if err != nil {
+		s.logger.Errorf("ReconcileReviews failed for submission %d: %v", in.Msg.GetSubmissionID(), err)
+		if errors.Is(err, ErrNotReconciler) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		if errors.Is(err, ErrNoReconcilePolicy) || errors.Is(err, ErrNoReadyReviews) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to reconcile reviews"))
+	}
+	return connect.NewResponse(review), nil
+}
+
+// AllocateReviewers allocates the course's teachers and teaching assistants as reviewers to the submissions for the given assignment.
+func (s *QuickFeedService) AllocateReviewers(_ context.Context, in *connect.Request[qf.ReviewAllocationRequest]) (*connect.Response[qf.ReviewAllocations], error) {
+	allocations, err := s.allocateReviewers(in.Msg)
+	if err != nil {
+		s.logger.Errorf("AllocateReviewers failed for request %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to allocate reviewers"))
+	}
+	return connect.NewResponse(allocations), nil
+}
+
+// ReassignReviews moves the given reviewer's pending reviews for the assignment to other reviewers.
+func (s *QuickFeedService) ReassignReviews(_ context.Context, in *connect.Request[qf.ReviewAllocationRequest]) (*connect.Response[qf.ReviewAllocations], error) {
+	allocations, err := s.reassignReviews(in.Msg)
+	if err != nil {
+		s.logger.Errorf("ReassignReviews failed for request %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to reassign reviews"))
+	}
+	return connect.NewResponse(allocations), nil
+}
+
+// GetReviewQueue returns the current user's pending review allocations in the given course.
+func (s *QuickFeedService) GetReviewQueue(ctx context.Context, in *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ReviewAllocations], error) {
+	allocations, err := s.getReviewQueue(in.Msg.GetCourseID(), userID(ctx))
+	if err != nil {
+		s.logger.Errorf("GetReviewQueue failed for course %d: %v", in.Msg.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get review queue"))
+	}
+	return connect.NewResponse(allocations), nil
+}
+
+// GetReviewerLoads returns the number of pull request reviews requested from each reviewer in the given course.
+func (s *QuickFeedService) GetReviewerLoads(_ context.Context, in *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ReviewerLoads], error) {
+	loads, err := s.db.GetReviewerLoads(in.Msg.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetReviewerLoads failed for course %d: %v", in.Msg.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get reviewer loads"))
+	}
+	return connect.NewResponse(&qf.ReviewerLoads{Loads: loads}), nil
+}
+
+// StartPeerReview opens the peer review phase for the assignment after its deadline.
+func (s *QuickFeedService) StartPeerReview(ctx context.Context, in *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error) {
+	peerReviews, err := s.startPeerReview(ctx, in.Msg)
+	if err != nil {
+		s.logger.Errorf("StartPeerReview failed for request %+v: %v", in.Msg, err)
+		if ctxErr := ctxErr(ctx); ctxErr != nil {
+			return nil, ctxErr
+		}
+		if errors.Is(err, ErrPeerReviewBeforeDeadline) || errors.Is(err, ErrPeerReviewStarted) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to start peer review"))
+	}
+	return connect.NewResponse(peerReviews), nil
+}
+
+// EndPeerReview closes the peer review phase for the assignment, revoking the reviewers' access.
+func (s *QuickFeedService) EndPeerReview(ctx context.Context, in *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error) {
+	peerReviews, err := s.endPeerReview(ctx, in.Msg)
+	if err != nil {
+		s.logger.Errorf("EndPeerReview failed for request %+v: %v", in.Msg, err)
+		if ctxErr := ctxErr(ctx); ctxErr != nil {
+			return nil, ctxErr
+		}
+		if ok, parsedErr := parseSCMError(err); ok {
+			return nil, parsedErr
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to end peer review"))
+	}
+	return connect.NewResponse(peerReviews), nil
+}
+
+// GetPeerReviews returns the assignment's peer reviews. Students only get their own peer reviews.
+func (s *QuickFeedService) GetPeerReviews(ctx context.Context, in *connect.Request[qf.PeerReviewRequest]) (*connect.Response[qf.PeerReviews], error) {
+	reviewerID := userID(ctx)
+	if s.isStaff(reviewerID, in.Msg.GetCourseID()) {
+		reviewerID = 0
+	}
+	peerReviews, err := s.getPeerReviews(in.Msg, reviewerID)
+	if err != nil {
+		s.logger.Errorf("GetPeerReviews failed for request %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get peer reviews"))
+	}
+	return connect.NewResponse(peerReviews), nil
+}
+
+// GradePeerReview sets the teacher's assessment of a peer review's quality.
+func (s *QuickFeedService) GradePeerReview(_ context.Context, in *connect.Request[qf.PeerReview]) (*connect.Response[qf.PeerReview], error) {
+	peerReview, err := s.gradePeerReview(in.Msg)
+	if err != nil {
+		s.logger.Errorf("GradePeerReview failed for peer review %d: %v", in.Msg.GetID(), err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to grade peer review"))
+	}
+	return connect.NewResponse(peerReview), nil
+}
+
+// CreatePeerReview creates a peer review of a submission assigned to the current user.
+func (s *QuickFeedService) CreatePeerReview(ctx context.Context, in *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error) {
+	review, err := s.createPeerReview(userID(ctx), in.Msg.GetReview())
+	if err != nil {
+		s.logger.Errorf("CreatePeerReview failed for review %+v: %v", in.Msg, err)
+		if errors.Is(err, ErrPeerReviewNotOpen) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrPeerReviewNotOpen)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to create peer review"))
+	}
+	return connect.NewResponse(review), nil
+}
+
+// UpdatePeerReview updates the current user's peer review of a submission.
+func (s *QuickFeedService) UpdatePeerReview(ctx context.Context, in *connect.Request[qf.ReviewRequest]) (*connect.Response[qf.Review], error) {
+	review, err := s.updatePeerReview(userID(ctx), in.Msg.GetReview())
+	if err != nil {
+		s.logger.Errorf("UpdatePeerReview failed for review %+v: %v", in.Msg, err)
+		if errors.Is(err, ErrPeerReviewNotOpen) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrPeerReviewNotOpen)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to update peer review"))
+	}
+	return connect.NewResponse(review), nil
+}
+
 // UpdateSubmissions approves and/or releases all manual reviews for student submission for the given assignment
 // with the given score.
-func (s *QuickFeedService) UpdateSubmissions(_ context.Context, in *connect.Request[qf.UpdateSubmissionsRequest]) (*connect.Response[qf.Void], error) {
-	err := s.updateSubmissions(in.Msg)
+func (s *QuickFeedService) UpdateSubmissions(ctx context.Context, in *connect.Request[qf.UpdateSubmissionsRequest]) (*connect.Response[qf.Void], error) {
+	err := s.updateSubmissions(userID(ctx), in.Msg)
 	if err != nil {
 		s.logger.Errorf("UpdateSubmissions failed for request %+v: %v", in, err)
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to update submissions"))
@@ -465,6 +927,106 @@ func (s *QuickFeedService) UpdateSubmissions(_ context.Context, in *connect.Requ
 	return &connect.Response[qf.Void]{}, nil
 }
 
+// GetScheduledJobs returns the course's scheduled bulk changes to submissions.
+func (s *QuickFeedService) GetScheduledJobs(_ context.Context, in *connect.Request[qf.CourseRequest]) (*connect.Response[qf.ScheduledJobs], error) {
+	jobs, err := s.getScheduledJobs(in.Msg.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetScheduledJobs failed for course %d: %v", in.Msg.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get scheduled jobs"))
+	}
+	return connect.NewResponse(jobs), nil
+}
+
+// ScheduleJob schedules a bulk change to the assignment's submissions.
+func (s *QuickFeedService) ScheduleJob(_ context.Context, in *connect.Request[qf.ScheduledJob]) (*connect.Response[qf.ScheduledJob], error) {
+	job, err := s.scheduleJob(in.Msg)
+	if err != nil {
+		s.logger.Errorf("ScheduleJob failed for %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to schedule job"))
+	}
+	return connect.NewResponse(job), nil
+}
+
+// CancelScheduledJob removes a scheduled job that has not yet run.
+func (s *QuickFeedService) CancelScheduledJob(_ context.Context, in *connect.Request[qf.ScheduledJob]) (*connect.Response[qf.Void], error) {
+	if err := s.cancelScheduledJob(in.Msg); err != nil {
+		s.logger.Errorf("CancelScheduledJob failed for %+v: %v", in.Msg, err)
+		if errors.Is(err, ErrScheduledJobCompleted) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrScheduledJobCompleted)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to cancel scheduled job"))
+	}
+	return &connect.Response[qf.Void]{}, nil
+}
+
+// GetAuditEntries returns the course's audit log of bulk changes to submissions.
+func (s *QuickFeedService) GetAuditEntries(_ context.Context, in *connect.Request[qf.CourseRequest]) (*connect.Response[qf.AuditEntries], error) {
+	entries, err := s.getAuditEntries(in.Msg.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetAuditEntries failed for course %d: %v", in.Msg.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get audit entries"))
+	}
+	return connect.NewResponse(entries), nil
+}
+
+// GetGradingConfig returns the course's grading configuration.
+func (s *QuickFeedService) GetGradingConfig(_ context.Context, in *connect.Request[qf.CourseRequest]) (*connect.Response[qf.GradingConfig], error) {
+	config, err := s.getGradingConfig(in.Msg.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("GetGradingConfig failed for course %d: %v", in.Msg.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to get grading configuration"))
+	}
+	return connect.NewResponse(config), nil
+}
+
+// UpdateGradingConfig replaces the course's grading configuration.
+func (s *QuickFeedService) UpdateGradingConfig(_ context.Context, in *connect.Request[qf.GradingConfig]) (*connect.Response[qf.GradingConfig], error) {
+	config, err := s.updateGradingConfig(in.Msg)
+	if err != nil {
+		s.logger.Errorf("UpdateGradingConfig failed for %+v: %v", in.Msg, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to update grading configuration"))
+	}
+	return connect.NewResponse(config), nil
+}
+
+// ComputeFinalGrades computes the final grade of each student in the course.
+func (s *QuickFeedService) ComputeFinalGrades(_ context.Context, in *connect.Request[qf.CourseRequest]) (*connect.Response[qf.FinalGrades], error) {
+	grades, err := s.computeFinalGrades(in.Msg.GetCourseID())
+	if err != nil {
+		s.logger.Errorf("ComputeFinalGrades failed for course %d: %v", in.Msg.GetCourseID(), err)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to compute final grades"))
+	}
+	return connect.NewResponse(grades), nil
+}
+
+// ExportGrades returns the final grades of the course's students as a CSV or XLSX file.
+func (s *QuickFeedService) ExportGrades(_ context.Context, in *connect.Request[qf.GradeExportRequest]) (*connect.Response[qf.GradeExport], error) {
+	export, err := s.exportGrades(in.Msg)
+	if err != nil {
+		s.logger.Errorf("ExportGrades failed for course %d: %v", in.Msg.GetCourseID(), err)
+		if connect.CodeOf(err) != connect.CodeUnknown {
+			// err was already a status error; return it to client.
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to export grades"))
+	}
+	return connect.NewResponse(export), nil
+}
+
+// ImportExternalGrades imports the students' scores for the course's external grade components from a CSV file.
+func (s *QuickFeedService) ImportExternalGrades(_ context.Context, in *connect.Request[qf.ExternalGradeImportRequest]) (*connect.Response[qf.ExternalGradeImport], error) {
+	imported, err := s.importExternalGrades(in.Msg)
+	if err != nil {
+		s.logger.Errorf("ImportExternalGrades failed for course %d: %v", in.Msg.GetCourseID(), err)
+		if connect.CodeOf(err) != connect.CodeUnknown {
+			// err was already a status error; return it to client.
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to import external grades"))
+	}
+	return connect.NewResponse(imported), nil
+}
+
 // GetAssignments returns a list of all assignments for the given course.
 func (s *QuickFeedService) GetAssignments(_ context.Context, in *connect.Request[qf.CourseRequest]) (*connect.Response[qf.Assignments], error) {
 	assignments, err := s.getAssignments(in.Msg.GetCourseID())
@@ -504,6 +1066,37 @@ func (s *QuickFeedService) UpdateAssignments(ctx context.Context, in *connect.Re
 	return &connect.Response[qf.Void]{}, nil
 }
 
+// StartQuiz starts a new timed quiz attempt for the given assignment,
+// or returns the user's ongoing attempt if it has not yet been submitted or expired.
+func (s *QuickFeedService) StartQuiz(ctx context.Context, in *connect.Request[qf.QuizRequest]) (*connect.Response[qf.QuizAttempt], error) {
+	attempt, err := s.startQuiz(userID(ctx), in.Msg)
+	if err != nil {
+		s.logger.Errorf("StartQuiz failed for request %+v: %v", in.Msg, err)
+		if errors.Is(err, database.ErrNoQuizAttempts) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, database.ErrNoQuizAttempts)
+		}
+		if errors.Is(err, ErrQuizClosed) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrQuizClosed)
+		}
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("failed to start quiz"))
+	}
+	return connect.NewResponse(attempt), nil
+}
+
+// SubmitQuiz records the answers for the given quiz attempt and returns the resulting submission.
+func (s *QuickFeedService) SubmitQuiz(ctx context.Context, in *connect.Request[qf.QuizSubmission]) (*connect.Response[qf.Submission], error) {
+	submission, err := s.submitQuiz(userID(ctx), in.Msg)
+	if err != nil {
+		s.logger.Errorf("SubmitQuiz failed for attempt %d: %v", in.Msg.GetAttemptID(), err)
+		if errors.Is(err, ErrQuizSubmitted) || errors.Is(err, ErrQuizExpired) || errors.Is(err, ErrQuizClosed) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("failed to submit quiz"))
+	}
+	s.streams.Submission.SendTo(submission, submission.GetUserID())
+	return connect.NewResponse(submission), nil
+}
+
 // GetOrganization fetches a github organization by name.
 func (s *QuickFeedService) GetOrganization(ctx context.Context, in *connect.Request[qf.Organization]) (*connect.Response[qf.Organization], error) {
 	usr, err := s.db.GetUser(userID(ctx))
@@ -606,3 +1199,21 @@ func (s *QuickFeedService) SubmissionStream(ctx context.Context, _ *connect.Requ
 	s.streams.Submission.Add(stream, userID(ctx))
 	return stream.Run()
 }
+
+// RegradeRequestStream adds the created stream to the stream service.
+// The stream is used to notify students about status changes of their regrade requests.
+// The stream is closed when the client disconnects.
+func (s *QuickFeedService) RegradeRequestStream(ctx context.Context, _ *connect.Request[qf.Void], st *connect.ServerStream[qf.RegradeRequest]) error {
+	stream := stream.NewStream(ctx, st)
+	s.streams.RegradeRequest.Add(stream, userID(ctx))
+	return stream.Run()
+}
+
+// GroupInvitationStream adds the created stream to the stream service.
+// The stream is used to notify students about invitations to their groups and changes to them.
+// The stream is closed when the client disconnects.
+func (s *QuickFeedService) GroupInvitationStream(ctx context.Context, _ *connect.Request[qf.Void], st *connect.ServerStream[qf.GroupInvitation]) error {
+	stream := stream.NewStream(ctx, st)
+	s.streams.GroupInvitation.Add(stream, userID(ctx))
+	return stream.Run()
+}
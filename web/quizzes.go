@@ -0,0 +1,137 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/quickfeed/quickfeed/kit/score"
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrQuizSubmitted = errors.New("quiz attempt already submitted")
+	ErrQuizExpired   = errors.New("quiz attempt time limit exceeded")
+	ErrQuizClosed    = errors.New("quiz deadline has passed")
+)
+
+// startQuiz returns the user's ongoing attempt for the quiz of the given assignment,
+// or starts a new attempt if the user has no ongoing attempt.
+// A new attempt cannot be started after the assignment's deadline, and must be
+// submitted before the assignment's deadline, even if the time limit is not exceeded.
+// The returned attempt holds the quiz questions without the correct answers.
+func (s *QuickFeedService) startQuiz(userID uint64, request *qf.QuizRequest) (*qf.QuizAttempt, error) {
+	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: request.GetAssignmentID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assignment %d: %w", request.GetAssignmentID(), err)
+	}
+	quiz, err := s.db.GetQuiz(&qf.Quiz{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quiz for assignment %s: %w", assignment.GetName(), err)
+	}
+	now := time.Now()
+	if assignment.SinceDeadline(now) > 0 {
+		return nil, ErrQuizClosed
+	}
+	attempts, err := s.db.GetQuizAttempts(&qf.QuizAttempt{QuizID: quiz.GetID(), UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quiz attempts for user %d: %w", userID, err)
+	}
+	var attempt *qf.QuizAttempt
+	if n := len(attempts); n > 0 && !attempts[n-1].IsSubmitted() && !attempts[n-1].IsExpired(now) {
+		attempt = attempts[n-1]
+	} else {
+		deadline := now.Add(time.Duration(quiz.GetTimeLimit()) * time.Second)
+		if assignmentDeadline := assignment.GetDeadline().AsTime(); deadline.After(assignmentDeadline) {
+			deadline = assignmentDeadline
+		}
+		attempt = &qf.QuizAttempt{
+			QuizID:   quiz.GetID(),
+			UserID:   userID,
+			Started:  timestamppb.New(now),
+			Deadline: timestamppb.New(deadline),
+		}
+		if err := s.db.CreateQuizAttempt(attempt); err != nil {
+			return nil, fmt.Errorf("failed to start quiz attempt for user %d: %w", userID, err)
+		}
+	}
+	attempt.Quiz = quiz.CloneWithoutAnswers()
+	return attempt, nil
+}
+
+// submitQuiz records the answers for the given quiz attempt, and records the resulting
+// per-question scores as a submission for the quiz's assignment, replacing the results
+// of any previous attempt with a lower score; the best attempt counts. The returned
+// submission holds the results of the best attempt. The submission is auto approved
+// if the assignment allows it. The answers cannot be submitted after the assignment's deadline.
+func (s *QuickFeedService) submitQuiz(userID uint64, request *qf.QuizSubmission) (*qf.Submission, error) {
+	attempts, err := s.db.GetQuizAttempts(&qf.QuizAttempt{ID: request.GetAttemptID(), UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quiz attempt %d: %w", request.GetAttemptID(), err)
+	}
+	if len(attempts) == 0 {
+		return nil, fmt.Errorf("quiz attempt %d not found for user %d", request.GetAttemptID(), userID)
+	}
+	attempt := attempts[0]
+	if attempt.IsSubmitted() {
+		return nil, ErrQuizSubmitted
+	}
+	now := time.Now()
+	if attempt.IsExpired(now) {
+		return nil, ErrQuizExpired
+	}
+	quiz, err := s.db.GetQuiz(&qf.Quiz{ID: attempt.GetQuizID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quiz %d: %w", attempt.GetQuizID(), err)
+	}
+	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: quiz.GetAssignmentID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assignment %d: %w", quiz.GetAssignmentID(), err)
+	}
+	if assignment.SinceDeadline(now) > qf.QuizSubmitGrace {
+		return nil, ErrQuizClosed
+	}
+
+	attempt.Answers = request.GetAnswers()
+	attempt.Submitted = timestamppb.New(now)
+	if err := s.db.UpdateQuizAttempt(attempt); err != nil {
+		return nil, fmt.Errorf("failed to update quiz attempt %d: %w", attempt.GetID(), err)
+	}
+
+	previous, err := s.db.GetSubmission(&qf.Submission{AssignmentID: assignment.GetID(), UserID: userID})
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to get previous submission: %w", err)
+	}
+	if previous == nil {
+		previous = &qf.Submission{Grades: []*qf.Grade{{UserID: userID}}}
+	}
+	results := &score.Results{
+		BuildInfo: &score.BuildInfo{
+			SubmissionDate: attempt.GetSubmitted(),
+			BuildDate:      attempt.GetSubmitted(),
+			BuildLog:       fmt.Sprintf("Quiz attempt %d of %d", attempt.GetAttempt(), quiz.GetMaxAttempts()),
+			ExecTime:       now.Sub(attempt.GetStarted().AsTime()).Milliseconds(),
+		},
+		Scores: quiz.Scores(attempt.GetAnswers()),
+	}
+	submissionScore := results.Sum()
+	if previous.GetID() > 0 && previous.GetScore() > submissionScore {
+		return previous, nil
+	}
+	submission := &qf.Submission{
+		ID:           previous.GetID(),
+		AssignmentID: assignment.GetID(),
+		UserID:       userID,
+		Score:        submissionScore,
+		Grades:       assignment.SubmissionStatus(previous, submissionScore),
+		Released:     previous.GetReleased(),
+		BuildInfo:    results.BuildInfo,
+		Scores:       results.Scores,
+	}
+	if err := s.db.CreateSubmission(submission); err != nil {
+		return nil, fmt.Errorf("failed to record quiz submission for user %d: %w", userID, err)
+	}
+	return submission, nil
+}
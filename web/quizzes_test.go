@@ -0,0 +1,212 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestQuiz(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+
+	student := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, student, course)
+
+	lab := &qf.Assignment{
+		CourseID:    course.ID,
+		Name:        "quiz lab",
+		Order:       1,
+		Deadline:    timestamppb.New(time.Now().Add(time.Hour)),
+		AutoApprove: true,
+		ScoreLimit:  75,
+		Quiz: &qf.Quiz{
+			CourseID:    course.ID,
+			TimeLimit:   60,
+			MaxAttempts: 3,
+			Questions: []*qf.QuizQuestion{
+				{Number: 1, Text: "Pick the primes", Choices: []string{"2", "4", "5"}, Answers: []string{"a", "c"}, Weight: 2},
+				{Number: 2, Text: "Is Go compiled?", Choices: []string{"yes", "no"}, Answers: []string{"a"}, Weight: 2},
+			},
+		},
+	}
+	if err := db.UpdateAssignments([]*qf.Assignment{lab}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	cookie := Cookie(t, tm, student)
+	quizRequest := &qf.QuizRequest{CourseID: course.ID, AssignmentID: lab.ID}
+
+	attempt, err := client.StartQuiz(ctx, qtest.RequestWithCookie(quizRequest, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := attempt.Msg.GetAttempt(); got != 1 {
+		t.Errorf("StartQuiz() attempt = %d, want 1", got)
+	}
+	for _, question := range attempt.Msg.GetQuiz().GetQuestions() {
+		if len(question.GetAnswers()) > 0 {
+			t.Errorf("StartQuiz() revealed answers for question %d", question.GetNumber())
+		}
+	}
+	// starting the quiz again returns the ongoing attempt
+	ongoing, err := client.StartQuiz(ctx, qtest.RequestWithCookie(quizRequest, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ongoing.Msg.GetID() != attempt.Msg.GetID() {
+		t.Errorf("StartQuiz() started attempt %d, want ongoing attempt %d", ongoing.Msg.GetID(), attempt.Msg.GetID())
+	}
+
+	// one of two correct choices for question 1 and the correct choice for question 2
+	quizSubmission := &qf.QuizSubmission{
+		CourseID:  course.ID,
+		AttemptID: attempt.Msg.GetID(),
+		Answers: []*qf.QuizAnswer{
+			{Number: 1, Selected: []string{"a"}},
+			{Number: 2, Selected: []string{"a"}},
+		},
+	}
+	submission, err := client.SubmitQuiz(ctx, qtest.RequestWithCookie(quizSubmission, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := submission.Msg.GetScore(); got != 75 {
+		t.Errorf("SubmitQuiz() score = %d, want 75", got)
+	}
+	if len(submission.Msg.GetScores()) != 2 {
+		t.Errorf("SubmitQuiz() got %d scores, want 2", len(submission.Msg.GetScores()))
+	}
+	if !submission.Msg.IsApproved(student.ID) {
+		t.Error("SubmitQuiz() submission not auto approved")
+	}
+	if _, err := client.SubmitQuiz(ctx, qtest.RequestWithCookie(quizSubmission, cookie)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("SubmitQuiz() again: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	// the second attempt replaces the results of the first attempt in the same submission
+	attempt, err = client.StartQuiz(ctx, qtest.RequestWithCookie(quizRequest, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	quizSubmission.AttemptID = attempt.Msg.GetID()
+	quizSubmission.Answers = []*qf.QuizAnswer{{Number: 1, Selected: []string{"a", "c"}}, {Number: 2, Selected: []string{"a"}}}
+	secondSubmission, err := client.SubmitQuiz(ctx, qtest.RequestWithCookie(quizSubmission, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := secondSubmission.Msg.GetScore(); got != 100 {
+		t.Errorf("SubmitQuiz() score = %d, want 100", got)
+	}
+	if secondSubmission.Msg.GetID() != submission.Msg.GetID() {
+		t.Errorf("SubmitQuiz() created submission %d, want %d", secondSubmission.Msg.GetID(), submission.Msg.GetID())
+	}
+
+	// the third attempt has a lower score and does not replace the results of the second attempt
+	attempt, err = client.StartQuiz(ctx, qtest.RequestWithCookie(quizRequest, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	quizSubmission.AttemptID = attempt.Msg.GetID()
+	quizSubmission.Answers = []*qf.QuizAnswer{{Number: 1, Selected: []string{"b"}}}
+	thirdSubmission, err := client.SubmitQuiz(ctx, qtest.RequestWithCookie(quizSubmission, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := thirdSubmission.Msg.GetScore(); got != 100 {
+		t.Errorf("SubmitQuiz() score = %d, want best score 100", got)
+	}
+	gotSubmission, err := db.GetSubmission(&qf.Submission{ID: submission.Msg.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := gotSubmission.GetScore(); got != 100 {
+		t.Errorf("submission score = %d, want best score 100", got)
+	}
+
+	// no attempts remaining
+	if _, err := client.StartQuiz(ctx, qtest.RequestWithCookie(quizRequest, cookie)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("StartQuiz() with no attempts remaining: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	// another user cannot submit the student's attempt
+	quizSubmission.AttemptID = attempt.Msg.GetID()
+	if _, err := client.SubmitQuiz(ctx, qtest.RequestWithCookie(quizSubmission, Cookie(t, tm, admin))); err == nil {
+		t.Error("SubmitQuiz() succeeded for another user's attempt")
+	}
+}
+
+func TestQuizDeadline(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+
+	student := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, student, course)
+
+	lab := &qf.Assignment{
+		CourseID: course.ID,
+		Name:     "quiz lab",
+		Order:    1,
+		Deadline: timestamppb.New(time.Now().Add(time.Hour)),
+		Quiz: &qf.Quiz{
+			CourseID:    course.ID,
+			TimeLimit:   7200,
+			MaxAttempts: 2,
+			Questions: []*qf.QuizQuestion{
+				{Number: 1, Text: "Is Go compiled?", Choices: []string{"yes", "no"}, Answers: []string{"a"}, Weight: 1},
+			},
+		},
+	}
+	if err := db.UpdateAssignments([]*qf.Assignment{lab}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	cookie := Cookie(t, tm, student)
+	quizRequest := &qf.QuizRequest{CourseID: course.ID, AssignmentID: lab.ID}
+	attempt, err := client.StartQuiz(ctx, qtest.RequestWithCookie(quizRequest, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the attempt must be submitted before the assignment's deadline, even with a longer time limit
+	if got, want := attempt.Msg.GetDeadline().AsTime(), lab.GetDeadline().AsTime(); !got.Equal(want) {
+		t.Errorf("StartQuiz() attempt deadline = %v, want %v", got, want)
+	}
+
+	// the assignment's deadline passes before the attempt is submitted
+	lab.Deadline = timestamppb.New(time.Now().Add(-time.Hour))
+	if err := db.UpdateAssignments([]*qf.Assignment{lab}); err != nil {
+		t.Fatal(err)
+	}
+	quizSubmission := &qf.QuizSubmission{
+		CourseID:  course.ID,
+		AttemptID: attempt.Msg.GetID(),
+		Answers:   []*qf.QuizAnswer{{Number: 1, Selected: []string{"a"}}},
+	}
+	if _, err := client.SubmitQuiz(ctx, qtest.RequestWithCookie(quizSubmission, cookie)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("SubmitQuiz() after deadline: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+	if _, err := client.StartQuiz(ctx, qtest.RequestWithCookie(quizRequest, cookie)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("StartQuiz() after deadline: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+}
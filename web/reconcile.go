@@ -0,0 +1,73 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrNoReconcilePolicy = errors.New("assignment has no reconcile policy")
+	ErrNoReadyReviews    = errors.New("submission has no ready reviews")
+	ErrInvalidFinal      = errors.New("final review does not match the submission's reviews")
+	ErrNotReconciler     = errors.New("only the course creator can reconcile reviews")
+	ErrFinalReviewUpdate = errors.New("only the course creator can update the final review")
+)
+
+// getReconciliation returns the reconciliation of the requested submission's ready reviews.
+func (s *QuickFeedService) getReconciliation(request *qf.ReconcileRequest) (*qf.Assignment, *qf.Reconciliation, error) {
+	submission, err := s.db.GetSubmission(&qf.Submission{ID: request.GetSubmissionID()})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get submission %d: %w", request.GetSubmissionID(), err)
+	}
+	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: submission.GetAssignmentID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get assignment %d: %w", submission.GetAssignmentID(), err)
+	}
+	return assignment, assignment.Reconcile(submission.GetID(), submission.GetReviews()), nil
+}
+
+// reconcileReviews creates the final review for the requested submission according to the assignment's
+// reconcile policy, and sets the submission's score to the final review's score.
+// Only the course creator can reconcile reviews, and with the manual reconcile policy,
+// the final review must be provided by the course creator.
+func (s *QuickFeedService) reconcileReviews(reviewerID uint64, request *qf.ReconcileRequest) (*qf.Review, error) {
+	assignment, reconciliation, err := s.getReconciliation(request)
+	if err != nil {
+		return nil, err
+	}
+	if !s.isCourseCreator(request.GetCourseID(), reviewerID) {
+		return nil, ErrNotReconciler
+	}
+	if assignment.GetReconcilePolicy() == qf.Assignment_NONE {
+		return nil, ErrNoReconcilePolicy
+	}
+	if len(reconciliation.GetReviews()) == 0 {
+		return nil, ErrNoReadyReviews
+	}
+	final := reconciliation.GetFinal()
+	if assignment.GetReconcilePolicy() == qf.Assignment_MANUAL {
+		final = request.GetFinal()
+		if final == nil || !final.HasSameCriteria(reconciliation.GetReviews()[0]) {
+			return nil, ErrInvalidFinal
+		}
+		for _, bm := range final.GetGradingBenchmarks() {
+			bm.ID, bm.ReviewID = 0, 0
+			for _, c := range bm.GetCriteria() {
+				c.ID, c.BenchmarkID = 0, 0
+			}
+		}
+		final.ID = 0
+		final.Ready = true
+		final.ComputeScore()
+	}
+	final.SubmissionID = request.GetSubmissionID()
+	final.ReviewerID = reviewerID
+	final.Edited = timestamppb.Now()
+	if err := s.db.CreateFinalReview(final); err != nil {
+		return nil, fmt.Errorf("failed to create final review for submission %d: %w", request.GetSubmissionID(), err)
+	}
+	return final, nil
+}
@@ -0,0 +1,124 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+)
+
+func TestReconcileReviews(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	teacher := qtest.CreateFakeUser(t, db)
+	qtest.EnrollTeacher(t, db, teacher, course)
+	student := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, student, course)
+	assistant := qtest.CreateFakeUser(t, db)
+	qtest.EnrollUser(t, db, assistant, course, qf.Enrollment_ASSISTANT)
+
+	lab := &qf.Assignment{
+		CourseID:        course.ID,
+		Name:            "lab1",
+		Order:           1,
+		Reviewers:       2,
+		ReconcilePolicy: qf.Assignment_AVERAGE,
+		GradingBenchmarks: []*qf.GradingBenchmark{{
+			CourseID: course.ID,
+			Heading:  "Code",
+			Criteria: []*qf.GradingCriterion{
+				{CourseID: course.ID, Description: "Compiles", Points: 50},
+				{CourseID: course.ID, Description: "Quality", Points: 50, Partial: true},
+			},
+		}},
+	}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &qf.Submission{AssignmentID: lab.ID, UserID: student.ID}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	reconcileRequest := &qf.ReconcileRequest{CourseID: course.ID, SubmissionID: submission.ID}
+	if _, err := client.ReconcileReviews(ctx, qtest.RequestWithCookie(reconcileRequest, Cookie(t, tm, admin))); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("ReconcileReviews() without ready reviews: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	for i, reviewer := range []*qf.User{admin, teacher} {
+		cookie := Cookie(t, tm, reviewer)
+		review, err := client.CreateReview(ctx, qtest.RequestWithCookie(&qf.ReviewRequest{
+			CourseID: course.ID,
+			Review:   &qf.Review{SubmissionID: submission.ID, ReviewerID: reviewer.ID},
+		}, cookie))
+		if err != nil {
+			t.Fatal(err)
+		}
+		criteria := review.Msg.GetGradingBenchmarks()[0].GetCriteria()
+		criteria[0].Grade = qf.GradingCriterion_PASSED
+		criteria[1].Grade = qf.GradingCriterion_PASSED
+		criteria[1].Awarded = uint64(20 + 10*i) // 70 and 80 points
+		review.Msg.Ready = true
+		if _, err := client.UpdateReview(ctx, qtest.RequestWithCookie(&qf.ReviewRequest{CourseID: course.ID, Review: review.Msg}, cookie)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reconciliation, err := client.GetReconciliation(ctx, qtest.RequestWithCookie(reconcileRequest, Cookie(t, tm, admin)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reconciliation.Msg.GetConflicts()) != 1 || reconciliation.Msg.GetConflicts()[0].GetSpread() != 10 {
+		t.Errorf("GetReconciliation() conflicts = %v, want one conflict with spread 10", reconciliation.Msg.GetConflicts())
+	}
+
+	// only the course creator can reconcile reviews
+	if _, err := client.ReconcileReviews(ctx, qtest.RequestWithCookie(reconcileRequest, Cookie(t, tm, teacher))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("ReconcileReviews() by teacher: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+
+	// reconciling twice replaces the final review
+	var final *qf.Review
+	for range 2 {
+		resp, err := client.ReconcileReviews(ctx, qtest.RequestWithCookie(reconcileRequest, Cookie(t, tm, admin)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		final = resp.Msg
+		if !final.GetFinal() || final.GetScore() != 75 {
+			t.Errorf("ReconcileReviews() = (final=%t, score=%d), want (final=true, score=75)", final.GetFinal(), final.GetScore())
+		}
+	}
+
+	// only the course creator can update the final review
+	for _, criterion := range final.GetGradingBenchmarks()[0].GetCriteria() {
+		criterion.Grade = qf.GradingCriterion_PASSED
+		criterion.Awarded = 50
+	}
+	for _, user := range []*qf.User{assistant, teacher} {
+		if _, err := client.UpdateReview(ctx, qtest.RequestWithCookie(&qf.ReviewRequest{CourseID: course.ID, Review: final}, Cookie(t, tm, user))); connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("UpdateReview() of final review by user %d: got %v, want %v", user.GetID(), err, connect.CodePermissionDenied)
+		}
+	}
+	gotSubmission, err := db.GetSubmission(&qf.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotSubmission.GetScore() != 75 {
+		t.Errorf("submission score = %d, want 75", gotSubmission.GetScore())
+	}
+	if len(gotSubmission.GetReviews()) != 3 {
+		t.Errorf("submission has %d reviews, want 2 reviews and 1 final review", len(gotSubmission.GetReviews()))
+	}
+}
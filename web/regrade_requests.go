@@ -0,0 +1,122 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrRegradeRequestAccess = errors.New("regrade requests can only be made for your own submissions")
+	ErrRegradeRequestOpen   = errors.New("the submission already has an open regrade request")
+	ErrRegradeRequestStatus = errors.New("the regrade request cannot change to the requested status")
+	ErrRegradeRequestAction = errors.New("only resolving a regrade request can rebuild or review the submission")
+)
+
+// getRegradeRequests returns the course's regrade requests matching the query.
+// Teachers and teaching assistants get all regrade requests; students only get their own regrade requests.
+func (s *QuickFeedService) getRegradeRequests(userID uint64, query *qf.RegradeRequestQuery) (*qf.RegradeRequests, error) {
+	filter := &qf.RegradeRequest{CourseID: query.GetCourseID(), SubmissionID: query.GetSubmissionID()}
+	if !s.isStaff(userID, query.GetCourseID()) {
+		filter.RequesterID = userID
+	}
+	requests, err := s.db.GetRegradeRequests(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get regrade requests for course %d: %w", query.GetCourseID(), err)
+	}
+	return &qf.RegradeRequests{Requests: requests}, nil
+}
+
+// createRegradeRequest creates a pending regrade request for the requester's submission.
+// A submission can only have one open regrade request at a time.
+func (s *QuickFeedService) createRegradeRequest(request *qf.RegradeRequest) (*qf.RegradeRequest, error) {
+	if strings.TrimSpace(request.GetReason()) == "" {
+		return nil, errors.New("regrade request must have a reason")
+	}
+	submission, err := s.getCourseSubmission(request.GetCourseID(), request.GetSubmissionID())
+	if err != nil {
+		return nil, err
+	}
+	if !submission.BelongsTo(request.GetRequesterID()) {
+		return nil, ErrRegradeRequestAccess
+	}
+	requests, err := s.db.GetRegradeRequests(&qf.RegradeRequest{SubmissionID: submission.GetID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get regrade requests for submission %d: %w", submission.GetID(), err)
+	}
+	for _, r := range requests {
+		if r.IsOpen() {
+			return nil, ErrRegradeRequestOpen
+		}
+	}
+	request.ID = 0
+	request.Status = qf.RegradeRequest_PENDING
+	request.ResolverID = 0
+	request.Resolution = ""
+	request.Action = qf.RegradeRequest_NONE
+	request.Created = timestamppb.Now()
+	request.Updated = request.Created
+	if err := s.db.CreateRegradeRequest(request); err != nil {
+		return nil, fmt.Errorf("failed to create regrade request: %w", err)
+	}
+	return request, nil
+}
+
+// updateRegradeRequest changes the status of the given regrade request on behalf of the resolver,
+// and notifies the requester about the change. Resolving the request performs the request's action.
+func (s *QuickFeedService) updateRegradeRequest(resolverID uint64, request *qf.RegradeRequest) (*qf.RegradeRequest, error) {
+	requests, err := s.db.GetRegradeRequests(&qf.RegradeRequest{ID: request.GetID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get regrade request %d: %w", request.GetID(), err)
+	}
+	if request.GetID() == 0 || len(requests) != 1 {
+		return nil, fmt.Errorf("regrade request %d not found in course %d", request.GetID(), request.GetCourseID())
+	}
+	stored := requests[0]
+	if !stored.CanTransitionTo(request.GetStatus()) {
+		return nil, ErrRegradeRequestStatus
+	}
+	if request.GetAction() != qf.RegradeRequest_NONE {
+		if request.GetStatus() != qf.RegradeRequest_RESOLVED {
+			return nil, ErrRegradeRequestAction
+		}
+		if err := s.regrade(resolverID, stored, request.GetAction()); err != nil {
+			return nil, err
+		}
+	}
+	stored.Status = request.GetStatus()
+	stored.ResolverID = resolverID
+	stored.Resolution = request.GetResolution()
+	stored.Action = request.GetAction()
+	stored.Updated = timestamppb.Now()
+	if err := s.db.UpdateRegradeRequest(stored); err != nil {
+		return nil, fmt.Errorf("failed to update regrade request %d: %w", stored.GetID(), err)
+	}
+	s.streams.RegradeRequest.SendTo(stored, stored.GetRequesterID())
+	return stored, nil
+}
+
+// regrade reconsiders the regrade request's submission by either running
+// the submission's tests again or creating a new review by the resolver.
+func (s *QuickFeedService) regrade(resolverID uint64, request *qf.RegradeRequest, action qf.RegradeRequest_Action) error {
+	submission, err := s.getCourseSubmission(request.GetCourseID(), request.GetSubmissionID())
+	if err != nil {
+		return err
+	}
+	switch action {
+	case qf.RegradeRequest_REBUILD:
+		return s.rebuildSubmission(&qf.RebuildRequest{
+			CourseID:     request.GetCourseID(),
+			AssignmentID: submission.GetAssignmentID(),
+			SubmissionID: submission.GetID(),
+		})
+	case qf.RegradeRequest_REVIEW:
+		// The new review comes in addition to the assignment's number of reviews.
+		_, err := s.addReview(submission, &qf.Review{ReviewerID: resolverID})
+		return err
+	}
+	return fmt.Errorf("unknown regrade action %v", action)
+}
@@ -0,0 +1,133 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestRegradeRequests(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	student := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, student, course)
+	otherStudent := qtest.CreateFakeUser(t, db)
+	qtest.EnrollStudent(t, db, otherStudent, course)
+
+	lab := &qf.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	submission := &qf.Submission{AssignmentID: lab.ID, UserID: student.ID, Score: 60, Released: true}
+	if err := db.CreateSubmission(submission); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateReview(&qf.Review{SubmissionID: submission.ID, ReviewerID: admin.ID, Ready: true, Score: 60}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	teacherCookie := Cookie(t, tm, admin)
+	studentCookie := Cookie(t, tm, student)
+	otherStudentCookie := Cookie(t, tm, otherStudent)
+
+	request := &qf.RegradeRequest{CourseID: course.ID, SubmissionID: submission.ID, RequesterID: student.ID, Reason: "Task 3 was graded against the wrong output."}
+	if _, err := client.CreateRegradeRequest(ctx, qtest.RequestWithCookie(request, otherStudentCookie)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("CreateRegradeRequest() on behalf of another student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	otherRequest := &qf.RegradeRequest{CourseID: course.ID, SubmissionID: submission.ID, RequesterID: otherStudent.ID, Reason: "I want a better score."}
+	if _, err := client.CreateRegradeRequest(ctx, qtest.RequestWithCookie(otherRequest, otherStudentCookie)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("CreateRegradeRequest() for another student's submission: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	created, err := client.CreateRegradeRequest(ctx, qtest.RequestWithCookie(request, studentCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Msg.GetStatus() != qf.RegradeRequest_PENDING || created.Msg.GetCreated() == nil {
+		t.Errorf("CreateRegradeRequest() = %v, want pending request", created.Msg)
+	}
+	if _, err := client.CreateRegradeRequest(ctx, qtest.RequestWithCookie(request, studentCookie)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("CreateRegradeRequest() with open request: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+
+	query := &qf.RegradeRequestQuery{CourseID: course.ID}
+	teacherRequests, err := client.GetRegradeRequests(ctx, qtest.RequestWithCookie(query, teacherCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*qf.RegradeRequest{created.Msg}, teacherRequests.Msg.GetRequests(), protocmp.Transform()); diff != "" {
+		t.Errorf("GetRegradeRequests() by teacher mismatch (-want +got):\n%s", diff)
+	}
+	otherStudentRequests, err := client.GetRegradeRequests(ctx, qtest.RequestWithCookie(query, otherStudentCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(otherStudentRequests.Msg.GetRequests()) != 0 {
+		t.Errorf("GetRegradeRequests() by other student = %v, want no requests", otherStudentRequests.Msg.GetRequests())
+	}
+
+	accept := &qf.RegradeRequest{ID: created.Msg.GetID(), CourseID: course.ID, SubmissionID: submission.ID, Status: qf.RegradeRequest_ACCEPTED, Action: qf.RegradeRequest_REVIEW}
+	if _, err := client.UpdateRegradeRequest(ctx, qtest.RequestWithCookie(accept, studentCookie)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("UpdateRegradeRequest() by student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	if _, err := client.UpdateRegradeRequest(ctx, qtest.RequestWithCookie(accept, teacherCookie)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("UpdateRegradeRequest() with action on accept: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+	accept.Action = qf.RegradeRequest_NONE
+	accepted, err := client.UpdateRegradeRequest(ctx, qtest.RequestWithCookie(accept, teacherCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accepted.Msg.GetStatus() != qf.RegradeRequest_ACCEPTED || accepted.Msg.GetResolverID() != admin.ID {
+		t.Errorf("UpdateRegradeRequest() = %v, want request accepted by %d", accepted.Msg, admin.ID)
+	}
+
+	// resolving the request with a new review exceeds the assignment's number of reviewers
+	resolve := &qf.RegradeRequest{ID: created.Msg.GetID(), CourseID: course.ID, SubmissionID: submission.ID, Status: qf.RegradeRequest_RESOLVED, Action: qf.RegradeRequest_REVIEW, Resolution: "Task 3 will be reviewed again."}
+	resolved, err := client.UpdateRegradeRequest(ctx, qtest.RequestWithCookie(resolve, teacherCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.Msg.GetStatus() != qf.RegradeRequest_RESOLVED || resolved.Msg.GetResolution() != resolve.GetResolution() {
+		t.Errorf("UpdateRegradeRequest() = %v, want resolved request", resolved.Msg)
+	}
+	gotSubmission, err := db.GetSubmission(&qf.Submission{ID: submission.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gotSubmission.GetReviews()) != 2 {
+		t.Errorf("submission has %d reviews after regrade, want 2", len(gotSubmission.GetReviews()))
+	}
+
+	// resolved requests are final
+	resolve.Status = qf.RegradeRequest_REJECTED
+	resolve.Action = qf.RegradeRequest_NONE
+	if _, err := client.UpdateRegradeRequest(ctx, qtest.RequestWithCookie(resolve, teacherCookie)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("UpdateRegradeRequest() for resolved request: got %v, want %v", err, connect.CodeFailedPrecondition)
+	}
+	studentRequests, err := client.GetRegradeRequests(ctx, qtest.RequestWithCookie(query, studentCookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*qf.RegradeRequest{resolved.Msg}, studentRequests.Msg.GetRequests(), protocmp.Transform()); diff != "" {
+		t.Errorf("GetRegradeRequests() by student mismatch (-want +got):\n%s", diff)
+	}
+
+	// a new regrade request can be made once the previous one is resolved
+	if _, err := client.CreateRegradeRequest(ctx, qtest.RequestWithCookie(request, studentCookie)); err != nil {
+		t.Errorf("CreateRegradeRequest() after resolve: %v", err)
+	}
+}
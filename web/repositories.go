@@ -34,7 +34,7 @@ func repoTypes(enrollment *qf.Enrollment) []qf.Repository_Type {
 		qf.Repository_ASSIGNMENTS,
 		qf.Repository_USER,
 	}
-	if enrollment.IsTeacher() {
+	if enrollment.IsStaff() {
 		repositories = append(repositories, qf.Repository_TESTS)
 	}
 	if enrollment.GroupID > 0 {
@@ -0,0 +1,169 @@
+package web
+
+import (
+	"fmt"
+
+	"github.com/quickfeed/quickfeed/assignments"
+	"github.com/quickfeed/quickfeed/qf"
+)
+
+// allocateReviewers allocates the course's teachers and teaching assistants as reviewers to the submissions for the given assignment,
+// such that each submission gets the assignment's number of reviewers. Existing allocations are kept.
+// Returns all review allocations for the assignment.
+func (s *QuickFeedService) allocateReviewers(request *qf.ReviewAllocationRequest) (*qf.ReviewAllocations, error) {
+	assignment, submissions, allocations, err := s.getReviewAllocationState(request)
+	if err != nil {
+		return nil, err
+	}
+	reviewers, err := s.getReviewers(request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	created, err := assignments.AllocateReviewers(assignment, submissions, reviewers, allocations)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.UpdateReviewAllocations(nil, created); err != nil {
+		return nil, fmt.Errorf("failed to save review allocations for assignment %s: %w", assignment.GetName(), err)
+	}
+	return &qf.ReviewAllocations{Allocations: append(allocations, created...)}, nil
+}
+
+// reassignReviews moves the given reviewer's pending review allocations for the assignment
+// to the course's other teachers and teaching assistants. Allocations for reviews already completed by the reviewer are kept,
+// while the reviewer's unfinished reviews of the reassigned submissions are deleted.
+// Returns all review allocations for the assignment.
+func (s *QuickFeedService) reassignReviews(request *qf.ReviewAllocationRequest) (*qf.ReviewAllocations, error) {
+	assignment, submissions, allocations, err := s.getReviewAllocationState(request)
+	if err != nil {
+		return nil, err
+	}
+	staff, err := s.getReviewers(request.GetCourseID())
+	if err != nil {
+		return nil, err
+	}
+	var reviewers []*qf.User
+	for _, reviewer := range staff {
+		if reviewer.GetID() != request.GetReviewerID() {
+			reviewers = append(reviewers, reviewer)
+		}
+	}
+	pending := make(map[uint64]bool)
+	for _, allocation := range assignments.PendingAllocations(submissions, allocations) {
+		pending[allocation.GetID()] = true
+	}
+	var deleted, kept []*qf.ReviewAllocation
+	for _, allocation := range allocations {
+		if allocation.GetReviewerID() == request.GetReviewerID() && pending[allocation.GetID()] {
+			deleted = append(deleted, allocation)
+		} else {
+			kept = append(kept, allocation)
+		}
+	}
+	created, err := assignments.AllocateReviewers(assignment, submissions, reviewers, kept)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.deleteUnfinishedReviews(submissions, deleted); err != nil {
+		return nil, err
+	}
+	if err := s.db.UpdateReviewAllocations(deleted, created); err != nil {
+		return nil, fmt.Errorf("failed to save review allocations for assignment %s: %w", assignment.GetName(), err)
+	}
+	return &qf.ReviewAllocations{Allocations: append(kept, created...)}, nil
+}
+
+// deleteUnfinishedReviews deletes the unfinished reviews created by the reviewers of the given allocations,
+// such that the reviews do not count toward the assignment's number of reviewers.
+func (s *QuickFeedService) deleteUnfinishedReviews(submissions []*qf.Submission, allocations []*qf.ReviewAllocation) error {
+	allocated := make(map[uint64]map[uint64]bool) // [submissionID][reviewerID]
+	for _, allocation := range allocations {
+		if allocated[allocation.GetSubmissionID()] == nil {
+			allocated[allocation.GetSubmissionID()] = make(map[uint64]bool)
+		}
+		allocated[allocation.GetSubmissionID()][allocation.GetReviewerID()] = true
+	}
+	for _, submission := range submissions {
+		for _, review := range submission.GetReviews() {
+			if review.GetReady() || review.GetFinal() || review.GetPeer() || !allocated[submission.GetID()][review.GetReviewerID()] {
+				continue
+			}
+			if err := s.db.DeleteReview(&qf.Review{ID: review.GetID()}); err != nil {
+				return fmt.Errorf("failed to delete unfinished review %d of submission %d: %w", review.GetID(), submission.GetID(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// getReviewers returns the course's teachers, followed by its teaching assistants.
+func (s *QuickFeedService) getReviewers(courseID uint64) ([]*qf.User, error) {
+	reviewers, err := s.db.GetCourseTeachers(&qf.Course{ID: courseID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewers for course %d: %w", courseID, err)
+	}
+	assistants, err := s.db.GetEnrollmentsByCourse(courseID, qf.Enrollment_ASSISTANT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teaching assistants for course %d: %w", courseID, err)
+	}
+	for _, assistant := range assistants {
+		reviewers = append(reviewers, assistant.GetUser())
+	}
+	return reviewers, nil
+}
+
+// getReviewQueue returns the review allocations in the given course for which
+// the user has not yet completed a review.
+func (s *QuickFeedService) getReviewQueue(courseID, userID uint64) (*qf.ReviewAllocations, error) {
+	allocations, err := s.db.GetReviewAllocations(&qf.ReviewAllocation{CourseID: courseID, ReviewerID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review allocations for user %d: %w", userID, err)
+	}
+	submissions, err := s.db.GetCourseSubmissions(courseID, qf.SubmissionRequest_ALL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get submissions for course %d: %w", courseID, err)
+	}
+	return &qf.ReviewAllocations{Allocations: assignments.PendingAllocations(submissions, allocations)}, nil
+}
+
+// getReviewAllocationState returns the requested assignment, its submissions and its review allocations.
+func (s *QuickFeedService) getReviewAllocationState(request *qf.ReviewAllocationRequest) (*qf.Assignment, []*qf.Submission, []*qf.ReviewAllocation, error) {
+	assignment, err := s.db.GetAssignment(&qf.Assignment{ID: request.GetAssignmentID(), CourseID: request.GetCourseID()})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get assignment %d: %w", request.GetAssignmentID(), err)
+	}
+	submissions, err := s.getAssignmentSubmissions(assignment)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	allocations, err := s.db.GetReviewAllocations(&qf.ReviewAllocation{AssignmentID: assignment.GetID()})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get review allocations for assignment %s: %w", assignment.GetName(), err)
+	}
+	return assignment, submissions, allocations, nil
+}
+
+// getAssignmentSubmissions returns the submissions for the given assignment.
+func (s *QuickFeedService) getAssignmentSubmissions(assignment *qf.Assignment) ([]*qf.Submission, error) {
+	courseSubmissions, err := s.db.GetCourseSubmissions(assignment.GetCourseID(), qf.SubmissionRequest_ALL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get submissions for course %d: %w", assignment.GetCourseID(), err)
+	}
+	var submissions []*qf.Submission
+	for _, submission := range courseSubmissions {
+		if submission.GetAssignmentID() == assignment.GetID() {
+			submissions = append(submissions, submission)
+		}
+	}
+	return submissions, nil
+}
+
+// isAllocated returns true if the reviewer is among the given allocations.
+func isAllocated(allocations []*qf.ReviewAllocation, reviewerID uint64) bool {
+	for _, allocation := range allocations {
+		if allocation.GetReviewerID() == reviewerID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+)
+
+func TestAllocateAndReassignReviewers(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	teacher := qtest.CreateFakeUser(t, db)
+	qtest.EnrollTeacher(t, db, teacher, course)
+
+	lab := &qf.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 4; i++ {
+		student := qtest.CreateFakeUser(t, db)
+		qtest.EnrollStudent(t, db, student, course)
+		if err := db.CreateSubmission(&qf.Submission{AssignmentID: lab.ID, UserID: student.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+	request := &qf.ReviewAllocationRequest{CourseID: course.ID, AssignmentID: lab.ID}
+	allocations, err := client.AllocateReviewers(ctx, qtest.RequestWithCookie(request, Cookie(t, tm, admin)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	load := make(map[uint64]int)
+	for _, allocation := range allocations.Msg.GetAllocations() {
+		load[allocation.GetReviewerID()]++
+	}
+	if load[admin.ID] != 2 || load[teacher.ID] != 2 {
+		t.Errorf("AllocateReviewers() load = %v, want 2 submissions for each reviewer", load)
+	}
+
+	// allocating again keeps the existing allocations
+	again, err := client.AllocateReviewers(ctx, qtest.RequestWithCookie(request, Cookie(t, tm, admin)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Msg.GetAllocations()) != 4 {
+		t.Errorf("AllocateReviewers() again got %d allocations, want 4", len(again.Msg.GetAllocations()))
+	}
+
+	queue, err := client.GetReviewQueue(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, Cookie(t, tm, teacher)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue.Msg.GetAllocations()) != 2 {
+		t.Errorf("GetReviewQueue() got %d allocations, want 2", len(queue.Msg.GetAllocations()))
+	}
+
+	// the teacher drops out; the admin takes over the teacher's pending reviews
+	request.ReviewerID = teacher.ID
+	if _, err := client.ReassignReviews(ctx, qtest.RequestWithCookie(request, Cookie(t, tm, admin))); err != nil {
+		t.Fatal(err)
+	}
+	queue, err = client.GetReviewQueue(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, Cookie(t, tm, teacher)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue.Msg.GetAllocations()) != 0 {
+		t.Errorf("GetReviewQueue() after reassignment got %d allocations, want 0", len(queue.Msg.GetAllocations()))
+	}
+	queue, err = client.GetReviewQueue(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, Cookie(t, tm, admin)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue.Msg.GetAllocations()) != 4 {
+		t.Errorf("GetReviewQueue() after reassignment got %d allocations, want 4", len(queue.Msg.GetAllocations()))
+	}
+
+	// reviewers that are not allocated to a submission cannot review it
+	submission := queue.Msg.GetAllocations()[0].GetSubmissionID()
+	reviewRequest := &qf.ReviewRequest{CourseID: course.ID, Review: &qf.Review{SubmissionID: submission, ReviewerID: teacher.ID}}
+	if _, err := client.CreateReview(ctx, qtest.RequestWithCookie(reviewRequest, Cookie(t, tm, teacher))); err == nil {
+		t.Error("CreateReview() succeeded for reviewer that is not allocated")
+	}
+}
+
+func TestReassignReviewsWithUnfinishedReview(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockOrgs())
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	teacher := qtest.CreateFakeUser(t, db)
+	qtest.EnrollTeacher(t, db, teacher, course)
+
+	lab := &qf.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		student := qtest.CreateFakeUser(t, db)
+		qtest.EnrollStudent(t, db, student, course)
+		if err := db.CreateSubmission(&qf.Submission{AssignmentID: lab.ID, UserID: student.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+	request := &qf.ReviewAllocationRequest{CourseID: course.ID, AssignmentID: lab.ID}
+	if _, err := client.AllocateReviewers(ctx, qtest.RequestWithCookie(request, Cookie(t, tm, admin))); err != nil {
+		t.Fatal(err)
+	}
+	queue, err := client.GetReviewQueue(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, Cookie(t, tm, teacher)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue.Msg.GetAllocations()) != 1 {
+		t.Fatalf("GetReviewQueue() got %d allocations, want 1", len(queue.Msg.GetAllocations()))
+	}
+
+	// the teacher starts reviewing the allocated submission, but drops out before the review is ready
+	submissionID := queue.Msg.GetAllocations()[0].GetSubmissionID()
+	reviewRequest := &qf.ReviewRequest{CourseID: course.ID, Review: &qf.Review{SubmissionID: submissionID, ReviewerID: teacher.ID}}
+	unfinished, err := client.CreateReview(ctx, qtest.RequestWithCookie(reviewRequest, Cookie(t, tm, teacher)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.ReviewerID = teacher.ID
+	if _, err := client.ReassignReviews(ctx, qtest.RequestWithCookie(request, Cookie(t, tm, admin))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetReview(&qf.Review{ID: unfinished.Msg.GetID()}); err == nil {
+		t.Error("GetReview() found the dropped reviewer's unfinished review, want it deleted")
+	}
+
+	// the newly allocated reviewer can create the submission's review
+	reviewRequest.Review = &qf.Review{SubmissionID: submissionID, ReviewerID: admin.ID}
+	if _, err := client.CreateReview(ctx, qtest.RequestWithCookie(reviewRequest, Cookie(t, tm, admin))); err != nil {
+		t.Errorf("CreateReview() by the newly allocated reviewer failed: %v", err)
+	}
+	submission, err := db.GetSubmission(&qf.Submission{ID: submissionID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(submission.GetReviews()) != 1 || submission.GetReviews()[0].GetReviewerID() != admin.ID {
+		t.Errorf("submission reviews = %v, want one review by reviewer %d", submission.GetReviews(), admin.ID)
+	}
+}
@@ -0,0 +1,98 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+)
+
+// importRoster replaces the course's institution roster with the students in the CSV file,
+// and enrolls the users with pending enrollments that match the roster as students,
+// unless the enrollments are waitlisted.
+// Enrollments that fail to be approved remain pending, such that a teacher can approve them.
+func (s *QuickFeedService) importRoster(ctx context.Context, sc scm.SCM, request *qf.RosterImportRequest) (*qf.RosterCoverage, error) {
+	entries, err := parseRoster(request.GetData())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.UpdateRoster(request.GetCourseID(), entries); err != nil {
+		return nil, fmt.Errorf("failed to update roster for course %d: %w", request.GetCourseID(), err)
+	}
+	pending, err := s.db.GetEnrollmentsByCourse(request.GetCourseID(), qf.Enrollment_PENDING)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending enrollments for course %d: %w", request.GetCourseID(), err)
+	}
+	for _, enrollment := range pending {
+		if enrollment.GetWaitlisted() || !onRoster(entries, enrollment.GetUser()) {
+			continue
+		}
+		if err := s.enrollStudent(ctx, sc, enrollment); err != nil {
+			s.logger.Errorf("Failed to enroll roster student %q in course %d: %v", enrollment.GetUser().GetLogin(), request.GetCourseID(), err)
+		}
+	}
+	return s.getRosterCoverage(request.GetCourseID())
+}
+
+// parseRoster returns the roster entries of the CSV file. Each row of the file has a student ID,
+// an email and an optional GitHub login; a header row without an email address is skipped.
+func parseRoster(data []byte) ([]*qf.RosterEntry, error) {
+	rows, err := readCSV(data)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to read CSV roster: %w", err))
+	}
+	var entries []*qf.RosterEntry
+	rowOf := make(map[string]int)
+	for i, row := range rows {
+		studentID, email, login := csvField(row, 0), csvField(row, 1), csvField(row, 2)
+		if (studentID == "" && email == "" && login == "") || (i == 0 && !strings.Contains(email, "@")) {
+			// skip empty rows and the header row
+			continue
+		}
+		if studentID == "" || email == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("roster row %d has no student ID or email", i+1))
+		}
+		if row, ok := rowOf[studentID]; ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("roster rows %d and %d have the same student ID %s", row, i+1, studentID))
+		}
+		rowOf[studentID] = i + 1
+		entries = append(entries, &qf.RosterEntry{StudentID: studentID, Email: email, Login: login})
+	}
+	return entries, nil
+}
+
+// getRosterCoverage returns the students on the course's roster that have not signed up,
+// and the pending enrollments that do not match the roster.
+func (s *QuickFeedService) getRosterCoverage(courseID uint64) (*qf.RosterCoverage, error) {
+	entries, err := s.db.GetRoster(courseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roster for course %d: %w", courseID, err)
+	}
+	enrollments, err := s.db.GetEnrollmentsByCourse(courseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enrollments for course %d: %w", courseID, err)
+	}
+	coverage := &qf.RosterCoverage{Entries: uint32(len(entries))}
+	for _, entry := range entries {
+		if slices.ContainsFunc(enrollments, func(enrollment *qf.Enrollment) bool { return entry.Matches(enrollment.GetUser()) }) {
+			coverage.SignedUp++
+		} else {
+			coverage.NotSignedUp = append(coverage.NotSignedUp, entry)
+		}
+	}
+	for _, enrollment := range enrollments {
+		if enrollment.IsPending() && !onRoster(entries, enrollment.GetUser()) {
+			coverage.Unmatched = append(coverage.Unmatched, enrollment)
+		}
+	}
+	return coverage, nil
+}
+
+// onRoster returns true if the user matches one of the roster entries.
+func onRoster(entries []*qf.RosterEntry, user *qf.User) bool {
+	return slices.ContainsFunc(entries, func(entry *qf.RosterEntry) bool { return entry.Matches(user) })
+}
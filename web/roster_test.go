@@ -0,0 +1,97 @@
+package web_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/go-cmp/cmp"
+	"github.com/quickfeed/quickfeed/database"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"github.com/quickfeed/quickfeed/web"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestImportRoster(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+
+	admin := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "admin", Login: "admin"})
+	client, tm := web.MockClientWithOption(t, db, scm.WithMockCourses())
+
+	ctx := context.Background()
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+	cookie := Cookie(t, tm, admin)
+
+	alice := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Alice", Login: "alice", StudentID: "1001", Email: "alice@uis.no", EmailVerified: true})
+	bob := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Bob", Login: "bob", StudentID: "1002", Email: "bob@example.com"})
+	// mallory claims carol's student ID and email, but the email is not verified
+	mallory := qtest.CreateFakeCustomUser(t, db, &qf.User{Name: "Mallory", Login: "mallory", StudentID: "1003", Email: "carol@uis.no"})
+
+	// alice signs up before the roster is imported
+	if _, err := client.CreateEnrollment(ctx, qtest.RequestWithCookie(&qf.Enrollment{CourseID: course.ID, UserID: alice.ID}, Cookie(t, tm, alice))); err != nil {
+		t.Fatal(err)
+	}
+	checkEnrollmentStatus(t, db, course.ID, alice.ID, qf.Enrollment_PENDING)
+
+	roster := "Student ID,Email,GitHub\n1001,alice@uis.no,\n1002,bob@uis.no,Bob\n1003,carol@uis.no\n"
+	request := &qf.RosterImportRequest{CourseID: course.ID, Data: []byte(roster)}
+	if _, err := client.ImportRoster(ctx, qtest.RequestWithCookie(request, Cookie(t, tm, alice))); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("ImportRoster() by student: got %v, want %v", err, connect.CodePermissionDenied)
+	}
+	coverage, err := client.ImportRoster(ctx, qtest.RequestWithCookie(request, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEnrollmentStatus(t, db, course.ID, alice.ID, qf.Enrollment_STUDENT)
+	wantCoverage := &qf.RosterCoverage{
+		Entries:  3,
+		SignedUp: 1,
+		NotSignedUp: []*qf.RosterEntry{
+			{CourseID: course.ID, StudentID: "1002", Email: "bob@uis.no", Login: "Bob"},
+			{CourseID: course.ID, StudentID: "1003", Email: "carol@uis.no"},
+		},
+	}
+	if diff := cmp.Diff(wantCoverage, coverage.Msg, protocmp.Transform(), protocmp.IgnoreFields(&qf.RosterEntry{}, "ID")); diff != "" {
+		t.Errorf("ImportRoster() mismatch (-want +got):\n%s", diff)
+	}
+
+	// bob matches the roster by login, while mallory does not match the roster
+	for _, user := range []*qf.User{bob, mallory} {
+		if _, err := client.CreateEnrollment(ctx, qtest.RequestWithCookie(&qf.Enrollment{CourseID: course.ID, UserID: user.ID}, Cookie(t, tm, user))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkEnrollmentStatus(t, db, course.ID, bob.ID, qf.Enrollment_STUDENT)
+	checkEnrollmentStatus(t, db, course.ID, mallory.ID, qf.Enrollment_PENDING)
+
+	coverage, err = client.GetRosterCoverage(ctx, qtest.RequestWithCookie(&qf.CourseRequest{CourseID: course.ID}, cookie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if coverage.Msg.GetSignedUp() != 2 || len(coverage.Msg.GetNotSignedUp()) != 1 || coverage.Msg.GetNotSignedUp()[0].GetStudentID() != "1003" {
+		t.Errorf("GetRosterCoverage() = %v, want 2 signed up and student 1003 not signed up", coverage.Msg)
+	}
+	if len(coverage.Msg.GetUnmatched()) != 1 || coverage.Msg.GetUnmatched()[0].GetUserID() != mallory.ID {
+		t.Errorf("GetRosterCoverage() unmatched = %v, want only %s", coverage.Msg.GetUnmatched(), mallory.Name)
+	}
+
+	request = &qf.RosterImportRequest{CourseID: course.ID, Data: []byte("1001,alice@uis.no\n1001,bob@uis.no\n")}
+	if _, err := client.ImportRoster(ctx, qtest.RequestWithCookie(request, cookie)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("ImportRoster() with duplicate student ID: got %v, want %v", err, connect.CodeInvalidArgument)
+	}
+}
+
+func checkEnrollmentStatus(t *testing.T, db database.Database, courseID, userID uint64, want qf.Enrollment_UserStatus) {
+	t.Helper()
+	enrollment, err := db.GetEnrollmentByCourseAndUser(courseID, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrollment.GetStatus() != want {
+		t.Errorf("enrollment status of user %d = %v, want %v", userID, enrollment.GetStatus(), want)
+	}
+}
@@ -0,0 +1,147 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/quickfeed/quickfeed/qf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrScheduledJobCompleted = errors.New("the scheduled job has already run")
+
+// RunScheduler runs the scheduled jobs that are due and expires group invitations
+// at the given interval until the context is canceled.
+// Since scheduled jobs are stored in the database, jobs that became due
+// while the server was down are run when the scheduler starts.
+func (s *QuickFeedService) RunScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		now := time.Now()
+		s.runScheduledJobs(now)
+		s.expireGroupInvitations(now)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runScheduledJobs runs all pending jobs that are due at the given time.
+func (s *QuickFeedService) runScheduledJobs(now time.Time) {
+	jobs, err := s.db.GetPendingScheduledJobs()
+	if err != nil {
+		s.logger.Errorf("Failed to get scheduled jobs: %v", err)
+		return
+	}
+	for _, job := range jobs {
+		assignment, err := s.db.GetAssignment(&qf.Assignment{ID: job.GetAssignmentID()})
+		if err != nil {
+			s.logger.Errorf("Failed to get assignment %d for scheduled job %d: %v", job.GetAssignmentID(), job.GetID(), err)
+			continue
+		}
+		if !job.IsDue(assignment, now) {
+			continue
+		}
+		if err := s.runScheduledJob(job, now); err != nil {
+			// The job remains pending and is retried at the next run.
+			s.logger.Errorf("Scheduled job %d for assignment %d failed: %v", job.GetID(), job.GetAssignmentID(), err)
+		}
+	}
+}
+
+// runScheduledJob performs the job's bulk change, records the change in the
+// course's audit log and marks the job as completed.
+func (s *QuickFeedService) runScheduledJob(job *qf.ScheduledJob, now time.Time) error {
+	entry := &qf.AuditEntry{
+		CourseID:       job.GetCourseID(),
+		AssignmentID:   job.GetAssignmentID(),
+		ScheduledJobID: job.GetID(),
+	}
+	switch job.GetType() {
+	case qf.ScheduledJob_RELEASE:
+		released, err := s.db.ReleaseReviewedSubmissions(job.GetAssignmentID())
+		if err != nil {
+			return err
+		}
+		entry.Change = "released submissions with a ready review"
+		entry.Submissions = uint32(released)
+	case qf.ScheduledJob_GRADE:
+		approved, rejected, err := s.db.GradeSubmissions(job.GetAssignmentID(), job.GetScoreLimit())
+		if err != nil {
+			return err
+		}
+		entry.Change = fmt.Sprintf("approved %d submissions with score >= %d and rejected %d submissions", approved, job.GetScoreLimit(), rejected)
+		entry.Submissions = uint32(approved + rejected)
+	default:
+		return fmt.Errorf("unknown scheduled job type %v", job.GetType())
+	}
+	job.Completed = timestamppb.New(now)
+	if err := s.db.SaveScheduledJob(job); err != nil {
+		return err
+	}
+	s.audit(entry)
+	return nil
+}
+
+// getScheduledJobs returns the course's scheduled jobs.
+func (s *QuickFeedService) getScheduledJobs(courseID uint64) (*qf.ScheduledJobs, error) {
+	jobs, err := s.db.GetScheduledJobs(&qf.ScheduledJob{CourseID: courseID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled jobs for course %d: %w", courseID, err)
+	}
+	return &qf.ScheduledJobs{Jobs: jobs}, nil
+}
+
+// scheduleJob schedules the given job for the assignment,
+// replacing the assignment's earlier job of the same type, if any.
+func (s *QuickFeedService) scheduleJob(job *qf.ScheduledJob) (*qf.ScheduledJob, error) {
+	if _, err := s.db.GetAssignment(&qf.Assignment{ID: job.GetAssignmentID(), CourseID: job.GetCourseID()}); err != nil {
+		return nil, fmt.Errorf("failed to get assignment %d in course %d: %w", job.GetAssignmentID(), job.GetCourseID(), err)
+	}
+	job.Completed = nil
+	if err := s.db.SaveScheduledJob(job); err != nil {
+		return nil, fmt.Errorf("failed to schedule job for assignment %d: %w", job.GetAssignmentID(), err)
+	}
+	return job, nil
+}
+
+// cancelScheduledJob removes the given job, unless it has already run.
+func (s *QuickFeedService) cancelScheduledJob(job *qf.ScheduledJob) error {
+	jobs, err := s.db.GetScheduledJobs(&qf.ScheduledJob{ID: job.GetID(), CourseID: job.GetCourseID()})
+	if err != nil {
+		return fmt.Errorf("failed to get scheduled job %d: %w", job.GetID(), err)
+	}
+	if job.GetID() == 0 || len(jobs) != 1 {
+		return fmt.Errorf("scheduled job %d not found in course %d", job.GetID(), job.GetCourseID())
+	}
+	if jobs[0].GetCompleted() != nil {
+		return ErrScheduledJobCompleted
+	}
+	if err := s.db.DeleteScheduledJob(jobs[0]); err != nil {
+		return fmt.Errorf("failed to delete scheduled job %d: %w", job.GetID(), err)
+	}
+	return nil
+}
+
+// getAuditEntries returns the course's audit log.
+func (s *QuickFeedService) getAuditEntries(courseID uint64) (*qf.AuditEntries, error) {
+	entries, err := s.db.GetAuditEntries(&qf.AuditEntry{CourseID: courseID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit entries for course %d: %w", courseID, err)
+	}
+	return &qf.AuditEntries{Entries: entries}, nil
+}
+
+// audit records the given bulk change in the course's audit log.
+// The change has already been made, so failing to record it is only logged.
+func (s *QuickFeedService) audit(entry *qf.AuditEntry) {
+	entry.Created = timestamppb.Now()
+	if err := s.db.CreateAuditEntry(entry); err != nil {
+		s.logger.Errorf("Failed to record audit entry %+v: %v", entry, err)
+	}
+}
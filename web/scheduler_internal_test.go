@@ -0,0 +1,131 @@
+package web
+
+import (
+	"testing"
+	"time"
+
+	"github.com/quickfeed/quickfeed/ci"
+	"github.com/quickfeed/quickfeed/database"
+	"github.com/quickfeed/quickfeed/internal/qtest"
+	"github.com/quickfeed/quickfeed/qf"
+	"github.com/quickfeed/quickfeed/scm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRunScheduledJobs(t *testing.T) {
+	db, cleanup := qtest.TestDB(t)
+	defer cleanup()
+	s := NewQuickFeedService(qtest.Logger(t).Desugar(), db, scm.MockManager(t, scm.WithMockOrgs()), BaseHookOptions{}, &ci.Local{})
+
+	admin := qtest.CreateFakeUser(t, db)
+	course := qtest.MockCourses[0]
+	qtest.CreateCourse(t, db, admin, course)
+
+	deadline := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	lab := &qf.Assignment{CourseID: course.ID, Name: "lab1", Order: 1, Reviewers: 1, Deadline: timestamppb.New(deadline)}
+	if err := db.CreateAssignment(lab); err != nil {
+		t.Fatal(err)
+	}
+	scores := []uint32{90, 50, 80}
+	statuses := []qf.Submission_Status{qf.Submission_NONE, qf.Submission_NONE, qf.Submission_REVISION}
+	var submissions []*qf.Submission
+	for i, score := range scores {
+		student := qtest.CreateFakeUser(t, db)
+		qtest.EnrollStudent(t, db, student, course)
+		submission := &qf.Submission{
+			AssignmentID: lab.ID,
+			UserID:       student.ID,
+			Score:        score,
+			Grades:       []*qf.Grade{{UserID: student.ID, Status: statuses[i]}},
+		}
+		if err := db.CreateSubmission(submission); err != nil {
+			t.Fatal(err)
+		}
+		submissions = append(submissions, submission)
+	}
+	// only the first submission has a ready review
+	if err := db.CreateReview(&qf.Review{SubmissionID: submissions[0].ID, ReviewerID: admin.ID, Ready: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateReview(&qf.Review{SubmissionID: submissions[1].ID, ReviewerID: admin.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	releaseAt := deadline.Add(24 * time.Hour)
+	if _, err := s.scheduleJob(&qf.ScheduledJob{CourseID: course.ID, AssignmentID: lab.ID, Type: qf.ScheduledJob_RELEASE, RunAt: timestamppb.New(releaseAt)}); err != nil {
+		t.Fatal(err)
+	}
+	// rescheduling replaces the earlier grade job
+	if _, err := s.scheduleJob(&qf.ScheduledJob{CourseID: course.ID, AssignmentID: lab.ID, Type: qf.ScheduledJob_GRADE, GraceHours: 12, ScoreLimit: 60}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.scheduleJob(&qf.ScheduledJob{CourseID: course.ID, AssignmentID: lab.ID, Type: qf.ScheduledJob_GRADE, GraceHours: 48, ScoreLimit: 80}); err != nil {
+		t.Fatal(err)
+	}
+	if jobs, err := s.getScheduledJobs(course.ID); err != nil || len(jobs.GetJobs()) != 2 {
+		t.Fatalf("getScheduledJobs() = %v, %v, want 2 jobs", jobs, err)
+	}
+
+	// the grade job is not yet due 12 hours after the deadline
+	s.runScheduledJobs(deadline.Add(12 * time.Hour))
+	checkGrades(t, db, submissions, []qf.Submission_Status{qf.Submission_NONE, qf.Submission_NONE, qf.Submission_REVISION})
+
+	s.runScheduledJobs(releaseAt)
+	checkReleased(t, db, submissions, []bool{true, false, false})
+	checkGrades(t, db, submissions, []qf.Submission_Status{qf.Submission_NONE, qf.Submission_NONE, qf.Submission_REVISION})
+
+	s.runScheduledJobs(deadline.Add(48 * time.Hour))
+	checkGrades(t, db, submissions, []qf.Submission_Status{qf.Submission_APPROVED, qf.Submission_REJECTED, qf.Submission_REVISION})
+
+	// completed jobs are not run again
+	s.runScheduledJobs(deadline.Add(72 * time.Hour))
+	entries, err := s.getAuditEntries(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantSubmissions := []uint32{1, 2}
+	if len(entries.GetEntries()) != len(wantSubmissions) {
+		t.Fatalf("getAuditEntries() = %v, want %d entries", entries.GetEntries(), len(wantSubmissions))
+	}
+	for i, entry := range entries.GetEntries() {
+		if entry.GetScheduledJobID() == 0 || entry.GetSubmissions() != wantSubmissions[i] {
+			t.Errorf("audit entry %d = %v, want scheduled change of %d submissions", i, entry, wantSubmissions[i])
+		}
+	}
+
+	jobs, err := s.getScheduledJobs(course.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.cancelScheduledJob(jobs.GetJobs()[0]); err != ErrScheduledJobCompleted {
+		t.Errorf("cancelScheduledJob() for completed job: got %v, want %v", err, ErrScheduledJobCompleted)
+	}
+}
+
+func checkReleased(t *testing.T, db database.Database, submissions []*qf.Submission, want []bool) {
+	t.Helper()
+	for i, submission := range submissions {
+		got, err := db.GetSubmission(&qf.Submission{ID: submission.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.GetReleased() != want[i] {
+			t.Errorf("submission %d released = %t, want %t", submission.ID, got.GetReleased(), want[i])
+		}
+	}
+}
+
+func checkGrades(t *testing.T, db database.Database, submissions []*qf.Submission, want []qf.Submission_Status) {
+	t.Helper()
+	for i, submission := range submissions {
+		got, err := db.GetSubmission(&qf.Submission{ID: submission.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, grade := range got.GetGrades() {
+			if grade.GetStatus() != want[i] {
+				t.Errorf("submission %d status = %v, want %v", submission.ID, grade.GetStatus(), want[i])
+			}
+		}
+	}
+}
@@ -12,13 +12,17 @@ import (
 // To add a new service, add a new field to this struct and
 // initialize the service in the NewStreamServices function.
 type StreamServices struct {
-	Submission *Service[uint64, qf.Submission]
+	Submission      *Service[uint64, qf.Submission]
+	RegradeRequest  *Service[uint64, qf.RegradeRequest]
+	GroupInvitation *Service[uint64, qf.GroupInvitation]
 }
 
 // NewStreamServices creates a new StreamServices.
 func NewStreamServices() *StreamServices {
 	return &StreamServices{
-		Submission: NewService[uint64, qf.Submission](),
+		Submission:      NewService[uint64, qf.Submission](),
+		RegradeRequest:  NewService[uint64, qf.RegradeRequest](),
+		GroupInvitation: NewService[uint64, qf.GroupInvitation](),
 	}
 }
 
@@ -17,8 +17,9 @@ func (s *QuickFeedService) updateUser(curUser *qf.User, request *qf.User) (*qf.U
 	if request.StudentID != "" {
 		updateUser.StudentID = request.StudentID
 	}
-	if request.Email != "" {
+	if request.Email != "" && request.Email != updateUser.Email {
 		updateUser.Email = request.Email
+		updateUser.EmailVerified = false
 	}
 	if request.AvatarURL != "" {
 		updateUser.AvatarURL = request.AvatarURL